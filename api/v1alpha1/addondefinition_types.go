@@ -17,7 +17,11 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"fmt"
+	"slices"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/version"
 )
 
 // AddonCategory defines the category of an addon for UI grouping.
@@ -151,6 +155,43 @@ type AddonChartSpec struct {
 	// Used for version dropdown in UI. If empty, only defaultVersion shown.
 	// +optional
 	AvailableVersions []string `json:"availableVersions,omitempty"`
+
+	// Compatibility records the Kubernetes and Butler versions each chart
+	// version was tested with. Entries must name defaultVersion or one of
+	// availableVersions. Admission warns when a TenantAddon installs a
+	// combination that is not listed; if empty, no warnings are raised.
+	// +optional
+	// +listType=map
+	// +listMapKey=version
+	Compatibility []AddonVersionCompatibility `json:"compatibility,omitempty"`
+}
+
+// AddonVersionCompatibility lists the versions a chart version was tested with.
+type AddonVersionCompatibility struct {
+	// Version is the chart version.
+	// +kubebuilder:validation:Required
+	Version string `json:"version"`
+
+	// KubernetesVersions is the tested Kubernetes version range.
+	// +kubebuilder:validation:Required
+	KubernetesVersions VersionRange `json:"kubernetesVersions"`
+
+	// ButlerVersions is the tested Butler version range. If unset, the
+	// chart version is tested with every Butler release.
+	// +optional
+	ButlerVersions *VersionRange `json:"butlerVersions,omitempty"`
+}
+
+// VersionRange is an inclusive range of versions. A bound given as
+// major.minor (e.g., "v1.32") covers every patch release of that minor.
+type VersionRange struct {
+	// Min is the lowest version in the range.
+	// +optional
+	Min string `json:"min,omitempty"`
+
+	// Max is the highest version in the range.
+	// +optional
+	Max string `json:"max,omitempty"`
 }
 
 // AddonDefaults provides default installation settings.
@@ -209,7 +250,7 @@ type AddonLinks struct {
 }
 
 // +kubebuilder:object:root=true
-// +kubebuilder:resource:scope=Cluster,shortName=ad;adddef
+// +kubebuilder:resource:scope=Cluster,shortName=ad;adddef,categories=butler
 // +kubebuilder:printcolumn:name="Display Name",type="string",JSONPath=".spec.displayName",description="Human-readable name"
 // +kubebuilder:printcolumn:name="Category",type="string",JSONPath=".spec.category",description="Addon category"
 // +kubebuilder:printcolumn:name="Chart",type="string",JSONPath=".spec.chart.name",description="Helm chart name"
@@ -282,3 +323,137 @@ func (a *AddonDefinition) GetEffectiveTier() string {
 	}
 	return string(AddonTierApps)
 }
+
+// Versions returns the default version followed by the other available versions.
+func (a *AddonDefinition) Versions() []string {
+	versions := []string{a.Spec.Chart.DefaultVersion}
+	for _, v := range a.Spec.Chart.AvailableVersions {
+		if !slices.Contains(versions, v) {
+			versions = append(versions, v)
+		}
+	}
+	return versions
+}
+
+// GetCompatibility returns the compatibility entry for the chart version, or nil.
+func (a *AddonDefinition) GetCompatibility(chartVersion string) *AddonVersionCompatibility {
+	for i := range a.Spec.Chart.Compatibility {
+		if a.Spec.Chart.Compatibility[i].Version == chartVersion {
+			return &a.Spec.Chart.Compatibility[i]
+		}
+	}
+	return nil
+}
+
+// CheckCompatibility returns an error if the chart version has not been
+// tested with the Kubernetes version and, when butlerVersion is not empty,
+// the Butler version. Definitions without compatibility data are not checked.
+func (a *AddonDefinition) CheckCompatibility(chartVersion, kubernetesVersion, butlerVersion string) error {
+	if len(a.Spec.Chart.Compatibility) == 0 {
+		return nil
+	}
+	c := a.GetCompatibility(chartVersion)
+	if c == nil {
+		return fmt.Errorf("%s %s has no tested Kubernetes versions", a.Name, chartVersion)
+	}
+	ok, err := c.KubernetesVersions.Contains(kubernetesVersion)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("%s %s is not tested with Kubernetes %s (tested: %s)", a.Name, chartVersion, kubernetesVersion, c.KubernetesVersions)
+	}
+	if butlerVersion == "" || c.ButlerVersions == nil {
+		return nil
+	}
+	ok, err = c.ButlerVersions.Contains(butlerVersion)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("%s %s is not tested with Butler %s (tested: %s)", a.Name, chartVersion, butlerVersion, c.ButlerVersions)
+	}
+	return nil
+}
+
+// TestedVersions returns the chart versions tested with the Kubernetes
+// version and, when butlerVersion is not empty, the Butler version, in
+// the order returned by Versions.
+func (a *AddonDefinition) TestedVersions(kubernetesVersion, butlerVersion string) []string {
+	var out []string
+	for _, v := range a.Versions() {
+		if a.GetCompatibility(v) != nil && a.CheckCompatibility(v, kubernetesVersion, butlerVersion) == nil {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// Contains returns true if v is within the range. An empty bound is open.
+func (r *VersionRange) Contains(v string) (bool, error) {
+	got, err := version.ParseGeneric(v)
+	if err != nil {
+		return false, fmt.Errorf("invalid version %q: %w", v, err)
+	}
+	if r.Min != "" {
+		min, err := version.ParseGeneric(r.Min)
+		if err != nil {
+			return false, fmt.Errorf("invalid range minimum %q: %w", r.Min, err)
+		}
+		if got.LessThan(min) {
+			return false, nil
+		}
+	}
+	if r.Max != "" {
+		max, err := version.ParseGeneric(r.Max)
+		if err != nil {
+			return false, fmt.Errorf("invalid range maximum %q: %w", r.Max, err)
+		}
+		if len(max.Components()) == 2 {
+			// A major.minor bound covers every patch release.
+			got = version.MajorMinor(got.Major(), got.Minor())
+		}
+		if max.LessThan(got) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Validate returns an error if a bound does not parse or Min exceeds Max.
+func (r *VersionRange) Validate() error {
+	var min, max *version.Version
+	var err error
+	if r.Min != "" {
+		if min, err = version.ParseGeneric(r.Min); err != nil {
+			return fmt.Errorf("invalid minimum %q: %w", r.Min, err)
+		}
+	}
+	if r.Max != "" {
+		if max, err = version.ParseGeneric(r.Max); err != nil {
+			return fmt.Errorf("invalid maximum %q: %w", r.Max, err)
+		}
+	}
+	if min == nil || max == nil {
+		return nil
+	}
+	if len(max.Components()) == 2 {
+		min = version.MajorMinor(min.Major(), min.Minor())
+	}
+	if max.LessThan(min) {
+		return fmt.Errorf("minimum %s is above maximum %s", r.Min, r.Max)
+	}
+	return nil
+}
+
+// String formats the range as "min - max", with "*" for an open bound.
+func (r VersionRange) String() string {
+	min, max := r.Min, r.Max
+	if min == "" {
+		min = "*"
+	}
+	if max == "" {
+		max = "*"
+	}
+	return min + " - " + max
+}
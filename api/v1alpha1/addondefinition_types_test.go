@@ -18,7 +18,11 @@ package v1alpha1
 
 import (
 	"encoding/json"
+	"slices"
+	"strings"
 	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestGetEffectiveTier(t *testing.T) {
@@ -133,3 +137,78 @@ func TestIconDataField(t *testing.T) {
 		})
 	}
 }
+
+func TestAddonDefinitionCheckCompatibility(t *testing.T) {
+	a := &AddonDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "cilium"},
+		Spec: AddonDefinitionSpec{Chart: AddonChartSpec{
+			DefaultVersion:    "1.16.5",
+			AvailableVersions: []string{"1.17.0", "1.15.9"},
+			Compatibility: []AddonVersionCompatibility{
+				{Version: "1.17.0", KubernetesVersions: VersionRange{Min: "v1.30"}, ButlerVersions: &VersionRange{Min: "v0.9.0"}},
+				{Version: "1.16.5", KubernetesVersions: VersionRange{Min: "v1.29", Max: "v1.32"}},
+			},
+		}},
+	}
+
+	tests := []struct {
+		name                  string
+		chart, kubernetes, bv string
+		wantErr               string
+	}{
+		{"within range", "1.16.5", "v1.31.4", "", ""},
+		{"major.minor max covers patches", "1.16.5", "v1.32.9", "", ""},
+		{"above range", "1.16.5", "v1.33.0", "", "not tested with Kubernetes v1.33.0 (tested: v1.29 - v1.32)"},
+		{"below range", "1.16.5", "v1.28.15", "", "not tested with Kubernetes"},
+		{"open max", "1.17.0", "v1.40.0", "", ""},
+		{"butler version tested", "1.17.0", "v1.31.4", "v0.10.2", ""},
+		{"butler version untested", "1.17.0", "v1.31.4", "v0.8.0", "not tested with Butler v0.8.0"},
+		{"no entry", "1.15.9", "v1.31.4", "", "no tested Kubernetes versions"},
+		{"invalid version", "1.16.5", "latest", "", "invalid version"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := a.CheckCompatibility(tt.chart, tt.kubernetes, tt.bv)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("CheckCompatibility() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("CheckCompatibility() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	if got := a.TestedVersions("v1.31.4", "v0.8.0"); !slices.Equal(got, []string{"1.16.5"}) {
+		t.Errorf("TestedVersions() = %v, want [1.16.5]", got)
+	}
+	if got := a.TestedVersions("v1.31.4", ""); !slices.Equal(got, []string{"1.16.5", "1.17.0"}) {
+		t.Errorf("TestedVersions() = %v, want [1.16.5 1.17.0]", got)
+	}
+
+	a.Spec.Chart.Compatibility = nil
+	if err := a.CheckCompatibility("9.9.9", "v1.31.4", ""); err != nil {
+		t.Errorf("CheckCompatibility() without data error = %v, want nil", err)
+	}
+}
+
+func TestVersionRangeValidate(t *testing.T) {
+	tests := []struct {
+		r       VersionRange
+		wantErr bool
+	}{
+		{VersionRange{}, false},
+		{VersionRange{Min: "v1.29", Max: "v1.32"}, false},
+		{VersionRange{Min: "v1.32.4", Max: "v1.32"}, false},
+		{VersionRange{Min: "v1.32.4", Max: "v1.32.1"}, true},
+		{VersionRange{Min: "v1.33", Max: "v1.32"}, true},
+		{VersionRange{Min: "latest"}, true},
+	}
+	for _, tt := range tests {
+		if err := tt.r.Validate(); (err != nil) != tt.wantErr {
+			t.Errorf("%s Validate() error = %v, wantErr %v", tt.r, err, tt.wantErr)
+		}
+	}
+}
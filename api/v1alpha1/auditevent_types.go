@@ -0,0 +1,244 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AuditAction is the kind of change recorded by an AuditEvent.
+// +kubebuilder:validation:Enum=Create;Update;Delete;Scale;Upgrade;Restore
+type AuditAction string
+
+const (
+	// AuditActionCreate records object creation.
+	AuditActionCreate AuditAction = "Create"
+
+	// AuditActionUpdate records a spec change not covered by a more
+	// specific action.
+	AuditActionUpdate AuditAction = "Update"
+
+	// AuditActionDelete records object deletion.
+	AuditActionDelete AuditAction = "Delete"
+
+	// AuditActionScale records a change to worker or node pool replicas.
+	AuditActionScale AuditAction = "Scale"
+
+	// AuditActionUpgrade records a Kubernetes or OS version change.
+	AuditActionUpgrade AuditAction = "Upgrade"
+
+	// AuditActionRestore records a restore from backup.
+	AuditActionRestore AuditAction = "Restore"
+)
+
+// AuditOutcome is the result of an audited operation.
+// +kubebuilder:validation:Enum=Succeeded;Denied;Failed
+type AuditOutcome string
+
+const (
+	// AuditOutcomeSucceeded indicates the operation was admitted.
+	AuditOutcomeSucceeded AuditOutcome = "Succeeded"
+
+	// AuditOutcomeDenied indicates the operation was rejected by
+	// authorization, admission, or an approval policy.
+	AuditOutcomeDenied AuditOutcome = "Denied"
+
+	// AuditOutcomeFailed indicates the operation was attempted but failed.
+	AuditOutcomeFailed AuditOutcome = "Failed"
+)
+
+const (
+	// MaxAuditFieldChanges is the number of field changes kept per record.
+	MaxAuditFieldChanges = 50
+
+	// maxAuditValueLength bounds each recorded old and new value.
+	maxAuditValueLength = 256
+
+	// DefaultAuditEventRetention is how long AuditEvents are kept when
+	// ButlerConfig.spec.audit.eventRetention is unset.
+	DefaultAuditEventRetention = 90 * 24 * time.Hour
+)
+
+// AuditFieldChange is a single field change in an audit diff summary.
+type AuditFieldChange struct {
+	// Field is the JSON path of the changed field (e.g., "spec.workers.replicas").
+	// +kubebuilder:validation:Required
+	Field string `json:"field"`
+
+	// From is the old value, formatted for display. Empty when the field
+	// was unset or is a structured field.
+	// +optional
+	// +kubebuilder:validation:MaxLength=256
+	From string `json:"from,omitempty"`
+
+	// To is the new value, formatted for display. Empty when the field
+	// was removed or is a structured field.
+	// +optional
+	// +kubebuilder:validation:MaxLength=256
+	To string `json:"to,omitempty"`
+}
+
+// AuditRecord describes who did what to which resource, and when.
+type AuditRecord struct {
+	// Actor is the authenticated user or service account that performed
+	// the operation.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Actor string `json:"actor"`
+
+	// Action is the kind of change.
+	// +kubebuilder:validation:Required
+	Action AuditAction `json:"action"`
+
+	// ResourceRef identifies the changed object.
+	// +kubebuilder:validation:Required
+	ResourceRef ChangeTargetReference `json:"resourceRef"`
+
+	// Team is the name of the Team owning the resource, if any.
+	// +optional
+	Team string `json:"team,omitempty"`
+
+	// Timestamp is when the operation was performed.
+	// +kubebuilder:validation:Required
+	Timestamp metav1.Time `json:"timestamp"`
+
+	// Outcome is the result of the operation.
+	// +kubebuilder:default="Succeeded"
+	// +optional
+	Outcome AuditOutcome `json:"outcome,omitempty"`
+
+	// Summary is a one-line human-readable description of the change
+	// (e.g., "scaled workers from 3 to 5").
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	Summary string `json:"summary,omitempty"`
+
+	// Changes summarizes the changed fields, for updates.
+	// +optional
+	// +kubebuilder:validation:MaxItems=50
+	Changes []AuditFieldChange `json:"changes,omitempty"`
+
+	// ChangesTruncated is true if more fields changed than are listed.
+	// +optional
+	ChangesTruncated bool `json:"changesTruncated,omitempty"`
+
+	// ChangeRequest is the name of the approved ChangeRequest that
+	// authorized the operation, if any.
+	// +optional
+	ChangeRequest string `json:"changeRequest,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=ae,categories=butler
+// +kubebuilder:printcolumn:name="Actor",type="string",JSONPath=".spec.actor",description="User who performed the operation"
+// +kubebuilder:printcolumn:name="Action",type="string",JSONPath=".spec.action",description="Action"
+// +kubebuilder:printcolumn:name="Kind",type="string",JSONPath=".spec.resourceRef.kind",description="Resource kind"
+// +kubebuilder:printcolumn:name="Resource",type="string",JSONPath=".spec.resourceRef.name",description="Resource name"
+// +kubebuilder:printcolumn:name="Outcome",type="string",JSONPath=".spec.outcome",description="Outcome",priority=1
+// +kubebuilder:printcolumn:name="Time",type="date",JSONPath=".spec.timestamp",description="When the operation was performed"
+
+// AuditEvent is an immutable record of a change to a Butler resource.
+// butler-server creates one per audited operation in the namespace of the
+// changed resource, or in the platform namespace for cluster-scoped
+// resources, so teams can list the history of their clusters with
+// "kubectl get auditevents -l butler.butlerlabs.dev/tenant=<name>".
+// Events are garbage collected after ButlerConfig.spec.audit.eventRetention.
+type AuditEvent struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="audit events are immutable"
+	Spec AuditRecord `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AuditEventList contains a list of AuditEvent.
+type AuditEventList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AuditEvent `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AuditEvent{}, &AuditEventList{})
+}
+
+// Helper methods
+
+// NewAuditEvent returns an AuditEvent for the record in namespace. The
+// event uses a generated name prefixed with the resource kind and name,
+// and is labeled for selection by team, tenant cluster, and action.
+func NewAuditEvent(namespace string, rec AuditRecord) *AuditEvent {
+	prefix := strings.ToLower(rec.ResourceRef.Kind) + "-" + rec.ResourceRef.Name
+	if len(prefix) > 200 {
+		prefix = prefix[:200]
+	}
+	labels := map[string]string{
+		LabelAuditAction: string(rec.Action),
+		LabelAuditKind:   rec.ResourceRef.Kind,
+	}
+	if rec.Team != "" {
+		labels[LabelTeam] = rec.Team
+	}
+	if rec.ResourceRef.Kind == "TenantCluster" {
+		labels[LabelTenant] = rec.ResourceRef.Name
+	}
+	return &AuditEvent{
+		TypeMeta: metav1.TypeMeta{APIVersion: GroupVersion.String(), Kind: "AuditEvent"},
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: strings.TrimSuffix(prefix, "-") + "-",
+			Namespace:    namespace,
+			Labels:       labels,
+		},
+		Spec: rec,
+	}
+}
+
+// SetChanges fills the record's diff summary from a change plan, keeping
+// at most MaxAuditFieldChanges fields and truncating long values.
+func (r *AuditRecord) SetChanges(plan *ChangePlan) {
+	r.Changes = nil
+	r.ChangesTruncated = false
+	for _, c := range plan.Changes {
+		if len(r.Changes) == MaxAuditFieldChanges {
+			r.ChangesTruncated = true
+			break
+		}
+		r.Changes = append(r.Changes, AuditFieldChange{
+			Field: c.Field,
+			From:  truncateAuditValue(c.From),
+			To:    truncateAuditValue(c.To),
+		})
+	}
+}
+
+func truncateAuditValue(s string) string {
+	if len(s) <= maxAuditValueLength {
+		return s
+	}
+	return s[:maxAuditValueLength-3] + "..."
+}
+
+// IsExpired returns true if the event is older than retention at now.
+// A retention of zero keeps events indefinitely.
+func (e *AuditEvent) IsExpired(now time.Time, retention time.Duration) bool {
+	return retention > 0 && now.Sub(e.Spec.Timestamp.Time) >= retention
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNewAuditEvent(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	rec := AuditRecord{
+		Actor:       "alice@example.com",
+		Action:      AuditActionScale,
+		ResourceRef: ChangeTargetReference{Kind: "TenantCluster", Name: "prod", Namespace: "team-a"},
+		Team:        "team-a",
+		Timestamp:   metav1.NewTime(now),
+	}
+	e := NewAuditEvent("team-a", rec)
+	if e.GenerateName != "tenantcluster-prod-" || e.Namespace != "team-a" {
+		t.Errorf("metadata = %s/%s", e.Namespace, e.GenerateName)
+	}
+	if e.Labels[LabelTenant] != "prod" || e.Labels[LabelTeam] != "team-a" || e.Labels[LabelAuditAction] != "Scale" {
+		t.Errorf("labels = %v", e.Labels)
+	}
+
+	if e.IsExpired(now.Add(24*time.Hour), 48*time.Hour) {
+		t.Error("event within retention should not be expired")
+	}
+	if !e.IsExpired(now.Add(48*time.Hour), 48*time.Hour) {
+		t.Error("event past retention should be expired")
+	}
+	if e.IsExpired(now.Add(1000*time.Hour), 0) {
+		t.Error("zero retention should keep events")
+	}
+}
+
+func TestAuditRecordSetChanges(t *testing.T) {
+	plan := &ChangePlan{}
+	plan.add("spec.workers.replicas", "3", "5", ChangeImpactScale, "scale")
+	plan.add("spec.annotations", strings.Repeat("x", 300), "", ChangeImpactInPlace, "annotations")
+
+	var rec AuditRecord
+	rec.SetChanges(plan)
+	if len(rec.Changes) != 2 || rec.ChangesTruncated {
+		t.Fatalf("SetChanges() = %+v", rec)
+	}
+	if rec.Changes[0] != (AuditFieldChange{Field: "spec.workers.replicas", From: "3", To: "5"}) {
+		t.Errorf("Changes[0] = %+v", rec.Changes[0])
+	}
+	if got := len(rec.Changes[1].From); got != maxAuditValueLength {
+		t.Errorf("truncated value length = %d, want %d", got, maxAuditValueLength)
+	}
+
+	for i := 0; i < MaxAuditFieldChanges; i++ {
+		plan.add(fmt.Sprintf("spec.f%d", i), "", "", ChangeImpactInPlace, "")
+	}
+	rec.SetChanges(plan)
+	if len(rec.Changes) != MaxAuditFieldChanges || !rec.ChangesTruncated {
+		t.Errorf("SetChanges() kept %d changes, truncated=%v", len(rec.Changes), rec.ChangesTruncated)
+	}
+}
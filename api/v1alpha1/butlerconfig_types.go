@@ -17,6 +17,9 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"fmt"
+	"time"
+
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -102,6 +105,12 @@ type ButlerConfigSpec struct {
 	// +optional
 	SSHAuthorizedKey string `json:"sshAuthorizedKey,omitempty"`
 
+	// SSHCertificateAuthority enables certificate-based SSH access to
+	// Workspaces. Teams may override it in
+	// spec.resourceLimits.workspacePolicy.
+	// +optional
+	SSHCertificateAuthority *SSHCertificateAuthority `json:"sshCertificateAuthority,omitempty"`
+
 	// DefaultTimeServers are the platform-wide default NTP servers for Talos worker nodes.
 	// Can be overridden per-provider via ProviderConfig.spec.network.timeServers
 	// or per-cluster via TenantCluster.spec.timeServers.
@@ -116,6 +125,121 @@ type ButlerConfigSpec struct {
 	// Notifications configures real-time notification forwarding.
 	// +optional
 	Notifications *NotificationsConfig `json:"notifications,omitempty"`
+
+	// ApprovalPolicy lists operations that require an approved ChangeRequest.
+	// +optional
+	ApprovalPolicy *ApprovalPolicy `json:"approvalPolicy,omitempty"`
+
+	// Archive configures ClusterArchive records for deleted TenantClusters.
+	// +optional
+	Archive *ArchiveConfig `json:"archive,omitempty"`
+
+	// ProvisioningLimits bounds concurrent provisioning work so bulk
+	// requests queue instead of overloading infrastructure providers.
+	// +optional
+	ProvisioningLimits *ProvisioningLimits `json:"provisioningLimits,omitempty"`
+
+	// EnvironmentPolicies are guardrails for TenantClusters by environment
+	// tier (spec.ownership.environment), enforced by the admission webhook.
+	// Clusters without spec.ownership are held to the policy of
+	// DefaultEnvironmentTier. Lowering the tier of a cluster, including
+	// removing spec.ownership, is denied unless an approval rule gates
+	// TenantCluster updates.
+	// +optional
+	// +listType=map
+	// +listMapKey=tier
+	EnvironmentPolicies []EnvironmentPolicy `json:"environmentPolicies,omitempty"`
+
+	// DefaultEnvironmentTier is the tier of TenantClusters without
+	// spec.ownership. If unset, such clusters are unclassified and no
+	// environment policy applies to them.
+	// +optional
+	DefaultEnvironmentTier EnvironmentTier `json:"defaultEnvironmentTier,omitempty"`
+
+	// ReconcileTuning adjusts how often controllers resync and probe.
+	// Controllers read it at runtime; changes apply on the next reconcile.
+	// +optional
+	ReconcileTuning *ReconcileTuning `json:"reconcileTuning,omitempty"`
+}
+
+// Default reconcile intervals used when ReconcileTuning leaves a field unset.
+const (
+	// DefaultResyncInterval is how often an unchanged object is reconciled.
+	DefaultResyncInterval = 10 * time.Minute
+
+	// DefaultStatusRefreshPeriod is how often status gathered from tenant
+	// clusters and providers (node counts, versions, capacity) is refreshed.
+	DefaultStatusRefreshPeriod = time.Minute
+
+	// DefaultClusterProbeInterval is how often tenant API servers are probed.
+	DefaultClusterProbeInterval = 30 * time.Second
+
+	// DefaultProviderProbeInterval is how often provider connectivity is probed.
+	DefaultProviderProbeInterval = 5 * time.Minute
+)
+
+// ReconcileTuning adjusts controller cadences. Edge sites with slow or
+// metered links typically lengthen them; large fleets may shorten the
+// status refresh period for fresher dashboards at the cost of API load.
+type ReconcileTuning struct {
+	// DefaultResyncInterval is how often an unchanged object is reconciled.
+	// +kubebuilder:default="10m"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('30s')",message="defaultResyncInterval must be at least 30s"
+	// +optional
+	DefaultResyncInterval *metav1.Duration `json:"defaultResyncInterval,omitempty"`
+
+	// ResyncIntervals override defaultResyncInterval for individual kinds.
+	// +optional
+	// +listType=map
+	// +listMapKey=kind
+	// +kubebuilder:validation:MaxItems=64
+	ResyncIntervals []ResyncInterval `json:"resyncIntervals,omitempty"`
+
+	// StatusRefreshPeriod is how often status gathered from tenant clusters
+	// and providers is refreshed.
+	// +kubebuilder:default="1m"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('10s')",message="statusRefreshPeriod must be at least 10s"
+	// +optional
+	StatusRefreshPeriod *metav1.Duration `json:"statusRefreshPeriod,omitempty"`
+
+	// HealthProbes adjusts health probe intervals.
+	// +optional
+	HealthProbes *HealthProbeTuning `json:"healthProbes,omitempty"`
+}
+
+// ResyncInterval sets the resync interval for one kind.
+type ResyncInterval struct {
+	// Kind is the Butler resource kind (e.g., "TenantCluster").
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Kind string `json:"kind"`
+
+	// Interval is how often an unchanged object of the kind is reconciled.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('30s')",message="interval must be at least 30s"
+	Interval metav1.Duration `json:"interval"`
+}
+
+// HealthProbeTuning adjusts health probe intervals.
+type HealthProbeTuning struct {
+	// ClusterInterval is how often tenant API servers are probed.
+	// +kubebuilder:default="30s"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('5s')",message="clusterInterval must be at least 5s"
+	// +optional
+	ClusterInterval *metav1.Duration `json:"clusterInterval,omitempty"`
+
+	// ProviderInterval is how often provider connectivity is probed.
+	// +kubebuilder:default="5m"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('10s')",message="providerInterval must be at least 10s"
+	// +optional
+	ProviderInterval *metav1.Duration `json:"providerInterval,omitempty"`
+
+	// ComponentHeartbeatTimeout is how long a component heartbeat in
+	// status.components stays fresh. Components heartbeat at a third of it.
+	// +kubebuilder:default="3m"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('30s')",message="componentHeartbeatTimeout must be at least 30s"
+	// +optional
+	ComponentHeartbeatTimeout *metav1.Duration `json:"componentHeartbeatTimeout,omitempty"`
 }
 
 // NotificationsConfig configures notification forwarding.
@@ -123,6 +247,10 @@ type NotificationsConfig struct {
 	// WebhookURL is an optional URL to POST notifications to (Slack, PagerDuty, Teams, etc).
 	// +optional
 	WebhookURL string `json:"webhookURL,omitempty"`
+
+	// SMTP is the default SMTP relay for email NotificationChannels.
+	// +optional
+	SMTP *SMTPConfig `json:"smtp,omitempty"`
 }
 
 // AuditConfig configures audit logging behavior.
@@ -142,6 +270,13 @@ type AuditConfig struct {
 	// +kubebuilder:validation:Maximum=100000
 	// +optional
 	BufferSize *int32 `json:"bufferSize,omitempty"`
+
+	// EventRetention is how long AuditEvent objects are kept before they
+	// are garbage collected. Set to 0 to keep events indefinitely.
+	// Events forwarded to WebhookURL are unaffected.
+	// +kubebuilder:default="2160h"
+	// +optional
+	EventRetention *metav1.Duration `json:"eventRetention,omitempty"`
 }
 
 // MultiTenancyConfig configures multi-tenancy behavior.
@@ -152,6 +287,81 @@ type MultiTenancyConfig struct {
 	Mode MultiTenancyMode `json:"mode,omitempty"`
 }
 
+// ProvisioningLimits bounds concurrent provisioning work.
+// Work over a limit stays queued and the waiting object reports a
+// Progressing=False condition with reason Throttled. A limit of 0 means unlimited.
+type ProvisioningLimits struct {
+	// MaxConcurrentMachineRequestsPerProvider is the number of MachineRequests
+	// a single ProviderConfig may be creating or deleting at once.
+	// +kubebuilder:default=10
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxConcurrentMachineRequestsPerProvider *int32 `json:"maxConcurrentMachineRequestsPerProvider,omitempty"`
+
+	// ProviderOverrides sets per-ProviderConfig machine concurrency.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	ProviderOverrides []ProviderConcurrencyLimit `json:"providerOverrides,omitempty"`
+
+	// MaxConcurrentClusterCreations is the number of TenantClusters that may
+	// be provisioning at once across the platform.
+	// +kubebuilder:default=5
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxConcurrentClusterCreations *int32 `json:"maxConcurrentClusterCreations,omitempty"`
+
+	// MaxConcurrentClusterCreationsPerTeam is the number of TenantClusters a
+	// single Team may have provisioning at once.
+	// +kubebuilder:default=2
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxConcurrentClusterCreationsPerTeam *int32 `json:"maxConcurrentClusterCreationsPerTeam,omitempty"`
+}
+
+// ProviderConcurrencyLimit overrides machine concurrency for one ProviderConfig.
+type ProviderConcurrencyLimit struct {
+	// Name of the ProviderConfig.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// MaxConcurrentMachineRequests is the number of MachineRequests this
+	// provider may be creating or deleting at once.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=0
+	MaxConcurrentMachineRequests int32 `json:"maxConcurrentMachineRequests"`
+}
+
+// EnvironmentPolicy lists requirements for TenantClusters in one
+// environment tier. Creates and updates that violate the policy are denied,
+// as is moving a cluster to a tier whose policy it does not meet.
+type EnvironmentPolicy struct {
+	// Tier is the environment tier the policy applies to.
+	// +kubebuilder:validation:Required
+	Tier EnvironmentTier `json:"tier"`
+
+	// MinControlPlaneReplicas is the minimum number of API server replicas.
+	// Set to 3 to require a highly available control plane.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=3
+	// +optional
+	MinControlPlaneReplicas *int32 `json:"minControlPlaneReplicas,omitempty"`
+
+	// MinWorkerReplicas is the minimum total number of workers across all
+	// worker pools. Not applied to virtual clusters, which have no workers.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinWorkerReplicas *int32 `json:"minWorkerReplicas,omitempty"`
+
+	// RequireDeletionProtection requires spec.deletionProtection.
+	// +optional
+	RequireDeletionProtection bool `json:"requireDeletionProtection,omitempty"`
+
+	// RequireBackups requires the backup addon to be enabled.
+	// +optional
+	RequireBackups bool `json:"requireBackups,omitempty"`
+}
+
 // ResourceLimits defines resource limits for Teams.
 type ResourceLimits struct {
 	// MaxClusters is the maximum number of TenantClusters a Team can create.
@@ -240,11 +450,151 @@ type ButlerConfigStatus struct {
 	// Observability shows the status of platform observability.
 	// +optional
 	Observability *ObservabilityStatus `json:"observability,omitempty"`
+
+	// Components reports the health of each platform component.
+	// Each component upserts its own entry and refreshes LastHeartbeat
+	// periodically; an entry with a stale heartbeat should be treated as down.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Components []ComponentHealth `json:"components,omitempty"`
+
+	// Fleet aggregates platform-wide state for dashboards.
+	// Refreshed periodically by the controller, so it may lag by up to
+	// one refresh interval.
+	// +optional
+	Fleet *FleetStatus `json:"fleet,omitempty"`
+
+	// Reconcile reports controller reconcile diagnostics.
+	// +optional
+	Reconcile *ReconcileStatus `json:"reconcile,omitempty"`
+}
+
+// ComponentType classifies a platform component.
+// +kubebuilder:validation:Enum=Controller;ProviderController;Server;Console;Other
+type ComponentType string
+
+const (
+	// ComponentTypeController is butler-controller.
+	ComponentTypeController ComponentType = "Controller"
+
+	// ComponentTypeProviderController is an infrastructure provider controller
+	// (e.g., butler-provider-nutanix).
+	ComponentTypeProviderController ComponentType = "ProviderController"
+
+	// ComponentTypeServer is butler-server.
+	ComponentTypeServer ComponentType = "Server"
+
+	// ComponentTypeConsole is the Butler Console.
+	ComponentTypeConsole ComponentType = "Console"
+
+	// ComponentTypeOther is any other platform component.
+	ComponentTypeOther ComponentType = "Other"
+)
+
+// DefaultComponentHeartbeatTimeout is how long a component heartbeat stays
+// fresh. Components should heartbeat at least every third of this interval.
+const DefaultComponentHeartbeatTimeout = 3 * time.Minute
+
+// ComponentHealth is a health report published by a platform component.
+type ComponentHealth struct {
+	// Name is the component name (e.g., "butler-provider-nutanix").
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Type classifies the component.
+	// +optional
+	Type ComponentType `json:"type,omitempty"`
+
+	// Version is the running version.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// Ready indicates the component reported itself ready.
+	Ready bool `json:"ready"`
+
+	// LastHeartbeat is when the component last reported.
+	// +optional
+	LastHeartbeat *metav1.Time `json:"lastHeartbeat,omitempty"`
+
+	// Message explains a not-ready state.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// IsHealthy returns true if the component is ready and its heartbeat is
+// newer than timeout.
+func (h *ComponentHealth) IsHealthy(now time.Time, timeout time.Duration) bool {
+	if !h.Ready || h.LastHeartbeat == nil {
+		return false
+	}
+	return now.Sub(h.LastHeartbeat.Time) < timeout
+}
+
+// FleetStatus aggregates the state of every cluster, machine, pool, and
+// addon managed by the platform.
+type FleetStatus struct {
+	// ClustersByPhase counts TenantClusters by phase.
+	// +optional
+	ClustersByPhase map[string]int32 `json:"clustersByPhase,omitempty"`
+
+	// MachinesByProvider counts MachineRequests by provider type.
+	// +optional
+	MachinesByProvider map[string]int32 `json:"machinesByProvider,omitempty"`
+
+	// IPUtilization sums usage across all NetworkPools.
+	// +optional
+	IPUtilization *FleetIPUtilization `json:"ipUtilization,omitempty"`
+
+	// AddonFailures counts failed addon installs by addon name, across
+	// TenantAddons and cluster addon status.
+	// +optional
+	AddonFailures map[string]int32 `json:"addonFailures,omitempty"`
+
+	// ComponentVersions lists the running version of each platform component
+	// (e.g., "butler-controller", "butler-provider-nutanix").
+	// Derived from Components.
+	// +optional
+	ComponentVersions map[string]string `json:"componentVersions,omitempty"`
+
+	// UnhealthyComponents lists platform components that are not ready or
+	// whose heartbeat is stale. Derived from Components.
+	// +optional
+	UnhealthyComponents []string `json:"unhealthyComponents,omitempty"`
+
+	// LastUpdated is when the aggregates were last refreshed.
+	// +optional
+	LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
+}
+
+// FleetIPUtilization sums IP usage across NetworkPools.
+type FleetIPUtilization struct {
+	// Pools is the number of NetworkPools.
+	// +optional
+	Pools int32 `json:"pools,omitempty"`
+
+	// TotalIPs is the number of usable IPs across all pools.
+	// +optional
+	TotalIPs int32 `json:"totalIPs,omitempty"`
+
+	// AllocatedIPs is the number of allocated IPs across all pools.
+	// +optional
+	AllocatedIPs int32 `json:"allocatedIPs,omitempty"`
+
+	// Percent is AllocatedIPs as a percentage of TotalIPs.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percent int32 `json:"percent,omitempty"`
+
+	// ExhaustedPools lists pools with no available IPs.
+	// +optional
+	ExhaustedPools []string `json:"exhaustedPools,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:scope=Cluster,shortName=bc
+// +kubebuilder:resource:scope=Cluster,shortName=bc,categories=butler
 // +kubebuilder:printcolumn:name="Mode",type="string",JSONPath=".spec.multiTenancy.mode",description="Multi-tenancy mode"
 // +kubebuilder:printcolumn:name="Git",type="string",JSONPath=".spec.gitProvider.type",description="Git provider"
 // +kubebuilder:printcolumn:name="Teams",type="integer",JSONPath=".status.teamCount",description="Number of teams"
@@ -275,6 +625,9 @@ func init() {
 	SchemeBuilder.Register(&ButlerConfig{}, &ButlerConfigList{})
 }
 
+// ButlerConfigName is the name of the singleton ButlerConfig.
+const ButlerConfigName = "butler"
+
 // Helper methods
 
 // IsGitProviderConfigured returns true if a Git provider is configured.
@@ -399,6 +752,14 @@ func (c *ButlerConfig) GetAuditBufferSize() int32 {
 	return *c.Spec.Audit.BufferSize
 }
 
+// GetAuditEventRetention returns how long AuditEvents are kept (default: 90 days).
+func (c *ButlerConfig) GetAuditEventRetention() time.Duration {
+	if c.Spec.Audit == nil || c.Spec.Audit.EventRetention == nil {
+		return DefaultAuditEventRetention
+	}
+	return c.Spec.Audit.EventRetention.Duration
+}
+
 // GetNotificationsWebhookURL returns the notifications webhook URL, or empty string if not configured.
 func (c *ButlerConfig) GetNotificationsWebhookURL() string {
 	if c.Spec.Notifications == nil {
@@ -407,6 +768,49 @@ func (c *ButlerConfig) GetNotificationsWebhookURL() string {
 	return c.Spec.Notifications.WebhookURL
 }
 
+// GetNotificationsSMTP returns the SMTP relay for an email channel: the
+// channel's own settings if set, otherwise the platform default, or nil.
+func (c *ButlerConfig) GetNotificationsSMTP(channel *EmailChannelConfig) *SMTPConfig {
+	if channel != nil && channel.SMTP != nil {
+		return channel.SMTP
+	}
+	if c.Spec.Notifications == nil {
+		return nil
+	}
+	return c.Spec.Notifications.SMTP
+}
+
+// IsArchiveEnabled returns true if ClusterArchives are created on deletion (default: true).
+func (c *ButlerConfig) IsArchiveEnabled() bool {
+	if c.Spec.Archive == nil || c.Spec.Archive.Enabled == nil {
+		return true
+	}
+	return *c.Spec.Archive.Enabled
+}
+
+// GetArchiveRetention returns how long ClusterArchives are kept (default: 90 days).
+// Returns 0 if archives are kept indefinitely.
+func (c *ButlerConfig) GetArchiveRetention() time.Duration {
+	if c.Spec.Archive == nil || c.Spec.Archive.Retention == nil {
+		return 90 * 24 * time.Hour
+	}
+	return c.Spec.Archive.Retention.Duration
+}
+
+// GetApprovalRule returns the first approval rule gating the operation on
+// the given kind, or nil if the operation does not require approval.
+func (c *ButlerConfig) GetApprovalRule(apiGroup, kind string, op ChangeOperation) *ApprovalRule {
+	if c == nil || c.Spec.ApprovalPolicy == nil {
+		return nil
+	}
+	for i := range c.Spec.ApprovalPolicy.Rules {
+		if c.Spec.ApprovalPolicy.Rules[i].Matches(apiGroup, kind, op) {
+			return &c.Spec.ApprovalPolicy.Rules[i]
+		}
+	}
+	return nil
+}
+
 // GetDefaultTimeServers returns the platform-wide default NTP servers.
 // Returns nil if not configured (caller should fall back to pool.ntp.org).
 func (c *ButlerConfig) GetDefaultTimeServers() []string {
@@ -416,6 +820,230 @@ func (c *ButlerConfig) GetDefaultTimeServers() []string {
 	return c.Spec.DefaultTimeServers
 }
 
+// GetFleetClusterCount returns the number of TenantClusters in a phase
+// according to the last fleet refresh.
+func (c *ButlerConfig) GetFleetClusterCount(phase TenantClusterPhase) int32 {
+	if c.Status.Fleet == nil {
+		return 0
+	}
+	return c.Status.Fleet.ClustersByPhase[string(phase)]
+}
+
+// GetComponent returns the health report for the named component, or nil.
+func (c *ButlerConfig) GetComponent(name string) *ComponentHealth {
+	for i := range c.Status.Components {
+		if c.Status.Components[i].Name == name {
+			return &c.Status.Components[i]
+		}
+	}
+	return nil
+}
+
+// UnhealthyComponents returns the names of components that are not healthy
+// at now, using GetComponentHeartbeatTimeout.
+func (c *ButlerConfig) UnhealthyComponents(now time.Time) []string {
+	var names []string
+	timeout := c.GetComponentHeartbeatTimeout()
+	for i := range c.Status.Components {
+		if !c.Status.Components[i].IsHealthy(now, timeout) {
+			names = append(names, c.Status.Components[i].Name)
+		}
+	}
+	return names
+}
+
+// GetResyncInterval returns the resync interval for kind, falling back to
+// the default resync interval and then to DefaultResyncInterval.
+func (c *ButlerConfig) GetResyncInterval(kind string) time.Duration {
+	t := c.reconcileTuning()
+	for _, r := range t.ResyncIntervals {
+		if r.Kind == kind {
+			return r.Interval.Duration
+		}
+	}
+	return durationOr(t.DefaultResyncInterval, DefaultResyncInterval)
+}
+
+// GetStatusRefreshPeriod returns the status refresh period, defaulting to
+// DefaultStatusRefreshPeriod.
+func (c *ButlerConfig) GetStatusRefreshPeriod() time.Duration {
+	return durationOr(c.reconcileTuning().StatusRefreshPeriod, DefaultStatusRefreshPeriod)
+}
+
+// GetClusterProbeInterval returns the tenant API server probe interval,
+// defaulting to DefaultClusterProbeInterval.
+func (c *ButlerConfig) GetClusterProbeInterval() time.Duration {
+	if p := c.reconcileTuning().HealthProbes; p != nil {
+		return durationOr(p.ClusterInterval, DefaultClusterProbeInterval)
+	}
+	return DefaultClusterProbeInterval
+}
+
+// GetProviderProbeInterval returns the provider connectivity probe
+// interval, defaulting to DefaultProviderProbeInterval.
+func (c *ButlerConfig) GetProviderProbeInterval() time.Duration {
+	if p := c.reconcileTuning().HealthProbes; p != nil {
+		return durationOr(p.ProviderInterval, DefaultProviderProbeInterval)
+	}
+	return DefaultProviderProbeInterval
+}
+
+// GetComponentHeartbeatTimeout returns how long a component heartbeat
+// stays fresh, defaulting to DefaultComponentHeartbeatTimeout.
+func (c *ButlerConfig) GetComponentHeartbeatTimeout() time.Duration {
+	if p := c.reconcileTuning().HealthProbes; p != nil {
+		return durationOr(p.ComponentHeartbeatTimeout, DefaultComponentHeartbeatTimeout)
+	}
+	return DefaultComponentHeartbeatTimeout
+}
+
+// reconcileTuning returns the reconcile tuning, or an empty one if the
+// config or the section is nil.
+func (c *ButlerConfig) reconcileTuning() *ReconcileTuning {
+	if c == nil || c.Spec.ReconcileTuning == nil {
+		return &ReconcileTuning{}
+	}
+	return c.Spec.ReconcileTuning
+}
+
+// durationOr returns d, or def if d is nil or not positive.
+func durationOr(d *metav1.Duration, def time.Duration) time.Duration {
+	if d == nil || d.Duration <= 0 {
+		return def
+	}
+	return d.Duration
+}
+
+// GetMaxConcurrentMachineRequests returns the machine concurrency limit for
+// a ProviderConfig. Returns 10 when unset and 0 for unlimited.
+func (c *ButlerConfig) GetMaxConcurrentMachineRequests(providerName string) int32 {
+	limits := c.Spec.ProvisioningLimits
+	if limits == nil {
+		return 10
+	}
+	for _, o := range limits.ProviderOverrides {
+		if o.Name == providerName {
+			return o.MaxConcurrentMachineRequests
+		}
+	}
+	if limits.MaxConcurrentMachineRequestsPerProvider == nil {
+		return 10
+	}
+	return *limits.MaxConcurrentMachineRequestsPerProvider
+}
+
+// GetMaxConcurrentClusterCreations returns the platform-wide cluster creation
+// limit. Returns 5 when unset and 0 for unlimited.
+func (c *ButlerConfig) GetMaxConcurrentClusterCreations() int32 {
+	if c.Spec.ProvisioningLimits == nil || c.Spec.ProvisioningLimits.MaxConcurrentClusterCreations == nil {
+		return 5
+	}
+	return *c.Spec.ProvisioningLimits.MaxConcurrentClusterCreations
+}
+
+// GetMaxConcurrentClusterCreationsPerTeam returns the per-Team cluster
+// creation limit. Returns 2 when unset and 0 for unlimited.
+func (c *ButlerConfig) GetMaxConcurrentClusterCreationsPerTeam() int32 {
+	if c.Spec.ProvisioningLimits == nil || c.Spec.ProvisioningLimits.MaxConcurrentClusterCreationsPerTeam == nil {
+		return 2
+	}
+	return *c.Spec.ProvisioningLimits.MaxConcurrentClusterCreationsPerTeam
+}
+
+// GetEnvironmentPolicy returns the policy for the given tier, or nil if
+// the tier has no policy.
+func (c *ButlerConfig) GetEnvironmentPolicy(tier EnvironmentTier) *EnvironmentPolicy {
+	if c == nil {
+		return nil
+	}
+	for i := range c.Spec.EnvironmentPolicies {
+		if c.Spec.EnvironmentPolicies[i].Tier == tier {
+			return &c.Spec.EnvironmentPolicies[i]
+		}
+	}
+	return nil
+}
+
+// GetEnvironmentTier returns the environment tier of tc: its ownership
+// tier, or DefaultEnvironmentTier if it has none. Returns "" if the cluster
+// is unclassified.
+func (c *ButlerConfig) GetEnvironmentTier(tc *TenantCluster) EnvironmentTier {
+	if tier := tc.EnvironmentTier(); tier != "" || c == nil {
+		return tier
+	}
+	return c.Spec.DefaultEnvironmentTier
+}
+
+// EnvironmentPolicyViolations returns the ways tc violates the policy for
+// its environment tier. Returns nil if the cluster is unclassified or its
+// tier has no policy.
+func (c *ButlerConfig) EnvironmentPolicyViolations(tc *TenantCluster) []string {
+	p := c.GetEnvironmentPolicy(c.GetEnvironmentTier(tc))
+	if p == nil {
+		return nil
+	}
+	return p.Violations(tc)
+}
+
+// EnvironmentTierChangeViolation returns why updating oldTC to tc is
+// denied, or "" if it is allowed. Lowering the environment tier of a
+// cluster, or leaving it unclassified, would drop the guardrails of its
+// tier, so it requires an approval rule that gates TenantCluster updates.
+func (c *ButlerConfig) EnvironmentTierChangeViolation(oldTC, tc *TenantCluster) string {
+	from, to := c.GetEnvironmentTier(oldTC), c.GetEnvironmentTier(tc)
+	if environmentTierRank(to) >= environmentTierRank(from) {
+		return ""
+	}
+	if c.GetApprovalRule(GroupVersion.Group, "TenantCluster", ChangeOperationUpdate) != nil {
+		return ""
+	}
+	if to == "" {
+		to = "unclassified"
+	}
+	return fmt.Sprintf("environment tier cannot be lowered from %s to %s unless an approval rule gates TenantCluster updates", from, to)
+}
+
+// environmentTierRank orders tiers from unclassified (0) to prod.
+func environmentTierRank(tier EnvironmentTier) int {
+	switch tier {
+	case EnvironmentTierDev:
+		return 1
+	case EnvironmentTierStaging:
+		return 2
+	case EnvironmentTierProd:
+		return 3
+	}
+	return 0
+}
+
+// Violations returns the ways tc does not meet the policy.
+func (p *EnvironmentPolicy) Violations(tc *TenantCluster) []string {
+	var out []string
+	if p.MinControlPlaneReplicas != nil {
+		replicas := tc.Spec.ControlPlane.Replicas
+		if replicas == 0 {
+			replicas = 1
+		}
+		if replicas < *p.MinControlPlaneReplicas {
+			out = append(out, fmt.Sprintf("%s clusters require at least %d control plane replicas, got %d",
+				p.Tier, *p.MinControlPlaneReplicas, replicas))
+		}
+	}
+	if p.MinWorkerReplicas != nil && !tc.Spec.IsVirtual() {
+		if n := tc.Spec.TotalWorkerReplicas(); n < *p.MinWorkerReplicas {
+			out = append(out, fmt.Sprintf("%s clusters require at least %d workers, got %d",
+				p.Tier, *p.MinWorkerReplicas, n))
+		}
+	}
+	if p.RequireDeletionProtection && !tc.Spec.DeletionProtection {
+		out = append(out, fmt.Sprintf("%s clusters require deletionProtection", p.Tier))
+	}
+	if p.RequireBackups && !tc.Spec.Addons.Backup.IsBackupEnabled() {
+		out = append(out, fmt.Sprintf("%s clusters require the backup addon", p.Tier))
+	}
+	return out
+}
+
 // ImageFactoryConfig configures the Butler Image Factory.
 type ImageFactoryConfig struct {
 	// URL is the base URL of the Image Factory API.
@@ -0,0 +1,246 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestButlerConfigEnvironmentPolicyViolations(t *testing.T) {
+	three, one := int32(3), int32(1)
+	cfg := &ButlerConfig{Spec: ButlerConfigSpec{EnvironmentPolicies: []EnvironmentPolicy{
+		{Tier: EnvironmentTierProd, MinControlPlaneReplicas: &three, RequireDeletionProtection: true, RequireBackups: true},
+		{Tier: EnvironmentTierDev, MinWorkerReplicas: &one},
+	}}}
+	enabled := true
+
+	tests := []struct {
+		name string
+		spec TenantClusterSpec
+		want []string
+	}{
+		{
+			name: "unclassified",
+			spec: TenantClusterSpec{},
+		},
+		{
+			name: "tier without policy",
+			spec: TenantClusterSpec{Ownership: &OwnershipSpec{Environment: EnvironmentTierStaging}},
+		},
+		{
+			name: "dev allows a single replica",
+			spec: TenantClusterSpec{
+				Ownership: &OwnershipSpec{Environment: EnvironmentTierDev},
+				Workers:   WorkersSpec{Replicas: 1},
+			},
+		},
+		{
+			name: "dev without workers",
+			spec: TenantClusterSpec{Ownership: &OwnershipSpec{Environment: EnvironmentTierDev}},
+			want: []string{"at least 1 workers"},
+		},
+		{
+			name: "prod with defaults",
+			spec: TenantClusterSpec{Ownership: &OwnershipSpec{Environment: EnvironmentTierProd}},
+			want: []string{"control plane replicas, got 1", "deletionProtection", "backup addon"},
+		},
+		{
+			name: "compliant prod",
+			spec: TenantClusterSpec{
+				Ownership:          &OwnershipSpec{Environment: EnvironmentTierProd},
+				ControlPlane:       ControlPlaneSpec{Replicas: 3},
+				DeletionProtection: true,
+				Addons:             AddonsSpec{Backup: &BackupAddonSpec{Enabled: &enabled}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := cfg.EnvironmentPolicyViolations(&TenantCluster{Spec: tt.spec})
+			if len(got) != len(tt.want) {
+				t.Fatalf("EnvironmentPolicyViolations() = %q, want %d violations", got, len(tt.want))
+			}
+			for i, want := range tt.want {
+				if !strings.Contains(got[i], want) {
+					t.Errorf("violation %d = %q, want it to contain %q", i, got[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestButlerConfigDefaultEnvironmentTier(t *testing.T) {
+	cfg := &ButlerConfig{Spec: ButlerConfigSpec{
+		DefaultEnvironmentTier: EnvironmentTierProd,
+		EnvironmentPolicies:    []EnvironmentPolicy{{Tier: EnvironmentTierProd, RequireDeletionProtection: true}},
+	}}
+
+	unclassified := &TenantCluster{}
+	if got := cfg.GetEnvironmentTier(unclassified); got != EnvironmentTierProd {
+		t.Errorf("GetEnvironmentTier() = %q, want the default tier prod", got)
+	}
+	if got := cfg.EnvironmentPolicyViolations(unclassified); len(got) != 1 || !strings.Contains(got[0], "deletionProtection") {
+		t.Errorf("EnvironmentPolicyViolations() = %q, want the prod policy applied", got)
+	}
+
+	dev := &TenantCluster{Spec: TenantClusterSpec{Ownership: &OwnershipSpec{Environment: EnvironmentTierDev}}}
+	if got := cfg.GetEnvironmentTier(dev); got != EnvironmentTierDev {
+		t.Errorf("GetEnvironmentTier() = %q, want the ownership tier dev", got)
+	}
+}
+
+func TestButlerConfigEnvironmentTierChangeViolation(t *testing.T) {
+	withTier := func(tier EnvironmentTier) *TenantCluster {
+		tc := &TenantCluster{}
+		if tier != "" {
+			tc.Spec.Ownership = &OwnershipSpec{Environment: tier}
+		}
+		return tc
+	}
+	gated := &ApprovalPolicy{Rules: []ApprovalRule{{
+		APIGroup: GroupVersion.Group, Kind: "TenantCluster", Operations: []ChangeOperation{ChangeOperationUpdate},
+	}}}
+
+	tests := []struct {
+		name     string
+		spec     ButlerConfigSpec
+		from, to EnvironmentTier
+		want     string
+	}{
+		{name: "raised", from: EnvironmentTierDev, to: EnvironmentTierProd},
+		{name: "unchanged", from: EnvironmentTierProd, to: EnvironmentTierProd},
+		{name: "lowered", from: EnvironmentTierProd, to: EnvironmentTierDev, want: "lowered from prod to dev"},
+		{name: "ownership removed", from: EnvironmentTierStaging, want: "lowered from staging to unclassified"},
+		{
+			name: "ownership removed under the default tier",
+			spec: ButlerConfigSpec{DefaultEnvironmentTier: EnvironmentTierProd},
+			from: EnvironmentTierProd,
+		},
+		{
+			name: "lowered with approval gate",
+			spec: ButlerConfigSpec{ApprovalPolicy: gated},
+			from: EnvironmentTierProd, to: EnvironmentTierDev,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &ButlerConfig{Spec: tt.spec}
+			got := cfg.EnvironmentTierChangeViolation(withTier(tt.from), withTier(tt.to))
+			if (got == "") != (tt.want == "") || !strings.Contains(got, tt.want) {
+				t.Errorf("EnvironmentTierChangeViolation() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	var nilConfig *ButlerConfig
+	if got := nilConfig.EnvironmentTierChangeViolation(withTier(EnvironmentTierProd), withTier("")); got == "" {
+		t.Errorf("EnvironmentTierChangeViolation() on nil config allowed removing the prod tier")
+	}
+}
+
+func TestButlerConfigReconcileTuning(t *testing.T) {
+	var nilConfig *ButlerConfig
+	if got := nilConfig.GetResyncInterval("TenantCluster"); got != DefaultResyncInterval {
+		t.Errorf("GetResyncInterval() on nil config = %v, want %v", got, DefaultResyncInterval)
+	}
+
+	cfg := &ButlerConfig{}
+	if got := cfg.GetStatusRefreshPeriod(); got != DefaultStatusRefreshPeriod {
+		t.Errorf("GetStatusRefreshPeriod() = %v, want %v", got, DefaultStatusRefreshPeriod)
+	}
+	if got := cfg.GetClusterProbeInterval(); got != DefaultClusterProbeInterval {
+		t.Errorf("GetClusterProbeInterval() = %v, want %v", got, DefaultClusterProbeInterval)
+	}
+
+	cfg.Spec.ReconcileTuning = &ReconcileTuning{
+		DefaultResyncInterval: &metav1.Duration{Duration: 30 * time.Minute},
+		ResyncIntervals:       []ResyncInterval{{Kind: "ProviderConfig", Interval: metav1.Duration{Duration: time.Hour}}},
+		HealthProbes:          &HealthProbeTuning{ComponentHeartbeatTimeout: &metav1.Duration{Duration: 10 * time.Minute}},
+	}
+	if got := cfg.GetResyncInterval("ProviderConfig"); got != time.Hour {
+		t.Errorf("GetResyncInterval(ProviderConfig) = %v, want 1h", got)
+	}
+	if got := cfg.GetResyncInterval("TenantCluster"); got != 30*time.Minute {
+		t.Errorf("GetResyncInterval(TenantCluster) = %v, want 30m", got)
+	}
+	if got := cfg.GetProviderProbeInterval(); got != DefaultProviderProbeInterval {
+		t.Errorf("GetProviderProbeInterval() = %v, want %v", got, DefaultProviderProbeInterval)
+	}
+
+	now := time.Now()
+	heartbeat := metav1.NewTime(now.Add(-5 * time.Minute))
+	cfg.Status.Components = []ComponentHealth{{Name: "butler-controller", Ready: true, LastHeartbeat: &heartbeat}}
+	if got := cfg.UnhealthyComponents(now); len(got) != 0 {
+		t.Errorf("UnhealthyComponents() = %v, want none with a 10m heartbeat timeout", got)
+	}
+}
+
+func TestButlerConfigArchive(t *testing.T) {
+	enabled, disabled := true, false
+	tests := []struct {
+		name          string
+		archive       *ArchiveConfig
+		wantEnabled   bool
+		wantRetention time.Duration
+	}{
+		{
+			name:          "unset",
+			wantEnabled:   true,
+			wantRetention: 90 * 24 * time.Hour,
+		},
+		{
+			name:          "empty",
+			archive:       &ArchiveConfig{},
+			wantEnabled:   true,
+			wantRetention: 90 * 24 * time.Hour,
+		},
+		{
+			name:          "disabled",
+			archive:       &ArchiveConfig{Enabled: &disabled},
+			wantRetention: 90 * 24 * time.Hour,
+		},
+		{
+			name:          "custom retention",
+			archive:       &ArchiveConfig{Enabled: &enabled, Retention: &metav1.Duration{Duration: 7 * 24 * time.Hour}},
+			wantEnabled:   true,
+			wantRetention: 7 * 24 * time.Hour,
+		},
+		{
+			name:        "kept indefinitely",
+			archive:     &ArchiveConfig{Retention: &metav1.Duration{}},
+			wantEnabled: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &ButlerConfig{Spec: ButlerConfigSpec{Archive: tt.archive}}
+			if got := cfg.IsArchiveEnabled(); got != tt.wantEnabled {
+				t.Errorf("IsArchiveEnabled() = %v, want %v", got, tt.wantEnabled)
+			}
+			if got := cfg.GetArchiveRetention(); got != tt.wantRetention {
+				t.Errorf("GetArchiveRetention() = %v, want %v", got, tt.wantRetention)
+			}
+		})
+	}
+}
@@ -0,0 +1,528 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+)
+
+// Change plans.
+//
+// PlanTenantClusterChange and PlanClusterBootstrapChange describe what the
+// controllers will do when a spec is updated, for the console's review
+// dialog and CLI dry runs. Plans are computed from the specs alone and do
+// not account for the current state of the cluster.
+
+// ChangeImpact classifies the effect of a spec change.
+type ChangeImpact string
+
+const (
+	// ChangeImpactInPlace is applied without touching machines.
+	ChangeImpactInPlace ChangeImpact = "InPlace"
+
+	// ChangeImpactScale adds or removes machines.
+	ChangeImpactScale ChangeImpact = "Scale"
+
+	// ChangeImpactRolling replaces machines or control plane components
+	// one batch at a time.
+	ChangeImpactRolling ChangeImpact = "Rolling"
+
+	// ChangeImpactDisruptive is accepted by the API but cannot be applied to
+	// a running cluster without downtime or recreation.
+	ChangeImpactDisruptive ChangeImpact = "Disruptive"
+
+	// ChangeImpactForbidden is rejected by the API server.
+	ChangeImpactForbidden ChangeImpact = "Forbidden"
+)
+
+// PlannedChange is a single change in a ChangePlan.
+// +kubebuilder:object:generate=false
+type PlannedChange struct {
+	// Field is the JSON path of the changed field (e.g., "spec.workers.replicas").
+	Field string
+
+	// From and To are the old and new values, formatted for display.
+	// Empty for changes to structured fields.
+	From string
+	To   string
+
+	Impact      ChangeImpact
+	Description string
+}
+
+// ChangePlan is the list of effects of a spec change.
+// +kubebuilder:object:generate=false
+type ChangePlan struct {
+	Changes []PlannedChange
+}
+
+// IsEmpty returns true if the plan has no changes.
+func (p *ChangePlan) IsEmpty() bool {
+	return len(p.Changes) == 0
+}
+
+// HasImpact returns true if any change has the given impact.
+func (p *ChangePlan) HasImpact(impact ChangeImpact) bool {
+	for _, c := range p.Changes {
+		if c.Impact == impact {
+			return true
+		}
+	}
+	return false
+}
+
+// String formats the plan with one change per line.
+func (p *ChangePlan) String() string {
+	if p.IsEmpty() {
+		return "no changes"
+	}
+	var b strings.Builder
+	for _, c := range p.Changes {
+		fmt.Fprintf(&b, "[%s] %s", c.Impact, c.Field)
+		if c.From != "" || c.To != "" {
+			fmt.Fprintf(&b, ": %s -> %s", displayValue(c.From), displayValue(c.To))
+		}
+		fmt.Fprintf(&b, ": %s\n", c.Description)
+	}
+	return b.String()
+}
+
+func displayValue(s string) string {
+	if s == "" {
+		return "(unset)"
+	}
+	return s
+}
+
+func (p *ChangePlan) add(field, from, to string, impact ChangeImpact, format string, args ...interface{}) {
+	p.Changes = append(p.Changes, PlannedChange{
+		Field: field, From: from, To: to, Impact: impact, Description: fmt.Sprintf(format, args...),
+	})
+}
+
+// addons compares optional addon specs by name and records installs,
+// removals, and updates.
+func (p *ChangePlan) addons(path string, names []string, oldAddons, newAddons []interface{}) {
+	for i, name := range names {
+		oldNil, newNil := isNilAddon(oldAddons[i]), isNilAddon(newAddons[i])
+		switch {
+		case oldNil && newNil:
+		case oldNil:
+			p.add(path+"."+name, "", "", ChangeImpactInPlace, "%s addon installed", name)
+		case newNil:
+			p.add(path+"."+name, "", "", ChangeImpactInPlace, "%s addon removed", name)
+		case !equality.Semantic.DeepEqual(oldAddons[i], newAddons[i]):
+			p.add(path+"."+name, "", "", ChangeImpactInPlace, "%s addon reconfigured", name)
+		}
+	}
+}
+
+func isNilAddon(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	return rv.Kind() == reflect.Ptr && rv.IsNil()
+}
+
+// PlanTenantClusterChange returns the effects of changing a TenantCluster
+// spec from oldSpec to newSpec.
+func PlanTenantClusterChange(oldSpec, newSpec *TenantClusterSpec) *ChangePlan {
+	p := &ChangePlan{}
+	replicas := newSpec.Workers.Replicas
+
+	if oldSpec.BootstrapProvider != newSpec.BootstrapProvider {
+		p.add("spec.bootstrapProvider", string(oldSpec.BootstrapProvider), string(newSpec.BootstrapProvider),
+			ChangeImpactForbidden, "bootstrapProvider is immutable")
+	}
+	if oldSpec.TenancyMode != newSpec.TenancyMode {
+		p.add("spec.tenancyMode", string(oldSpec.TenancyMode), string(newSpec.TenancyMode),
+			ChangeImpactForbidden, "tenancyMode is immutable")
+	}
+	if o, n := providerRefString(oldSpec.ProviderConfigRef), providerRefString(newSpec.ProviderConfigRef); o != "" && o != n {
+		p.add("spec.providerConfigRef", o, n, ChangeImpactForbidden,
+			"providerConfigRef cannot be changed or removed once set")
+	}
+	if o, n := oldSpec.TeamRef, newSpec.TeamRef; o != nil && (n == nil || o.Name != n.Name) {
+		var name string
+		if n != nil {
+			name = n.Name
+		}
+		p.add("spec.teamRef", o.Name, name, ChangeImpactForbidden, "teamRef cannot be changed or removed once set")
+	}
+	planVirtualCluster(p, oldSpec.Virtual, newSpec.Virtual)
+	if oldSpec.KubernetesVersion != newSpec.KubernetesVersion {
+		p.add("spec.kubernetesVersion", oldSpec.KubernetesVersion, newSpec.KubernetesVersion, ChangeImpactRolling,
+			"rolling upgrade of the control plane, then %s", workerReplacement(newSpec.Workers.UpdateStrategy, replicas))
+	}
+
+	if o, n := oldSpec.ControlPlane.Replicas, newSpec.ControlPlane.Replicas; o != n {
+		p.add("spec.controlPlane.replicas", fmt.Sprint(o), fmt.Sprint(n), ChangeImpactInPlace,
+			"API server scaled from %d to %d replicas", o, n)
+	}
+	if !equality.Semantic.DeepEqual(oldSpec.ControlPlane.DataStoreRef, newSpec.ControlPlane.DataStoreRef) {
+		p.add("spec.controlPlane.dataStoreRef", "", "", ChangeImpactDisruptive,
+			"control plane state is not migrated between DataStores")
+	}
+	if !equality.Semantic.DeepEqual(oldSpec.ControlPlane.VIPPoolRef, newSpec.ControlPlane.VIPPoolRef) {
+		p.add("spec.controlPlane.vipPoolRef", "", "", ChangeImpactDisruptive,
+			"API server endpoint changes; existing kubeconfigs stop working")
+	}
+	if !equality.Semantic.DeepEqual(oldSpec.ControlPlane.CertSANs, newSpec.ControlPlane.CertSANs) {
+		p.add("spec.controlPlane.certSANs", "", "", ChangeImpactRolling,
+			"API server certificate reissued and API server pods restarted")
+	}
+	if !equality.Semantic.DeepEqual(oldSpec.ControlPlane.Encryption, newSpec.ControlPlane.Encryption) {
+		p.add("spec.controlPlane.encryption", "", "", ChangeImpactRolling,
+			"API server pods restarted and encrypted resources rewritten")
+	}
+	if !equality.Semantic.DeepEqual(oldSpec.ControlPlane.AccessControl, newSpec.ControlPlane.AccessControl) {
+		p.add("spec.controlPlane.accessControl", "", "", ChangeImpactInPlace,
+			"API server allow-list updated; clients outside it are disconnected")
+	}
+
+	if o, n := oldSpec.Workers.Replicas, newSpec.Workers.Replicas; o < n {
+		p.add("spec.workers.replicas", fmt.Sprint(o), fmt.Sprint(n), ChangeImpactScale,
+			"%d new worker MachineRequests", n-o)
+	} else if o > n {
+		p.add("spec.workers.replicas", fmt.Sprint(o), fmt.Sprint(n), ChangeImpactScale,
+			"%d workers drained and deleted", o-n)
+	}
+	if oldSpec.Workers.MachineTemplate.RequiresReplacement(&newSpec.Workers.MachineTemplate) &&
+		oldSpec.KubernetesVersion == newSpec.KubernetesVersion {
+		p.add("spec.workers.machineTemplate", "", "", ChangeImpactRolling, "%s", workerReplacement(newSpec.Workers.UpdateStrategy, replicas))
+	}
+	if !equality.Semantic.DeepEqual(oldSpec.Workers.MachineTemplate.NodeAnnotations, newSpec.Workers.MachineTemplate.NodeAnnotations) {
+		p.add("spec.workers.machineTemplate.nodeAnnotations", "", "", ChangeImpactInPlace, "node annotations updated on existing workers")
+	}
+	if !equality.Semantic.DeepEqual(oldSpec.Hibernation, newSpec.Hibernation) {
+		p.add("spec.hibernation", "", "", ChangeImpactInPlace, "hibernation schedule applies from the next scheduled transition")
+	}
+	if !equality.Semantic.DeepEqual(oldSpec.RebootPolicy, newSpec.RebootPolicy) {
+		p.add("spec.rebootPolicy", "", "", ChangeImpactInPlace, "reboot policy applies to future reboots")
+	}
+	if !equality.Semantic.DeepEqual(oldSpec.Workers.Distribution, newSpec.Workers.Distribution) && replicas > 0 {
+		p.add("spec.workers.distribution", "", "", ChangeImpactRolling, "workers rebalanced across failure domains")
+	}
+	if !equality.Semantic.DeepEqual(oldSpec.Workers.UpdateStrategy, newSpec.Workers.UpdateStrategy) ||
+		!equality.Semantic.DeepEqual(oldSpec.Workers.Drain, newSpec.Workers.Drain) {
+		p.add("spec.workers", "", "", ChangeImpactInPlace, "update strategy and drain settings apply to future rollouts")
+	}
+	planWorkerPools(p, oldSpec, newSpec)
+
+	on, nn := &oldSpec.Networking, &newSpec.Networking
+	if on.PodCIDR != nn.PodCIDR {
+		p.add("spec.networking.podCIDR", on.PodCIDR, nn.PodCIDR, ChangeImpactForbidden,
+			"podCIDR is immutable")
+	}
+	if on.ServiceCIDR != nn.ServiceCIDR {
+		p.add("spec.networking.serviceCIDR", on.ServiceCIDR, nn.ServiceCIDR, ChangeImpactForbidden,
+			"serviceCIDR is immutable")
+	}
+	if !equality.Semantic.DeepEqual(on.PodCIDRs, nn.PodCIDRs) || !equality.Semantic.DeepEqual(on.ServiceCIDRs, nn.ServiceCIDRs) {
+		p.add("spec.networking", "", "", ChangeImpactForbidden,
+			"IP families cannot be changed on a running cluster")
+	}
+	if on.DNSServiceIP != nn.DNSServiceIP && on.GetDNSServiceIP() != nn.GetDNSServiceIP() {
+		p.add("spec.networking.dnsServiceIP", on.DNSServiceIP, nn.DNSServiceIP, ChangeImpactForbidden,
+			"dnsServiceIP is immutable")
+	}
+	if !equality.Semantic.DeepEqual(on.LoadBalancerPool, nn.LoadBalancerPool) ||
+		!equality.Semantic.DeepEqual(on.LBPoolSize, nn.LBPoolSize) {
+		p.add("spec.networking.loadBalancerPool", "", "", ChangeImpactInPlace,
+			"LoadBalancer pool reallocated; existing Services may receive new IPs")
+	}
+	if !equality.Semantic.DeepEqual(on.StaticLoadBalancerIPs, nn.StaticLoadBalancerIPs) {
+		p.add("spec.networking.staticLoadBalancerIPs", "", "", ChangeImpactInPlace,
+			"pinned Services re-addressed; update DNS records for changed addresses")
+	}
+
+	oa, na := &oldSpec.Addons, &newSpec.Addons
+	p.addons("spec.addons",
+		[]string{"cni", "loadBalancer", "certManager", "storage", "ingress", "gitops", "backup", "multus"},
+		[]interface{}{oa.CNI, oa.LoadBalancer, oa.CertManager, oa.Storage, oa.Ingress, oa.GitOps, oa.Backup, oa.Multus},
+		[]interface{}{na.CNI, na.LoadBalancer, na.CertManager, na.Storage, na.Ingress, na.GitOps, na.Backup, na.Multus})
+
+	if !equality.Semantic.DeepEqual(oldSpec.TimeServers, newSpec.TimeServers) {
+		p.add("spec.timeServers", "", "", ChangeImpactInPlace, "worker time servers updated")
+	}
+	if !equality.Semantic.DeepEqual(oldSpec.Workspaces, newSpec.Workspaces) {
+		p.add("spec.workspaces", "", "", ChangeImpactInPlace, "workspace settings updated")
+	}
+	if !equality.Semantic.DeepEqual(oldSpec.AccessGrants, newSpec.AccessGrants) {
+		p.add("spec.accessGrants", "", "", ChangeImpactInPlace, "access grants updated")
+	}
+	if !equality.Semantic.DeepEqual(oldSpec.PolicyExceptions, newSpec.PolicyExceptions) {
+		p.add("spec.policyExceptions", "", "", ChangeImpactInPlace, "policy exceptions updated in the tenant cluster")
+	}
+	if oldSpec.DeletionProtection != newSpec.DeletionProtection {
+		p.add("spec.deletionProtection", fmt.Sprint(oldSpec.DeletionProtection), fmt.Sprint(newSpec.DeletionProtection),
+			ChangeImpactInPlace, "deletion protection updated")
+	}
+	if !equality.Semantic.DeepEqual(oldSpec.Ownership, newSpec.Ownership) {
+		p.add("spec.ownership", "", "", ChangeImpactInPlace,
+			"ownership updated; existing nodes keep their tier and business-unit labels until replaced")
+	}
+	oldTier, newTier := ownershipTier(oldSpec.Ownership), ownershipTier(newSpec.Ownership)
+	if environmentTierRank(newTier) < environmentTierRank(oldTier) {
+		p.add("spec.ownership.environment", string(oldTier), string(newTier), ChangeImpactInPlace,
+			"environment tier lowered; denied unless an approval rule gates TenantCluster updates, and the %s environment policy no longer applies", oldTier)
+	}
+	var oldMeta, newMeta ClusterMetadata
+	if oldSpec.ClusterMetadata != nil {
+		oldMeta = *oldSpec.ClusterMetadata
+	}
+	if newSpec.ClusterMetadata != nil {
+		newMeta = *newSpec.ClusterMetadata
+	}
+	if !equality.Semantic.DeepEqual(oldMeta.Labels, newMeta.Labels) {
+		p.add("spec.clusterMetadata.labels", "", "", ChangeImpactInPlace,
+			"namespace and Cluster labels updated; existing nodes keep their labels until replaced")
+	}
+	if !equality.Semantic.DeepEqual(oldMeta.Annotations, newMeta.Annotations) {
+		p.add("spec.clusterMetadata.annotations", "", "", ChangeImpactInPlace, "namespace and Cluster annotations updated")
+	}
+	return p
+}
+
+// providerRefString returns a TenantCluster's providerConfigRef as
+// "namespace/name", with the namespace defaulted to butler-system, or ""
+// when ref is nil.
+func providerRefString(ref *ProviderReference) string {
+	if ref == nil {
+		return ""
+	}
+	namespace := ref.Namespace
+	if namespace == "" {
+		namespace = "butler-system"
+	}
+	return namespace + "/" + ref.Name
+}
+
+// ownershipTier returns the environment tier of o, or "" when o is nil.
+func ownershipTier(o *OwnershipSpec) EnvironmentTier {
+	if o == nil {
+		return ""
+	}
+	return o.Environment
+}
+
+// planVirtualCluster adds the effects of changes to spec.virtual.
+func planVirtualCluster(p *ChangePlan, o, n *VirtualClusterSpec) {
+	if o == nil || n == nil {
+		return
+	}
+	if o.HostClusterRef != n.HostClusterRef {
+		p.add("spec.virtual.hostClusterRef", o.HostClusterRef.Namespace+"/"+o.HostClusterRef.Name,
+			n.HostClusterRef.Namespace+"/"+n.HostClusterRef.Name, ChangeImpactForbidden, "hostClusterRef is immutable")
+	}
+	if !equality.Semantic.DeepEqual(o.Sync, n.Sync) {
+		p.add("spec.virtual.sync", "", "", ChangeImpactRolling, "vcluster syncer restarted with the new sync settings")
+	}
+	if !equality.Semantic.DeepEqual(o.ResourceQuota, n.ResourceQuota) {
+		p.add("spec.virtual.resourceQuota", "", "", ChangeImpactInPlace,
+			"host namespace quota updated; running pods are not evicted")
+	}
+}
+
+// planWorkerPools adds the effects of changes to spec.workerPools.
+// Pools are matched by name.
+func planWorkerPools(p *ChangePlan, oldSpec, newSpec *TenantClusterSpec) {
+	oldPools := map[string]WorkerPoolSpec{}
+	for _, pool := range oldSpec.AllWorkerPools()[1:] {
+		oldPools[pool.Name] = pool
+	}
+	for _, n := range newSpec.AllWorkerPools()[1:] {
+		path := fmt.Sprintf("spec.workerPools[%s]", n.Name)
+		o, ok := oldPools[n.Name]
+		delete(oldPools, n.Name)
+		if !ok {
+			p.add(path, "", "", ChangeImpactScale, "worker pool %s added with %d MachineRequests", n.Name, n.Replicas)
+			continue
+		}
+		if o.Replicas < n.Replicas {
+			p.add(path+".replicas", fmt.Sprint(o.Replicas), fmt.Sprint(n.Replicas), ChangeImpactScale,
+				"%d new worker MachineRequests in pool %s", n.Replicas-o.Replicas, n.Name)
+		} else if o.Replicas > n.Replicas {
+			p.add(path+".replicas", fmt.Sprint(o.Replicas), fmt.Sprint(n.Replicas), ChangeImpactScale,
+				"%d workers in pool %s drained and deleted", o.Replicas-n.Replicas, n.Name)
+		}
+		if n.Replicas == 0 {
+			continue
+		}
+		if oldSpec.KubernetesVersion != newSpec.KubernetesVersion ||
+			o.MachineTemplate.RequiresReplacement(&n.MachineTemplate) ||
+			!equality.Semantic.DeepEqual(o.InfrastructureOverride, n.InfrastructureOverride) ||
+			!equality.Semantic.DeepEqual(o.Labels, n.Labels) ||
+			!equality.Semantic.DeepEqual(o.Taints, n.Taints) {
+			p.add(path, "", "", ChangeImpactRolling, "pool %s: %s", n.Name, workerReplacement(n.UpdateStrategy, n.Replicas))
+		}
+		if !equality.Semantic.DeepEqual(o.Distribution, n.Distribution) {
+			p.add(path+".distribution", "", "", ChangeImpactRolling, "pool %s rebalanced across failure domains", n.Name)
+		}
+		if !equality.Semantic.DeepEqual(o.MachineTemplate.NodeAnnotations, n.MachineTemplate.NodeAnnotations) {
+			p.add(path+".machineTemplate.nodeAnnotations", "", "", ChangeImpactInPlace, "node annotations updated in pool %s", n.Name)
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(oldPools)) {
+		p.add(fmt.Sprintf("spec.workerPools[%s]", name), "", "", ChangeImpactScale,
+			"worker pool %s removed; %d workers drained and deleted", name, oldPools[name].Replicas)
+	}
+}
+
+// workerReplacement describes how workers are replaced under an update
+// strategy.
+func workerReplacement(s *MachineUpdateStrategy, replicas int32) string {
+	if s.GetType() == MachineUpdateStrategyOnDelete {
+		return fmt.Sprintf("%d workers replaced as they are deleted", replicas)
+	}
+	surge, unavailable, err := s.ResolveRollingUpdate(replicas)
+	if err != nil {
+		return fmt.Sprintf("%d workers replaced", replicas)
+	}
+	return fmt.Sprintf("%d workers replaced with maxSurge %d and maxUnavailable %d", replicas, surge, unavailable)
+}
+
+// PlanClusterBootstrapChange returns the effects of changing a
+// ClusterBootstrap spec from oldSpec to newSpec.
+func PlanClusterBootstrapChange(oldSpec, newSpec *ClusterBootstrapSpec) *ChangePlan {
+	p := &ChangePlan{}
+
+	if oldSpec.Provider != newSpec.Provider {
+		p.add("spec.provider", oldSpec.Provider, newSpec.Provider, ChangeImpactForbidden, "provider is immutable")
+	}
+	if oldSpec.Cluster.Name != newSpec.Cluster.Name {
+		p.add("spec.cluster.name", oldSpec.Cluster.Name, newSpec.Cluster.Name, ChangeImpactForbidden, "cluster.name is immutable")
+	}
+	if oldSpec.Cluster.Topology != newSpec.Cluster.Topology {
+		p.add("spec.cluster.topology", string(oldSpec.Cluster.Topology), string(newSpec.Cluster.Topology),
+			ChangeImpactDisruptive, "topology cannot be changed after bootstrap")
+	}
+	if !equality.Semantic.DeepEqual(oldSpec.Talos.ReleaseRef, newSpec.Talos.ReleaseRef) {
+		p.add("spec.talos.releaseRef", "", "", ChangeImpactRolling,
+			"nodes upgraded in turn to the release's Talos version and installer image")
+	}
+	if oldSpec.Talos.Version != newSpec.Talos.Version {
+		p.add("spec.talos.version", oldSpec.Talos.Version, newSpec.Talos.Version, ChangeImpactRolling,
+			"Talos upgraded on each node in turn, control plane first")
+	} else if oldSpec.Talos.Schematic != newSpec.Talos.Schematic {
+		p.add("spec.talos.schematic", oldSpec.Talos.Schematic, newSpec.Talos.Schematic, ChangeImpactRolling,
+			"nodes upgraded in turn to the new installer image")
+	}
+	if !equality.Semantic.DeepEqual(oldSpec.Talos.ConfigPatches, newSpec.Talos.ConfigPatches) {
+		p.add("spec.talos.configPatches", "", "", ChangeImpactInPlace, "machine config patches applied to each node")
+	}
+
+	planNodePool(p, "spec.cluster.controlPlane", "control plane", &oldSpec.Cluster.ControlPlane, &newSpec.Cluster.ControlPlane)
+	oldWorkers, newWorkers := oldSpec.Cluster.Workers, newSpec.Cluster.Workers
+	if oldWorkers == nil {
+		oldWorkers = &ClusterBootstrapNodePool{}
+	}
+	if newWorkers == nil {
+		newWorkers = &ClusterBootstrapNodePool{}
+	}
+	planNodePool(p, "spec.cluster.workers", "worker", oldWorkers, newWorkers)
+
+	on, nn := &oldSpec.Network, &newSpec.Network
+	if on.PodCIDR != nn.PodCIDR {
+		p.add("spec.network.podCIDR", on.PodCIDR, nn.PodCIDR, ChangeImpactDisruptive,
+			"pod CIDR cannot be changed on a running cluster")
+	}
+	if on.ServiceCIDR != nn.ServiceCIDR {
+		p.add("spec.network.serviceCIDR", on.ServiceCIDR, nn.ServiceCIDR, ChangeImpactDisruptive,
+			"service CIDR cannot be changed on a running cluster")
+	}
+	if !equality.Semantic.DeepEqual(on.VIPPoolRef, nn.VIPPoolRef) {
+		p.add("spec.network.vipPoolRef", "", "", ChangeImpactDisruptive,
+			"API server VIP change requires reissuing certificates and kubeconfigs")
+	}
+	if on.VIP != nn.VIP {
+		p.add("spec.network.vip", on.VIP, nn.VIP, ChangeImpactDisruptive,
+			"API server VIP change requires reissuing certificates and kubeconfigs")
+	}
+	if !equality.Semantic.DeepEqual(on.LoadBalancerPool, nn.LoadBalancerPool) {
+		p.add("spec.network.loadBalancerPool", on.LoadBalancerPool.ToAddressRange(), nn.LoadBalancerPool.ToAddressRange(),
+			ChangeImpactInPlace, "LoadBalancer pool updated; existing Services may receive new IPs")
+	}
+
+	oa, na := &oldSpec.Addons, &newSpec.Addons
+	p.addons("spec.addons",
+		[]string{"cni", "storage", "loadBalancer", "gitOps", "controlPlaneHA", "certManager", "ingress",
+			"controlPlaneProvider", "capi", "butlerController", "console", "server", "monitoring", "backup"},
+		[]interface{}{oa.CNI, oa.Storage, oa.LoadBalancer, oa.GitOps, oa.ControlPlaneHA, oa.CertManager, oa.Ingress,
+			oa.ControlPlaneProvider, oa.CAPI, oa.ButlerController, oa.Console, oa.Server, oa.Monitoring, oa.Backup},
+		[]interface{}{na.CNI, na.Storage, na.LoadBalancer, na.GitOps, na.ControlPlaneHA, na.CertManager, na.Ingress,
+			na.ControlPlaneProvider, na.CAPI, na.ButlerController, na.Console, na.Server, na.Monitoring, na.Backup})
+
+	if !equality.Semantic.DeepEqual(oldSpec.ControlPlaneExposure, newSpec.ControlPlaneExposure) {
+		p.add("spec.controlPlaneExposure", "", "", ChangeImpactInPlace, "default tenant control plane exposure updated")
+	}
+	if oldSpec.Paused != newSpec.Paused {
+		desc := "reconciliation resumed"
+		if newSpec.Paused {
+			desc = "reconciliation paused"
+		}
+		p.add("spec.paused", fmt.Sprint(oldSpec.Paused), fmt.Sprint(newSpec.Paused), ChangeImpactInPlace, "%s", desc)
+	}
+	return p
+}
+
+func planNodePool(p *ChangePlan, path, role string, o, n *ClusterBootstrapNodePool) {
+	if o.Replicas < n.Replicas {
+		p.add(path+".replicas", fmt.Sprint(o.Replicas), fmt.Sprint(n.Replicas), ChangeImpactScale,
+			"%d new %s MachineRequests", n.Replicas-o.Replicas, role)
+	} else if o.Replicas > n.Replicas {
+		p.add(path+".replicas", fmt.Sprint(o.Replicas), fmt.Sprint(n.Replicas), ChangeImpactScale,
+			"%d %s nodes removed", o.Replicas-n.Replicas, role)
+	}
+	if o.Replicas == 0 || n.Replicas == 0 {
+		return
+	}
+	if o.CPU != n.CPU || o.MemoryMB != n.MemoryMB || o.DiskGB != n.DiskGB ||
+		!equality.Semantic.DeepEqual(o.ExtraDisks, n.ExtraDisks) || !equality.Semantic.DeepEqual(o.Firmware, n.Firmware) ||
+		!equality.Semantic.DeepEqual(o.Placement, n.Placement) {
+		p.add(path, "", "", ChangeImpactRolling, "%s nodes replaced one at a time", role)
+	}
+	if !equality.Semantic.DeepEqual(o.Labels, n.Labels) {
+		p.add(path+".labels", "", "", ChangeImpactInPlace, "%s node labels updated", role)
+	}
+	if equality.Semantic.DeepEqual(o.Overrides, n.Overrides) {
+		return
+	}
+	var replaced, relabeled int32
+	for i := range min(o.Replicas, n.Replicas) {
+		a, b := o.ForNode(i), n.ForNode(i)
+		if a.CPU != b.CPU || a.MemoryMB != b.MemoryMB || a.DiskGB != b.DiskGB ||
+			!equality.Semantic.DeepEqual(a.ExtraDisks, b.ExtraDisks) {
+			replaced++
+		} else if !equality.Semantic.DeepEqual(a.Labels, b.Labels) {
+			relabeled++
+		}
+	}
+	if replaced > 0 {
+		p.add(path+".overrides", "", "", ChangeImpactRolling, "%d %s nodes replaced one at a time", replaced, role)
+	}
+	if relabeled > 0 {
+		p.add(path+".overrides", "", "", ChangeImpactInPlace, "%d %s node labels updated", relabeled, role)
+	}
+}
@@ -0,0 +1,283 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPlanTenantClusterChange(t *testing.T) {
+	base := TenantClusterSpec{
+		KubernetesVersion: "v1.31.4",
+		Workers: WorkersSpec{
+			Replicas: 3,
+			MachineTemplate: MachineTemplateSpec{
+				CPU:    4,
+				Memory: resource.MustParse("8Gi"),
+			},
+		},
+		Networking:        NetworkingSpec{PodCIDR: "10.244.0.0/16", ServiceCIDR: "10.96.0.0/12"},
+		TeamRef:           &LocalObjectReference{Name: "team-a"},
+		ProviderConfigRef: &ProviderReference{Name: "harvester"},
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(*TenantClusterSpec)
+		want   []string
+		impact ChangeImpact
+	}{
+		{
+			name:   "no change",
+			mutate: func(*TenantClusterSpec) {},
+		},
+		{
+			name:   "scale up",
+			mutate: func(s *TenantClusterSpec) { s.Workers.Replicas = 5 },
+			want:   []string{"2 new worker MachineRequests"},
+			impact: ChangeImpactScale,
+		},
+		{
+			name:   "scale down",
+			mutate: func(s *TenantClusterSpec) { s.Workers.Replicas = 1 },
+			want:   []string{"2 workers drained and deleted"},
+			impact: ChangeImpactScale,
+		},
+		{
+			name: "version bump with machine template change is one rollout",
+			mutate: func(s *TenantClusterSpec) {
+				s.KubernetesVersion = "v1.32.0"
+				s.Workers.MachineTemplate.CPU = 8
+			},
+			want:   []string{"rolling upgrade of the control plane, then 3 workers replaced with maxSurge 1 and maxUnavailable 0"},
+			impact: ChangeImpactRolling,
+		},
+		{
+			name: "memory change under OnDelete",
+			mutate: func(s *TenantClusterSpec) {
+				s.Workers.MachineTemplate.Memory = resource.MustParse("16Gi")
+				s.Workers.UpdateStrategy = &MachineUpdateStrategy{Type: MachineUpdateStrategyOnDelete}
+			},
+			want:   []string{"3 workers replaced as they are deleted", "update strategy"},
+			impact: ChangeImpactRolling,
+		},
+		{
+			name:   "pod CIDR",
+			mutate: func(s *TenantClusterSpec) { s.Networking.PodCIDR = "10.200.0.0/16" },
+			want:   []string{"podCIDR is immutable"},
+			impact: ChangeImpactForbidden,
+		},
+		{
+			name: "dual-stack added",
+			mutate: func(s *TenantClusterSpec) {
+				s.Networking.PodCIDRs = []string{"10.244.0.0/16", "fd00:10:244::/56"}
+			},
+			want:   []string{"IP families cannot be changed"},
+			impact: ChangeImpactForbidden,
+		},
+		{
+			name:   "provider changed",
+			mutate: func(s *TenantClusterSpec) { s.ProviderConfigRef = &ProviderReference{Name: "nutanix"} },
+			want:   []string{"providerConfigRef cannot be changed"},
+			impact: ChangeImpactForbidden,
+		},
+		{
+			name:   "provider namespace made explicit",
+			mutate: func(s *TenantClusterSpec) { s.ProviderConfigRef.Namespace = "butler-system" },
+		},
+		{
+			name:   "team removed",
+			mutate: func(s *TenantClusterSpec) { s.TeamRef = nil },
+			want:   []string{"teamRef cannot be changed"},
+			impact: ChangeImpactForbidden,
+		},
+		{
+			name:   "bootstrap provider",
+			mutate: func(s *TenantClusterSpec) { s.BootstrapProvider = BootstrapProviderK3s },
+			want:   []string{"immutable"},
+			impact: ChangeImpactForbidden,
+		},
+		{
+			name:   "addon installed",
+			mutate: func(s *TenantClusterSpec) { s.Addons.CertManager = &CertManagerSpec{Version: "v1.16.0"} },
+			want:   []string{"certManager addon installed"},
+			impact: ChangeImpactInPlace,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated := base.DeepCopy()
+			tt.mutate(updated)
+			plan := PlanTenantClusterChange(&base, updated)
+			if len(plan.Changes) != len(tt.want) {
+				t.Fatalf("PlanTenantClusterChange() =\n%s\nwant %d changes", plan, len(tt.want))
+			}
+			for i, c := range plan.Changes {
+				if !strings.Contains(c.Description, tt.want[i]) {
+					t.Errorf("change %d = %q, want it to contain %q", i, c.Description, tt.want[i])
+				}
+			}
+			if tt.impact != "" && !plan.HasImpact(tt.impact) {
+				t.Errorf("plan has no %s change:\n%s", tt.impact, plan)
+			}
+		})
+	}
+}
+
+func TestPlanClusterBootstrapChange(t *testing.T) {
+	base := ClusterBootstrapSpec{
+		Provider: "harvester",
+		Cluster: ClusterBootstrapClusterSpec{
+			Name:         "mgmt",
+			ControlPlane: ClusterBootstrapNodePool{Replicas: 3, CPU: 4, MemoryMB: 8192, DiskGB: 50},
+		},
+		Talos: ClusterBootstrapTalosSpec{Version: "v1.9.0"},
+	}
+
+	updated := base.DeepCopy()
+	updated.Talos.Version = "v1.9.1"
+	updated.Cluster.Workers = &ClusterBootstrapNodePool{Replicas: 2, CPU: 4, MemoryMB: 8192, DiskGB: 50}
+	updated.Addons.Console = &ConsoleAddonSpec{}
+
+	plan := PlanClusterBootstrapChange(&base, updated)
+	want := []string{"Talos upgraded", "2 new worker MachineRequests", "console addon installed"}
+	if len(plan.Changes) != len(want) {
+		t.Fatalf("PlanClusterBootstrapChange() =\n%s\nwant %d changes", plan, len(want))
+	}
+	for i, c := range plan.Changes {
+		if !strings.Contains(c.Description, want[i]) {
+			t.Errorf("change %d = %q, want it to contain %q", i, c.Description, want[i])
+		}
+	}
+}
+
+func TestPlanClusterBootstrapNodeOverrides(t *testing.T) {
+	base := ClusterBootstrapSpec{
+		Cluster: ClusterBootstrapClusterSpec{
+			ControlPlane: ClusterBootstrapNodePool{Replicas: 3, CPU: 4, MemoryMB: 8192, DiskGB: 50},
+		},
+	}
+
+	cpu := int32(8)
+	updated := base.DeepCopy()
+	updated.Cluster.ControlPlane.Overrides = []ClusterBootstrapNodeOverride{
+		{Index: 0, CPU: &cpu},
+		{Index: 2, Labels: map[string]string{"pivot": "true"}},
+	}
+
+	plan := PlanClusterBootstrapChange(&base, updated)
+	want := []string{"1 control plane nodes replaced", "1 control plane node labels updated"}
+	if len(plan.Changes) != len(want) {
+		t.Fatalf("PlanClusterBootstrapChange() =\n%s\nwant %d changes", plan, len(want))
+	}
+	for i, c := range plan.Changes {
+		if !strings.Contains(c.Description, want[i]) {
+			t.Errorf("change %d = %q, want it to contain %q", i, c.Description, want[i])
+		}
+	}
+}
+
+func TestPlanTenantClusterWorkerPools(t *testing.T) {
+	base := TenantClusterSpec{
+		KubernetesVersion: "v1.31.2",
+		Workers:           WorkersSpec{Replicas: 3},
+		WorkerPools: []WorkerPoolSpec{
+			{Name: "gpu", Replicas: 2, MachineTemplate: MachineTemplateSpec{CPU: 16}},
+			{Name: "mem", Replicas: 1},
+		},
+	}
+
+	updated := base.DeepCopy()
+	updated.WorkerPools[0].Taints = []NodeTaint{{Key: "gpu", Effect: TaintEffectNoSchedule}}
+	updated.WorkerPools[1] = WorkerPoolSpec{Name: "batch", Replicas: 4}
+
+	plan := PlanTenantClusterChange(&base, updated)
+	want := []string{"pool gpu: 2 workers replaced", "worker pool batch added with 4", "worker pool mem removed"}
+	if len(plan.Changes) != len(want) {
+		t.Fatalf("PlanTenantClusterChange() =\n%s\nwant %d changes", plan, len(want))
+	}
+	for i, c := range plan.Changes {
+		if !strings.Contains(c.Description, want[i]) {
+			t.Errorf("change %d = %q, want it to contain %q", i, c.Description, want[i])
+		}
+	}
+}
+
+func TestPlanTenantClusterNodeAnnotations(t *testing.T) {
+	base := TenantClusterSpec{
+		KubernetesVersion: "v1.31.2",
+		Workers:           WorkersSpec{Replicas: 3},
+		WorkerPools:       []WorkerPoolSpec{{Name: "gpu", Replicas: 2}},
+	}
+
+	updated := base.DeepCopy()
+	updated.Workers.MachineTemplate.NodeAnnotations = map[string]string{"team": "ml"}
+	updated.WorkerPools[0].MachineTemplate.NodeAnnotations = map[string]string{"team": "ml"}
+	plan := PlanTenantClusterChange(&base, updated)
+	if len(plan.Changes) != 2 || plan.HasImpact(ChangeImpactRolling) {
+		t.Errorf("PlanTenantClusterChange() =\n%s\nwant two in-place annotation updates", plan)
+	}
+
+	updated = base.DeepCopy()
+	updated.WorkerPools[0].MachineTemplate.KubeletExtraArgs = map[string]string{"max-pods": "250"}
+	plan = PlanTenantClusterChange(&base, updated)
+	if len(plan.Changes) != 1 || !plan.HasImpact(ChangeImpactRolling) {
+		t.Errorf("PlanTenantClusterChange() =\n%s\nwant a rolling replacement of pool gpu", plan)
+	}
+}
+
+func TestPlanTenantClusterVirtual(t *testing.T) {
+	pods := int32(50)
+	base := TenantClusterSpec{
+		KubernetesVersion: "v1.31.4",
+		TenancyMode:       TenancyModeVirtual,
+		Virtual: &VirtualClusterSpec{
+			HostClusterRef: NamespacedObjectReference{Name: "shared", Namespace: "platform"},
+		},
+	}
+
+	updated := base.DeepCopy()
+	updated.Virtual.Sync = &VirtualClusterSync{ToHost: []VirtualSyncToHostResource{VirtualSyncToHostIngresses}}
+	updated.Virtual.ResourceQuota = &VirtualClusterQuota{Pods: &pods}
+	plan := PlanTenantClusterChange(&base, updated)
+	if len(plan.Changes) != 2 || !plan.HasImpact(ChangeImpactRolling) || !plan.HasImpact(ChangeImpactInPlace) {
+		t.Errorf("PlanTenantClusterChange() =\n%s\nwant a syncer restart and a quota update", plan)
+	}
+
+	updated = base.DeepCopy()
+	updated.TenancyMode = TenancyModeDedicated
+	updated.Virtual.HostClusterRef.Name = "other"
+	plan = PlanTenantClusterChange(&base, updated)
+	if len(plan.Changes) != 2 || plan.Changes[0].Impact != ChangeImpactForbidden || plan.Changes[1].Impact != ChangeImpactForbidden {
+		t.Errorf("PlanTenantClusterChange() =\n%s\nwant tenancyMode and hostClusterRef forbidden", plan)
+	}
+}
+
+func TestPlanTenantClusterDefaultedDNSServiceIP(t *testing.T) {
+	oldSpec := TenantClusterSpec{Networking: NetworkingSpec{ServiceCIDR: "10.96.0.0/12"}}
+	tc := &TenantCluster{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.Now()}, Spec: *oldSpec.DeepCopy()}
+	tc.Default()
+
+	if p := PlanTenantClusterChange(&oldSpec, &tc.Spec); p.HasImpact(ChangeImpactDisruptive) {
+		t.Errorf("materialized defaults planned as disruptive:\n%s", p)
+	}
+}
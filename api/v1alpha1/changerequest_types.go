@@ -0,0 +1,411 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ChangeOperation is an admission operation that can be gated by approval.
+// +kubebuilder:validation:Enum=CREATE;UPDATE;DELETE
+type ChangeOperation string
+
+const (
+	// ChangeOperationCreate gates object creation.
+	ChangeOperationCreate ChangeOperation = "CREATE"
+
+	// ChangeOperationUpdate gates object updates.
+	ChangeOperationUpdate ChangeOperation = "UPDATE"
+
+	// ChangeOperationDelete gates object deletion.
+	ChangeOperationDelete ChangeOperation = "DELETE"
+)
+
+// ChangePatchType is the format of a proposed change.
+// +kubebuilder:validation:Enum=merge;json
+type ChangePatchType string
+
+const (
+	// ChangePatchTypeMerge is a JSON merge patch (RFC 7386).
+	ChangePatchTypeMerge ChangePatchType = "merge"
+
+	// ChangePatchTypeJSON is a JSON patch (RFC 6902).
+	ChangePatchTypeJSON ChangePatchType = "json"
+)
+
+// ReviewDecision is a reviewer's decision on a ChangeRequest.
+// +kubebuilder:validation:Enum=Approve;Reject
+type ReviewDecision string
+
+const (
+	// ReviewDecisionApprove approves the change.
+	ReviewDecisionApprove ReviewDecision = "Approve"
+
+	// ReviewDecisionReject rejects the change. A single rejection from an
+	// allowed approver rejects the request.
+	ReviewDecisionReject ReviewDecision = "Reject"
+)
+
+// ChangeRequestPhase represents the current phase of a ChangeRequest.
+// +kubebuilder:validation:Enum=Pending;Approved;Rejected;Expired;Applied;Failed
+type ChangeRequestPhase string
+
+const (
+	// ChangeRequestPhasePending indicates the request is awaiting reviews.
+	ChangeRequestPhasePending ChangeRequestPhase = "Pending"
+
+	// ChangeRequestPhaseApproved indicates enough approvals were recorded.
+	// The requester may now perform the operation.
+	ChangeRequestPhaseApproved ChangeRequestPhase = "Approved"
+
+	// ChangeRequestPhaseRejected indicates a reviewer rejected the request.
+	ChangeRequestPhaseRejected ChangeRequestPhase = "Rejected"
+
+	// ChangeRequestPhaseExpired indicates the request expired before it was applied.
+	ChangeRequestPhaseExpired ChangeRequestPhase = "Expired"
+
+	// ChangeRequestPhaseApplied indicates the approved operation was admitted.
+	// A ChangeRequest authorizes a single operation.
+	ChangeRequestPhaseApplied ChangeRequestPhase = "Applied"
+
+	// ChangeRequestPhaseFailed indicates the approved operation could not be applied.
+	ChangeRequestPhaseFailed ChangeRequestPhase = "Failed"
+)
+
+// ChangeRequestSpec defines the desired state of ChangeRequest.
+// +kubebuilder:validation:XValidation:rule="self.targetRef == oldSelf.targetRef && self.operation == oldSelf.operation",message="targetRef and operation are immutable"
+// +kubebuilder:validation:XValidation:rule="self.operation != 'UPDATE' || has(self.patch)",message="patch is required for UPDATE"
+type ChangeRequestSpec struct {
+	// TargetRef identifies the object the change applies to.
+	// +kubebuilder:validation:Required
+	TargetRef ChangeTargetReference `json:"targetRef"`
+
+	// Operation is the gated operation.
+	// +kubebuilder:validation:Required
+	Operation ChangeOperation `json:"operation"`
+
+	// Patch is the proposed change, serialized according to PatchType.
+	// Required for UPDATE. The webhook only admits an update whose spec
+	// equals the spec of the current object with this patch applied.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="patch is immutable"
+	Patch string `json:"patch,omitempty"`
+
+	// PatchType is the format of Patch.
+	// +kubebuilder:default="merge"
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="patchType is immutable"
+	PatchType ChangePatchType `json:"patchType,omitempty"`
+
+	// Reason explains why the change is needed. Shown to reviewers.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Reason string `json:"reason"`
+
+	// Requester is the user who created the request.
+	// Set by the admission webhook from the authenticated user and immutable.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="requester is immutable"
+	Requester string `json:"requester,omitempty"`
+
+	// ExpiresAt is when the request expires if not applied.
+	// Set by the admission webhook to the approval rule's TTL from
+	// creation; an earlier time may be requested.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="expiresAt is immutable"
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// Reviews records reviewer decisions. Reviewers add an entry with a
+	// decision; the admission webhook sets Reviewer, Groups, and Time from
+	// the authenticated user, rejects reviews by the requester or by users
+	// the approval rule does not list, and rejects changes to recorded
+	// reviews.
+	// +optional
+	// +listType=map
+	// +listMapKey=reviewer
+	Reviews []ChangeReview `json:"reviews,omitempty"`
+}
+
+// ChangeTargetReference identifies an object by group, kind, and name.
+type ChangeTargetReference struct {
+	// APIGroup of the target. Defaults to butler.butlerlabs.dev.
+	// +kubebuilder:default="butler.butlerlabs.dev"
+	// +optional
+	APIGroup string `json:"apiGroup,omitempty"`
+
+	// Kind of the target (e.g., "TenantCluster", "Team").
+	// +kubebuilder:validation:Required
+	Kind string `json:"kind"`
+
+	// Name of the target.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Namespace of the target. Empty for cluster-scoped targets.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// ChangeReview is a reviewer's decision.
+type ChangeReview struct {
+	// Reviewer is the user who reviewed. Set by the admission webhook.
+	// +kubebuilder:validation:Required
+	Reviewer string `json:"reviewer"`
+
+	// Groups are the reviewer's groups when the review was recorded.
+	// Set by the admission webhook.
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+
+	// Decision is Approve or Reject.
+	// +kubebuilder:validation:Required
+	Decision ReviewDecision `json:"decision"`
+
+	// Comment is an optional note from the reviewer.
+	// +optional
+	Comment string `json:"comment,omitempty"`
+
+	// Time is when the review was recorded. Set by the admission webhook.
+	// +optional
+	Time *metav1.Time `json:"time,omitempty"`
+}
+
+// ChangeRequestStatus defines the observed state of ChangeRequest.
+type ChangeRequestStatus struct {
+	// Conditions represent the latest available observations.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Phase represents the current phase of the request.
+	// +optional
+	Phase ChangeRequestPhase `json:"phase,omitempty"`
+
+	// RequiredApprovals is the number of approvals required by the matching rule.
+	// +optional
+	RequiredApprovals int32 `json:"requiredApprovals,omitempty"`
+
+	// Approvals is the number of valid approvals recorded.
+	// +optional
+	Approvals int32 `json:"approvals,omitempty"`
+
+	// AppliedAt is when the approved operation was admitted.
+	// +optional
+	AppliedAt *metav1.Time `json:"appliedAt,omitempty"`
+
+	// Message provides human-readable status information.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// ObservedGeneration is the last observed generation.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=chr,categories=butler
+// +kubebuilder:printcolumn:name="Operation",type="string",JSONPath=".spec.operation",description="Gated operation"
+// +kubebuilder:printcolumn:name="Kind",type="string",JSONPath=".spec.targetRef.kind",description="Target kind"
+// +kubebuilder:printcolumn:name="Target",type="string",JSONPath=".spec.targetRef.name",description="Target name"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Request phase"
+// +kubebuilder:printcolumn:name="Requester",type="string",JSONPath=".spec.requester",description="Requester",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ChangeRequest proposes a gated operation on a Butler resource for
+// four-eyes approval. When ButlerConfig.spec.approvalPolicy has a rule
+// matching an operation, the admission webhook rejects it unless an
+// unexpired ChangeRequest for the same target and operation has enough
+// approvals. ChangeRequests live in the target's namespace, or in
+// butler-system for cluster-scoped targets. Creates and updates name the
+// ChangeRequest with the butler.butlerlabs.dev/change-request annotation;
+// deletes are matched to any authorizing ChangeRequest for the target.
+type ChangeRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ChangeRequestSpec   `json:"spec,omitempty"`
+	Status ChangeRequestStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ChangeRequestList contains a list of ChangeRequest.
+type ChangeRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ChangeRequest `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ChangeRequest{}, &ChangeRequestList{})
+}
+
+// ApprovalPolicy defines which operations require an approved ChangeRequest.
+type ApprovalPolicy struct {
+	// Rules lists gated operations. An operation matching any rule is gated.
+	// +optional
+	Rules []ApprovalRule `json:"rules,omitempty"`
+}
+
+// ApprovalRule gates operations on a kind of resource.
+type ApprovalRule struct {
+	// APIGroup of the gated resource. Defaults to butler.butlerlabs.dev.
+	// +kubebuilder:default="butler.butlerlabs.dev"
+	// +optional
+	APIGroup string `json:"apiGroup,omitempty"`
+
+	// Kind of the gated resource (e.g., "TenantCluster").
+	// +kubebuilder:validation:Required
+	Kind string `json:"kind"`
+
+	// Operations that require approval.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	Operations []ChangeOperation `json:"operations"`
+
+	// MinApprovals is the number of distinct approvers required.
+	// The requester never counts towards this number.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinApprovals int32 `json:"minApprovals,omitempty"`
+
+	// Approvers lists users or groups allowed to approve.
+	// If empty, any user other than the requester who may update the
+	// ChangeRequest may approve.
+	// +optional
+	Approvers []string `json:"approvers,omitempty"`
+
+	// TTL is how long a ChangeRequest stays valid after creation.
+	// +kubebuilder:default="72h"
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+}
+
+// Helper methods
+
+// IsApproved returns true if the request is approved and not expired at now.
+func (c *ChangeRequest) IsApproved(now time.Time) bool {
+	if c.Status.Phase != ChangeRequestPhaseApproved {
+		return false
+	}
+	return !c.IsExpired(now)
+}
+
+// IsExpired returns true if the request has an expiry in the past.
+func (c *ChangeRequest) IsExpired(now time.Time) bool {
+	return c.Spec.ExpiresAt != nil && !now.Before(c.Spec.ExpiresAt.Time)
+}
+
+// IsRejected returns true if a reviewer that rule allows to approve
+// rejected the request.
+func (c *ChangeRequest) IsRejected(rule *ApprovalRule) bool {
+	for _, r := range c.Spec.Reviews {
+		if r.Decision == ReviewDecisionReject && c.CanReview(rule, r.Reviewer, r.Groups) {
+			return true
+		}
+	}
+	return false
+}
+
+// CountApprovals returns the number of approvals from reviewers that rule
+// allows to approve. Reviews is a map list keyed by reviewer, so each
+// reviewer counts at most once.
+func (c *ChangeRequest) CountApprovals(rule *ApprovalRule) int32 {
+	var n int32
+	for _, r := range c.Spec.Reviews {
+		if r.Decision == ReviewDecisionApprove && c.CanReview(rule, r.Reviewer, r.Groups) {
+			n++
+		}
+	}
+	return n
+}
+
+// CanReview returns true if the user, a member of groups, may review the
+// request under rule: the user is not the requester and, when the rule
+// lists approvers, is listed by name or group.
+func (c *ChangeRequest) CanReview(rule *ApprovalRule, user string, groups []string) bool {
+	if user == "" || user == c.Spec.Requester {
+		return false
+	}
+	return rule == nil || len(rule.Approvers) == 0 || listsApprover(rule.Approvers, user, groups)
+}
+
+// Authorizes returns nil if the request authorizes op on target at now
+// under rule: it is for the same target and operation, has not expired or
+// been applied, was not rejected, and has at least the rule's minimum
+// number of approvals. Approvals are counted from spec.reviews rather than
+// read from status.
+func (c *ChangeRequest) Authorizes(rule *ApprovalRule, target ChangeTargetReference, op ChangeOperation, now time.Time) error {
+	want := c.Spec.TargetRef
+	if want.APIGroup == "" {
+		want.APIGroup = GroupVersion.Group
+	}
+	if want != target || c.Spec.Operation != op {
+		return fmt.Errorf("ChangeRequest %s is for %s of %s %s", c.Name, c.Spec.Operation, want.Kind, want.Name)
+	}
+	switch {
+	case c.IsExpired(now):
+		return fmt.Errorf("ChangeRequest %s has expired", c.Name)
+	case c.Status.Phase == ChangeRequestPhaseApplied:
+		return fmt.Errorf("ChangeRequest %s has already been applied", c.Name)
+	case c.IsRejected(rule):
+		return fmt.Errorf("ChangeRequest %s was rejected", c.Name)
+	}
+	if n, required := c.CountApprovals(rule), rule.GetMinApprovals(); n < required {
+		return fmt.Errorf("ChangeRequest %s has %d of %d required approvals", c.Name, n, required)
+	}
+	return nil
+}
+
+// GetMinApprovals returns MinApprovals, defaulting to 1.
+func (r *ApprovalRule) GetMinApprovals() int32 {
+	if r == nil || r.MinApprovals < 1 {
+		return 1
+	}
+	return r.MinApprovals
+}
+
+// GetTTL returns TTL, defaulting to 72 hours.
+func (r *ApprovalRule) GetTTL() time.Duration {
+	if r == nil || r.TTL == nil {
+		return 72 * time.Hour
+	}
+	return r.TTL.Duration
+}
+
+// Matches returns true if the rule gates the given operation on the kind.
+func (r *ApprovalRule) Matches(apiGroup, kind string, op ChangeOperation) bool {
+	group := r.APIGroup
+	if group == "" {
+		group = GroupVersion.Group
+	}
+	if group != apiGroup || r.Kind != kind {
+		return false
+	}
+	for _, o := range r.Operations {
+		if o == op {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestChangeRequestCountApprovals(t *testing.T) {
+	listed := &ApprovalRule{Approvers: []string{"bob@example.com", "platform-admins"}}
+
+	tests := []struct {
+		name    string
+		rule    *ApprovalRule
+		reviews []ChangeReview
+		want    int32
+	}{
+		{
+			name: "no reviews",
+			want: 0,
+		},
+		{
+			name: "requester approval does not count",
+			reviews: []ChangeReview{
+				{Reviewer: "alice@example.com", Decision: ReviewDecisionApprove},
+			},
+			want: 0,
+		},
+		{
+			name: "two approvers",
+			reviews: []ChangeReview{
+				{Reviewer: "bob@example.com", Decision: ReviewDecisionApprove},
+				{Reviewer: "carol@example.com", Decision: ReviewDecisionApprove},
+			},
+			want: 2,
+		},
+		{
+			name: "rejection does not count",
+			reviews: []ChangeReview{
+				{Reviewer: "bob@example.com", Decision: ReviewDecisionApprove},
+				{Reviewer: "carol@example.com", Decision: ReviewDecisionReject},
+			},
+			want: 1,
+		},
+		{
+			name: "unlisted reviewer does not count",
+			rule: listed,
+			reviews: []ChangeReview{
+				{Reviewer: "bob@example.com", Decision: ReviewDecisionApprove},
+				{Reviewer: "mallory@example.com", Decision: ReviewDecisionApprove, Groups: []string{"developers"}},
+			},
+			want: 1,
+		},
+		{
+			name: "listed group counts",
+			rule: listed,
+			reviews: []ChangeReview{
+				{Reviewer: "dave@example.com", Decision: ReviewDecisionApprove, Groups: []string{"platform-admins"}},
+			},
+			want: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &ChangeRequest{
+				Spec: ChangeRequestSpec{
+					Requester: "alice@example.com",
+					Reviews:   tt.reviews,
+				},
+			}
+			if got := c.CountApprovals(tt.rule); got != tt.want {
+				t.Errorf("CountApprovals() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChangeRequestAuthorizes(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	rule := &ApprovalRule{
+		Kind:         "TenantCluster",
+		Operations:   []ChangeOperation{ChangeOperationDelete},
+		MinApprovals: 2,
+		Approvers:    []string{"sre"},
+	}
+	target := ChangeTargetReference{APIGroup: GroupVersion.Group, Kind: "TenantCluster", Name: "payments", Namespace: "team-a"}
+	approve := func(user string) ChangeReview {
+		return ChangeReview{Reviewer: user, Groups: []string{"sre"}, Decision: ReviewDecisionApprove}
+	}
+	request := func(mutate func(*ChangeRequest)) *ChangeRequest {
+		c := &ChangeRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "delete-payments", Namespace: "team-a"},
+			Spec: ChangeRequestSpec{
+				TargetRef: ChangeTargetReference{Kind: "TenantCluster", Name: "payments", Namespace: "team-a"},
+				Operation: ChangeOperationDelete,
+				Requester: "alice@example.com",
+				Reviews:   []ChangeReview{approve("bob@example.com"), approve("carol@example.com")},
+			},
+		}
+		if mutate != nil {
+			mutate(c)
+		}
+		return c
+	}
+
+	tests := []struct {
+		name    string
+		request *ChangeRequest
+		op      ChangeOperation
+		want    string
+	}{
+		{name: "approved", request: request(nil), op: ChangeOperationDelete},
+		{name: "other operation", request: request(nil), op: ChangeOperationUpdate, want: "is for DELETE of TenantCluster payments"},
+		{
+			name:    "too few approvals",
+			request: request(func(c *ChangeRequest) { c.Spec.Reviews = c.Spec.Reviews[:1] }),
+			op:      ChangeOperationDelete,
+			want:    "has 1 of 2 required approvals",
+		},
+		{
+			name: "rejected",
+			request: request(func(c *ChangeRequest) {
+				c.Spec.Reviews = append(c.Spec.Reviews, ChangeReview{Reviewer: "dave@example.com", Groups: []string{"sre"}, Decision: ReviewDecisionReject})
+			}),
+			op:   ChangeOperationDelete,
+			want: "was rejected",
+		},
+		{
+			name: "expired",
+			request: request(func(c *ChangeRequest) {
+				past := metav1.NewTime(now.Add(-time.Minute))
+				c.Spec.ExpiresAt = &past
+			}),
+			op:   ChangeOperationDelete,
+			want: "has expired",
+		},
+		{
+			name:    "already applied",
+			request: request(func(c *ChangeRequest) { c.Status.Phase = ChangeRequestPhaseApplied }),
+			op:      ChangeOperationDelete,
+			want:    "already been applied",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Authorizes(rule, target, tt.op, now)
+			switch {
+			case tt.want == "" && err != nil:
+				t.Errorf("Authorizes() = %v, want nil", err)
+			case tt.want != "" && (err == nil || !strings.Contains(err.Error(), tt.want)):
+				t.Errorf("Authorizes() = %v, want an error containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestChangeRequestIsApproved(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	past := metav1.NewTime(now.Add(-time.Hour))
+	future := metav1.NewTime(now.Add(time.Hour))
+
+	tests := []struct {
+		name      string
+		phase     ChangeRequestPhase
+		expiresAt *metav1.Time
+		want      bool
+	}{
+		{name: "approved without expiry", phase: ChangeRequestPhaseApproved, want: true},
+		{name: "approved before expiry", phase: ChangeRequestPhaseApproved, expiresAt: &future, want: true},
+		{name: "approved but expired", phase: ChangeRequestPhaseApproved, expiresAt: &past, want: false},
+		{name: "pending", phase: ChangeRequestPhasePending, want: false},
+		{name: "already applied", phase: ChangeRequestPhaseApplied, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &ChangeRequest{
+				Spec:   ChangeRequestSpec{ExpiresAt: tt.expiresAt},
+				Status: ChangeRequestStatus{Phase: tt.phase},
+			}
+			if got := c.IsApproved(now); got != tt.want {
+				t.Errorf("IsApproved() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ArchivedArtifactType identifies the kind of artifact retained with an archive.
+// +kubebuilder:validation:Enum=Kubeconfig;TalosConfig;EtcdBackup;AuditLog;Manifests
+type ArchivedArtifactType string
+
+const (
+	// ArchivedArtifactKubeconfig is the final admin kubeconfig.
+	ArchivedArtifactKubeconfig ArchivedArtifactType = "Kubeconfig"
+
+	// ArchivedArtifactTalosConfig is the final talosconfig.
+	ArchivedArtifactTalosConfig ArchivedArtifactType = "TalosConfig"
+
+	// ArchivedArtifactEtcdBackup is a final etcd snapshot taken before teardown.
+	ArchivedArtifactEtcdBackup ArchivedArtifactType = "EtcdBackup"
+
+	// ArchivedArtifactAuditLog is the exported audit log for the cluster.
+	ArchivedArtifactAuditLog ArchivedArtifactType = "AuditLog"
+
+	// ArchivedArtifactManifests is an export of Butler-managed objects for the cluster.
+	ArchivedArtifactManifests ArchivedArtifactType = "Manifests"
+)
+
+// ClusterArchiveSpec is the recorded state of a deleted TenantCluster.
+// The spec is written once by the controller. Identity and timestamps are
+// immutable; only the controller is granted update access for the rest.
+// +kubebuilder:validation:XValidation:rule="self.clusterRef == oldSelf.clusterRef && self.clusterUID == oldSelf.clusterUID",message="clusterRef and clusterUID are immutable"
+// +kubebuilder:validation:XValidation:rule="self.createdAt == oldSelf.createdAt && self.deletedAt == oldSelf.deletedAt",message="createdAt and deletedAt are immutable"
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.deletedBy) || (has(self.deletedBy) && self.deletedBy == oldSelf.deletedBy)",message="deletedBy is immutable"
+type ClusterArchiveSpec struct {
+	// ClusterRef identifies the deleted TenantCluster.
+	// +kubebuilder:validation:Required
+	ClusterRef NamespacedObjectReference `json:"clusterRef"`
+
+	// ClusterUID is the UID of the deleted TenantCluster.
+	// Distinguishes clusters that reused the same name.
+	// +kubebuilder:validation:Required
+	ClusterUID string `json:"clusterUID"`
+
+	// Team is the name of the owning Team.
+	// +optional
+	Team string `json:"team,omitempty"`
+
+	// ClusterSpec is the final spec of the TenantCluster.
+	// +kubebuilder:validation:Required
+	ClusterSpec TenantClusterSpec `json:"clusterSpec"`
+
+	// Labels are the final labels of the TenantCluster.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations are the final annotations of the TenantCluster.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// CreatedAt is when the TenantCluster was created.
+	// +kubebuilder:validation:Required
+	CreatedAt metav1.Time `json:"createdAt"`
+
+	// CreatedBy is the user who created the TenantCluster.
+	// +optional
+	CreatedBy string `json:"createdBy,omitempty"`
+
+	// DeletedAt is when deletion of the TenantCluster was requested.
+	// +kubebuilder:validation:Required
+	DeletedAt metav1.Time `json:"deletedAt"`
+
+	// DeletedBy is the user who deleted the TenantCluster.
+	// +optional
+	DeletedBy string `json:"deletedBy,omitempty"`
+
+	// Usage summarizes resource consumption over the cluster's lifetime.
+	// +optional
+	Usage *ClusterUsageTotals `json:"usage,omitempty"`
+
+	// Artifacts lists artifacts retained after deletion.
+	// +optional
+	Artifacts []ArchivedArtifact `json:"artifacts,omitempty"`
+
+	// ExpiresAt is when the archive is garbage collected.
+	// Computed from ButlerConfig.spec.archive.retention at deletion time.
+	// Nil means the archive is kept indefinitely.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+}
+
+// ClusterUsageTotals summarizes lifetime resource consumption.
+type ClusterUsageTotals struct {
+	// NodeHours is the total worker node-hours.
+	// +optional
+	NodeHours *resource.Quantity `json:"nodeHours,omitempty"`
+
+	// CPUCoreHours is the total worker CPU core-hours.
+	// +optional
+	CPUCoreHours *resource.Quantity `json:"cpuCoreHours,omitempty"`
+
+	// MemoryGiBHours is the total worker memory GiB-hours.
+	// +optional
+	MemoryGiBHours *resource.Quantity `json:"memoryGiBHours,omitempty"`
+
+	// PeakWorkers is the highest observed worker count.
+	// +optional
+	PeakWorkers int32 `json:"peakWorkers,omitempty"`
+}
+
+// ArchivedArtifact is an artifact retained after cluster deletion.
+type ArchivedArtifact struct {
+	// Type of the artifact.
+	// +kubebuilder:validation:Required
+	Type ArchivedArtifactType `json:"type"`
+
+	// SecretRef references a Secret holding the artifact.
+	// Used for small artifacts such as kubeconfigs.
+	// +optional
+	SecretRef *SecretReference `json:"secretRef,omitempty"`
+
+	// Location is an external URL for the artifact (e.g., an object storage URL).
+	// +optional
+	Location string `json:"location,omitempty"`
+
+	// SHA256 is the hex-encoded checksum of the artifact.
+	// +optional
+	SHA256 string `json:"sha256,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=carc,categories=butler
+// +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".spec.clusterRef.name",description="Deleted cluster"
+// +kubebuilder:printcolumn:name="Namespace",type="string",JSONPath=".spec.clusterRef.namespace",description="Cluster namespace"
+// +kubebuilder:printcolumn:name="Team",type="string",JSONPath=".spec.team",description="Owning team"
+// +kubebuilder:printcolumn:name="Deleted",type="date",JSONPath=".spec.deletedAt",description="Deletion time"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ClusterArchive is an immutable record of a deleted TenantCluster.
+// The TenantCluster controller creates one while processing its finalizer,
+// so the record outlives the cluster, its namespace, and its Team.
+// Archives are named "{namespace}-{name}-{short uid}".
+type ClusterArchive struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ClusterArchiveSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterArchiveList contains a list of ClusterArchive.
+type ClusterArchiveList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterArchive `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterArchive{}, &ClusterArchiveList{})
+}
+
+// ArchiveConfig configures ClusterArchive creation and retention.
+type ArchiveConfig struct {
+	// Enabled controls whether a ClusterArchive is created on deletion.
+	// +kubebuilder:default=true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Retention is how long archives are kept. Set to 0 to keep archives indefinitely.
+	// +kubebuilder:default="2160h"
+	// +optional
+	Retention *metav1.Duration `json:"retention,omitempty"`
+
+	// Artifacts lists artifact types to retain with each archive.
+	// If empty, only the spec snapshot and metadata are kept.
+	// +optional
+	Artifacts []ArchivedArtifactType `json:"artifacts,omitempty"`
+}
+
+// Helper methods
+
+// IsExpired returns true if the archive has passed its expiry time.
+func (a *ClusterArchive) IsExpired(now time.Time) bool {
+	return a.Spec.ExpiresAt != nil && !now.Before(a.Spec.ExpiresAt.Time)
+}
+
+// Lifetime returns how long the cluster existed.
+func (a *ClusterArchive) Lifetime() time.Duration {
+	return a.Spec.DeletedAt.Sub(a.Spec.CreatedAt.Time)
+}
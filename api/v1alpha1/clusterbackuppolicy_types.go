@@ -0,0 +1,224 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterBackupPolicy condition types.
+const (
+	// ClusterBackupPolicyConditionReady indicates the Velero Schedule exists
+	// in the tenant cluster and matches the policy.
+	ClusterBackupPolicyConditionReady = "Ready"
+
+	// ClusterBackupPolicyConditionLastBackupSucceeded indicates the most
+	// recent backup completed without errors.
+	ClusterBackupPolicyConditionLastBackupSucceeded = "LastBackupSucceeded"
+)
+
+// DefaultBackupTTL is how long backups are kept when retention.ttl is unset.
+const DefaultBackupTTL = 720 * time.Hour
+
+// ClusterBackupPolicySpec defines the desired state of ClusterBackupPolicy.
+type ClusterBackupPolicySpec struct {
+	// ClusterRef references the TenantCluster in the same namespace to back up.
+	// The cluster must have the backup addon enabled.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="clusterRef is immutable"
+	ClusterRef LocalObjectReference `json:"clusterRef"`
+
+	// Schedule is when backups run, in cron format (e.g., "0 2 * * *") or
+	// as a descriptor such as "@daily". Times are UTC.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^(@(yearly|annually|monthly|weekly|daily|midnight|hourly)|(\S+\s+){4}\S+)$`
+	Schedule string `json:"schedule"`
+
+	// Retention controls how long backups are kept.
+	// +optional
+	Retention *BackupRetention `json:"retention,omitempty"`
+
+	// IncludedNamespaces limits backups to these namespaces. Empty means all.
+	// +optional
+	// +listType=set
+	IncludedNamespaces []string `json:"includedNamespaces,omitempty"`
+
+	// ExcludedNamespaces are skipped.
+	// +optional
+	// +listType=set
+	ExcludedNamespaces []string `json:"excludedNamespaces,omitempty"`
+
+	// StorageLocationRef names a Velero BackupStorageLocation in the tenant
+	// cluster. Defaults to the location configured by
+	// spec.addons.backup.storageLocation on the TenantCluster.
+	// +optional
+	StorageLocationRef *LocalObjectReference `json:"storageLocationRef,omitempty"`
+
+	// SnapshotVolumes takes volume snapshots in addition to resource backups.
+	// Defaults to the backup addon setting.
+	// +optional
+	SnapshotVolumes *bool `json:"snapshotVolumes,omitempty"`
+
+	// Paused stops scheduling new backups. Existing backups are kept.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// BackupRetention controls how long backups are kept.
+type BackupRetention struct {
+	// TTL is how long each backup is kept.
+	// +kubebuilder:default="720h"
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+
+	// KeepLast keeps at most this many completed backups, deleting the
+	// oldest first even if their TTL has not elapsed.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	KeepLast *int32 `json:"keepLast,omitempty"`
+}
+
+// ClusterBackupPolicyStatus defines the observed state of ClusterBackupPolicy.
+type ClusterBackupPolicyStatus struct {
+	// Conditions represent the latest available observations.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// LastBackupName is the name of the most recent Velero Backup.
+	// +optional
+	LastBackupName string `json:"lastBackupName,omitempty"`
+
+	// LastBackupPhase is the Velero phase of the most recent backup
+	// (e.g., "Completed", "PartiallyFailed").
+	// +optional
+	LastBackupPhase string `json:"lastBackupPhase,omitempty"`
+
+	// LastBackupTime is when the most recent backup started.
+	// +optional
+	LastBackupTime *metav1.Time `json:"lastBackupTime,omitempty"`
+
+	// LastSuccessfulBackupTime is when the most recent completed backup started.
+	// +optional
+	LastSuccessfulBackupTime *metav1.Time `json:"lastSuccessfulBackupTime,omitempty"`
+
+	// NextBackupTime is when the next backup is scheduled.
+	// +optional
+	NextBackupTime *metav1.Time `json:"nextBackupTime,omitempty"`
+
+	// BackupCount is the number of retained backups created by this policy.
+	// +optional
+	BackupCount int32 `json:"backupCount,omitempty"`
+
+	// Message provides human-readable status information.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// ObservedGeneration is the last observed generation.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=cbp,categories=butler
+// +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".spec.clusterRef.name",description="Tenant cluster"
+// +kubebuilder:printcolumn:name="Schedule",type="string",JSONPath=".spec.schedule",description="Backup schedule"
+// +kubebuilder:printcolumn:name="Paused",type="boolean",JSONPath=".spec.paused",description="Scheduling paused"
+// +kubebuilder:printcolumn:name="Last Backup",type="date",JSONPath=".status.lastBackupTime",description="Most recent backup"
+// +kubebuilder:printcolumn:name="Backups",type="integer",JSONPath=".status.backupCount",description="Retained backups"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ClusterBackupPolicy schedules Velero backups of a TenantCluster.
+// The controller maintains a Velero Schedule in the tenant cluster and
+// reports the resulting backups. A cluster may have several policies, for
+// example hourly backups of one namespace and nightly full backups.
+type ClusterBackupPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterBackupPolicySpec   `json:"spec,omitempty"`
+	Status ClusterBackupPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterBackupPolicyList contains a list of ClusterBackupPolicy.
+type ClusterBackupPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterBackupPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterBackupPolicy{}, &ClusterBackupPolicyList{})
+}
+
+// Helper methods
+
+// GetTTL returns how long each backup is kept, defaulting to DefaultBackupTTL.
+func (p *ClusterBackupPolicy) GetTTL() time.Duration {
+	if r := p.Spec.Retention; r != nil && r.TTL != nil {
+		return r.TTL.Duration
+	}
+	return DefaultBackupTTL
+}
+
+// VeleroScheduleName returns the name of the Velero Schedule created in
+// the tenant cluster for this policy.
+func (p *ClusterBackupPolicy) VeleroScheduleName() string {
+	return "butler-" + p.Name
+}
+
+// ShouldSnapshotVolumes returns whether volume snapshots are taken, falling
+// back to the cluster's backup addon setting and then to true.
+func (p *ClusterBackupPolicy) ShouldSnapshotVolumes(addon *BackupAddonSpec) bool {
+	if p.Spec.SnapshotVolumes != nil {
+		return *p.Spec.SnapshotVolumes
+	}
+	if addon != nil && addon.SnapshotVolumes != nil {
+		return *addon.SnapshotVolumes
+	}
+	return true
+}
+
+// ExcessBackups returns how many of count retained backups exceed
+// retention.keepLast and should be deleted, oldest first.
+func (p *ClusterBackupPolicy) ExcessBackups(count int32) int32 {
+	r := p.Spec.Retention
+	if r == nil || r.KeepLast == nil || count <= *r.KeepLast {
+		return 0
+	}
+	return count - *r.KeepLast
+}
+
+// IsBackupOverdue returns true if the last successful backup is older than
+// interval plus grace, for alerting on silently failing schedules.
+// Paused policies are never overdue.
+func (p *ClusterBackupPolicy) IsBackupOverdue(now time.Time, interval, grace time.Duration) bool {
+	if p.Spec.Paused {
+		return false
+	}
+	last := p.Status.LastSuccessfulBackupTime
+	if last == nil {
+		return now.Sub(p.CreationTimestamp.Time) > interval+grace
+	}
+	return now.Sub(last.Time) > interval+grace
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestClusterBackupPolicyIsBackupOverdue(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *metav1.Time { t := metav1.NewTime(now.Add(d)); return &t }
+
+	tests := []struct {
+		name    string
+		created time.Duration
+		last    *metav1.Time
+		paused  bool
+		want    bool
+	}{
+		{"recent backup", -72 * time.Hour, at(-20 * time.Hour), false, false},
+		{"stale backup", -72 * time.Hour, at(-30 * time.Hour), false, true},
+		{"new policy without backups", -time.Hour, nil, false, false},
+		{"old policy without backups", -72 * time.Hour, nil, false, true},
+		{"paused", -72 * time.Hour, at(-48 * time.Hour), true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &ClusterBackupPolicy{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: *at(tt.created)},
+				Spec:       ClusterBackupPolicySpec{Paused: tt.paused},
+				Status:     ClusterBackupPolicyStatus{LastSuccessfulBackupTime: tt.last},
+			}
+			if got := p.IsBackupOverdue(now, 24*time.Hour, 4*time.Hour); got != tt.want {
+				t.Errorf("IsBackupOverdue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClusterBackupPolicyRetention(t *testing.T) {
+	keep := int32(7)
+	p := &ClusterBackupPolicy{}
+	if got := p.GetTTL(); got != DefaultBackupTTL {
+		t.Errorf("GetTTL() = %v, want %v", got, DefaultBackupTTL)
+	}
+	if got := p.ExcessBackups(20); got != 0 {
+		t.Errorf("ExcessBackups() without keepLast = %d, want 0", got)
+	}
+
+	p.Spec.Retention = &BackupRetention{TTL: &metav1.Duration{Duration: 48 * time.Hour}, KeepLast: &keep}
+	if got := p.GetTTL(); got != 48*time.Hour {
+		t.Errorf("GetTTL() = %v, want 48h", got)
+	}
+	if got := p.ExcessBackups(10); got != 3 {
+		t.Errorf("ExcessBackups(10) = %d, want 3", got)
+	}
+}
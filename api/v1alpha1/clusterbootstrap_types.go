@@ -17,11 +17,13 @@ limitations under the License.
 package v1alpha1
 
 import (
-	"encoding/binary"
 	"fmt"
+	"maps"
 	"net"
+	"net/netip"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -39,6 +41,16 @@ const (
 	ClusterBootstrapPhaseFailed               ClusterBootstrapPhase = "Failed"
 )
 
+// ClusterBootstrap condition types and reasons
+const (
+	// ClusterBootstrapConditionDeprecatedFields is True when the spec relies on deprecated fields
+	ClusterBootstrapConditionDeprecatedFields = "DeprecatedFields"
+
+	// ReasonLegacyAddressPool indicates addons.loadBalancer.addressPool was converted
+	// into network.loadBalancerPool
+	ReasonLegacyAddressPool = "LegacyAddressPool"
+)
+
 // ClusterTopology defines the cluster topology type
 type ClusterTopology string
 
@@ -119,7 +131,8 @@ type ControlPlaneExposureSpec struct {
 // ClusterBootstrapSpec defines the desired state of ClusterBootstrap
 type ClusterBootstrapSpec struct {
 	// Provider is the infrastructure provider type.
-	// +kubebuilder:validation:Enum=harvester;nutanix;proxmox;gcp;aws;azure
+	// +kubebuilder:validation:Enum=harvester;nutanix;proxmox;vsphere;openstack;baremetal;gcp;aws;azure
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="provider is immutable"
 	Provider string `json:"provider"`
 
 	// ProviderRef references the ProviderConfig to use for provisioning
@@ -162,6 +175,7 @@ type ClusterBootstrapClusterSpec struct {
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:MaxLength=63
 	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="cluster.name is immutable"
 	Name string `json:"name"`
 
 	// Topology defines the cluster topology
@@ -184,6 +198,7 @@ type ClusterBootstrapClusterSpec struct {
 
 // ClusterBootstrapNodePool defines a pool of nodes for bootstrap
 // Uses same units as MachineRequest (MemoryMB, DiskGB) for consistency
+// +kubebuilder:validation:XValidation:rule="!has(self.overrides) || self.overrides.all(o, o.index < self.replicas)",message="overrides index must be less than replicas"
 type ClusterBootstrapNodePool struct {
 	// Replicas is the number of nodes in this pool
 	// For single-node topology, controlPlane.replicas is forced to 1
@@ -216,20 +231,121 @@ type ClusterBootstrapNodePool struct {
 	// Labels to apply to nodes in this pool
 	// +optional
 	Labels map[string]string `json:"labels,omitempty"`
+
+	// Taints to apply to nodes in this pool when they register
+	// Use these to reserve nodes for dedicated workloads
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Taints []NodeTaint `json:"taints,omitempty"`
+
+	// KubeletExtraArgs are extra kubelet flags, without the leading "--"
+	// Flags managed by Butler, such as node-labels and register-with-taints, may not be set
+	// +optional
+	// +kubebuilder:validation:MaxProperties=32
+	KubeletExtraArgs map[string]string `json:"kubeletExtraArgs,omitempty"`
+
+	// NodeAnnotations to apply to nodes in this pool after they register
+	// +optional
+	NodeAnnotations map[string]string `json:"nodeAnnotations,omitempty"`
+
+	// Firmware configures boot firmware, secure boot, and the virtual TPM
+	// for nodes in this pool
+	// +optional
+	Firmware *FirmwareSpec `json:"firmware,omitempty"`
+
+	// Placement constrains where nodes in this pool are placed
+	// If antiAffinityGroup is not set, nodes are grouped by cluster and role,
+	// so control plane nodes are spread across hosts by default
+	// +optional
+	Placement *PlacementSpec `json:"placement,omitempty"`
+
+	// Overrides adjusts sizing for individual nodes in the pool, for example
+	// a larger first control plane node to host pivot workloads
+	// Applied to the MachineRequest generated for the node at that index
+	// +optional
+	// +listType=map
+	// +listMapKey=index
+	Overrides []ClusterBootstrapNodeOverride `json:"overrides,omitempty"`
+}
+
+// ValidateNodeSettings checks kubelet extra args and node annotations
+func (p *ClusterBootstrapNodePool) ValidateNodeSettings() error {
+	return validateNodeSettings(p.KubeletExtraArgs, p.NodeAnnotations)
+}
+
+// ClusterBootstrapNodeOverride overrides pool settings for a single node
+// Unset fields inherit the pool value
+// +kubebuilder:validation:XValidation:rule="has(self.cpu) || has(self.memoryMB) || has(self.diskGB) || has(self.extraDisks) || has(self.labels)",message="override must set at least one field"
+type ClusterBootstrapNodeOverride struct {
+	// Index is the zero-based ordinal of the node in the pool
+	// Control plane index 0 is the first node bootstrapped
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=9
+	Index int32 `json:"index"`
+
+	// CPU is the number of CPU cores for this node
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=128
+	CPU *int32 `json:"cpu,omitempty"`
+
+	// MemoryMB is the memory in MB for this node
+	// +optional
+	// +kubebuilder:validation:Minimum=2048
+	MemoryMB *int32 `json:"memoryMB,omitempty"`
+
+	// DiskGB is the root disk size in GB for this node
+	// +optional
+	// +kubebuilder:validation:Minimum=20
+	DiskGB *int32 `json:"diskGB,omitempty"`
+
+	// ExtraDisks replaces the pool extraDisks for this node
+	// +optional
+	ExtraDisks []DiskSpec `json:"extraDisks,omitempty"`
+
+	// Labels are merged over the pool labels for this node
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
 }
 
 // ClusterBootstrapNetworkSpec defines cluster networking for bootstrap
+// For dual-stack clusters set podCIDRs and serviceCIDRs to one IPv4 and one
+// IPv6 CIDR each; the first entry selects the primary IP family
+// +kubebuilder:validation:XValidation:rule="!has(self.vip) || !has(self.vipPoolRef)",message="vip and vipPoolRef are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!has(self.podCIDRs) || self.podCIDR in self.podCIDRs",message="podCIDRs must include podCIDR"
+// +kubebuilder:validation:XValidation:rule="!has(self.serviceCIDRs) || self.serviceCIDR in self.serviceCIDRs",message="serviceCIDRs must include serviceCIDR"
 type ClusterBootstrapNetworkSpec struct {
 	// PodCIDR is the CIDR for pod networking
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:Pattern=`^([0-9]{1,3}\.){3}[0-9]{1,3}/[0-9]{1,2}$`
+	// +kubebuilder:validation:MaxLength=43
+	// +kubebuilder:validation:XValidation:rule="isCIDR(self)",message="must be a valid CIDR"
 	PodCIDR string `json:"podCIDR"`
 
 	// ServiceCIDR is the CIDR for service networking
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:Pattern=`^([0-9]{1,3}\.){3}[0-9]{1,3}/[0-9]{1,2}$`
+	// +kubebuilder:validation:MaxLength=43
+	// +kubebuilder:validation:XValidation:rule="isCIDR(self)",message="must be a valid CIDR"
 	ServiceCIDR string `json:"serviceCIDR"`
 
+	// PodCIDRs lists the pod CIDRs of a dual-stack cluster, primary family
+	// first. Must include podCIDR
+	// +optional
+	// +kubebuilder:validation:MaxItems=2
+	// +kubebuilder:validation:items:MaxLength=43
+	// +kubebuilder:validation:XValidation:rule="self.all(c, isCIDR(c))",message="must be valid CIDRs"
+	// +kubebuilder:validation:XValidation:rule="size(self) < 2 || !isCIDR(self[0]) || !isCIDR(self[1]) || cidr(self[0]).ip().family() != cidr(self[1]).ip().family()",message="dual-stack CIDRs must be one IPv4 and one IPv6 CIDR"
+	PodCIDRs []string `json:"podCIDRs,omitempty"`
+
+	// ServiceCIDRs lists the service CIDRs of a dual-stack cluster, primary
+	// family first. Must include serviceCIDR
+	// +optional
+	// +kubebuilder:validation:MaxItems=2
+	// +kubebuilder:validation:items:MaxLength=43
+	// +kubebuilder:validation:XValidation:rule="self.all(c, isCIDR(c))",message="must be valid CIDRs"
+	// +kubebuilder:validation:XValidation:rule="size(self) < 2 || !isCIDR(self[0]) || !isCIDR(self[1]) || cidr(self[0]).ip().family() != cidr(self[1]).ip().family()",message="dual-stack CIDRs must be one IPv4 and one IPv6 CIDR"
+	ServiceCIDRs []string `json:"serviceCIDRs,omitempty"`
+
 	// VIP is the control plane endpoint. For on-prem providers this is
 	// a virtual IP managed by kube-vip. For cloud providers this can be
 	// a load balancer IP or DNS name. Optional for cloud providers where
@@ -237,6 +353,12 @@ type ClusterBootstrapNetworkSpec struct {
 	// +optional
 	VIP string `json:"vip,omitempty"`
 
+	// VIPPoolRef allocates the VIP from this NetworkPool with a controlplane
+	// IPAllocation instead of setting VIP directly. The allocated address
+	// is reported in status.vip
+	// +optional
+	VIPPoolRef *LocalObjectReference `json:"vipPoolRef,omitempty"`
+
 	// VIPInterface is the network interface for the VIP (optional, auto-detected)
 	// +optional
 	VIPInterface string `json:"vipInterface,omitempty"`
@@ -249,15 +371,18 @@ type ClusterBootstrapNetworkSpec struct {
 }
 
 // LoadBalancerPoolSpec defines an IP address range for LoadBalancer services
+// +kubebuilder:validation:XValidation:rule="!isIP(self.start) || !isIP(self.end) || ip(self.start).family() == ip(self.end).family()",message="start and end must be the same IP family"
 type LoadBalancerPoolSpec struct {
 	// Start is the first IP in the pool (inclusive)
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:Pattern=`^([0-9]{1,3}\.){3}[0-9]{1,3}$`
+	// +kubebuilder:validation:MaxLength=39
+	// +kubebuilder:validation:XValidation:rule="isIP(self)",message="must be a valid IP address"
 	Start string `json:"start"`
 
 	// End is the last IP in the pool (inclusive)
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:Pattern=`^([0-9]{1,3}\.){3}[0-9]{1,3}$`
+	// +kubebuilder:validation:MaxLength=39
+	// +kubebuilder:validation:XValidation:rule="isIP(self)",message="must be a valid IP address"
 	End string `json:"end"`
 }
 
@@ -267,17 +392,18 @@ func (p *LoadBalancerPoolSpec) Validate() error {
 		return nil
 	}
 
-	startIP := net.ParseIP(p.Start)
-	if startIP == nil {
+	if _, err := parseAddr(p.Start); err != nil {
 		return fmt.Errorf("invalid start IP: %s", p.Start)
 	}
-
-	endIP := net.ParseIP(p.End)
-	if endIP == nil {
+	if _, err := parseAddr(p.End); err != nil {
 		return fmt.Errorf("invalid end IP: %s", p.End)
 	}
 
-	if ipToUint32(startIP) > ipToUint32(endIP) {
+	cmp, err := compareAddrs(p.Start, p.End)
+	if err != nil {
+		return err
+	}
+	if cmp > 0 {
 		return fmt.Errorf("start IP %s must be <= end IP %s", p.Start, p.End)
 	}
 
@@ -290,22 +416,24 @@ func (p *LoadBalancerPoolSpec) ContainsIP(ip string) bool {
 		return false
 	}
 
-	checkIP := net.ParseIP(ip)
-	if checkIP == nil {
+	afterStart, err := compareAddrs(ip, p.Start)
+	if err != nil {
 		return false
 	}
-
-	startIP := net.ParseIP(p.Start)
-	endIP := net.ParseIP(p.End)
-	if startIP == nil || endIP == nil {
+	beforeEnd, err := compareAddrs(ip, p.End)
+	if err != nil {
 		return false
 	}
 
-	checkVal := ipToUint32(checkIP)
-	startVal := ipToUint32(startIP)
-	endVal := ipToUint32(endIP)
+	return afterStart >= 0 && beforeEnd <= 0
+}
 
-	return checkVal >= startVal && checkVal <= endVal
+// Family returns the IP family of the pool, or "" if Start is invalid
+func (p *LoadBalancerPoolSpec) Family() IPFamily {
+	if p == nil {
+		return ""
+	}
+	return IPFamilyOf(p.Start)
 }
 
 // ToAddressRange returns the pool as "start-end" string for MetalLB
@@ -316,13 +444,39 @@ func (p *LoadBalancerPoolSpec) ToAddressRange() string {
 	return fmt.Sprintf("%s-%s", p.Start, p.End)
 }
 
-// ipToUint32 converts an IPv4 address to a uint32
-func ipToUint32(ip net.IP) uint32 {
-	ip = ip.To4()
-	if ip == nil {
-		return 0
+// ParseAddressRange parses a legacy MetalLB address pool string into a
+// LoadBalancerPoolSpec. Accepts "start-end" (spaces around the dash are
+// allowed) or a CIDR, which covers every address in the block as MetalLB
+// does
+func ParseAddressRange(s string) (*LoadBalancerPoolSpec, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, fmt.Errorf("empty address range")
+	}
+
+	if strings.Contains(s, "/") {
+		prefix, err := netip.ParsePrefix(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR %q: %w", s, err)
+		}
+		prefix = prefix.Masked()
+		return &LoadBalancerPoolSpec{Start: prefix.Addr().String(), End: lastAddr(prefix).String()}, nil
+	}
+
+	startStr, endStr, ok := strings.Cut(s, "-")
+	if !ok {
+		return nil, fmt.Errorf("address range %q must be \"start-end\" or a CIDR", s)
+	}
+	pool := &LoadBalancerPoolSpec{Start: strings.TrimSpace(startStr), End: strings.TrimSpace(endStr)}
+	for _, ip := range []string{pool.Start, pool.End} {
+		if _, err := parseAddr(ip); err != nil {
+			return nil, fmt.Errorf("invalid IP address %q in range %q", ip, s)
+		}
+	}
+	if err := pool.Validate(); err != nil {
+		return nil, err
 	}
-	return binary.BigEndian.Uint32(ip)
+	return pool, nil
 }
 
 // isValidEndpoint returns true if s is a valid IP address or RFC 1123 hostname.
@@ -350,6 +504,15 @@ func isValidEndpoint(s string) bool {
 // VIP is optional for cloud providers where kube-vip is not used.
 // VIP may be an IP address (on-prem) or a DNS hostname (cloud LB endpoint).
 func (n *ClusterBootstrapNetworkSpec) Validate() error {
+	if n.VIP != "" && n.VIPPoolRef != nil {
+		return fmt.Errorf("vip and vipPoolRef are mutually exclusive")
+	}
+	if err := ValidateDualStack(n.PodCIDR, n.PodCIDRs); err != nil {
+		return fmt.Errorf("invalid podCIDRs: %w", err)
+	}
+	if err := ValidateDualStack(n.ServiceCIDR, n.ServiceCIDRs); err != nil {
+		return fmt.Errorf("invalid serviceCIDRs: %w", err)
+	}
 	if n.VIP != "" {
 		if !isValidEndpoint(n.VIP) {
 			return fmt.Errorf("invalid VIP address or hostname: %s", n.VIP)
@@ -377,16 +540,39 @@ func (n *ClusterBootstrapNetworkSpec) Validate() error {
 	return nil
 }
 
+// GetPodCIDRs returns the pod CIDRs, primary family first
+func (n *ClusterBootstrapNetworkSpec) GetPodCIDRs() []string {
+	return dualStackCIDRs(n.PodCIDR, n.PodCIDRs)
+}
+
+// GetServiceCIDRs returns the service CIDRs, primary family first
+func (n *ClusterBootstrapNetworkSpec) GetServiceCIDRs() []string {
+	return dualStackCIDRs(n.ServiceCIDR, n.ServiceCIDRs)
+}
+
+// IsDualStack returns true if the cluster has both IPv4 and IPv6 networks
+func (n *ClusterBootstrapNetworkSpec) IsDualStack() bool {
+	return len(cidrFamilies(n.GetServiceCIDRs())) == 2
+}
+
 // ClusterBootstrapTalosSpec defines Talos configuration for bootstrap
+// +kubebuilder:validation:XValidation:rule="has(self.releaseRef) != (has(self.version) && has(self.schematic))",message="set either releaseRef or both version and schematic"
+// +kubebuilder:validation:XValidation:rule="!has(self.releaseRef) || (!has(self.version) && !has(self.schematic))",message="version and schematic may not be set with releaseRef"
 type ClusterBootstrapTalosSpec struct {
+	// ReleaseRef references a TalosRelease providing the version and schematic
+	// +optional
+	ReleaseRef *LocalObjectReference `json:"releaseRef,omitempty"`
+
 	// Version is the Talos version to use
-	// +kubebuilder:validation:Required
+	// Required unless releaseRef is set
 	// +kubebuilder:validation:Pattern=`^v[0-9]+\.[0-9]+\.[0-9]+$`
-	Version string `json:"version"`
+	// +optional
+	Version string `json:"version,omitempty"`
 
 	// Schematic is the Talos factory schematic ID for the image
-	// +kubebuilder:validation:Required
-	Schematic string `json:"schematic"`
+	// Required unless releaseRef is set
+	// +optional
+	Schematic string `json:"schematic,omitempty"`
 
 	// ConfigPatches allows inline Talos config patches
 	// +optional
@@ -457,6 +643,18 @@ type ClusterBootstrapAddonsSpec struct {
 	// Console defines Butler Console configuration
 	// +optional
 	Console *ConsoleAddonSpec `json:"console,omitempty"`
+
+	// Server defines butler-server (the API backend for the console) configuration
+	// +optional
+	Server *ServerAddonSpec `json:"server,omitempty"`
+
+	// Monitoring defines platform observability for the management cluster
+	// +optional
+	Monitoring *MonitoringAddonSpec `json:"monitoring,omitempty"`
+
+	// Backup defines backups of the management cluster
+	// +optional
+	Backup *BackupAddonSpec `json:"backup,omitempty"`
 }
 
 // CNIAddonSpec defines CNI configuration
@@ -488,10 +686,21 @@ type StorageAddonSpec struct {
 	Version string `json:"version,omitempty"`
 
 	// ReplicaCount is the default replica count for Longhorn volumes
+	// Used for policies that do not set their own replica count
 	// For single-node topology, this is automatically set to 1
 	// +optional
 	// +kubebuilder:default=3
 	ReplicaCount *int32 `json:"replicaCount,omitempty"`
+
+	// Policies are the StorageClasses to create, each with its own replica
+	// count, data locality, and snapshot schedule. If empty, a single
+	// default "longhorn" policy is created
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:rule="self.filter(p, has(p.default) && p.default).size() <= 1",message="at most one storage policy may be the default"
+	Policies []StoragePolicy `json:"policies,omitempty"`
 }
 
 // LoadBalancerAddonSpec defines load balancer configuration
@@ -510,7 +719,7 @@ type LoadBalancerAddonSpec struct {
 // GitOpsAddonSpec defines GitOps configuration
 type GitOpsAddonSpec struct {
 	// Type is the GitOps type
-	// +kubebuilder:validation:Enum=flux;none
+	// +kubebuilder:validation:Enum=flux;argocd;none
 	// +kubebuilder:default=flux
 	Type string `json:"type,omitempty"`
 
@@ -518,6 +727,43 @@ type GitOpsAddonSpec struct {
 	// +optional
 	// +kubebuilder:default=true
 	Enabled *bool `json:"enabled,omitempty"`
+
+	// Version is the GitOps tool version
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// Repository configures the Git repository the management cluster syncs from
+	// +optional
+	Repository *GitRepositorySpec `json:"repository,omitempty"`
+
+	// ArgoCD configures Argo CD when type is argocd
+	// +optional
+	ArgoCD *ArgoCDOptions `json:"argocd,omitempty"`
+}
+
+// ToGitOpsSpec converts the bootstrap GitOps config to the shared GitOpsSpec
+// Returns nil when GitOps is disabled or the type is "none"
+func (s *GitOpsAddonSpec) ToGitOpsSpec() *GitOpsSpec {
+	if s == nil {
+		return &GitOpsSpec{Provider: string(GitOpsProviderFluxCD)}
+	}
+	if s.Enabled != nil && !*s.Enabled {
+		return nil
+	}
+	typ := s.Type
+	if typ == "" {
+		typ = "flux"
+	}
+	provider := NormalizeGitOpsProvider(typ)
+	if provider == "" {
+		return nil
+	}
+	return &GitOpsSpec{
+		Provider:   string(provider),
+		Version:    s.Version,
+		Repository: s.Repository,
+		ArgoCD:     s.ArgoCD,
+	}
 }
 
 // ControlPlaneHAAddonSpec defines control plane HA configuration
@@ -576,6 +822,97 @@ type ControlPlaneProviderAddonSpec struct {
 	// Version is the addon version
 	// +optional
 	Version string `json:"version,omitempty"`
+
+	// DataStore configures the DataStore created during bootstrap
+	// Steward and Kamaji need at least one DataStore before a tenant
+	// control plane can be created; this one becomes the default
+	// +optional
+	DataStore *DataStoreBootstrapSpec `json:"dataStore,omitempty"`
+}
+
+// DataStoreDriver is the backend driver for a hosted control plane DataStore
+// +kubebuilder:validation:Enum=etcd;MySQL;PostgreSQL;NATS
+type DataStoreDriver string
+
+const (
+	// DataStoreDriverEtcd stores tenant control plane state in etcd
+	DataStoreDriverEtcd DataStoreDriver = "etcd"
+
+	// DataStoreDriverMySQL stores tenant control plane state in MySQL via kine
+	DataStoreDriverMySQL DataStoreDriver = "MySQL"
+
+	// DataStoreDriverPostgreSQL stores tenant control plane state in PostgreSQL via kine
+	DataStoreDriverPostgreSQL DataStoreDriver = "PostgreSQL"
+
+	// DataStoreDriverNATS stores tenant control plane state in NATS via kine
+	DataStoreDriverNATS DataStoreDriver = "NATS"
+)
+
+// DataStoreBootstrapSpec defines the default DataStore deployed during bootstrap
+type DataStoreBootstrapSpec struct {
+	// Enabled controls whether a DataStore is deployed
+	// Disable to register an externally managed DataStore instead
+	// +optional
+	// +kubebuilder:default=true
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Name is the DataStore resource name
+	// +optional
+	// +kubebuilder:default="default"
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name,omitempty"`
+
+	// Driver is the DataStore backend
+	// +optional
+	// +kubebuilder:default=etcd
+	Driver DataStoreDriver `json:"driver,omitempty"`
+
+	// Replicas is the number of backend replicas
+	// Use an odd number for etcd to keep quorum
+	// +optional
+	// +kubebuilder:default=3
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=7
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// StorageClass is the storage class for backend volumes
+	// If not specified, the cluster default storage class is used
+	// +optional
+	StorageClass string `json:"storageClass,omitempty"`
+
+	// Size is the volume size per replica
+	// +optional
+	// +kubebuilder:default="10Gi"
+	Size *resource.Quantity `json:"size,omitempty"`
+}
+
+// IsEnabled returns true if a DataStore should be deployed during bootstrap
+func (d *DataStoreBootstrapSpec) IsEnabled() bool {
+	return d == nil || d.Enabled == nil || *d.Enabled
+}
+
+// GetName returns the DataStore name, defaulting to "default"
+func (d *DataStoreBootstrapSpec) GetName() string {
+	if d == nil || d.Name == "" {
+		return "default"
+	}
+	return d.Name
+}
+
+// GetDriver returns the DataStore driver, defaulting to etcd
+func (d *DataStoreBootstrapSpec) GetDriver() DataStoreDriver {
+	if d == nil || d.Driver == "" {
+		return DataStoreDriverEtcd
+	}
+	return d.Driver
+}
+
+// GetReplicas returns the backend replica count, defaulting to 3
+func (d *DataStoreBootstrapSpec) GetReplicas() int32 {
+	if d == nil || d.Replicas == nil {
+		return 3
+	}
+	return *d.Replicas
 }
 
 // CAPIAddonSpec defines Cluster API configuration
@@ -594,12 +931,115 @@ type CAPIAddonSpec struct {
 	// The management cluster's provider is ALWAYS included automatically
 	// +optional
 	InfrastructureProviders []CAPIInfraProviderSpec `json:"infrastructureProviders,omitempty"`
+
+	// CertManagerVersion pins the cert-manager version installed with CAPI
+	// If not specified, the version bundled with the CAPI release is used
+	// +optional
+	CertManagerVersion string `json:"certManagerVersion,omitempty"`
+
+	// ProviderRepositories overrides where provider components are fetched from
+	// Use with ImageOverrides for air-gapped installs
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=32
+	ProviderRepositories []CAPIProviderRepository `json:"providerRepositories,omitempty"`
+
+	// ImageOverrides rewrites container images in provider components
+	// Keys are image prefixes (a registry, or a repository without tag) and
+	// values are their replacements; the longest matching prefix wins
+	// Example: {"registry.k8s.io": "harbor.internal/k8s"}
+	// +optional
+	// +kubebuilder:validation:MaxProperties=64
+	ImageOverrides map[string]string `json:"imageOverrides,omitempty"`
+}
+
+// CAPIProviderType is the clusterctl provider type
+// +kubebuilder:validation:Enum=CoreProvider;BootstrapProvider;ControlPlaneProvider;InfrastructureProvider
+type CAPIProviderType string
+
+const (
+	// CAPIProviderTypeCore is the cluster-api core provider
+	CAPIProviderTypeCore CAPIProviderType = "CoreProvider"
+
+	// CAPIProviderTypeBootstrap is a bootstrap provider (e.g., talos)
+	CAPIProviderTypeBootstrap CAPIProviderType = "BootstrapProvider"
+
+	// CAPIProviderTypeControlPlane is a control plane provider (e.g., talos)
+	CAPIProviderTypeControlPlane CAPIProviderType = "ControlPlaneProvider"
+
+	// CAPIProviderTypeInfrastructure is an infrastructure provider (e.g., harvester)
+	CAPIProviderTypeInfrastructure CAPIProviderType = "InfrastructureProvider"
+)
+
+// CAPIProviderRepository overrides the component source for a single provider
+// Exactly one of url or configMapRef must be set
+// +kubebuilder:validation:XValidation:rule="has(self.url) != has(self.configMapRef)",message="exactly one of url or configMapRef must be set"
+type CAPIProviderRepository struct {
+	// Type is the provider type
+	// +kubebuilder:validation:Required
+	Type CAPIProviderType `json:"type"`
+
+	// Name is the provider name as known to clusterctl (e.g., "cluster-api", "talos", "harvester")
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+
+	// URL is the components URL, in clusterctl format
+	// Example: https://mirror.internal/capi/{version}/core-components.yaml
+	// +optional
+	// +kubebuilder:validation:MaxLength=2048
+	URL string `json:"url,omitempty"`
+
+	// ConfigMapRef references a ConfigMap holding provider components
+	// The ConfigMap must have a "components" key and a "metadata" key
+	// +optional
+	ConfigMapRef *NamespacedObjectReference `json:"configMapRef,omitempty"`
+}
+
+// GetProviderRepository returns the repository override for a provider, or nil
+func (c *CAPIAddonSpec) GetProviderRepository(providerType CAPIProviderType, name string) *CAPIProviderRepository {
+	if c == nil {
+		return nil
+	}
+	for i := range c.ProviderRepositories {
+		r := &c.ProviderRepositories[i]
+		if r.Type == providerType && r.Name == name {
+			return r
+		}
+	}
+	return nil
+}
+
+// ResolveImage applies ImageOverrides to an image reference
+// Prefixes match only at a registry or path boundary, so "registry.k8s.io"
+// does not match "registry.k8s.io.example.com/foo"
+func (c *CAPIAddonSpec) ResolveImage(image string) string {
+	if c == nil || len(c.ImageOverrides) == 0 {
+		return image
+	}
+	best := ""
+	for prefix := range c.ImageOverrides {
+		if len(prefix) <= len(best) || !strings.HasPrefix(image, prefix) {
+			continue
+		}
+		if rest := image[len(prefix):]; rest != "" && !strings.ContainsAny(rest[:1], "/:@") {
+			continue
+		}
+		best = prefix
+	}
+	if best == "" {
+		return image
+	}
+	return c.ImageOverrides[best] + image[len(best):]
 }
 
 // CAPIInfraProviderSpec defines an infrastructure provider configuration
 type CAPIInfraProviderSpec struct {
 	// Name is the provider name
-	// +kubebuilder:validation:Enum=harvester;nutanix;proxmox;gcp;aws;azure
+	// +kubebuilder:validation:Enum=harvester;nutanix;proxmox;vsphere;openstack;baremetal;gcp;aws;azure
 	Name string `json:"name"`
 
 	// Version overrides the default provider version
@@ -628,6 +1068,9 @@ type ButlerControllerAddonSpec struct {
 	// +optional
 	// +kubebuilder:default="ghcr.io/butlerdotdev/butler-controller"
 	Image string `json:"image,omitempty"`
+
+	// PlatformComponentSpec configures replicas, resources, and scheduling
+	PlatformComponentSpec `json:",inline"`
 }
 
 // ConsoleAddonSpec defines Butler Console configuration
@@ -645,6 +1088,230 @@ type ConsoleAddonSpec struct {
 	// Ingress defines ingress configuration for the console
 	// +optional
 	Ingress *ConsoleIngressSpec `json:"ingress,omitempty"`
+
+	// Config configures console authentication and branding at install time
+	// +optional
+	Config *ConsoleConfig `json:"config,omitempty"`
+
+	// PlatformComponentSpec configures replicas, resources, and scheduling
+	PlatformComponentSpec `json:",inline"`
+}
+
+// MonitoringAddonSpec defines management cluster observability
+type MonitoringAddonSpec struct {
+	// Enabled controls whether the monitoring stack is installed
+	// +kubebuilder:default=false
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Prometheus configures kube-prometheus-stack
+	// +optional
+	Prometheus *PrometheusAddonSpec `json:"prometheus,omitempty"`
+
+	// Vector configures the Vector log aggregator
+	// +optional
+	Vector *VectorAddonSpec `json:"vector,omitempty"`
+
+	// Grafana configures Grafana
+	// +optional
+	Grafana *GrafanaAddonSpec `json:"grafana,omitempty"`
+}
+
+// PrometheusAddonSpec configures kube-prometheus-stack
+type PrometheusAddonSpec struct {
+	// Enabled controls whether kube-prometheus-stack is installed
+	// +kubebuilder:default=true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Version is the kube-prometheus-stack chart version
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// Retention is the metrics retention period (e.g., "15d")
+	// +kubebuilder:default="15d"
+	// +kubebuilder:validation:Pattern=`^[0-9]+(ms|s|m|h|d|w|y)$`
+	// +optional
+	Retention string `json:"retention,omitempty"`
+
+	// StorageSize is the Prometheus volume size
+	// +kubebuilder:default="50Gi"
+	// +optional
+	StorageSize *resource.Quantity `json:"storageSize,omitempty"`
+
+	// StorageClass for the Prometheus volume (defaults to the cluster default)
+	// +optional
+	StorageClass string `json:"storageClass,omitempty"`
+}
+
+// VectorAddonSpec configures the Vector aggregator
+type VectorAddonSpec struct {
+	// Enabled controls whether the Vector aggregator is installed
+	// +kubebuilder:default=true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Version is the Vector chart version
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// StorageSize is the aggregator buffer volume size
+	// +kubebuilder:default="10Gi"
+	// +optional
+	StorageSize *resource.Quantity `json:"storageSize,omitempty"`
+}
+
+// GrafanaAddonSpec configures Grafana
+type GrafanaAddonSpec struct {
+	// Enabled controls whether Grafana is installed
+	// +kubebuilder:default=true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// AdminSecretRef references a Secret with "admin-user" and "admin-password" keys
+	// If not set, credentials are generated and stored in butler-system.
+	// +optional
+	AdminSecretRef *SecretReference `json:"adminSecretRef,omitempty"`
+
+	// Ingress defines ingress configuration for Grafana
+	// +optional
+	Ingress *ConsoleIngressSpec `json:"ingress,omitempty"`
+}
+
+// MonitoringEndpoints reports endpoints of the installed monitoring stack
+type MonitoringEndpoints struct {
+	// MetricEndpoint is the Prometheus remote-write URL
+	// +optional
+	MetricEndpoint string `json:"metricEndpoint,omitempty"`
+
+	// LogEndpoint is the Vector aggregator ingestion URL
+	// +optional
+	LogEndpoint string `json:"logEndpoint,omitempty"`
+
+	// GrafanaURL is the URL to access Grafana
+	// +optional
+	GrafanaURL string `json:"grafanaURL,omitempty"`
+}
+
+// ToPipelineConfig returns an ObservabilityPipelineConfig pointing at these endpoints
+// Used to seed ButlerConfig.spec.observability.pipeline after bootstrap.
+func (e *MonitoringEndpoints) ToPipelineConfig() *ObservabilityPipelineConfig {
+	if e == nil {
+		return nil
+	}
+	return &ObservabilityPipelineConfig{
+		LogEndpoint:    e.LogEndpoint,
+		MetricEndpoint: e.MetricEndpoint,
+	}
+}
+
+// ServerAddonSpec defines butler-server configuration
+type ServerAddonSpec struct {
+	// Enabled controls whether butler-server is installed
+	// Defaults to enabled when the console is enabled.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Version is the butler-server version (image tag)
+	// +kubebuilder:default="latest"
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// Image is the full image reference (overrides default)
+	// +kubebuilder:default="ghcr.io/butlerdotdev/butler-server"
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Ingress defines ingress configuration for the server
+	// If not set, the server is exposed under /api on the console ingress host.
+	// +optional
+	Ingress *ConsoleIngressSpec `json:"ingress,omitempty"`
+
+	// IdentityProviderRefs lists the cluster-scoped IdentityProviders the server
+	// accepts logins from. If empty, the console's IdentityProvider is used.
+	// +optional
+	IdentityProviderRefs []LocalObjectReference `json:"identityProviderRefs,omitempty"`
+
+	// TLS configures TLS on the server's own listener
+	// +optional
+	TLS *ServerTLSSpec `json:"tls,omitempty"`
+
+	// PlatformComponentSpec configures replicas, resources, and scheduling
+	PlatformComponentSpec `json:",inline"`
+}
+
+// ServerTLSSpec configures TLS for butler-server
+// +kubebuilder:validation:XValidation:rule="!self.enabled || has(self.secretRef) || has(self.issuerRef)",message="secretRef or issuerRef is required when TLS is enabled"
+type ServerTLSSpec struct {
+	// Enabled serves HTTPS on the server listener
+	// +kubebuilder:default=false
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// SecretRef references a kubernetes.io/tls Secret with the serving certificate
+	// +optional
+	SecretRef *SecretReference `json:"secretRef,omitempty"`
+
+	// IssuerRef is the name of a cert-manager ClusterIssuer used to issue the
+	// serving certificate when SecretRef is not set
+	// +optional
+	IssuerRef string `json:"issuerRef,omitempty"`
+}
+
+// ConsoleConfig configures Butler Console authentication and branding
+type ConsoleConfig struct {
+	// BaseURL is the externally reachable console URL (e.g., "https://butler.example.com")
+	// Used for OIDC redirect URIs and links in notifications.
+	// Defaults to https://<ingress host> when ingress is enabled.
+	// +kubebuilder:validation:Pattern=`^https?://`
+	// +optional
+	BaseURL string `json:"baseURL,omitempty"`
+
+	// IdentityProviderRef references the cluster-scoped IdentityProvider used for login
+	// If not set, only local admin login is available.
+	// +optional
+	IdentityProviderRef *LocalObjectReference `json:"identityProviderRef,omitempty"`
+
+	// SessionSecretRef references the Secret holding the session signing key
+	// If not set, a random key is generated at install time and stored in
+	// a Secret in butler-system.
+	// +optional
+	SessionSecretRef *SecretReference `json:"sessionSecretRef,omitempty"`
+
+	// SessionTTL is how long a login session remains valid
+	// +kubebuilder:default="12h"
+	// +optional
+	SessionTTL *metav1.Duration `json:"sessionTTL,omitempty"`
+
+	// Branding customizes the console appearance
+	// +optional
+	Branding *ConsoleBranding `json:"branding,omitempty"`
+}
+
+// ConsoleBranding customizes the Butler Console appearance
+type ConsoleBranding struct {
+	// Title replaces "Butler" in the page title and header
+	// +kubebuilder:validation:MaxLength=64
+	// +optional
+	Title string `json:"title,omitempty"`
+
+	// LogoURL is the URL of the header logo
+	// +optional
+	LogoURL string `json:"logoURL,omitempty"`
+
+	// FaviconURL is the URL of the favicon
+	// +optional
+	FaviconURL string `json:"faviconURL,omitempty"`
+
+	// PrimaryColor is the accent color as a hex code (e.g., "#1f6feb")
+	// +kubebuilder:validation:Pattern=`^#[0-9a-fA-F]{6}$`
+	// +optional
+	PrimaryColor string `json:"primaryColor,omitempty"`
+
+	// LoginMessage is shown on the login page (e.g., an acceptable use notice)
+	// +kubebuilder:validation:MaxLength=1024
+	// +optional
+	LoginMessage string `json:"loginMessage,omitempty"`
 }
 
 // ConsoleIngressSpec defines ingress configuration for the Butler Console
@@ -683,6 +1350,14 @@ type ClusterBootstrapStatus struct {
 	// +optional
 	ControlPlaneEndpoint string `json:"controlPlaneEndpoint,omitempty"`
 
+	// VIP is the address allocated from spec.network.vipPoolRef
+	// +optional
+	VIP string `json:"vip,omitempty"`
+
+	// VIPAllocationRef references the controlplane IPAllocation for the VIP
+	// +optional
+	VIPAllocationRef *LocalObjectReference `json:"vipAllocationRef,omitempty"`
+
 	// Kubeconfig contains the base64-encoded kubeconfig for the cluster
 	// +optional
 	Kubeconfig string `json:"kubeconfig,omitempty"`
@@ -695,6 +1370,14 @@ type ClusterBootstrapStatus struct {
 	// +optional
 	ConsoleURL string `json:"consoleURL,omitempty"`
 
+	// ServerURL is the URL to access butler-server
+	// +optional
+	ServerURL string `json:"serverURL,omitempty"`
+
+	// Monitoring reports endpoints of the management cluster monitoring stack
+	// +optional
+	Monitoring *MonitoringEndpoints `json:"monitoring,omitempty"`
+
 	// Machines contains the status of each machine
 	// +optional
 	Machines []ClusterBootstrapMachineStatus `json:"machines,omitempty"`
@@ -722,6 +1405,14 @@ type ClusterBootstrapStatus struct {
 	// AddonsInstalled tracks which addons have been installed
 	// +optional
 	AddonsInstalled map[string]bool `json:"addonsInstalled,omitempty"`
+
+	// Summary holds flat counts for printer columns
+	// +optional
+	Summary *StatusSummary `json:"summary,omitempty"`
+
+	// Reconcile reports controller reconcile diagnostics
+	// +optional
+	Reconcile *ReconcileStatus `json:"reconcile,omitempty"`
 }
 
 // ClusterBootstrapMachineStatus tracks the status of a machine in the cluster
@@ -750,10 +1441,14 @@ type ClusterBootstrapMachineStatus struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:shortName=cb
+// +kubebuilder:resource:shortName=cb,categories=butler
+// +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".spec.cluster.name"
 // +kubebuilder:printcolumn:name="Topology",type="string",JSONPath=".spec.cluster.topology"
 // +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Control Plane",type="string",JSONPath=".status.summary.controlPlaneReady"
+// +kubebuilder:printcolumn:name="Workers",type="string",JSONPath=".status.summary.workersReady"
+// +kubebuilder:printcolumn:name="Addons",type="string",JSONPath=".status.summary.addonsHealthy"
 // +kubebuilder:printcolumn:name="Endpoint",type="string",JSONPath=".status.controlPlaneEndpoint"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:validation:XValidation:rule="!has(self.spec.controlPlaneExposure) || self.spec.controlPlaneExposure.mode != 'Ingress' || self.spec.controlPlaneExposure.hostname != ''",message="hostname is required when controlPlaneExposure.mode is Ingress"
@@ -832,6 +1527,72 @@ func (c *ClusterBootstrap) GetControlPlaneReplicas() int32 {
 	return c.Spec.Cluster.ControlPlane.Replicas
 }
 
+// ForNode returns the effective settings for the node at index with any
+// override applied. The result has Replicas 1 and no overrides, and is used
+// to size the MachineRequest generated for that node
+func (p *ClusterBootstrapNodePool) ForNode(index int32) ClusterBootstrapNodePool {
+	node := ClusterBootstrapNodePool{
+		Replicas:   1,
+		CPU:        p.CPU,
+		MemoryMB:   p.MemoryMB,
+		DiskGB:     p.DiskGB,
+		ExtraDisks: p.ExtraDisks,
+		Firmware:   p.Firmware,
+		Placement:  p.Placement,
+		Labels:     p.Labels,
+	}
+	for i := range p.Overrides {
+		o := &p.Overrides[i]
+		if o.Index != index {
+			continue
+		}
+		if o.CPU != nil {
+			node.CPU = *o.CPU
+		}
+		if o.MemoryMB != nil {
+			node.MemoryMB = *o.MemoryMB
+		}
+		if o.DiskGB != nil {
+			node.DiskGB = *o.DiskGB
+		}
+		if o.ExtraDisks != nil {
+			node.ExtraDisks = o.ExtraDisks
+		}
+		if len(o.Labels) > 0 {
+			labels := make(map[string]string, len(p.Labels)+len(o.Labels))
+			maps.Copy(labels, p.Labels)
+			maps.Copy(labels, o.Labels)
+			node.Labels = labels
+		}
+		break
+	}
+	return *node.DeepCopy()
+}
+
+// EffectivePlacement returns the placement for MachineRequests of the pool
+// The anti-affinity group defaults to "{cluster}-{role}" with a Preferred
+// host spread
+func (p *ClusterBootstrapNodePool) EffectivePlacement(clusterName string, role MachineRole) *PlacementSpec {
+	placement := &PlacementSpec{}
+	if p.Placement != nil {
+		placement = p.Placement.DeepCopy()
+	}
+	if placement.AntiAffinityGroup == "" {
+		placement.AntiAffinityGroup = clusterName + "-" + string(role)
+	}
+	placement.HostSpread = placement.GetHostSpread()
+	return placement
+}
+
+// GetVIP returns the control plane VIP from spec, or the address allocated
+// from spec.network.vipPoolRef
+func (c *ClusterBootstrap) GetVIP() string {
+	if c.Spec.Network.VIP != "" {
+		return c.Spec.Network.VIP
+	}
+	return c.Status.VIP
+}
+
 // GetControlPlaneIPs returns the IP addresses of control plane nodes
 func (c *ClusterBootstrap) GetControlPlaneIPs() []string {
 	var ips []string
@@ -911,6 +1672,47 @@ func (s *ClusterBootstrapAddonsSpec) GetButlerControllerImage() string {
 	return image + ":" + version
 }
 
+// NormalizeLoadBalancerPool reconciles the deprecated
+// addons.loadBalancer.addressPool with network.loadBalancerPool so both
+// describe the same range. Called by the mutating webhook; validation runs
+// it on a copy to report conflicts and warnings for objects that have not
+// been through admission.
+//
+// A legacy string is parsed into network.loadBalancerPool when that is unset,
+// and rewritten in canonical "start-end" form. Returns a deprecation warning
+// when the legacy field was the only source (the controller mirrors it in
+// the DeprecatedFields condition), and an error when the legacy string is
+// invalid or disagrees with network.loadBalancerPool.
+func (c *ClusterBootstrap) NormalizeLoadBalancerPool() (warnings []string, err error) {
+	lb := c.Spec.Addons.LoadBalancer
+	pool := c.Spec.Network.LoadBalancerPool
+
+	if lb == nil || lb.AddressPool == "" {
+		if lb != nil && pool != nil {
+			lb.AddressPool = pool.ToAddressRange()
+		}
+		return nil, nil
+	}
+
+	legacy, err := ParseAddressRange(lb.AddressPool)
+	if err != nil {
+		return nil, fmt.Errorf("addons.loadBalancer.addressPool: %w", err)
+	}
+
+	if pool == nil {
+		c.Spec.Network.LoadBalancerPool = legacy
+		lb.AddressPool = legacy.ToAddressRange()
+		return []string{"addons.loadBalancer.addressPool is deprecated; use network.loadBalancerPool"}, nil
+	}
+
+	if legacy.Start != pool.Start || legacy.End != pool.End {
+		return nil, fmt.Errorf("addons.loadBalancer.addressPool %q conflicts with network.loadBalancerPool %s",
+			lb.AddressPool, pool.ToAddressRange())
+	}
+	lb.AddressPool = pool.ToAddressRange()
+	return nil, nil
+}
+
 // GetLoadBalancerAddressPool returns the address pool string for MetalLB
 // Prefers network.loadBalancerPool (validated), falls back to addons.loadBalancer.addressPool (legacy)
 func (c *ClusterBootstrap) GetLoadBalancerAddressPool() string {
@@ -951,15 +1753,103 @@ func (s *ClusterBootstrapAddonsSpec) GetConsoleIngressHost(clusterName string) s
 	return s.Console.Ingress.Host
 }
 
-// GetStorageReplicaCount returns the effective storage replica count based on topology
+// IsMonitoringEnabled returns whether the monitoring stack should be installed
+func (s *ClusterBootstrapAddonsSpec) IsMonitoringEnabled() bool {
+	if s == nil || s.Monitoring == nil || s.Monitoring.Enabled == nil {
+		return false
+	}
+	return *s.Monitoring.Enabled
+}
+
+// IsServerEnabled returns whether butler-server should be installed
+// Defaults to the console setting since the console requires the server.
+func (s *ClusterBootstrapAddonsSpec) IsServerEnabled() bool {
+	if s == nil || s.Server == nil || s.Server.Enabled == nil {
+		return s.IsConsoleEnabled()
+	}
+	return *s.Server.Enabled
+}
+
+// GetServerVersion returns the butler-server version to install
+func (s *ClusterBootstrapAddonsSpec) GetServerVersion() string {
+	if s == nil || s.Server == nil || s.Server.Version == "" {
+		return "latest"
+	}
+	return s.Server.Version
+}
+
+// GetConsoleBaseURL returns the console base URL, falling back to the ingress host
+func (s *ClusterBootstrapAddonsSpec) GetConsoleBaseURL(clusterName string) string {
+	if s != nil && s.Console != nil && s.Console.Config != nil && s.Console.Config.BaseURL != "" {
+		return s.Console.Config.BaseURL
+	}
+	return "https://" + s.GetConsoleIngressHost(clusterName)
+}
+
+// GetStorageReplicaCount returns the replica count of the default storage policy
+//
+// Deprecated: use GetStoragePolicies, which resolves the replica count of each policy
 func (c *ClusterBootstrap) GetStorageReplicaCount() int32 {
+	return *DefaultStoragePolicy(c.GetStoragePolicies()).ReplicaCount
+}
+
+// GetStoragePolicies returns the storage policies with replica counts resolved
+// Policies without a replica count use storage.replicaCount, and single-node
+// clusters are capped at 1 replica
+func (c *ClusterBootstrap) GetStoragePolicies() []StoragePolicy {
+	defaultReplicas := DefaultStorageReplicaCount
+	var policies []StoragePolicy
+	if s := c.Spec.Addons.Storage; s != nil {
+		if s.ReplicaCount != nil {
+			defaultReplicas = *s.ReplicaCount
+		}
+		policies = s.Policies
+	}
+	var maxReplicas int32
 	if c.IsSingleNode() {
-		return 1 // Single-node can only have 1 replica
+		maxReplicas = 1
+	}
+	return resolveStoragePolicies(policies, defaultReplicas, maxReplicas)
+}
+
+// Default sets fields that are otherwise resolved by getters, so the
+// persisted spec records the values the controller acts on: the exposure
+// mode, console and server enablement and versions, the console ingress
+// host, and storage replica counts. It is idempotent and is called by the
+// defaulting webhook
+func (c *ClusterBootstrap) Default() {
+	if c.Spec.ControlPlaneExposure == nil {
+		c.Spec.ControlPlaneExposure = &ControlPlaneExposureSpec{}
+	}
+	c.Spec.ControlPlaneExposure.Mode = c.GetControlPlaneExposureMode()
+
+	a := &c.Spec.Addons
+	consoleEnabled, serverEnabled := a.IsConsoleEnabled(), a.IsServerEnabled()
+	if a.Console == nil {
+		a.Console = &ConsoleAddonSpec{}
+	}
+	a.Console.Enabled = &consoleEnabled
+	a.Console.Version = a.GetConsoleVersion()
+	if in := a.Console.Ingress; in != nil && in.Enabled {
+		in.Host = a.GetConsoleIngressHost(c.Spec.Cluster.Name)
 	}
-	if c.Spec.Addons.Storage != nil && c.Spec.Addons.Storage.ReplicaCount != nil {
-		return *c.Spec.Addons.Storage.ReplicaCount
+	if a.Server == nil {
+		a.Server = &ServerAddonSpec{}
+	}
+	a.Server.Enabled = &serverEnabled
+	a.Server.Version = a.GetServerVersion()
+
+	if s := a.Storage; s != nil && s.Type != "none" {
+		s.Policies = c.GetStoragePolicies()
+		replicas := DefaultStorageReplicaCount
+		if s.ReplicaCount != nil {
+			replicas = *s.ReplicaCount
+		}
+		if c.IsSingleNode() {
+			replicas = 1
+		}
+		s.ReplicaCount = &replicas
 	}
-	return 3 // Default for HA
 }
 
 // GetControlPlaneExposureMode returns the control plane exposure mode, defaulting to LoadBalancer
@@ -1000,3 +1890,49 @@ func (c *ClusterBootstrap) GetControlPlaneExposureIngressClassName() string {
 	}
 	return c.Spec.ControlPlaneExposure.IngressClassName
 }
+
+// ResolveTalos returns the Talos version and schematic to install, taking
+// them from release when spec.talos.releaseRef is set. release may be nil
+// when no releaseRef is set
+func (c *ClusterBootstrap) ResolveTalos(release *TalosRelease) (version, schematic string, err error) {
+	t := c.Spec.Talos
+	if t.ReleaseRef == nil {
+		return t.Version, t.Schematic, nil
+	}
+	if release == nil || release.Name != t.ReleaseRef.Name {
+		return "", "", fmt.Errorf("TalosRelease %s not found", t.ReleaseRef.Name)
+	}
+	return release.Spec.Version, release.Spec.SchematicID, nil
+}
+
+// ComputeSummary returns the printer column summary for the bootstrap
+// Machine counts use the desired replicas from the spec, so nodes that have
+// not been requested yet count as not ready
+func (c *ClusterBootstrap) ComputeSummary() *StatusSummary {
+	var cpReady, workersReady int32
+	for _, m := range c.Status.Machines {
+		if !m.Ready {
+			continue
+		}
+		if m.Role == string(MachineRoleControlPlane) {
+			cpReady++
+		} else if m.Role == string(MachineRoleWorker) {
+			workersReady++
+		}
+	}
+	var workersDesired int32
+	if c.Spec.Cluster.Workers != nil && !c.IsSingleNode() {
+		workersDesired = c.Spec.Cluster.Workers.Replicas
+	}
+	var installed int32
+	for _, ok := range c.Status.AddonsInstalled {
+		if ok {
+			installed++
+		}
+	}
+	return &StatusSummary{
+		ControlPlaneReady: FormatReadyCount(cpReady, c.GetControlPlaneReplicas()),
+		WorkersReady:      FormatReadyCount(workersReady, workersDesired),
+		AddonsHealthy:     FormatReadyCount(installed, int32(len(c.Status.AddonsInstalled))),
+	}
+}
@@ -0,0 +1,277 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCAPIAddonSpecResolveImage(t *testing.T) {
+	spec := &CAPIAddonSpec{
+		ImageOverrides: map[string]string{
+			"registry.k8s.io": "harbor.internal/k8s",
+			"registry.k8s.io/cluster-api/cluster-api-controller": "harbor.internal/capi/core",
+			"ghcr.io/siderolabs": "harbor.internal/siderolabs",
+		},
+	}
+
+	tests := []struct {
+		name  string
+		image string
+		want  string
+	}{
+		{
+			name:  "registry prefix",
+			image: "registry.k8s.io/cluster-api/kubeadm-bootstrap-controller:v1.9.4",
+			want:  "harbor.internal/k8s/cluster-api/kubeadm-bootstrap-controller:v1.9.4",
+		},
+		{
+			name:  "longest prefix wins",
+			image: "registry.k8s.io/cluster-api/cluster-api-controller:v1.9.4",
+			want:  "harbor.internal/capi/core:v1.9.4",
+		},
+		{
+			name:  "digest is preserved",
+			image: "ghcr.io/siderolabs/cluster-api-talos-controller@sha256:abc",
+			want:  "harbor.internal/siderolabs/cluster-api-talos-controller@sha256:abc",
+		},
+		{
+			name:  "no match at partial path segment",
+			image: "registry.k8s.io.example.com/foo:v1",
+			want:  "registry.k8s.io.example.com/foo:v1",
+		},
+		{
+			name:  "unmatched image unchanged",
+			image: "quay.io/jetstack/cert-manager-controller:v1.16.0",
+			want:  "quay.io/jetstack/cert-manager-controller:v1.16.0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := spec.ResolveImage(tt.image); got != tt.want {
+				t.Errorf("ResolveImage(%q) = %q, want %q", tt.image, got, tt.want)
+			}
+		})
+	}
+
+	var nilSpec *CAPIAddonSpec
+	if got := nilSpec.ResolveImage("registry.k8s.io/pause:3.10"); got != "registry.k8s.io/pause:3.10" {
+		t.Errorf("nil spec ResolveImage = %q", got)
+	}
+}
+
+func TestParseAddressRange(t *testing.T) {
+	tests := []struct {
+		name      string
+		in        string
+		wantStart string
+		wantEnd   string
+		wantErr   bool
+	}{
+		{name: "range", in: "10.40.0.200-10.40.0.250", wantStart: "10.40.0.200", wantEnd: "10.40.0.250"},
+		{name: "range with spaces", in: " 10.40.0.200 - 10.40.0.250 ", wantStart: "10.40.0.200", wantEnd: "10.40.0.250"},
+		{name: "cidr", in: "10.40.1.0/28", wantStart: "10.40.1.0", wantEnd: "10.40.1.15"},
+		{name: "cidr host bits ignored", in: "10.40.1.7/30", wantStart: "10.40.1.4", wantEnd: "10.40.1.7"},
+		{name: "single address cidr", in: "10.40.1.9/32", wantStart: "10.40.1.9", wantEnd: "10.40.1.9"},
+		{name: "reversed range", in: "10.40.0.250-10.40.0.200", wantErr: true},
+		{name: "ipv6 range", in: "fd00::1-fd00::9", wantStart: "fd00::1", wantEnd: "fd00::9"},
+		{name: "ipv6 cidr", in: "fd00::/120", wantStart: "fd00::", wantEnd: "fd00::ff"},
+		{name: "mixed families", in: "10.40.0.1-fd00::9", wantErr: true},
+		{name: "no separator", in: "10.40.0.200", wantErr: true},
+		{name: "empty", in: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAddressRange(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseAddressRange(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Start != tt.wantStart || got.End != tt.wantEnd {
+				t.Errorf("ParseAddressRange(%q) = %s, want %s-%s", tt.in, got.ToAddressRange(), tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestClusterBootstrapNormalizeLoadBalancerPool(t *testing.T) {
+	tests := []struct {
+		name         string
+		legacy       string
+		pool         *LoadBalancerPoolSpec
+		wantPool     string
+		wantLegacy   string
+		wantWarnings int
+		wantErr      bool
+	}{
+		{
+			name:         "legacy only is converted",
+			legacy:       "10.40.0.200 - 10.40.0.250",
+			wantPool:     "10.40.0.200-10.40.0.250",
+			wantLegacy:   "10.40.0.200-10.40.0.250",
+			wantWarnings: 1,
+		},
+		{
+			name:       "structured only is mirrored",
+			pool:       &LoadBalancerPoolSpec{Start: "10.40.0.200", End: "10.40.0.250"},
+			wantPool:   "10.40.0.200-10.40.0.250",
+			wantLegacy: "10.40.0.200-10.40.0.250",
+		},
+		{
+			name:       "matching cidr is canonicalized",
+			legacy:     "10.40.0.0/28",
+			pool:       &LoadBalancerPoolSpec{Start: "10.40.0.0", End: "10.40.0.15"},
+			wantPool:   "10.40.0.0-10.40.0.15",
+			wantLegacy: "10.40.0.0-10.40.0.15",
+		},
+		{
+			name:    "conflict",
+			legacy:  "10.40.0.100-10.40.0.150",
+			pool:    &LoadBalancerPoolSpec{Start: "10.40.0.200", End: "10.40.0.250"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid legacy",
+			legacy:  "pool-a",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cb := &ClusterBootstrap{}
+			cb.Spec.Addons.LoadBalancer = &LoadBalancerAddonSpec{AddressPool: tt.legacy}
+			cb.Spec.Network.LoadBalancerPool = tt.pool
+
+			warnings, err := cb.NormalizeLoadBalancerPool()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizeLoadBalancerPool() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(warnings) != tt.wantWarnings {
+				t.Errorf("warnings = %v, want %d", warnings, tt.wantWarnings)
+			}
+			if got := cb.Spec.Network.LoadBalancerPool.ToAddressRange(); got != tt.wantPool {
+				t.Errorf("network.loadBalancerPool = %q, want %q", got, tt.wantPool)
+			}
+			if got := cb.Spec.Addons.LoadBalancer.AddressPool; got != tt.wantLegacy {
+				t.Errorf("addons.loadBalancer.addressPool = %q, want %q", got, tt.wantLegacy)
+			}
+		})
+	}
+}
+
+func TestClusterBootstrapNodePoolForNode(t *testing.T) {
+	cpu := int32(8)
+	memory := int32(16384)
+	pool := ClusterBootstrapNodePool{
+		Replicas: 3,
+		CPU:      4,
+		MemoryMB: 8192,
+		DiskGB:   50,
+		Labels:   map[string]string{"tier": "cp"},
+		Overrides: []ClusterBootstrapNodeOverride{
+			{Index: 0, CPU: &cpu, MemoryMB: &memory, Labels: map[string]string{"pivot": "true"}},
+		},
+	}
+
+	tests := []struct {
+		index      int32
+		wantCPU    int32
+		wantMemory int32
+		wantLabels map[string]string
+	}{
+		{0, 8, 16384, map[string]string{"tier": "cp", "pivot": "true"}},
+		{1, 4, 8192, map[string]string{"tier": "cp"}},
+	}
+
+	for _, tt := range tests {
+		got := pool.ForNode(tt.index)
+		if got.CPU != tt.wantCPU || got.MemoryMB != tt.wantMemory || got.DiskGB != 50 {
+			t.Errorf("ForNode(%d) sizing = %d/%d/%d, want %d/%d/50", tt.index, got.CPU, got.MemoryMB, got.DiskGB, tt.wantCPU, tt.wantMemory)
+		}
+		if !reflect.DeepEqual(got.Labels, tt.wantLabels) {
+			t.Errorf("ForNode(%d) labels = %v, want %v", tt.index, got.Labels, tt.wantLabels)
+		}
+		if got.Replicas != 1 || got.Overrides != nil {
+			t.Errorf("ForNode(%d) = replicas %d overrides %v, want 1 and nil", tt.index, got.Replicas, got.Overrides)
+		}
+	}
+	if pool.Labels["pivot"] != "" {
+		t.Errorf("ForNode modified pool labels: %v", pool.Labels)
+	}
+}
+
+func TestClusterBootstrapNodePoolEffectivePlacement(t *testing.T) {
+	pool := ClusterBootstrapNodePool{Replicas: 3}
+	got := pool.EffectivePlacement("mgmt", MachineRoleControlPlane)
+	if got.AntiAffinityGroup != "mgmt-control-plane" || got.HostSpread != HostSpreadPreferred {
+		t.Errorf("EffectivePlacement() = %+v, want group mgmt-control-plane with Preferred spread", got)
+	}
+
+	pool.Placement = &PlacementSpec{HostSpread: HostSpreadRequired, AvailabilityZone: "rack-a"}
+	got = pool.EffectivePlacement("mgmt", MachineRoleControlPlane)
+	if got.AntiAffinityGroup != "mgmt-control-plane" || got.HostSpread != HostSpreadRequired || got.AvailabilityZone != "rack-a" {
+		t.Errorf("EffectivePlacement() = %+v, want Required spread in rack-a", got)
+	}
+	if pool.Placement.AntiAffinityGroup != "" {
+		t.Errorf("EffectivePlacement modified pool placement: %+v", pool.Placement)
+	}
+}
+
+func TestClusterBootstrapDefault(t *testing.T) {
+	cb := &ClusterBootstrap{
+		Spec: ClusterBootstrapSpec{
+			Cluster: ClusterBootstrapClusterSpec{Name: "mgmt", Topology: ClusterTopologySingleNode},
+			Addons: ClusterBootstrapAddonsSpec{
+				Console: &ConsoleAddonSpec{Ingress: &ConsoleIngressSpec{Enabled: true}},
+				Storage: &StorageAddonSpec{Type: "longhorn"},
+			},
+		},
+	}
+	cb.Default()
+
+	if got := cb.Spec.ControlPlaneExposure.Mode; got != ControlPlaneExposureModeLoadBalancer {
+		t.Errorf("exposure mode = %q, want LoadBalancer", got)
+	}
+	a := cb.Spec.Addons
+	if !*a.Console.Enabled || a.Console.Version != "latest" || a.Console.Ingress.Host != "butler.mgmt.local" {
+		t.Errorf("console = enabled %v version %q host %q", *a.Console.Enabled, a.Console.Version, a.Console.Ingress.Host)
+	}
+	if !*a.Server.Enabled || a.Server.Version != "latest" {
+		t.Errorf("server = enabled %v version %q", *a.Server.Enabled, a.Server.Version)
+	}
+	if *a.Storage.ReplicaCount != 1 {
+		t.Errorf("storage replicaCount = %d, want 1 for single-node", *a.Storage.ReplicaCount)
+	}
+	if len(a.Storage.Policies) != 1 || a.Storage.Policies[0].Name != DefaultStoragePolicyName || *a.Storage.Policies[0].ReplicaCount != 1 {
+		t.Errorf("storage policies = %+v", a.Storage.Policies)
+	}
+
+	again := cb.DeepCopy()
+	again.Default()
+	if !reflect.DeepEqual(again.Spec, cb.Spec) {
+		t.Errorf("Default is not idempotent")
+	}
+}
@@ -0,0 +1,303 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RestoreScope selects what a ClusterRestore restores.
+// +kubebuilder:validation:Enum=Addons;Workloads;PersistentVolumes
+type RestoreScope string
+
+const (
+	// RestoreScopeAddons restores Butler-managed addons and their configuration.
+	RestoreScopeAddons RestoreScope = "Addons"
+
+	// RestoreScopeWorkloads restores user workloads and their Kubernetes resources.
+	RestoreScopeWorkloads RestoreScope = "Workloads"
+
+	// RestoreScopePersistentVolumes restores persistent volume data from snapshots.
+	RestoreScopePersistentVolumes RestoreScope = "PersistentVolumes"
+)
+
+// ExistingResourcePolicy controls how a restore treats resources that
+// already exist in the target cluster.
+// +kubebuilder:validation:Enum=None;Update
+type ExistingResourcePolicy string
+
+const (
+	// ExistingResourcePolicyNone leaves existing resources unchanged.
+	ExistingResourcePolicyNone ExistingResourcePolicy = "None"
+
+	// ExistingResourcePolicyUpdate updates existing resources to match the backup.
+	ExistingResourcePolicyUpdate ExistingResourcePolicy = "Update"
+)
+
+// ClusterRestorePhase represents the current phase of a ClusterRestore.
+// +kubebuilder:validation:Enum=Pending;Restoring;Completed;Failed
+type ClusterRestorePhase string
+
+const (
+	// ClusterRestorePhasePending indicates the restore is waiting for the
+	// target cluster and its backup addon to be ready.
+	ClusterRestorePhasePending ClusterRestorePhase = "Pending"
+
+	// ClusterRestorePhaseRestoring indicates the Velero Restore is running.
+	ClusterRestorePhaseRestoring ClusterRestorePhase = "Restoring"
+
+	// ClusterRestorePhaseCompleted indicates the restore finished.
+	// It may have completed with warnings; see status.warnings.
+	ClusterRestorePhaseCompleted ClusterRestorePhase = "Completed"
+
+	// ClusterRestorePhaseFailed indicates the restore failed.
+	ClusterRestorePhaseFailed ClusterRestorePhase = "Failed"
+)
+
+// ClusterRestore condition types.
+const (
+	// ClusterRestoreConditionTargetReady indicates the target cluster exists,
+	// is Ready, and has the backup addon installed.
+	ClusterRestoreConditionTargetReady = "TargetReady"
+
+	// ClusterRestoreConditionBackupAvailable indicates the backup was found
+	// in the storage location.
+	ClusterRestoreConditionBackupAvailable = "BackupAvailable"
+)
+
+// ClusterRestoreSpec defines the desired state of ClusterRestore.
+// A restore runs once, so the spec is immutable.
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec is immutable; create a new ClusterRestore"
+type ClusterRestoreSpec struct {
+	// BackupName is the name of the Velero Backup to restore
+	// (see ClusterBackupPolicy status.lastBackupName).
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	BackupName string `json:"backupName"`
+
+	// BackupPolicyRef references the ClusterBackupPolicy in the same
+	// namespace that created the backup. Used to find the storage location
+	// and the source cluster. Defaults to the policy recorded on the backup.
+	// +optional
+	BackupPolicyRef *LocalObjectReference `json:"backupPolicyRef,omitempty"`
+
+	// Target is the cluster to restore into.
+	// +kubebuilder:validation:Required
+	Target RestoreTarget `json:"target"`
+
+	// Scope selects what is restored.
+	// +kubebuilder:default={Addons,Workloads,PersistentVolumes}
+	// +kubebuilder:validation:MinItems=1
+	// +optional
+	// +listType=set
+	Scope []RestoreScope `json:"scope,omitempty"`
+
+	// IncludedNamespaces limits the restore to these namespaces. Empty means
+	// every namespace in the backup.
+	// +optional
+	// +listType=set
+	IncludedNamespaces []string `json:"includedNamespaces,omitempty"`
+
+	// ExcludedNamespaces are skipped.
+	// +optional
+	// +listType=set
+	ExcludedNamespaces []string `json:"excludedNamespaces,omitempty"`
+
+	// NamespaceMapping restores namespaces under new names, keyed by the
+	// namespace in the backup.
+	// +optional
+	NamespaceMapping map[string]string `json:"namespaceMapping,omitempty"`
+
+	// ExistingResourcePolicy controls how resources already present in the
+	// target cluster are handled.
+	// +kubebuilder:default="None"
+	// +optional
+	ExistingResourcePolicy ExistingResourcePolicy `json:"existingResourcePolicy,omitempty"`
+}
+
+// RestoreTarget selects an existing TenantCluster or describes a new one.
+// +kubebuilder:validation:XValidation:rule="has(self.clusterRef) != has(self.newCluster)",message="exactly one of clusterRef or newCluster must be set"
+type RestoreTarget struct {
+	// ClusterRef references an existing TenantCluster in the same namespace.
+	// The cluster must have the backup addon enabled.
+	// +optional
+	ClusterRef *LocalObjectReference `json:"clusterRef,omitempty"`
+
+	// NewCluster creates a TenantCluster to restore into.
+	// +optional
+	NewCluster *RestoreNewCluster `json:"newCluster,omitempty"`
+}
+
+// RestoreNewCluster describes a TenantCluster created for a restore.
+type RestoreNewCluster struct {
+	// Name of the TenantCluster to create in the restore's namespace.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// Spec of the new cluster. If unset, the spec of the backed-up cluster
+	// is copied from its TenantCluster or, if it was deleted, its
+	// ClusterArchive. The backup addon is always enabled.
+	// +optional
+	Spec *TenantClusterSpec `json:"spec,omitempty"`
+}
+
+// ClusterRestoreStatus defines the observed state of ClusterRestore.
+type ClusterRestoreStatus struct {
+	// Conditions represent the latest available observations.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Phase represents the current phase of the restore.
+	// +optional
+	Phase ClusterRestorePhase `json:"phase,omitempty"`
+
+	// TargetClusterRef references the TenantCluster being restored into,
+	// including one created from spec.target.newCluster.
+	// +optional
+	TargetClusterRef *LocalObjectReference `json:"targetClusterRef,omitempty"`
+
+	// VeleroRestoreName is the name of the Velero Restore in the target cluster.
+	// +optional
+	VeleroRestoreName string `json:"veleroRestoreName,omitempty"`
+
+	// StartedAt is when the Velero Restore was created.
+	// +optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+
+	// CompletedAt is when the restore reached Completed or Failed.
+	// +optional
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+
+	// ItemsRestored is the number of resources restored so far.
+	// +optional
+	ItemsRestored int32 `json:"itemsRestored,omitempty"`
+
+	// TotalItems is the number of resources to restore.
+	// +optional
+	TotalItems int32 `json:"totalItems,omitempty"`
+
+	// Warnings is the number of warnings reported by Velero.
+	// +optional
+	Warnings int32 `json:"warnings,omitempty"`
+
+	// Errors is the number of errors reported by Velero.
+	// +optional
+	Errors int32 `json:"errors,omitempty"`
+
+	// Message provides human-readable status information.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// ObservedGeneration is the last observed generation.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=crs,categories=butler
+// +kubebuilder:printcolumn:name="Backup",type="string",JSONPath=".spec.backupName",description="Velero backup"
+// +kubebuilder:printcolumn:name="Target",type="string",JSONPath=".status.targetClusterRef.name",description="Target cluster"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Restore phase"
+// +kubebuilder:printcolumn:name="Restored",type="integer",JSONPath=".status.itemsRestored",description="Items restored",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ClusterRestore restores a Velero backup of a TenantCluster, either into
+// an existing cluster or into a new one created for the restore. The
+// controller creates a Velero Restore in the target cluster and reports
+// its progress. Each ClusterRestore runs once.
+type ClusterRestore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterRestoreSpec   `json:"spec,omitempty"`
+	Status ClusterRestoreStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterRestoreList contains a list of ClusterRestore.
+type ClusterRestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterRestore `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterRestore{}, &ClusterRestoreList{})
+}
+
+// Helper methods
+
+// IsTerminal returns true if the restore has finished, successfully or not.
+func (r *ClusterRestore) IsTerminal() bool {
+	return r.Status.Phase == ClusterRestorePhaseCompleted || r.Status.Phase == ClusterRestorePhaseFailed
+}
+
+// HasScope returns true if the restore includes the given scope.
+// An empty scope restores everything.
+func (r *ClusterRestore) HasScope(s RestoreScope) bool {
+	if len(r.Spec.Scope) == 0 {
+		return true
+	}
+	for _, scope := range r.Spec.Scope {
+		if scope == s {
+			return true
+		}
+	}
+	return false
+}
+
+// TargetClusterName returns the name of the TenantCluster to restore into.
+func (r *ClusterRestore) TargetClusterName() string {
+	if t := r.Spec.Target.ClusterRef; t != nil {
+		return t.Name
+	}
+	if t := r.Spec.Target.NewCluster; t != nil {
+		return t.Name
+	}
+	return ""
+}
+
+// CreatesCluster returns true if the restore creates its target cluster.
+func (r *ClusterRestore) CreatesCluster() bool {
+	return r.Spec.Target.NewCluster != nil
+}
+
+// VeleroRestoreName returns the name of the Velero Restore created in the
+// target cluster for this restore.
+func (r *ClusterRestore) VeleroRestoreName() string {
+	return "butler-" + r.Name
+}
+
+// Duration returns how long the restore ran, or how long it has been
+// running at now if it has not finished.
+func (r *ClusterRestore) Duration(now time.Time) time.Duration {
+	if r.Status.StartedAt == nil {
+		return 0
+	}
+	if r.Status.CompletedAt != nil {
+		return r.Status.CompletedAt.Sub(r.Status.StartedAt.Time)
+	}
+	return now.Sub(r.Status.StartedAt.Time)
+}
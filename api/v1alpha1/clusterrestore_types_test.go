@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestClusterRestoreHasScope(t *testing.T) {
+	r := &ClusterRestore{}
+	for _, s := range []RestoreScope{RestoreScopeAddons, RestoreScopeWorkloads, RestoreScopePersistentVolumes} {
+		if !r.HasScope(s) {
+			t.Errorf("HasScope(%s) with empty scope = false, want true", s)
+		}
+	}
+
+	r.Spec.Scope = []RestoreScope{RestoreScopeWorkloads}
+	if !r.HasScope(RestoreScopeWorkloads) {
+		t.Error("HasScope(Workloads) = false, want true")
+	}
+	if r.HasScope(RestoreScopePersistentVolumes) {
+		t.Error("HasScope(PersistentVolumes) = true, want false")
+	}
+}
+
+func TestClusterRestoreTarget(t *testing.T) {
+	r := &ClusterRestore{Spec: ClusterRestoreSpec{
+		Target: RestoreTarget{ClusterRef: &LocalObjectReference{Name: "prod"}},
+	}}
+	if got := r.TargetClusterName(); got != "prod" {
+		t.Errorf("TargetClusterName() = %q, want %q", got, "prod")
+	}
+	if r.CreatesCluster() {
+		t.Error("CreatesCluster() = true for clusterRef target")
+	}
+
+	r.Spec.Target = RestoreTarget{NewCluster: &RestoreNewCluster{Name: "prod-restored"}}
+	if got := r.TargetClusterName(); got != "prod-restored" {
+		t.Errorf("TargetClusterName() = %q, want %q", got, "prod-restored")
+	}
+	if !r.CreatesCluster() {
+		t.Error("CreatesCluster() = false for newCluster target")
+	}
+}
+
+func TestClusterRestoreDuration(t *testing.T) {
+	start := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	r := &ClusterRestore{}
+	if got := r.Duration(start); got != 0 {
+		t.Errorf("Duration() before start = %v, want 0", got)
+	}
+
+	r.Status.StartedAt = &metav1.Time{Time: start}
+	if got := r.Duration(start.Add(5 * time.Minute)); got != 5*time.Minute {
+		t.Errorf("Duration() while running = %v, want 5m", got)
+	}
+
+	r.Status.CompletedAt = &metav1.Time{Time: start.Add(2 * time.Minute)}
+	r.Status.Phase = ClusterRestorePhaseCompleted
+	if got := r.Duration(start.Add(time.Hour)); got != 2*time.Minute {
+		t.Errorf("Duration() after completion = %v, want 2m", got)
+	}
+	if !r.IsTerminal() {
+		t.Error("IsTerminal() = false for Completed restore")
+	}
+}
@@ -0,0 +1,415 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterTemplateScope determines template visibility.
+// +kubebuilder:validation:Enum=cluster;team
+type ClusterTemplateScope string
+
+const (
+	// ClusterTemplateScopeCluster makes the template visible to all teams.
+	// Created by platform admins in the butler-system namespace.
+	ClusterTemplateScopeCluster ClusterTemplateScope = "cluster"
+
+	// ClusterTemplateScopeTeam makes the template visible only to the owning team.
+	ClusterTemplateScopeTeam ClusterTemplateScope = "team"
+)
+
+// ClusterTemplateVariableType is the type of a template variable.
+// +kubebuilder:validation:Enum=string;integer;boolean
+type ClusterTemplateVariableType string
+
+const (
+	// ClusterTemplateVariableString is a string variable.
+	ClusterTemplateVariableString ClusterTemplateVariableType = "string"
+
+	// ClusterTemplateVariableInteger is an integer variable.
+	ClusterTemplateVariableInteger ClusterTemplateVariableType = "integer"
+
+	// ClusterTemplateVariableBoolean is a boolean variable.
+	ClusterTemplateVariableBoolean ClusterTemplateVariableType = "boolean"
+)
+
+// ClusterTemplateSpec defines the desired state of a ClusterTemplate.
+type ClusterTemplateSpec struct {
+	// DisplayName shown in the template picker.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	DisplayName string `json:"displayName"`
+
+	// Description of the cluster this template creates.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Icon is a Material UI icon name or URL for the template card.
+	// +optional
+	Icon string `json:"icon,omitempty"`
+
+	// Scope determines visibility.
+	// "cluster" templates are visible to all teams (created by platform admins).
+	// "team" templates are visible only to the team that owns them.
+	// +kubebuilder:default="team"
+	// +optional
+	Scope ClusterTemplateScope `json:"scope,omitempty"`
+
+	// Variables are the inputs the template accepts. The console renders
+	// them as a form and the CLI accepts them as --set name=value.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=50
+	Variables []ClusterTemplateVariable `json:"variables,omitempty"`
+
+	// Template is a TenantCluster spec in which string values may
+	// reference variables with ${var.<name>}. A value that is exactly one
+	// reference takes the variable's type, so "${var.workers}" renders as
+	// a number for an integer variable; references inside longer strings
+	// are rendered as text. Write $${var.<name>} for a literal
+	// ${var.<name>}. A field whose value is exactly a reference to an
+	// optional variable without a value is omitted, and such references
+	// inside longer strings render as "". The rendered spec must be a
+	// valid TenantClusterSpec.
+	// +kubebuilder:validation:Required
+	Template ExtensionValues `json:"template"`
+}
+
+// ClusterTemplateVariable declares a template input.
+// +kubebuilder:validation:XValidation:rule="!has(self.pattern) || self.type == 'string'",message="pattern is only supported for string variables"
+// +kubebuilder:validation:XValidation:rule="(!has(self.minimum) && !has(self.maximum)) || self.type == 'integer'",message="minimum and maximum are only supported for integer variables"
+type ClusterTemplateVariable struct {
+	// Name is referenced as ${var.<name>} in the template.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[A-Za-z][A-Za-z0-9]*$`
+	Name string `json:"name"`
+
+	// DisplayName is the form label. Defaults to Name.
+	// +optional
+	DisplayName string `json:"displayName,omitempty"`
+
+	// Description is shown as help text.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Type of the variable.
+	// +kubebuilder:default="string"
+	// +optional
+	Type ClusterTemplateVariableType `json:"type,omitempty"`
+
+	// Required rejects rendering without a value. Ignored when Default is set.
+	// +optional
+	Required bool `json:"required,omitempty"`
+
+	// Default is used when no value is given, written as the CLI would
+	// accept it (e.g., "3" or "true").
+	// +optional
+	Default *string `json:"default,omitempty"`
+
+	// Enum restricts the value to one of these options.
+	// +optional
+	Enum []string `json:"enum,omitempty"`
+
+	// Minimum is the smallest allowed integer value.
+	// +optional
+	Minimum *int64 `json:"minimum,omitempty"`
+
+	// Maximum is the largest allowed integer value.
+	// +optional
+	Maximum *int64 `json:"maximum,omitempty"`
+
+	// Pattern is a regular expression a string value must match.
+	// +optional
+	Pattern string `json:"pattern,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=ctpl,categories=butler
+// +kubebuilder:printcolumn:name="Display Name",type="string",JSONPath=".spec.displayName",description="Template display name"
+// +kubebuilder:printcolumn:name="Scope",type="string",JSONPath=".spec.scope",description="Visibility scope"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ClusterTemplate is a reusable, parameterized TenantCluster blueprint for
+// one-click cluster creation. Like WorkspaceTemplate, templates are
+// data-only: the console and CLI render them into a TenantCluster and
+// record the template in the butler.butlerlabs.dev/cluster-template
+// annotation. Changes to a template do not affect existing clusters.
+// Cluster-scoped templates live in butler-system and are visible to all teams.
+// Team-scoped templates live in the team namespace and are visible only to that team.
+type ClusterTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ClusterTemplateSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterTemplateList contains a list of ClusterTemplates.
+type ClusterTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterTemplate{}, &ClusterTemplateList{})
+}
+
+// templateVariablePattern matches ${var.name} and the escaped $${var.name}.
+var templateVariablePattern = regexp.MustCompile(`\$?\$\{var\.([A-Za-z][A-Za-z0-9]*)\}`)
+
+// Helper methods
+
+// GetType returns the variable type, defaulting to string.
+func (v *ClusterTemplateVariable) GetType() ClusterTemplateVariableType {
+	if v.Type == "" {
+		return ClusterTemplateVariableString
+	}
+	return v.Type
+}
+
+// GetVariable returns the named variable, or nil.
+func (t *ClusterTemplate) GetVariable(name string) *ClusterTemplateVariable {
+	for i := range t.Spec.Variables {
+		if t.Spec.Variables[i].Name == name {
+			return &t.Spec.Variables[i]
+		}
+	}
+	return nil
+}
+
+// parse converts a raw value to the variable's type and checks its constraints.
+func (v *ClusterTemplateVariable) parse(raw string) (interface{}, error) {
+	if len(v.Enum) > 0 && !slices.Contains(v.Enum, raw) {
+		return nil, fmt.Errorf("must be one of %s", strings.Join(v.Enum, ", "))
+	}
+	switch v.GetType() {
+	case ClusterTemplateVariableInteger:
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not an integer", raw)
+		}
+		if v.Minimum != nil && n < *v.Minimum {
+			return nil, fmt.Errorf("%d is less than the minimum of %d", n, *v.Minimum)
+		}
+		if v.Maximum != nil && n > *v.Maximum {
+			return nil, fmt.Errorf("%d is greater than the maximum of %d", n, *v.Maximum)
+		}
+		return n, nil
+	case ClusterTemplateVariableBoolean:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a boolean", raw)
+		}
+		return b, nil
+	default:
+		if v.Pattern != "" {
+			re, err := regexp.Compile(v.Pattern)
+			if err != nil {
+				return nil, fmt.Errorf("invalid pattern %q: %w", v.Pattern, err)
+			}
+			if !re.MatchString(raw) {
+				return nil, fmt.Errorf("%q does not match %s", raw, v.Pattern)
+			}
+		}
+		return raw, nil
+	}
+}
+
+// ResolveVariables applies defaults to values and converts each to its
+// declared type. Values for undeclared variables, missing required values,
+// and values that violate their constraints are reported in a single error.
+// Optional variables without a value or default are omitted.
+func (t *ClusterTemplate) ResolveVariables(values map[string]string) (map[string]interface{}, error) {
+	var errs []string
+	for name := range values {
+		if t.GetVariable(name) == nil {
+			errs = append(errs, fmt.Sprintf("%s: unknown variable", name))
+		}
+	}
+	resolved := make(map[string]interface{}, len(t.Spec.Variables))
+	for i := range t.Spec.Variables {
+		v := &t.Spec.Variables[i]
+		raw, ok := values[v.Name]
+		if !ok && v.Default != nil {
+			raw, ok = *v.Default, true
+		}
+		if !ok {
+			if v.Required {
+				errs = append(errs, fmt.Sprintf("%s: value is required", v.Name))
+			}
+			continue
+		}
+		val, err := v.parse(raw)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", v.Name, err))
+			continue
+		}
+		resolved[v.Name] = val
+	}
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return nil, fmt.Errorf("cluster template %s: %s", t.Name, strings.Join(errs, "; "))
+	}
+	return resolved, nil
+}
+
+// ReferencedVariables returns the sorted names of variables referenced
+// in the template.
+func (t *ClusterTemplate) ReferencedVariables() ([]string, error) {
+	m, err := t.Spec.Template.ToMap()
+	if err != nil {
+		return nil, fmt.Errorf("cluster template %s: %w", t.Name, err)
+	}
+	seen := map[string]bool{}
+	collectTemplateVariables(m, seen)
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func collectTemplateVariables(v interface{}, seen map[string]bool) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for _, val := range t {
+			collectTemplateVariables(val, seen)
+		}
+	case []interface{}:
+		for _, val := range t {
+			collectTemplateVariables(val, seen)
+		}
+	case string:
+		for _, m := range templateVariablePattern.FindAllStringSubmatch(t, -1) {
+			if !strings.HasPrefix(m[0], "$$") {
+				seen[m[1]] = true
+			}
+		}
+	}
+}
+
+// Render resolves values and renders the template into a TenantClusterSpec.
+// Unknown fields in the rendered spec are an error, so typos in the
+// template are caught on first use.
+func (t *ClusterTemplate) Render(values map[string]string) (*TenantClusterSpec, error) {
+	vars, err := t.ResolveVariables(values)
+	if err != nil {
+		return nil, err
+	}
+	m, err := t.Spec.Template.ToMap()
+	if err != nil {
+		return nil, fmt.Errorf("cluster template %s: %w", t.Name, err)
+	}
+	r := &templateRenderer{t: t, vars: vars}
+	out := r.render("spec", m)
+	if len(r.errs) > 0 {
+		sort.Strings(r.errs)
+		return nil, fmt.Errorf("cluster template %s: %s", t.Name, strings.Join(r.errs, "; "))
+	}
+	raw, err := json.Marshal(out)
+	if err != nil {
+		return nil, fmt.Errorf("cluster template %s: %w", t.Name, err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+	spec := &TenantClusterSpec{}
+	if err := dec.Decode(spec); err != nil {
+		return nil, fmt.Errorf("cluster template %s: rendered template is not a valid TenantCluster spec: %w", t.Name, err)
+	}
+	return spec, nil
+}
+
+type templateRenderer struct {
+	t    *ClusterTemplate
+	vars map[string]interface{}
+	errs []string
+}
+
+// omitValue marks a field whose value is an unset optional variable.
+type omitValue struct{}
+
+func (r *templateRenderer) render(path string, v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(t))
+		for k, val := range t {
+			if rendered := r.render(path+"."+k, val); rendered != (omitValue{}) {
+				out[k] = rendered
+			}
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, 0, len(t))
+		for i, val := range t {
+			if rendered := r.render(fmt.Sprintf("%s[%d]", path, i), val); rendered != (omitValue{}) {
+				out = append(out, rendered)
+			}
+		}
+		return out
+	case string:
+		return r.renderString(path, t)
+	default:
+		return v
+	}
+}
+
+// renderString renders references in s. A string that is exactly one
+// reference is replaced by the typed value.
+func (r *templateRenderer) renderString(path, s string) interface{} {
+	if m := templateVariablePattern.FindStringSubmatch(s); m != nil && m[0] == s && !strings.HasPrefix(s, "$$") {
+		val, ok := r.lookup(path, m[1])
+		if !ok {
+			return omitValue{}
+		}
+		return val
+	}
+	return templateVariablePattern.ReplaceAllStringFunc(s, func(match string) string {
+		if strings.HasPrefix(match, "$$") {
+			return match[1:]
+		}
+		val, ok := r.lookup(path, templateVariablePattern.FindStringSubmatch(match)[1])
+		if !ok {
+			return ""
+		}
+		return fmt.Sprint(val)
+	})
+}
+
+// lookup returns the value of a variable. Referencing an undeclared
+// variable is an error; an optional variable without a value is not.
+func (r *templateRenderer) lookup(path, name string) (interface{}, bool) {
+	val, ok := r.vars[name]
+	if !ok && r.t.GetVariable(name) == nil {
+		r.errs = append(r.errs, fmt.Sprintf("%s: unknown variable var.%s", path, name))
+	}
+	return val, ok
+}
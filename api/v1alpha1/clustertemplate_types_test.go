@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"strings"
+	"testing"
+)
+
+func newTestClusterTemplate() *ClusterTemplate {
+	three, maxWorkers := "3", int64(10)
+	ct := &ClusterTemplate{Spec: ClusterTemplateSpec{
+		DisplayName: "Standard cluster",
+		Variables: []ClusterTemplateVariable{
+			{Name: "version", Required: true, Enum: []string{"v1.31.4", "v1.32.1"}},
+			{Name: "workers", Type: ClusterTemplateVariableInteger, Default: &three, Maximum: &maxWorkers},
+			{Name: "provider", Required: true, Pattern: `^[a-z0-9-]+$`},
+			{Name: "lbPoolSize", Type: ClusterTemplateVariableInteger},
+		},
+		Template: ExtensionValues{Raw: []byte(`{
+			"kubernetesVersion": "${var.version}",
+			"providerConfigRef": {"name": "${var.provider}"},
+			"workers": {"replicas": "${var.workers}", "machineTemplate": {"cpu": 4}},
+			"networking": {"lbPoolSize": "${var.lbPoolSize}"},
+			"controlPlane": {"certSANs": ["api-${var.provider}.example.com", "$${var.literal}"]}
+		}`)},
+	}}
+	ct.Name = "standard"
+	return ct
+}
+
+func TestClusterTemplateRender(t *testing.T) {
+	ct := newTestClusterTemplate()
+	spec, err := ct.Render(map[string]string{"version": "v1.32.1", "provider": "harvester-prod"})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if spec.KubernetesVersion != "v1.32.1" || spec.ProviderConfigRef.Name != "harvester-prod" {
+		t.Errorf("string variables not rendered: %+v", spec)
+	}
+	if spec.Workers.Replicas != 3 {
+		t.Errorf("Workers.Replicas = %d, want default 3", spec.Workers.Replicas)
+	}
+	if spec.Networking.LBPoolSize != nil {
+		t.Errorf("LBPoolSize = %v, want omitted for unset optional variable", *spec.Networking.LBPoolSize)
+	}
+	want := []string{"api-harvester-prod.example.com", "${var.literal}"}
+	if got := spec.ControlPlane.CertSANs; len(got) != 2 || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("CertSANs = %v, want %v", got, want)
+	}
+
+	spec, err = ct.Render(map[string]string{"version": "v1.31.4", "provider": "p", "workers": "7", "lbPoolSize": "16"})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if spec.Workers.Replicas != 7 || spec.Networking.LBPoolSize == nil || *spec.Networking.LBPoolSize != 16 {
+		t.Errorf("integer variables not rendered: replicas %d, lbPoolSize %v", spec.Workers.Replicas, spec.Networking.LBPoolSize)
+	}
+}
+
+func TestClusterTemplateRenderErrors(t *testing.T) {
+	ct := newTestClusterTemplate()
+	_, err := ct.Render(map[string]string{"version": "v1.30.0", "provider": "Bad_Name", "workers": "11", "extra": "x"})
+	if err == nil {
+		t.Fatal("Render() succeeded with invalid values")
+	}
+	for _, want := range []string{"extra: unknown variable", "version: must be one of", "does not match", "greater than the maximum"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not contain %q", err, want)
+		}
+	}
+	if _, err := ct.Render(map[string]string{"provider": "p"}); err == nil || !strings.Contains(err.Error(), "version: value is required") {
+		t.Errorf("Render() error = %v, want required version", err)
+	}
+
+	ct.Spec.Template.Raw = []byte(`{"kubernetesVersion": "v1.32.1", "wrokers": {}}`)
+	if _, err := ct.Render(map[string]string{"version": "v1.32.1", "provider": "p"}); err == nil || !strings.Contains(err.Error(), "not a valid TenantCluster spec") {
+		t.Errorf("Render() error = %v, want unknown field error", err)
+	}
+}
+
+func TestClusterTemplateReferencedVariables(t *testing.T) {
+	got, err := newTestClusterTemplate().ReferencedVariables()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "lbPoolSize,provider,version,workers"
+	if strings.Join(got, ",") != want {
+		t.Errorf("ReferencedVariables() = %v, want %s", got, want)
+	}
+}
@@ -17,7 +17,14 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 // ProviderReference references a ProviderConfig resource.
@@ -111,6 +118,153 @@ type ResourceQuantities struct {
 	Memory *resource.Quantity `json:"memory,omitempty"`
 }
 
+// EnvVar is a literal environment variable.
+type EnvVar struct {
+	// Name of the environment variable.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[A-Za-z_][A-Za-z0-9_]*$`
+	Name string `json:"name"`
+
+	// Value of the environment variable.
+	// +optional
+	Value string `json:"value,omitempty"`
+}
+
+// Toleration allows a pod to schedule onto nodes with matching taints.
+// Mirrors core/v1 Toleration.
+type Toleration struct {
+	// Key is the taint key the toleration applies to. Empty matches all keys.
+	// +optional
+	Key string `json:"key,omitempty"`
+
+	// Operator is the relationship between the key and the value.
+	// +kubebuilder:validation:Enum=Exists;Equal
+	// +kubebuilder:default="Equal"
+	// +optional
+	Operator string `json:"operator,omitempty"`
+
+	// Value is the taint value the toleration matches.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// Effect is the taint effect to match. Empty matches all effects.
+	// +kubebuilder:validation:Enum=NoSchedule;PreferNoSchedule;NoExecute
+	// +optional
+	Effect string `json:"effect,omitempty"`
+
+	// TolerationSeconds is how long a NoExecute toleration tolerates the taint.
+	// +optional
+	TolerationSeconds *int64 `json:"tolerationSeconds,omitempty"`
+}
+
+// PlatformComponentSpec configures scheduling and sizing of a Butler
+// platform component deployed to the management cluster.
+type PlatformComponentSpec struct {
+	// Replicas is the number of pods.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Resources sets container requests and limits.
+	// +optional
+	Resources *ComponentResources `json:"resources,omitempty"`
+
+	// NodeSelector constrains pods to nodes with matching labels.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations allow pods to schedule onto tainted nodes,
+	// such as control plane nodes.
+	// +optional
+	Tolerations []Toleration `json:"tolerations,omitempty"`
+
+	// ExtraEnv adds environment variables to the main container.
+	// +optional
+	ExtraEnv []EnvVar `json:"extraEnv,omitempty"`
+}
+
+// GetReplicas returns the replica count, defaulting to 1.
+func (p *PlatformComponentSpec) GetReplicas() int32 {
+	if p == nil || p.Replicas == nil {
+		return 1
+	}
+	return *p.Replicas
+}
+
+// PDBViolationPolicy controls drain behavior when a PodDisruptionBudget
+// blocks eviction.
+// +kubebuilder:validation:Enum=Wait;Force;Abort
+type PDBViolationPolicy string
+
+const (
+	// PDBViolationPolicyWait retries eviction until the drain timeout,
+	// then fails the operation.
+	PDBViolationPolicyWait PDBViolationPolicy = "Wait"
+
+	// PDBViolationPolicyForce retries eviction until the drain timeout,
+	// then deletes the remaining pods without honoring their PodDisruptionBudget.
+	PDBViolationPolicyForce PDBViolationPolicy = "Force"
+
+	// PDBViolationPolicyAbort fails the operation on the first blocked eviction.
+	PDBViolationPolicyAbort PDBViolationPolicy = "Abort"
+)
+
+// DrainPolicy controls how a node is drained before its machine is removed.
+type DrainPolicy struct {
+	// GracePeriod overrides the termination grace period of evicted pods.
+	// If not set, each pod's own terminationGracePeriodSeconds is used.
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+
+	// IgnoreDaemonSets skips DaemonSet-managed pods.
+	// +kubebuilder:default=true
+	// +optional
+	IgnoreDaemonSets *bool `json:"ignoreDaemonSets,omitempty"`
+
+	// DeleteEmptyDirData allows evicting pods that use emptyDir volumes.
+	// Their emptyDir data is lost.
+	// +kubebuilder:default=false
+	// +optional
+	DeleteEmptyDirData *bool `json:"deleteEmptyDirData,omitempty"`
+
+	// Timeout bounds the whole drain. Set to 0 to wait indefinitely.
+	// +kubebuilder:default="10m"
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// PDBViolation controls what happens when a PodDisruptionBudget blocks eviction.
+	// +kubebuilder:default=Wait
+	// +optional
+	PDBViolation PDBViolationPolicy `json:"pdbViolation,omitempty"`
+}
+
+// GetTimeout returns the drain timeout, defaulting to 10 minutes.
+// Zero means no timeout.
+func (d *DrainPolicy) GetTimeout() time.Duration {
+	if d == nil || d.Timeout == nil {
+		return 10 * time.Minute
+	}
+	return d.Timeout.Duration
+}
+
+// ShouldIgnoreDaemonSets returns whether DaemonSet pods are skipped, defaulting to true.
+func (d *DrainPolicy) ShouldIgnoreDaemonSets() bool {
+	return d == nil || d.IgnoreDaemonSets == nil || *d.IgnoreDaemonSets
+}
+
+// ShouldDeleteEmptyDirData returns whether emptyDir pods may be evicted, defaulting to false.
+func (d *DrainPolicy) ShouldDeleteEmptyDirData() bool {
+	return d != nil && d.DeleteEmptyDirData != nil && *d.DeleteEmptyDirData
+}
+
+// GetPDBViolationPolicy returns the PDB violation policy, defaulting to Wait.
+func (d *DrainPolicy) GetPDBViolationPolicy() PDBViolationPolicy {
+	if d == nil || d.PDBViolation == "" {
+		return PDBViolationPolicyWait
+	}
+	return d.PDBViolation
+}
+
 // TeamResourceLimits defines resource quotas and restrictions for a Team.
 // This is separate from ResourceLimits in butlerconfig_types.go which defines
 // platform-wide defaults. TeamResourceLimits includes additional fields for
@@ -166,7 +320,7 @@ type TeamResourceLimits struct {
 	// ====== Feature Restrictions ======
 
 	// AllowedKubernetesVersions restricts which K8s versions can be used.
-	// If empty, all supported versions are allowed.
+	// If empty, all available versions in the KubernetesVersionCatalog are allowed.
 	// +optional
 	AllowedKubernetesVersions []string `json:"allowedKubernetesVersions,omitempty"`
 
@@ -184,6 +338,232 @@ type TeamResourceLimits struct {
 	// Takes precedence over AllowedAddons.
 	// +optional
 	DeniedAddons []string `json:"deniedAddons,omitempty"`
+
+	// ====== Workspace Policy ======
+
+	// WorkspacePolicy restricts what workspaces in this team may use.
+	// +optional
+	WorkspacePolicy *WorkspacePolicy `json:"workspacePolicy,omitempty"`
+}
+
+// WorkspacePolicy defines team-level restrictions for Workspaces.
+type WorkspacePolicy struct {
+	// AllowedSecrets lists Secrets in the team namespace that workspaces may
+	// inject via spec.secrets. If both AllowedSecrets and AllowedSecretSelector
+	// are empty, no Secrets may be injected.
+	// +optional
+	AllowedSecrets []string `json:"allowedSecrets,omitempty"`
+
+	// AllowedSecretSelector allows any Secret in the team namespace whose
+	// labels match. Evaluated in addition to AllowedSecrets.
+	// +optional
+	AllowedSecretSelector *metav1.LabelSelector `json:"allowedSecretSelector,omitempty"`
+
+	// AllowDockerInDocker permits workspaces to run a privileged Docker
+	// daemon sidecar. Privileged containers can escape to the node, so
+	// only enable this on clusters dedicated to the team.
+	// +optional
+	AllowDockerInDocker bool `json:"allowDockerInDocker,omitempty"`
+
+	// MaxSidecars is the maximum number of sidecars per workspace.
+	// If not set, the API maximum of 8 applies. Set to 0 to disallow sidecars.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxSidecars *int32 `json:"maxSidecars,omitempty"`
+
+	// AllowedImagePrefixes restricts workspace and sidecar images to those
+	// starting with one of these prefixes. Include the trailing slash
+	// (e.g., "ghcr.io/butlerdotdev/") to match a whole registry path.
+	// If empty, any image is allowed.
+	// +optional
+	AllowedImagePrefixes []string `json:"allowedImagePrefixes,omitempty"`
+
+	// MaxCPUPerWorkspace is the maximum CPU of a single workspace.
+	// +optional
+	MaxCPUPerWorkspace *resource.Quantity `json:"maxCPUPerWorkspace,omitempty"`
+
+	// MaxMemoryPerWorkspace is the maximum memory of a single workspace.
+	// +optional
+	MaxMemoryPerWorkspace *resource.Quantity `json:"maxMemoryPerWorkspace,omitempty"`
+
+	// MaxStoragePerWorkspace is the maximum PVC size of a single workspace.
+	// +optional
+	MaxStoragePerWorkspace *resource.Quantity `json:"maxStoragePerWorkspace,omitempty"`
+
+	// MaxWorkspaces is the maximum number of workspaces across the team.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxWorkspaces *int32 `json:"maxWorkspaces,omitempty"`
+
+	// AllowedTemplates restricts workspaces to these WorkspaceTemplate names.
+	// If empty, any template (or none) may be used.
+	// +optional
+	AllowedTemplates []string `json:"allowedTemplates,omitempty"`
+
+	// AllowedClasses restricts workspaces to these WorkspaceClass names.
+	// If empty, any class may be used.
+	// +optional
+	AllowedClasses []string `json:"allowedClasses,omitempty"`
+
+	// RequireClass rejects workspaces that set free-form resources instead
+	// of a className.
+	// +optional
+	RequireClass bool `json:"requireClass,omitempty"`
+
+	// SSHCertificateAuthority overrides the platform SSH certificate
+	// authority for this team's workspaces. The CA key Secret must live in
+	// the team namespace.
+	// +optional
+	SSHCertificateAuthority *SSHCertificateAuthority `json:"sshCertificateAuthority,omitempty"`
+}
+
+// IsImageAllowed returns whether the image matches AllowedImagePrefixes.
+// Returns true when the policy is nil or no prefixes are set.
+func (p *WorkspacePolicy) IsImageAllowed(image string) bool {
+	if p == nil || len(p.AllowedImagePrefixes) == 0 {
+		return true
+	}
+	for _, prefix := range p.AllowedImagePrefixes {
+		if strings.HasPrefix(image, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsTemplateAllowed returns whether a workspace created from the named
+// template is allowed. An empty name means no template was used.
+func (p *WorkspacePolicy) IsTemplateAllowed(name string) bool {
+	if p == nil || len(p.AllowedTemplates) == 0 {
+		return true
+	}
+	for _, allowed := range p.AllowedTemplates {
+		if allowed == name {
+			return true
+		}
+	}
+	return false
+}
+
+// IsClassAllowed returns whether the named WorkspaceClass may be used.
+func (p *WorkspacePolicy) IsClassAllowed(name string) bool {
+	if p == nil || len(p.AllowedClasses) == 0 {
+		return true
+	}
+	for _, allowed := range p.AllowedClasses {
+		if allowed == name {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckWorkspace returns the policy violations of a workspace spec.
+// template is the WorkspaceTemplate the workspace was created from, if any,
+// and existing is the number of other workspaces in the team. class is the
+// WorkspaceClass named by spec.className and is required to check the CPU
+// and memory limits of class-based workspaces.
+// Returns nil if the workspace is allowed.
+func (p *WorkspacePolicy) CheckWorkspace(spec *WorkspaceSpec, class *WorkspaceClass, template string, existing int32) []string {
+	if p == nil {
+		return nil
+	}
+	var violations []string
+	if p.MaxWorkspaces != nil && existing >= *p.MaxWorkspaces {
+		violations = append(violations, fmt.Sprintf("team workspace limit of %d reached", *p.MaxWorkspaces))
+	}
+	if !p.IsTemplateAllowed(template) {
+		violations = append(violations, fmt.Sprintf("template %q is not allowed", template))
+	}
+	if !p.IsImageAllowed(spec.Image) {
+		violations = append(violations, fmt.Sprintf("image %q is not allowed", spec.Image))
+	}
+	for _, sc := range spec.Sidecars {
+		if !p.IsImageAllowed(sc.Image) {
+			violations = append(violations, fmt.Sprintf("sidecar %q image %q is not allowed", sc.Name, sc.Image))
+		}
+	}
+	if p.MaxSidecars != nil && int32(len(spec.Sidecars)) > *p.MaxSidecars {
+		violations = append(violations, fmt.Sprintf("%d sidecars exceed the limit of %d", len(spec.Sidecars), *p.MaxSidecars))
+	}
+	if spec.DockerInDocker && !p.AllowDockerInDocker {
+		violations = append(violations, "dockerInDocker is not allowed")
+	}
+
+	if spec.ClassName != "" && !p.IsClassAllowed(spec.ClassName) {
+		violations = append(violations, fmt.Sprintf("workspace class %q is not allowed", spec.ClassName))
+	}
+	if p.RequireClass && spec.Resources != nil {
+		violations = append(violations, "resources are not allowed; set className")
+	}
+
+	cpu, memory := "2", "4Gi"
+	if class != nil {
+		cpu, memory = class.Spec.CPU.String(), class.Spec.Memory.String()
+	} else if spec.Resources != nil {
+		if spec.Resources.CPU != "" {
+			cpu = spec.Resources.CPU
+		}
+		if spec.Resources.Memory != "" {
+			memory = spec.Resources.Memory
+		}
+	}
+	violations = appendQuantityViolation(violations, "cpu", cpu, p.MaxCPUPerWorkspace)
+	violations = appendQuantityViolation(violations, "memory", memory, p.MaxMemoryPerWorkspace)
+	storage := "10Gi"
+	if spec.StorageSize != nil {
+		storage = spec.StorageSize.String()
+	}
+	violations = appendQuantityViolation(violations, "storage", storage, p.MaxStoragePerWorkspace)
+	return violations
+}
+
+func appendQuantityViolation(violations []string, name, value string, limit *resource.Quantity) []string {
+	if limit == nil {
+		return violations
+	}
+	q, err := resource.ParseQuantity(value)
+	if err != nil {
+		return append(violations, fmt.Sprintf("invalid %s %q", name, value))
+	}
+	if q.Cmp(*limit) > 0 {
+		return append(violations, fmt.Sprintf("%s %s exceeds the limit of %s", name, q.String(), limit.String()))
+	}
+	return violations
+}
+
+// IsSecretAllowed returns whether a Secret with the given name and labels may
+// be injected into a workspace. Returns false when the policy is nil. An
+// empty AllowedSecretSelector matches no Secrets rather than all of them.
+func (p *WorkspacePolicy) IsSecretAllowed(name string, secretLabels map[string]string) bool {
+	if p == nil {
+		return false
+	}
+	for _, allowed := range p.AllowedSecrets {
+		if allowed == name {
+			return true
+		}
+	}
+	s := p.AllowedSecretSelector
+	if s == nil || (len(s.MatchLabels) == 0 && len(s.MatchExpressions) == 0) {
+		return false
+	}
+	selector, err := metav1.LabelSelectorAsSelector(s)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(secretLabels))
+}
+
+// listsApprover returns true if approvers names the user or one of the
+// user's groups. Each entry is matched against both.
+func listsApprover(approvers []string, user string, groups []string) bool {
+	for _, a := range approvers {
+		if a == user || slices.Contains(groups, a) {
+			return true
+		}
+	}
+	return false
 }
 
 // TeamResourceUsage shows current resource consumption for a Team.
@@ -208,6 +588,15 @@ type TeamResourceUsage struct {
 	// +optional
 	TotalStorage *resource.Quantity `json:"totalStorage,omitempty"`
 
+	// Workspaces is the number of Workspaces across the team.
+	// +optional
+	Workspaces int32 `json:"workspaces,omitempty"`
+
+	// Reserved is quota held by unbound ResourceClaims.
+	// Quota checks add this to the totals above.
+	// +optional
+	Reserved *ProvisioningRequirements `json:"reserved,omitempty"`
+
 	// ====== Utilization Percentages ======
 
 	// ClusterUtilization is percentage of MaxClusters used.
@@ -235,6 +624,152 @@ type TeamResourceUsage struct {
 	MemoryUtilization *int32 `json:"memoryUtilization,omitempty"`
 }
 
+// StatusSummary holds flat, preformatted counts for kubectl printer columns.
+// Controllers keep it in sync with the detailed status on every reconcile,
+// so columns show "0/3" rather than blank while deeper status is populated.
+// Counts are formatted as "ready/total" (see FormatReadyCount).
+type StatusSummary struct {
+	// ControlPlaneReady is the number of ready control plane replicas or nodes.
+	// +optional
+	ControlPlaneReady string `json:"controlPlaneReady,omitempty"`
+
+	// WorkersReady is the number of ready worker nodes.
+	// +optional
+	WorkersReady string `json:"workersReady,omitempty"`
+
+	// AddonsHealthy is the number of healthy addons.
+	// +optional
+	AddonsHealthy string `json:"addonsHealthy,omitempty"`
+
+	// ClustersReady is the number of Ready TenantClusters.
+	// +optional
+	ClustersReady string `json:"clustersReady,omitempty"`
+}
+
+// FormatReadyCount formats a count for StatusSummary as "ready/total".
+func FormatReadyCount(ready, total int32) string {
+	return fmt.Sprintf("%d/%d", ready, total)
+}
+
+// ReconcileStatus reports controller reconcile diagnostics so users can
+// tell whether a controller is processing an object or is stuck.
+type ReconcileStatus struct {
+	// LastReconcileTime is when the controller last reconciled the object.
+	// +optional
+	LastReconcileTime *metav1.Time `json:"lastReconcileTime,omitempty"`
+
+	// LastSuccessTime is when a reconcile last completed without error.
+	// +optional
+	LastSuccessTime *metav1.Time `json:"lastSuccessTime,omitempty"`
+
+	// Duration is how long the last reconcile took.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// ConsecutiveErrors is the number of reconciles that have failed in a row.
+	// Reset to 0 on success.
+	// +optional
+	ConsecutiveErrors int32 `json:"consecutiveErrors,omitempty"`
+
+	// LastError is the error returned by the most recent failed reconcile.
+	// +optional
+	LastError string `json:"lastError,omitempty"`
+
+	// NextRequeueTime is when the controller plans to reconcile again.
+	// +optional
+	NextRequeueTime *metav1.Time `json:"nextRequeueTime,omitempty"`
+
+	// ControllerVersion is the version of the controller that last reconciled the object.
+	// +optional
+	ControllerVersion string `json:"controllerVersion,omitempty"`
+}
+
+// IsStalled returns true if a planned requeue is more than threshold overdue.
+// Objects without a planned requeue are only reconciled on change and are
+// never considered stalled.
+func (r *ReconcileStatus) IsStalled(now time.Time, threshold time.Duration) bool {
+	if r == nil || r.NextRequeueTime == nil {
+		return false
+	}
+	return now.Sub(r.NextRequeueTime.Time) > threshold
+}
+
+// AccessGrant gives a user temporary elevated access to a single resource.
+// Grants are added by Team admins; the admission webhook rejects grants from
+// anyone else and records the granting user. Expired grants are revoked by
+// the controller and remain in status for audit.
+// +kubebuilder:validation:XValidation:rule="duration(self.duration) > duration('0s') && duration(self.duration) <= duration('168h')",message="duration must be greater than 0 and at most 168h"
+type AccessGrant struct {
+	// User is the email of the user receiving access.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=254
+	User string `json:"user"`
+
+	// Role is the access level granted.
+	// On TenantClusters it maps to the corresponding Team role's RBAC.
+	// On Workspaces, admin and operator grant SSH access and viewer grants
+	// read-only access to status and logs.
+	// +kubebuilder:default="viewer"
+	// +optional
+	Role TeamRole `json:"role,omitempty"`
+
+	// Duration is how long the grant lasts from when it is first applied.
+	// +kubebuilder:validation:Required
+	Duration metav1.Duration `json:"duration"`
+
+	// Reason is the justification recorded for auditors.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=512
+	Reason string `json:"reason"`
+
+	// GrantedBy is the Team admin who added the grant.
+	// Set by the admission webhook.
+	// +optional
+	GrantedBy string `json:"grantedBy,omitempty"`
+}
+
+// AccessGrantStatus records the lifetime of an AccessGrant.
+type AccessGrantStatus struct {
+	// User is the email of the user receiving access.
+	User string `json:"user"`
+
+	// Role is the access level granted.
+	// +optional
+	Role TeamRole `json:"role,omitempty"`
+
+	// GrantedBy is the Team admin who added the grant.
+	// +optional
+	GrantedBy string `json:"grantedBy,omitempty"`
+
+	// GrantedAt is when access was applied.
+	GrantedAt metav1.Time `json:"grantedAt"`
+
+	// ExpiresAt is when access is revoked.
+	ExpiresAt metav1.Time `json:"expiresAt"`
+
+	// RevokedAt is when access was actually removed, either at expiry or
+	// because the grant was deleted from spec.
+	// +optional
+	RevokedAt *metav1.Time `json:"revokedAt,omitempty"`
+}
+
+// IsActive returns true if the grant is in effect at now.
+func (g *AccessGrantStatus) IsActive(now time.Time) bool {
+	return g.RevokedAt == nil && !now.Before(g.GrantedAt.Time) && now.Before(g.ExpiresAt.Time)
+}
+
+// ActiveAccessGrant returns the active grant for user at now, or nil.
+func ActiveAccessGrant(grants []AccessGrantStatus, user string, now time.Time) *AccessGrantStatus {
+	for i := range grants {
+		if grants[i].User == user && grants[i].IsActive(now) {
+			return &grants[i]
+		}
+	}
+	return nil
+}
+
 // Kubernetes recommended labels.
 // See: https://kubernetes.io/docs/concepts/overview/working-with-objects/common-labels/
 const (
@@ -267,7 +802,10 @@ const (
 	// LabelWorkspaceOwner identifies the owner of a workspace (hashed email).
 	LabelWorkspaceOwner = "butler.butlerlabs.dev/workspace-owner"
 
-	// LabelAllocationType identifies the IP allocation type (loadbalancer, nodes).
+	// LabelWorkspaceTemplate identifies the WorkspaceTemplate a workspace was created from.
+	LabelWorkspaceTemplate = "butler.butlerlabs.dev/workspace-template"
+
+	// LabelAllocationType identifies the IP allocation type (loadbalancer, nodes, controlplane).
 	LabelAllocationType = "butler.butlerlabs.dev/allocation-type"
 
 	// LabelSchematicID identifies the factory schematic for image sync deduplication.
@@ -285,10 +823,32 @@ const (
 	// the parent Team has no environments. Immutable after create.
 	LabelEnvironment = "butler.butlerlabs.dev/environment"
 
+	// LabelEnvironmentTier is the dev, staging, or prod tier from a
+	// TenantCluster's spec.ownership.environment.
+	LabelEnvironmentTier = "butler.butlerlabs.dev/environment-tier"
+
+	// LabelBusinessUnit is the business unit from a TenantCluster's
+	// spec.ownership.businessUnit.
+	LabelBusinessUnit = "butler.butlerlabs.dev/business-unit"
+
 	// LabelPlatformLB identifies LoadBalancer services managed by butler platform
 	// addons (e.g., Traefik ingress controller). These are excluded from elastic
 	// IPAM usage counting since they are infrastructure, not tenant workload LBs.
 	LabelPlatformLB = "butler.butlerlabs.dev/platform-lb"
+
+	// LabelWorkerPool identifies the TenantCluster worker pool a node
+	// belongs to. Set to "default" for nodes from spec.workers.
+	LabelWorkerPool = "butler.butlerlabs.dev/worker-pool"
+
+	// LabelNetworkPrefix prefixes the node label set for each secondary
+	// worker network, e.g. "network.butlerlabs.dev/storage=true".
+	LabelNetworkPrefix = "network.butlerlabs.dev/"
+
+	// LabelAuditAction is the action recorded by an AuditEvent.
+	LabelAuditAction = "butler.butlerlabs.dev/audit-action"
+
+	// LabelAuditKind is the kind of the resource recorded by an AuditEvent.
+	LabelAuditKind = "butler.butlerlabs.dev/audit-kind"
 )
 
 // Butler-specific annotations.
@@ -333,6 +893,26 @@ const (
 
 	// AnnotationConnectTime records when the SSH service was created.
 	AnnotationConnectTime = "butler.butlerlabs.dev/connect-time"
+
+	// AnnotationClusterTemplate records the ClusterTemplate a TenantCluster
+	// was created from, as "namespace/name". Informational only.
+	AnnotationClusterTemplate = "butler.butlerlabs.dev/cluster-template"
+
+	// AnnotationChangeRequest names the approved ChangeRequest authorizing
+	// a gated create or update. Deletes use the annotation on the stored
+	// object when set, and otherwise any authorizing ChangeRequest for the
+	// object.
+	AnnotationChangeRequest = "butler.butlerlabs.dev/change-request"
+
+	// AnnotationSilences holds a JSON list of ConditionSilence entries.
+	// Notification controllers do not page for conditions matched by an
+	// active silence. Use GetSilences and SetSilences to read and write it.
+	AnnotationSilences = "butler.butlerlabs.dev/silences"
+
+	// AnnotationConversionData holds v1alpha1 fields that have no v1beta1
+	// equivalent, so objects round-trip between versions without loss.
+	// Written and consumed by the conversion webhook only.
+	AnnotationConversionData = "butler.butlerlabs.dev/conversion-data"
 )
 
 // Finalizers.
@@ -473,4 +1053,7 @@ const (
 
 	// ReasonImageSyncFailed indicates the image sync failed.
 	ReasonImageSyncFailed = "ImageSyncFailed"
+
+	// ReasonThrottled indicates work is queued behind ButlerConfig provisioning limits.
+	ReasonThrottled = "Throttled"
 )
@@ -0,0 +1,225 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestWorkspacePolicyCheckWorkspace(t *testing.T) {
+	int32Ptr := func(v int32) *int32 { return &v }
+	quantity := func(s string) *resource.Quantity {
+		q := resource.MustParse(s)
+		return &q
+	}
+
+	policy := &WorkspacePolicy{
+		AllowedImagePrefixes:   []string{"ghcr.io/butlerdotdev/"},
+		MaxCPUPerWorkspace:     quantity("4"),
+		MaxMemoryPerWorkspace:  quantity("8Gi"),
+		MaxStoragePerWorkspace: quantity("50Gi"),
+		MaxWorkspaces:          int32Ptr(5),
+		MaxSidecars:            int32Ptr(1),
+		AllowedTemplates:       []string{"go-dev"},
+		AllowedClasses:         []string{"small", "medium"},
+	}
+	class := func(name, cpu, memory string) *WorkspaceClass {
+		c := &WorkspaceClass{Spec: WorkspaceClassSpec{CPU: resource.MustParse(cpu), Memory: resource.MustParse(memory)}}
+		c.Name = name
+		return c
+	}
+
+	tests := []struct {
+		name     string
+		policy   *WorkspacePolicy
+		spec     WorkspaceSpec
+		class    *WorkspaceClass
+		template string
+		existing int32
+		want     int
+	}{
+		{
+			name:   "nil policy allows everything",
+			policy: nil,
+			spec:   WorkspaceSpec{Image: "docker.io/library/ubuntu", DockerInDocker: true},
+			want:   0,
+		},
+		{
+			name:     "defaults within limits",
+			policy:   policy,
+			spec:     WorkspaceSpec{Image: "ghcr.io/butlerdotdev/workspace-base:latest"},
+			template: "go-dev",
+			existing: 4,
+			want:     0,
+		},
+		{
+			name:     "image and template rejected",
+			policy:   policy,
+			spec:     WorkspaceSpec{Image: "ghcr.io/butlerdotdevil/workspace:latest"},
+			template: "python",
+			want:     2,
+		},
+		{
+			name:   "resources over limits",
+			policy: policy,
+			spec: WorkspaceSpec{
+				Image:       "ghcr.io/butlerdotdev/workspace-base:latest",
+				Resources:   &WorkspaceResources{CPU: "8", Memory: "16Gi"},
+				StorageSize: quantity("100Gi"),
+			},
+			template: "go-dev",
+			want:     3,
+		},
+		{
+			name:   "team limit, sidecars, and docker-in-docker",
+			policy: policy,
+			spec: WorkspaceSpec{
+				Image: "ghcr.io/butlerdotdev/workspace-base:latest",
+				Sidecars: []WorkspaceSidecar{
+					{Name: "db", Image: "ghcr.io/butlerdotdev/postgres:16"},
+					{Name: "cache", Image: "docker.io/library/redis:7"},
+				},
+				DockerInDocker: true,
+			},
+			template: "go-dev",
+			existing: 5,
+			want:     4,
+		},
+		{
+			name:     "class within limits",
+			policy:   policy,
+			spec:     WorkspaceSpec{Image: "ghcr.io/butlerdotdev/workspace-base:latest", ClassName: "medium"},
+			class:    class("medium", "4", "8Gi"),
+			template: "go-dev",
+			want:     0,
+		},
+		{
+			name:     "class not allowed and over limits",
+			policy:   policy,
+			spec:     WorkspaceSpec{Image: "ghcr.io/butlerdotdev/workspace-base:latest", ClassName: "xlarge"},
+			class:    class("xlarge", "16", "64Gi"),
+			template: "go-dev",
+			want:     3,
+		},
+		{
+			name:   "class required",
+			policy: &WorkspacePolicy{RequireClass: true},
+			spec: WorkspaceSpec{
+				Image:     "ghcr.io/butlerdotdev/workspace-base:latest",
+				Resources: &WorkspaceResources{CPU: "2", Memory: "4Gi"},
+			},
+			want: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.policy.CheckWorkspace(&tt.spec, tt.class, tt.template, tt.existing)
+			if len(got) != tt.want {
+				t.Errorf("CheckWorkspace() = %v, want %d violations", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWorkspacePolicyIsSecretAllowed(t *testing.T) {
+	gitSelector := &metav1.LabelSelector{MatchLabels: map[string]string{"butler.butlerlabs.dev/workspace-secret": "true"}}
+	allowedLabels := map[string]string{"butler.butlerlabs.dev/workspace-secret": "true"}
+
+	tests := []struct {
+		name   string
+		policy *WorkspacePolicy
+		secret string
+		labels map[string]string
+		want   bool
+	}{
+		{name: "nil policy", secret: "git-token", labels: allowedLabels},
+		{name: "empty policy", policy: &WorkspacePolicy{}, secret: "git-token", labels: allowedLabels},
+		{
+			name:   "empty selector matches nothing",
+			policy: &WorkspacePolicy{AllowedSecretSelector: &metav1.LabelSelector{}},
+			secret: "git-token",
+			labels: allowedLabels,
+		},
+		{name: "allowed by name", policy: &WorkspacePolicy{AllowedSecrets: []string{"git-token"}}, secret: "git-token", want: true},
+		{name: "name not listed", policy: &WorkspacePolicy{AllowedSecrets: []string{"git-token"}}, secret: "db-password"},
+		{
+			name:   "allowed by selector",
+			policy: &WorkspacePolicy{AllowedSecretSelector: gitSelector},
+			secret: "git-token",
+			labels: allowedLabels,
+			want:   true,
+		},
+		{
+			name:   "labels do not match selector",
+			policy: &WorkspacePolicy{AllowedSecretSelector: gitSelector},
+			secret: "db-password",
+			labels: map[string]string{"app": "db"},
+		},
+		{
+			name: "invalid selector",
+			policy: &WorkspacePolicy{AllowedSecretSelector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tier", Operator: "Near"}},
+			}},
+			secret: "git-token",
+			labels: map[string]string{"tier": "dev"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.IsSecretAllowed(tt.secret, tt.labels); got != tt.want {
+				t.Errorf("IsSecretAllowed(%q) = %v, want %v", tt.secret, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReconcileStatusIsStalled(t *testing.T) {
+	now := time.Now()
+	at := func(d time.Duration) *ReconcileStatus {
+		next := metav1.NewTime(now.Add(d))
+		return &ReconcileStatus{NextRequeueTime: &next}
+	}
+	threshold := 5 * time.Minute
+
+	tests := []struct {
+		name   string
+		status *ReconcileStatus
+		want   bool
+	}{
+		{name: "nil status"},
+		{name: "no planned requeue", status: &ReconcileStatus{}},
+		{name: "requeue in the future", status: at(time.Minute)},
+		{name: "requeue due now", status: at(0)},
+		{name: "overdue within threshold", status: at(-threshold + time.Second)},
+		{name: "overdue by exactly threshold", status: at(-threshold)},
+		{name: "overdue past threshold", status: at(-threshold - time.Second), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.status.IsStalled(now, threshold); got != tt.want {
+				t.Errorf("IsStalled() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,411 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ComplianceCheck identifies a compliance control evaluated per cluster.
+// +kubebuilder:validation:Enum=DeletionProtection;BackupCoverage;PolicyEngine;KubernetesVersionSupported;EncryptionAtRest
+type ComplianceCheck string
+
+const (
+	// ComplianceCheckDeletionProtection passes when spec.deletionProtection is set.
+	ComplianceCheckDeletionProtection ComplianceCheck = "DeletionProtection"
+
+	// ComplianceCheckBackupCoverage passes when a ClusterBackupPolicy for
+	// the cluster completed a backup within spec.backupMaxAge.
+	ComplianceCheckBackupCoverage ComplianceCheck = "BackupCoverage"
+
+	// ComplianceCheckPolicyEngine passes when Kyverno or Gatekeeper is
+	// installed in the cluster.
+	ComplianceCheckPolicyEngine ComplianceCheck = "PolicyEngine"
+
+	// ComplianceCheckKubernetesVersionSupported passes when the cluster's
+	// Kubernetes version is listed in the KubernetesVersionCatalog and is
+	// not past its end of life.
+	ComplianceCheckKubernetesVersionSupported ComplianceCheck = "KubernetesVersionSupported"
+
+	// ComplianceCheckEncryptionAtRest passes when the control plane
+	// encrypts Secrets at rest.
+	ComplianceCheckEncryptionAtRest ComplianceCheck = "EncryptionAtRest"
+)
+
+// AllComplianceChecks lists every check, in report order.
+var AllComplianceChecks = []ComplianceCheck{
+	ComplianceCheckDeletionProtection,
+	ComplianceCheckBackupCoverage,
+	ComplianceCheckPolicyEngine,
+	ComplianceCheckKubernetesVersionSupported,
+	ComplianceCheckEncryptionAtRest,
+}
+
+// ComplianceResult is the outcome of a check on a cluster.
+// +kubebuilder:validation:Enum=Pass;Fail;NotApplicable;Unknown
+type ComplianceResult string
+
+const (
+	// ComplianceResultPass indicates the cluster satisfies the check.
+	ComplianceResultPass ComplianceResult = "Pass"
+
+	// ComplianceResultFail indicates the cluster violates the check.
+	ComplianceResultFail ComplianceResult = "Fail"
+
+	// ComplianceResultNotApplicable indicates the check does not apply,
+	// such as encryption at rest on a virtual cluster.
+	ComplianceResultNotApplicable ComplianceResult = "NotApplicable"
+
+	// ComplianceResultUnknown indicates the check could not be evaluated,
+	// such as when no KubernetesVersionCatalog exists. Unknown results
+	// fail the cluster.
+	ComplianceResultUnknown ComplianceResult = "Unknown"
+)
+
+// ComplianceReport condition types.
+const (
+	// ComplianceReportConditionGenerated indicates the last scheduled run
+	// produced a report.
+	ComplianceReportConditionGenerated = "Generated"
+
+	// ComplianceReportConditionCompliant indicates every cluster passed
+	// every check in the last report.
+	ComplianceReportConditionCompliant = "Compliant"
+)
+
+// DefaultComplianceBackupMaxAge is the backup age limit used when
+// spec.backupMaxAge is unset.
+const DefaultComplianceBackupMaxAge = 48 * time.Hour
+
+// ComplianceReportSpec defines the desired state of ComplianceReport.
+type ComplianceReportSpec struct {
+	// Schedule is when the report is regenerated, in cron format
+	// (e.g., "0 6 * * 1") or as a descriptor such as "@daily". Times are UTC.
+	// +kubebuilder:default="@daily"
+	// +kubebuilder:validation:Pattern=`^(@(yearly|annually|monthly|weekly|daily|midnight|hourly)|(\S+\s+){4}\S+)$`
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+
+	// Checks limits the report to these checks. If empty, all checks run.
+	// +optional
+	// +listType=set
+	Checks []ComplianceCheck `json:"checks,omitempty"`
+
+	// ClusterSelector limits the report to matching TenantClusters in the
+	// report's namespace. If not set, every cluster of the team is included.
+	// +optional
+	ClusterSelector *metav1.LabelSelector `json:"clusterSelector,omitempty"`
+
+	// BackupMaxAge is the maximum age of the latest successful backup for
+	// the BackupCoverage check.
+	// +kubebuilder:default="48h"
+	// +optional
+	BackupMaxAge *metav1.Duration `json:"backupMaxAge,omitempty"`
+
+	// Suspend stops scheduled runs. The last report is kept.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+}
+
+// ComplianceCheckResult is the outcome of one check on one cluster.
+type ComplianceCheckResult struct {
+	// Check is the evaluated control.
+	Check ComplianceCheck `json:"check"`
+
+	// Result of the check.
+	Result ComplianceResult `json:"result"`
+
+	// Message explains the result, typically why it failed.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ClusterComplianceResult reports all checks for one cluster.
+type ClusterComplianceResult struct {
+	// ClusterRef is the evaluated TenantCluster.
+	ClusterRef LocalObjectReference `json:"clusterRef"`
+
+	// Compliant is true when no check failed or was unknown.
+	Compliant bool `json:"compliant"`
+
+	// Checks lists per-check results.
+	// +optional
+	// +listType=map
+	// +listMapKey=check
+	Checks []ComplianceCheckResult `json:"checks,omitempty"`
+}
+
+// ComplianceCheckSummary counts results of one check across clusters.
+type ComplianceCheckSummary struct {
+	// Check is the summarized control.
+	Check ComplianceCheck `json:"check"`
+
+	// Passed is the number of clusters that passed.
+	Passed int32 `json:"passed"`
+
+	// Failed is the number of clusters that failed or could not be evaluated.
+	Failed int32 `json:"failed"`
+
+	// NotApplicable is the number of clusters the check does not apply to.
+	// +optional
+	NotApplicable int32 `json:"notApplicable,omitempty"`
+}
+
+// ComplianceReportStatus defines the observed state of ComplianceReport.
+type ComplianceReportStatus struct {
+	// Conditions represent the latest available observations.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// GeneratedAt is when the current report was generated.
+	// +optional
+	GeneratedAt *metav1.Time `json:"generatedAt,omitempty"`
+
+	// NextRunTime is when the report is next regenerated.
+	// +optional
+	NextRunTime *metav1.Time `json:"nextRunTime,omitempty"`
+
+	// TotalClusters is the number of clusters evaluated.
+	// +optional
+	TotalClusters int32 `json:"totalClusters,omitempty"`
+
+	// CompliantClusters is the number of clusters that passed every check.
+	// +optional
+	CompliantClusters int32 `json:"compliantClusters,omitempty"`
+
+	// Summary counts results per check.
+	// +optional
+	// +listType=map
+	// +listMapKey=check
+	Summary []ComplianceCheckSummary `json:"summary,omitempty"`
+
+	// Clusters lists per-cluster results, sorted by name.
+	// +optional
+	Clusters []ClusterComplianceResult `json:"clusters,omitempty"`
+
+	// Message provides human-readable status information.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// ObservedGeneration is the last observed generation.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=cr,categories=butler
+// +kubebuilder:printcolumn:name="Schedule",type="string",JSONPath=".spec.schedule",description="Report schedule"
+// +kubebuilder:printcolumn:name="Clusters",type="integer",JSONPath=".status.totalClusters",description="Evaluated clusters"
+// +kubebuilder:printcolumn:name="Compliant",type="integer",JSONPath=".status.compliantClusters",description="Clusters passing every check"
+// +kubebuilder:printcolumn:name="Generated",type="date",JSONPath=".status.generatedAt",description="Last report"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ComplianceReport evaluates a team's TenantClusters against platform
+// compliance controls on a schedule. It lives in the team namespace and
+// holds the latest pass/fail breakdown in status, so auditors can query a
+// single object per team instead of inspecting every cluster.
+type ComplianceReport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ComplianceReportSpec   `json:"spec,omitempty"`
+	Status ComplianceReportStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ComplianceReportList contains a list of ComplianceReport.
+type ComplianceReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ComplianceReport `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ComplianceReport{}, &ComplianceReportList{})
+}
+
+// ClusterComplianceInputs holds the state, gathered by the controller,
+// that cluster compliance checks need beyond the TenantCluster itself.
+// +kubebuilder:object:generate=false
+type ClusterComplianceInputs struct {
+	// Now is the evaluation time.
+	Now time.Time
+
+	// BackupPolicies are the ClusterBackupPolicies in the cluster's namespace.
+	BackupPolicies []ClusterBackupPolicy
+
+	// PolicyEngines are the policy engines installed in the cluster.
+	PolicyEngines []PolicyEngine
+
+	// Catalog is the KubernetesVersionCatalog, or nil if none exists.
+	Catalog *KubernetesVersionCatalog
+}
+
+// Helper methods
+
+// GetChecks returns the checks to run, defaulting to all checks.
+func (r *ComplianceReport) GetChecks() []ComplianceCheck {
+	if len(r.Spec.Checks) == 0 {
+		return AllComplianceChecks
+	}
+	checks := make([]ComplianceCheck, 0, len(r.Spec.Checks))
+	for _, c := range AllComplianceChecks {
+		if slices.Contains(r.Spec.Checks, c) {
+			checks = append(checks, c)
+		}
+	}
+	return checks
+}
+
+// GetBackupMaxAge returns the backup age limit for BackupCoverage.
+func (r *ComplianceReport) GetBackupMaxAge() time.Duration {
+	if r.Spec.BackupMaxAge != nil && r.Spec.BackupMaxAge.Duration > 0 {
+		return r.Spec.BackupMaxAge.Duration
+	}
+	return DefaultComplianceBackupMaxAge
+}
+
+// EvaluateCluster runs the report's checks against a cluster.
+func (r *ComplianceReport) EvaluateCluster(tc *TenantCluster, in ClusterComplianceInputs) ClusterComplianceResult {
+	res := ClusterComplianceResult{ClusterRef: LocalObjectReference{Name: tc.Name}, Compliant: true}
+	for _, check := range r.GetChecks() {
+		cr := ComplianceCheckResult{Check: check}
+		cr.Result, cr.Message = r.evaluate(check, tc, in)
+		if cr.Result == ComplianceResultFail || cr.Result == ComplianceResultUnknown {
+			res.Compliant = false
+		}
+		res.Checks = append(res.Checks, cr)
+	}
+	return res
+}
+
+func (r *ComplianceReport) evaluate(check ComplianceCheck, tc *TenantCluster, in ClusterComplianceInputs) (ComplianceResult, string) {
+	switch check {
+	case ComplianceCheckDeletionProtection:
+		if tc.Spec.DeletionProtection {
+			return ComplianceResultPass, ""
+		}
+		return ComplianceResultFail, "deletionProtection is not enabled"
+
+	case ComplianceCheckBackupCoverage:
+		var latest *metav1.Time
+		for i := range in.BackupPolicies {
+			p := &in.BackupPolicies[i]
+			t := p.Status.LastSuccessfulBackupTime
+			if p.Spec.ClusterRef.Name != tc.Name || t == nil {
+				continue
+			}
+			if latest == nil || t.After(latest.Time) {
+				latest = t
+			}
+		}
+		if latest == nil {
+			return ComplianceResultFail, "no successful backup"
+		}
+		if age := in.Now.Sub(latest.Time); age > r.GetBackupMaxAge() {
+			return ComplianceResultFail, fmt.Sprintf("latest successful backup is %s old, limit is %s", age.Round(time.Minute), r.GetBackupMaxAge())
+		}
+		return ComplianceResultPass, ""
+
+	case ComplianceCheckPolicyEngine:
+		if len(in.PolicyEngines) == 0 {
+			return ComplianceResultFail, "no policy engine installed"
+		}
+		return ComplianceResultPass, ""
+
+	case ComplianceCheckKubernetesVersionSupported:
+		if in.Catalog == nil {
+			return ComplianceResultUnknown, "no KubernetesVersionCatalog"
+		}
+		v := tc.Spec.KubernetesVersion
+		e := in.Catalog.GetVersion(v)
+		switch {
+		case e == nil:
+			return ComplianceResultFail, fmt.Sprintf("version %s is not in the catalog", v)
+		case e.IsEOL(in.Now):
+			return ComplianceResultFail, fmt.Sprintf("version %s reached end of life on %s", v, e.EndOfLife.Format("2006-01-02"))
+		}
+		return ComplianceResultPass, ""
+
+	case ComplianceCheckEncryptionAtRest:
+		if tc.Spec.TenancyMode == TenancyModeVirtual {
+			return ComplianceResultNotApplicable, "virtual clusters use the host cluster's datastore"
+		}
+		enc := tc.Spec.ControlPlane.Encryption
+		if enc == nil {
+			return ComplianceResultFail, "encryption at rest is not enabled"
+		}
+		if !encryptsSecrets(enc.GetResources()) {
+			return ComplianceResultFail, fmt.Sprintf("encrypted resources %s do not include secrets", strings.Join(enc.GetResources(), ", "))
+		}
+		return ComplianceResultPass, ""
+	}
+	return ComplianceResultUnknown, fmt.Sprintf("unknown check %q", check)
+}
+
+// encryptsSecrets returns true if the resources cover core Secrets.
+func encryptsSecrets(resources []string) bool {
+	for _, r := range resources {
+		if r == "secrets" || r == "*" || r == "*.*" {
+			return true
+		}
+	}
+	return false
+}
+
+// SetResults records per-cluster results and recomputes the summary.
+// Clusters are sorted by name.
+func (s *ComplianceReportStatus) SetResults(results []ClusterComplianceResult, checks []ComplianceCheck) {
+	slices.SortFunc(results, func(a, b ClusterComplianceResult) int {
+		return strings.Compare(a.ClusterRef.Name, b.ClusterRef.Name)
+	})
+	s.Clusters = results
+	s.TotalClusters = int32(len(results))
+	s.CompliantClusters = 0
+	s.Summary = make([]ComplianceCheckSummary, len(checks))
+	for i, c := range checks {
+		s.Summary[i].Check = c
+	}
+	for _, res := range results {
+		if res.Compliant {
+			s.CompliantClusters++
+		}
+		for _, cr := range res.Checks {
+			i := slices.Index(checks, cr.Check)
+			if i < 0 {
+				continue
+			}
+			switch cr.Result {
+			case ComplianceResultPass:
+				s.Summary[i].Passed++
+			case ComplianceResultNotApplicable:
+				s.Summary[i].NotApplicable++
+			default:
+				s.Summary[i].Failed++
+			}
+		}
+	}
+}
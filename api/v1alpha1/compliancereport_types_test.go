@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestComplianceReportEvaluateCluster(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *metav1.Time { t := metav1.NewTime(now.Add(d)); return &t }
+
+	catalog := &KubernetesVersionCatalog{Spec: KubernetesVersionCatalogSpec{Versions: []KubernetesVersionEntry{
+		{Version: "v1.31.4"},
+		{Version: "v1.29.0", EndOfLife: at(-24 * time.Hour)},
+	}}}
+	backups := []ClusterBackupPolicy{
+		{Spec: ClusterBackupPolicySpec{ClusterRef: LocalObjectReference{Name: "good"}},
+			Status: ClusterBackupPolicyStatus{LastSuccessfulBackupTime: at(-72 * time.Hour)}},
+		{Spec: ClusterBackupPolicySpec{ClusterRef: LocalObjectReference{Name: "good"}},
+			Status: ClusterBackupPolicyStatus{LastSuccessfulBackupTime: at(-6 * time.Hour)}},
+		{Spec: ClusterBackupPolicySpec{ClusterRef: LocalObjectReference{Name: "bad"}},
+			Status: ClusterBackupPolicyStatus{LastSuccessfulBackupTime: at(-72 * time.Hour)}},
+	}
+	in := ClusterComplianceInputs{Now: now, BackupPolicies: backups, Catalog: catalog, PolicyEngines: []PolicyEngine{"kyverno"}}
+
+	r := &ComplianceReport{}
+	good := &TenantCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "good"},
+		Spec: TenantClusterSpec{
+			KubernetesVersion:  "v1.31.4",
+			DeletionProtection: true,
+			ControlPlane:       ControlPlaneSpec{Encryption: &EncryptionConfig{Provider: EncryptionProviderAESCBC}},
+		},
+	}
+	res := r.EvaluateCluster(good, in)
+	if !res.Compliant || len(res.Checks) != len(AllComplianceChecks) {
+		t.Fatalf("good cluster: %+v", res)
+	}
+
+	bad := &TenantCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "bad"},
+		Spec: TenantClusterSpec{
+			KubernetesVersion: "v1.29.0",
+			ControlPlane:      ControlPlaneSpec{Encryption: &EncryptionConfig{Provider: EncryptionProviderAESCBC, Resources: []string{"configmaps"}}},
+		},
+	}
+	res = r.EvaluateCluster(bad, ClusterComplianceInputs{Now: now, BackupPolicies: backups, Catalog: catalog})
+	if res.Compliant {
+		t.Fatal("bad cluster should not be compliant")
+	}
+	for _, c := range res.Checks {
+		if c.Result != ComplianceResultFail || c.Message == "" {
+			t.Errorf("bad cluster %s = %s (%q), want Fail with message", c.Check, c.Result, c.Message)
+		}
+	}
+
+	virtual := good.DeepCopy()
+	virtual.Spec.TenancyMode = TenancyModeVirtual
+	virtual.Spec.ControlPlane.Encryption = nil
+	r.Spec.Checks = []ComplianceCheck{ComplianceCheckKubernetesVersionSupported, ComplianceCheckEncryptionAtRest}
+	res = r.EvaluateCluster(virtual, ClusterComplianceInputs{Now: now})
+	if res.Compliant || len(res.Checks) != 2 ||
+		res.Checks[0].Result != ComplianceResultUnknown ||
+		res.Checks[1].Result != ComplianceResultNotApplicable {
+		t.Errorf("virtual cluster without catalog: %+v", res)
+	}
+}
+
+func TestComplianceReportStatusSetResults(t *testing.T) {
+	checks := []ComplianceCheck{ComplianceCheckDeletionProtection, ComplianceCheckEncryptionAtRest}
+	results := []ClusterComplianceResult{
+		{ClusterRef: LocalObjectReference{Name: "b"}, Checks: []ComplianceCheckResult{
+			{Check: ComplianceCheckDeletionProtection, Result: ComplianceResultFail},
+			{Check: ComplianceCheckEncryptionAtRest, Result: ComplianceResultNotApplicable},
+		}},
+		{ClusterRef: LocalObjectReference{Name: "a"}, Compliant: true, Checks: []ComplianceCheckResult{
+			{Check: ComplianceCheckDeletionProtection, Result: ComplianceResultPass},
+			{Check: ComplianceCheckEncryptionAtRest, Result: ComplianceResultPass},
+		}},
+	}
+
+	var s ComplianceReportStatus
+	s.SetResults(results, checks)
+	if s.TotalClusters != 2 || s.CompliantClusters != 1 || s.Clusters[0].ClusterRef.Name != "a" {
+		t.Fatalf("SetResults() = %+v", s)
+	}
+	want := []ComplianceCheckSummary{
+		{Check: ComplianceCheckDeletionProtection, Passed: 1, Failed: 1},
+		{Check: ComplianceCheckEncryptionAtRest, Passed: 1, NotApplicable: 1},
+	}
+	for i := range want {
+		if s.Summary[i] != want[i] {
+			t.Errorf("Summary[%d] = %+v, want %+v", i, s.Summary[i], want[i])
+		}
+	}
+}
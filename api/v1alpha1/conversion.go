@@ -0,0 +1,32 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// v1alpha1 is the conversion hub and storage version for the kinds served
+// at more than one version. Spoke versions convert to and from these types.
+
+// Hub marks TenantCluster as a conversion hub.
+func (*TenantCluster) Hub() {}
+
+// Hub marks ClusterBootstrap as a conversion hub.
+func (*ClusterBootstrap) Hub() {}
+
+// Hub marks Team as a conversion hub.
+func (*Team) Hub() {}
+
+// Hub marks ProviderConfig as a conversion hub.
+func (*ProviderConfig) Hub() {}
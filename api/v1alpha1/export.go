@@ -0,0 +1,335 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Platform export and import.
+//
+// A ButlerExport is a portable snapshot of the platform configuration of a
+// management cluster: everything needed to recreate Teams, Users, providers,
+// and pools on a new management cluster for migration or disaster recovery.
+// Tenant clusters and their workloads are not included; they are recovered
+// from cluster backups. Secrets are never embedded. The export lists every
+// Secret referenced by an exported object so they can be restored from the
+// secret store before the objects are imported.
+
+const (
+	// ButlerExportKind is the kind of an export document.
+	ButlerExportKind = "ButlerExport"
+
+	// ButlerExportFormatVersion is the current export format version.
+	// Exports with a newer version are rejected.
+	ButlerExportFormatVersion = 1
+)
+
+// ButlerExportKinds lists the kinds included in an export, in import
+// order: objects are created after the objects they reference.
+var ButlerExportKinds = []string{
+	"ButlerConfig",
+	"IdentityProvider",
+	"KubernetesVersionCatalog",
+	"TalosRelease",
+	"AddonDefinition",
+	"ManagementAddon",
+	"ProviderConfig",
+	"NetworkPool",
+	"Team",
+	"NotificationChannel",
+	"User",
+	"WorkspaceClass",
+	"WorkspaceTemplate",
+	"ClusterTemplate",
+}
+
+// ButlerExport is a versioned bundle of platform configuration.
+// +kubebuilder:object:generate=false
+type ButlerExport struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// FormatVersion is the export format version.
+	FormatVersion int `json:"formatVersion"`
+
+	// ExportedAt is when the export was taken.
+	ExportedAt metav1.Time `json:"exportedAt"`
+
+	// Source identifies the management cluster the export was taken from.
+	Source string `json:"source,omitempty"`
+
+	// Objects are the exported objects in import order. Status and
+	// server-populated metadata are removed.
+	Objects []unstructured.Unstructured `json:"objects"`
+
+	// Secrets lists the Secrets referenced by the exported objects.
+	Secrets []ExportedSecretReference `json:"secrets,omitempty"`
+}
+
+// ExportedSecretReference is a Secret that must exist before import.
+// +kubebuilder:object:generate=false
+type ExportedSecretReference struct {
+	// Namespace of the Secret.
+	Namespace string `json:"namespace"`
+
+	// Name of the Secret.
+	Name string `json:"name"`
+
+	// Keys lists the referenced keys. Empty means the whole Secret.
+	Keys []string `json:"keys,omitempty"`
+
+	// ReferencedBy lists the referencing objects as "Kind/namespace/name".
+	ReferencedBy []string `json:"referencedBy"`
+}
+
+// NewButlerExport builds an export of objs. Every object must be of a kind
+// in ButlerExportKinds. Secret references without a namespace resolve to
+// the referencing object's namespace, or to butler-system for
+// cluster-scoped objects.
+func NewButlerExport(objs []runtime.Object, source string, now time.Time) (*ButlerExport, error) {
+	scheme := newValidationScheme()
+	e := &ButlerExport{
+		TypeMeta:      metav1.TypeMeta{APIVersion: GroupVersion.String(), Kind: ButlerExportKind},
+		FormatVersion: ButlerExportFormatVersion,
+		ExportedAt:    metav1.NewTime(now.UTC().Truncate(time.Second)),
+		Source:        source,
+	}
+	for _, obj := range objs {
+		gvks, _, err := scheme.ObjectKinds(obj)
+		if err != nil {
+			return nil, fmt.Errorf("exporting %T: %w", obj, err)
+		}
+		if !slices.Contains(ButlerExportKinds, gvks[0].Kind) {
+			return nil, fmt.Errorf("exporting %s: kind is not exported", gvks[0].Kind)
+		}
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return nil, fmt.Errorf("exporting %s: %w", gvks[0].Kind, err)
+		}
+		u := unstructured.Unstructured{Object: content}
+		u.SetGroupVersionKind(gvks[0])
+		stripForExport(&u)
+		e.Objects = append(e.Objects, u)
+	}
+	e.sortObjects()
+	secrets, err := collectSecretReferences(e.Objects)
+	if err != nil {
+		return nil, fmt.Errorf("exporting %w", err)
+	}
+	e.Secrets = secrets
+	return e, nil
+}
+
+// Marshal returns the export as indented JSON.
+func (e *ButlerExport) Marshal() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// ParseButlerExport decodes an export from JSON or YAML and checks its
+// version and contents. Objects are returned in import order.
+func ParseButlerExport(data []byte) (*ButlerExport, error) {
+	raw, err := utilyaml.ToJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("parsing export: %w", err)
+	}
+	e := &ButlerExport{}
+	if err := json.Unmarshal(raw, e); err != nil {
+		return nil, fmt.Errorf("parsing export: %w", err)
+	}
+	if e.Kind != ButlerExportKind || e.APIVersion != GroupVersion.String() {
+		return nil, fmt.Errorf("parsing export: got %s %s, want %s %s", e.APIVersion, e.Kind, GroupVersion, ButlerExportKind)
+	}
+	if e.FormatVersion < 1 || e.FormatVersion > ButlerExportFormatVersion {
+		return nil, fmt.Errorf("parsing export: unsupported format version %d", e.FormatVersion)
+	}
+	for i := range e.Objects {
+		gvk := e.Objects[i].GroupVersionKind()
+		if gvk.GroupVersion() != GroupVersion || !slices.Contains(ButlerExportKinds, gvk.Kind) {
+			return nil, fmt.Errorf("parsing export: objects[%d]: unexpected %s", i, gvk)
+		}
+	}
+	e.sortObjects()
+	return e, nil
+}
+
+// Validate runs ValidateAll over the exported objects.
+func (e *ButlerExport) Validate() []ValidationFinding {
+	objs := make([]*unstructured.Unstructured, len(e.Objects))
+	for i := range e.Objects {
+		objs[i] = &e.Objects[i]
+	}
+	return ValidateAll(objs)
+}
+
+// Decode returns the exported objects as typed objects in import order.
+func (e *ButlerExport) Decode() ([]runtime.Object, error) {
+	scheme := newValidationScheme()
+	out := make([]runtime.Object, 0, len(e.Objects))
+	for i := range e.Objects {
+		u := &e.Objects[i]
+		obj, err := scheme.New(u.GroupVersionKind())
+		if err != nil {
+			return nil, fmt.Errorf("decoding objects[%d]: %w", i, err)
+		}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, obj); err != nil {
+			return nil, fmt.Errorf("decoding %s %s: %w", u.GetKind(), u.GetName(), err)
+		}
+		obj.GetObjectKind().SetGroupVersionKind(u.GroupVersionKind())
+		out = append(out, obj)
+	}
+	return out, nil
+}
+
+// sortObjects orders objects by ButlerExportKinds, then namespace and name.
+func (e *ButlerExport) sortObjects() {
+	sort.SliceStable(e.Objects, func(i, j int) bool {
+		a, b := &e.Objects[i], &e.Objects[j]
+		ka, kb := slices.Index(ButlerExportKinds, a.GetKind()), slices.Index(ButlerExportKinds, b.GetKind())
+		if ka != kb {
+			return ka < kb
+		}
+		if a.GetNamespace() != b.GetNamespace() {
+			return a.GetNamespace() < b.GetNamespace()
+		}
+		return a.GetName() < b.GetName()
+	})
+}
+
+// stripForExport removes status and metadata that the API server owns.
+func stripForExport(u *unstructured.Unstructured) {
+	delete(u.Object, "status")
+	for _, f := range []string{"uid", "resourceVersion", "generation", "creationTimestamp",
+		"deletionTimestamp", "deletionGracePeriodSeconds", "managedFields", "ownerReferences", "finalizers"} {
+		unstructured.RemoveNestedField(u.Object, "metadata", f)
+	}
+	unstructured.RemoveNestedField(u.Object, "metadata", "annotations", "kubectl.kubernetes.io/last-applied-configuration")
+	if len(u.GetAnnotations()) == 0 {
+		unstructured.RemoveNestedField(u.Object, "metadata", "annotations")
+	}
+}
+
+// collectSecretReferences finds Secret references in the spec of each
+// object. A reference is an object with a "name" under a key named
+// "secretRef", "credentialsRef", or ending in "SecretRef". References
+// that do not follow this convention are listed by typedSecretReferences.
+func collectSecretReferences(objs []unstructured.Unstructured) ([]ExportedSecretReference, error) {
+	refs := map[string]*ExportedSecretReference{}
+	for i := range objs {
+		u := &objs[i]
+		owner := u.GetKind() + "/" + u.GetNamespace() + "/" + u.GetName()
+		defaultNamespace := u.GetNamespace()
+		if defaultNamespace == "" {
+			defaultNamespace = "butler-system"
+		}
+		add := func(namespace, name string, keys ...string) {
+			if namespace == "" {
+				namespace = defaultNamespace
+			}
+			key := namespace + "/" + name
+			r, ok := refs[key]
+			if !ok {
+				r = &ExportedSecretReference{Namespace: namespace, Name: name}
+				refs[key] = r
+			}
+			for _, k := range keys {
+				if k != "" && !slices.Contains(r.Keys, k) {
+					r.Keys = append(r.Keys, k)
+				}
+			}
+			if !slices.Contains(r.ReferencedBy, owner) {
+				r.ReferencedBy = append(r.ReferencedBy, owner)
+			}
+		}
+
+		spec, _, _ := unstructured.NestedMap(u.Object, "spec")
+		walkSecretReferences(spec, func(ref map[string]interface{}) {
+			name, _ := ref["name"].(string)
+			namespace, _ := ref["namespace"].(string)
+			key, _ := ref["key"].(string)
+			add(namespace, name, key)
+		})
+		if err := typedSecretReferences(u, add); err != nil {
+			return nil, fmt.Errorf("%s: %w", owner, err)
+		}
+	}
+	out := make([]ExportedSecretReference, 0, len(refs))
+	for _, key := range slices.Sorted(maps.Keys(refs)) {
+		r := refs[key]
+		sort.Strings(r.Keys)
+		out = append(out, *r)
+	}
+	return out, nil
+}
+
+// typedSecretReferences calls add for each Secret reference of u that the
+// generic walk does not recognize. An empty namespace means the namespace
+// of u.
+func typedSecretReferences(u *unstructured.Unstructured, add func(namespace, name string, keys ...string)) error {
+	switch u.GetKind() {
+	case "WorkspaceTemplate":
+		// Template secrets are read from the namespace of the team creating
+		// the workspace; for team templates that is the template namespace.
+		var wt WorkspaceTemplate
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &wt); err != nil {
+			return err
+		}
+		for _, s := range wt.Spec.Template.Secrets {
+			var keys []string
+			if s.MountPath == "" {
+				for _, e := range s.Env {
+					keys = append(keys, e.Key)
+				}
+			}
+			add("", s.Name, keys...)
+		}
+	}
+	return nil
+}
+
+func walkSecretReferences(v interface{}, visit func(map[string]interface{})) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, val := range t {
+			if ref, ok := val.(map[string]interface{}); ok && isSecretReferenceKey(k) {
+				if name, _ := ref["name"].(string); name != "" {
+					visit(ref)
+					continue
+				}
+			}
+			walkSecretReferences(val, visit)
+		}
+	case []interface{}:
+		for _, val := range t {
+			walkSecretReferences(val, visit)
+		}
+	}
+}
+
+func isSecretReferenceKey(k string) bool {
+	return k == "secretRef" || k == "credentialsRef" || strings.HasSuffix(k, "SecretRef")
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestButlerExportRoundTrip(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	objs := []runtime.Object{
+		&Team{
+			ObjectMeta: metav1.ObjectMeta{Name: "platform", UID: "uid-1", ResourceVersion: "42"},
+			Spec:       TeamSpec{DisplayName: "Platform"},
+			Status:     TeamStatus{Namespace: "team-platform"},
+		},
+		&ProviderConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "harvester", Namespace: "butler-system"},
+			Spec: ProviderConfigSpec{
+				Provider:       ProviderTypeHarvester,
+				CredentialsRef: SecretReference{Name: "harvester-creds", Key: "kubeconfig"},
+			},
+		},
+		&ProviderConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "harvester-b", Namespace: "butler-system"},
+			Spec: ProviderConfigSpec{
+				Provider:       ProviderTypeHarvester,
+				CredentialsRef: SecretReference{Name: "harvester-creds", Key: "kubeconfig"},
+			},
+		},
+		&ButlerConfig{ObjectMeta: metav1.ObjectMeta{Name: ButlerConfigName}},
+	}
+	e, err := NewButlerExport(objs, "mgmt-a", now)
+	if err != nil {
+		t.Fatalf("NewButlerExport() error = %v", err)
+	}
+
+	var order []string
+	for _, u := range e.Objects {
+		order = append(order, u.GetKind()+"/"+u.GetName())
+	}
+	if got, want := strings.Join(order, ","), "ButlerConfig/butler,ProviderConfig/harvester,ProviderConfig/harvester-b,Team/platform"; got != want {
+		t.Errorf("object order = %s, want %s", got, want)
+	}
+	team := e.Objects[3]
+	if team.GetUID() != "" || team.GetResourceVersion() != "" {
+		t.Errorf("server metadata not stripped: uid=%q resourceVersion=%q", team.GetUID(), team.GetResourceVersion())
+	}
+	if _, ok := team.Object["status"]; ok {
+		t.Errorf("status not stripped")
+	}
+
+	if len(e.Secrets) != 1 {
+		t.Fatalf("Secrets = %+v, want 1 reference", e.Secrets)
+	}
+	s := e.Secrets[0]
+	if s.Namespace != "butler-system" || s.Name != "harvester-creds" || len(s.Keys) != 1 || len(s.ReferencedBy) != 2 {
+		t.Errorf("Secrets[0] = %+v", s)
+	}
+
+	data, err := e.Marshal()
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	parsed, err := ParseButlerExport(data)
+	if err != nil {
+		t.Fatalf("ParseButlerExport() error = %v", err)
+	}
+	if !parsed.ExportedAt.Equal(&e.ExportedAt) || parsed.Source != "mgmt-a" || len(parsed.Objects) != 4 {
+		t.Errorf("parsed export = %+v", parsed)
+	}
+	if findings := parsed.Validate(); len(findings) != 0 {
+		t.Errorf("Validate() = %v, want no findings", findings)
+	}
+	decoded, err := parsed.Decode()
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	pc, ok := decoded[1].(*ProviderConfig)
+	if !ok || pc.Spec.CredentialsRef.Name != "harvester-creds" {
+		t.Errorf("Decode()[1] = %#v, want ProviderConfig harvester", decoded[1])
+	}
+}
+
+func TestButlerExportWorkspaceTemplateSecrets(t *testing.T) {
+	wt := &WorkspaceTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "go-dev", Namespace: "team-a"},
+		Spec: WorkspaceTemplateSpec{
+			DisplayName: "Go",
+			Template: WorkspaceTemplateBody{
+				Secrets: []WorkspaceSecret{
+					{Name: "git-creds", Env: []WorkspaceSecretEnv{{Key: "token", Name: "GIT_TOKEN"}, {Key: "user"}}},
+					{Name: "ssh-keys", Env: []WorkspaceSecretEnv{{Key: "id_ed25519"}}, MountPath: "/home/dev/.ssh"},
+				},
+			},
+		},
+	}
+	e, err := NewButlerExport([]runtime.Object{wt}, "mgmt-a", time.Now())
+	if err != nil {
+		t.Fatalf("NewButlerExport() error = %v", err)
+	}
+
+	want := []ExportedSecretReference{
+		{Namespace: "team-a", Name: "git-creds", Keys: []string{"token", "user"}, ReferencedBy: []string{"WorkspaceTemplate/team-a/go-dev"}},
+		{Namespace: "team-a", Name: "ssh-keys", ReferencedBy: []string{"WorkspaceTemplate/team-a/go-dev"}},
+	}
+	if !reflect.DeepEqual(e.Secrets, want) {
+		t.Errorf("Secrets = %+v, want %+v", e.Secrets, want)
+	}
+}
+
+func TestButlerExportRejects(t *testing.T) {
+	_, err := NewButlerExport([]runtime.Object{&TenantCluster{ObjectMeta: metav1.ObjectMeta{Name: "c", Namespace: "team-a"}}}, "", time.Now())
+	if err == nil || !strings.Contains(err.Error(), "kind is not exported") {
+		t.Errorf("NewButlerExport(TenantCluster) error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{"wrong kind", "apiVersion: butler.butlerlabs.dev/v1alpha1\nkind: Team\n", "want butler.butlerlabs.dev/v1alpha1 ButlerExport"},
+		{"newer version", "apiVersion: butler.butlerlabs.dev/v1alpha1\nkind: ButlerExport\nformatVersion: 2\n", "unsupported format version 2"},
+		{
+			"unexported kind",
+			"apiVersion: butler.butlerlabs.dev/v1alpha1\nkind: ButlerExport\nformatVersion: 1\nobjects:\n- apiVersion: butler.butlerlabs.dev/v1alpha1\n  kind: TenantCluster\n  metadata:\n    name: c\n",
+			"objects[0]: unexpected",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseButlerExport([]byte(tt.data))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ParseButlerExport() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -61,6 +61,12 @@ type GitProviderConfig struct {
 	// - Bitbucket: "username" and "app-password"
 	// +kubebuilder:validation:Required
 	SecretRef LocalObjectReference `json:"secretRef"`
+
+	// WebhookSecretRef references the Secret holding the shared secret used
+	// to verify pull request webhooks from the provider. Webhooks drive the
+	// lifecycle of PreviewEnvironments and are rejected when this is unset.
+	// +optional
+	WebhookSecretRef *SecretReference `json:"webhookSecretRef,omitempty"`
 }
 
 // GitProviderStatus shows the status of the Git provider configuration.
@@ -234,7 +234,7 @@ const (
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:scope=Cluster,shortName=idp
+// +kubebuilder:resource:scope=Cluster,shortName=idp,categories=butler
 // +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type",description="Provider type"
 // +kubebuilder:printcolumn:name="Issuer",type="string",JSONPath=".spec.oidc.issuerURL",description="OIDC issuer URL"
 // +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Current phase"
@@ -120,7 +120,8 @@ type ImageSyncStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 
 	// ProviderImageRef is the provider-specific image reference once synced.
-	// Harvester: "namespace/name", Nutanix: UUID, Proxmox: template ID.
+	// Harvester: "namespace/name", Nutanix: UUID, Proxmox: template ID,
+	// vSphere: template inventory path, OpenStack: Glance image ID.
 	// +optional
 	ProviderImageRef string `json:"providerImageRef,omitempty"`
 
@@ -156,7 +157,7 @@ type ImageSyncStatus struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:shortName=is
+// +kubebuilder:resource:shortName=is,categories=butler
 // +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Sync phase"
 // +kubebuilder:printcolumn:name="Schematic",type="string",JSONPath=".spec.factoryRef.schematicID",description="Schematic ID",priority=1
 // +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".spec.factoryRef.version",description="OS version"
@@ -213,3 +214,19 @@ func (is *ImageSync) SetFailure(reason, message string) {
 	is.Status.FailureMessage = message
 	is.SetPhase(ImageSyncPhaseFailed)
 }
+
+// Helper methods
+
+// matches returns true if both references identify the same artifact,
+// treating empty arch and platform as their defaults.
+func (r ImageFactoryRef) matches(o ImageFactoryRef) bool {
+	orDefault := func(v, d string) string {
+		if v == "" {
+			return d
+		}
+		return v
+	}
+	return r.SchematicID == o.SchematicID && r.Version == o.Version &&
+		orDefault(r.Arch, "amd64") == orDefault(o.Arch, "amd64") &&
+		orDefault(r.Platform, "nocloud") == orDefault(o.Platform, "nocloud")
+}
@@ -21,7 +21,7 @@ import (
 )
 
 // IPAllocationType defines the purpose of an IP allocation.
-// +kubebuilder:validation:Enum=nodes;loadbalancer
+// +kubebuilder:validation:Enum=nodes;loadbalancer;controlplane
 type IPAllocationType string
 
 const (
@@ -30,6 +30,10 @@ const (
 
 	// IPAllocationTypeLoadBalancer is for load balancer IPs.
 	IPAllocationTypeLoadBalancer IPAllocationType = "loadbalancer"
+
+	// IPAllocationTypeControlPlane is for a single control plane VIP.
+	// The count is always 1.
+	IPAllocationTypeControlPlane IPAllocationType = "controlplane"
 )
 
 // IPAllocationPhase represents the current phase of an IPAllocation.
@@ -50,15 +54,35 @@ const (
 	IPAllocationPhaseFailed IPAllocationPhase = "Failed"
 )
 
+// IPAllocation condition types.
+const (
+	// IPAllocationConditionVerified indicates the assigned addresses were
+	// verified as free with the pool's verifyBeforeAllocate method.
+	IPAllocationConditionVerified = "Verified"
+
+	// IPAllocationConditionAddressConflict indicates a candidate or
+	// assigned address was found in use.
+	IPAllocationConditionAddressConflict = "AddressConflict"
+)
+
 // IPAllocationSpec defines the desired state of IPAllocation.
+// +kubebuilder:validation:XValidation:rule="has(self.tenantClusterRef) != has(self.clusterBootstrapRef)",message="exactly one of tenantClusterRef or clusterBootstrapRef is required"
+// +kubebuilder:validation:XValidation:rule="self.type != 'controlplane' || !has(self.count) || self.count == 1",message="count must be 1 for controlplane allocations"
+// +kubebuilder:validation:XValidation:rule="self.type != 'controlplane' || !has(self.pinnedRange) || self.pinnedRange.startAddress == self.pinnedRange.endAddress",message="pinnedRange must be a single address for controlplane allocations"
+// +kubebuilder:validation:XValidation:rule="!has(self.clusterBootstrapRef) || self.type == 'controlplane'",message="clusterBootstrapRef is only supported for controlplane allocations"
 type IPAllocationSpec struct {
 	// PoolRef references the NetworkPool to allocate from.
 	// +kubebuilder:validation:Required
 	PoolRef LocalObjectReference `json:"poolRef"`
 
 	// TenantClusterRef references the TenantCluster this allocation is for.
-	// +kubebuilder:validation:Required
-	TenantClusterRef NamespacedObjectReference `json:"tenantClusterRef"`
+	// +optional
+	TenantClusterRef *NamespacedObjectReference `json:"tenantClusterRef,omitempty"`
+
+	// ClusterBootstrapRef references the ClusterBootstrap this allocation is
+	// for. Only controlplane allocations may reference a ClusterBootstrap.
+	// +optional
+	ClusterBootstrapRef *LocalObjectReference `json:"clusterBootstrapRef,omitempty"`
 
 	// Type specifies the purpose of the allocation.
 	// +kubebuilder:validation:Required
@@ -66,6 +90,7 @@ type IPAllocationSpec struct {
 
 	// Count is the number of IPs to allocate.
 	// If not specified, defaults from the NetworkPool are used.
+	// Always 1 for controlplane allocations.
 	// Ignored when PinnedRange is set.
 	// +optional
 	// +kubebuilder:validation:Minimum=1
@@ -73,21 +98,26 @@ type IPAllocationSpec struct {
 
 	// PinnedRange requests a specific IP range instead of automatic allocation.
 	// Used for migrating existing clusters to IPAM or reserving well-known addresses.
-	// The allocator validates the range is within the pool and not already allocated.
+	// The allocator validates the range is within the pool and not already
+	// allocated with NetworkPool.CheckPinnedRange.
 	// +optional
 	PinnedRange *PinnedIPRange `json:"pinnedRange,omitempty"`
 }
 
-// PinnedIPRange specifies an exact IP range to allocate.
+// PinnedIPRange specifies an exact IP range to allocate. The admission
+// webhook rejects ranges whose start is after their end.
+// +kubebuilder:validation:XValidation:rule="!isIP(self.startAddress) || !isIP(self.endAddress) || ip(self.startAddress).family() == ip(self.endAddress).family()",message="startAddress and endAddress must be the same IP family"
 type PinnedIPRange struct {
 	// StartAddress is the first IP of the pinned range.
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:Pattern=`^(\d{1,3}\.){3}\d{1,3}$`
+	// +kubebuilder:validation:MaxLength=39
+	// +kubebuilder:validation:XValidation:rule="isIP(self)",message="must be a valid IP address"
 	StartAddress string `json:"startAddress"`
 
 	// EndAddress is the last IP of the pinned range.
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:Pattern=`^(\d{1,3}\.){3}\d{1,3}$`
+	// +kubebuilder:validation:MaxLength=39
+	// +kubebuilder:validation:XValidation:rule="isIP(self)",message="must be a valid IP address"
 	EndAddress string `json:"endAddress"`
 }
 
@@ -135,14 +165,26 @@ type IPAllocationStatus struct {
 	// +optional
 	AllocatedBy string `json:"allocatedBy,omitempty"`
 
+	// Verification records per-address verification results from the most
+	// recent allocation attempt, including candidates that were skipped
+	// because they were in use. Empty when the pool does not verify.
+	// +optional
+	// +listType=map
+	// +listMapKey=address
+	Verification []IPVerificationResult `json:"verification,omitempty"`
+
 	// ReleasedAt is the timestamp when IPs were released.
 	// +optional
 	ReleasedAt *metav1.Time `json:"releasedAt,omitempty"`
+
+	// Reconcile reports controller reconcile diagnostics.
+	// +optional
+	Reconcile *ReconcileStatus `json:"reconcile,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:shortName=ipa
+// +kubebuilder:resource:shortName=ipa,categories=butler
 // +kubebuilder:printcolumn:name="Pool",type="string",JSONPath=".spec.poolRef.name",description="Network pool"
 // +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".spec.tenantClusterRef.name",description="Tenant cluster"
 // +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type",description="Allocation type"
@@ -172,3 +214,28 @@ type IPAllocationList struct {
 func init() {
 	SchemeBuilder.Register(&IPAllocation{}, &IPAllocationList{})
 }
+
+// Helper methods
+
+// GetCount returns the number of IPs to allocate. poolDefault is the
+// NetworkPool's per-tenant default for the allocation type.
+func (s *IPAllocationSpec) GetCount(poolDefault int32) int32 {
+	if s.Type == IPAllocationTypeControlPlane {
+		return 1
+	}
+	if s.Count != nil {
+		return *s.Count
+	}
+	return poolDefault
+}
+
+// ConflictingAddresses returns the addresses found in use during verification.
+func (s *IPAllocationStatus) ConflictingAddresses() []string {
+	var addrs []string
+	for _, v := range s.Verification {
+		if v.Result == IPVerificationResultInUse {
+			addrs = append(addrs, v.Address)
+		}
+	}
+	return addrs
+}
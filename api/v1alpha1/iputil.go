@@ -0,0 +1,462 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"net/netip"
+	"sort"
+)
+
+// IP allocation utilities shared by the NetworkPool controller and the
+// IPAllocation webhook. Addresses are handled as uint32 offsets so block
+// arithmetic stays simple; all blocks are inclusive.
+//
+// IPv4 blocks hold addresses directly. IPv6 blocks hold offsets within the
+// /96 named by Base, so an IPv6 block never spans more than 2^32 addresses.
+// IPv6 prefixes shorter than /96 are handled as their first /96, which is
+// ample for node and LoadBalancer addresses.
+
+// IPFamily is an IP address family.
+// +kubebuilder:validation:Enum=IPv4;IPv6
+type IPFamily string
+
+const (
+	// IPFamilyIPv4 is IPv4.
+	IPFamilyIPv4 IPFamily = "IPv4"
+
+	// IPFamilyIPv6 is IPv6.
+	IPFamilyIPv6 IPFamily = "IPv6"
+)
+
+// IPFamilyOf returns the family of an IP address or CIDR, or "" if s is
+// neither. IPv4-mapped IPv6 addresses are IPv4.
+func IPFamilyOf(s string) IPFamily {
+	a, err := parseAddr(s)
+	if err != nil {
+		p, perr := netip.ParsePrefix(s)
+		if perr != nil {
+			return ""
+		}
+		a = p.Addr().Unmap()
+	}
+	if a.Is4() {
+		return IPFamilyIPv4
+	}
+	return IPFamilyIPv6
+}
+
+// parseAddr parses an IP address without a zone, unmapping IPv4-mapped
+// IPv6 addresses.
+func parseAddr(s string) (netip.Addr, error) {
+	a, err := netip.ParseAddr(s)
+	if err != nil || a.Zone() != "" {
+		return netip.Addr{}, fmt.Errorf("invalid IP address %q", s)
+	}
+	return a.Unmap(), nil
+}
+
+// compareAddrs compares two IP addresses of the same family as strings.
+// Returns an error if either is invalid or the families differ.
+func compareAddrs(a, b string) (int, error) {
+	x, err := parseAddr(a)
+	if err != nil {
+		return 0, err
+	}
+	y, err := parseAddr(b)
+	if err != nil {
+		return 0, err
+	}
+	if x.Is4() != y.Is4() {
+		return 0, fmt.Errorf("%s and %s are different IP families", a, b)
+	}
+	return x.Compare(y), nil
+}
+
+// lastAddr returns the last address of a prefix.
+func lastAddr(p netip.Prefix) netip.Addr {
+	p = p.Masked()
+	b := p.Addr().AsSlice()
+	for i := p.Bits(); i < len(b)*8; i++ {
+		b[i/8] |= 0x80 >> (i % 8)
+	}
+	a, _ := netip.AddrFromSlice(b)
+	return a
+}
+
+// nthAddr returns the address n after the start of a prefix, or an
+// invalid address if it falls outside the prefix.
+func nthAddr(p netip.Prefix, n uint64) netip.Addr {
+	p = p.Masked()
+	b := p.Addr().AsSlice()
+	carry := n
+	for i := len(b) - 1; i >= 0 && carry > 0; i-- {
+		sum := uint64(b[i]) + carry&0xff
+		b[i] = byte(sum)
+		carry = carry>>8 + sum>>8
+	}
+	a, _ := netip.AddrFromSlice(b)
+	if carry > 0 || !p.Contains(a) {
+		return netip.Addr{}
+	}
+	return a
+}
+
+// IPBlock is an inclusive range of IPv4 addresses, or of IPv6 addresses
+// within the /96 named by Base.
+// +kubebuilder:object:generate=false
+type IPBlock struct {
+	// Base is the /96 holding an IPv6 block. Invalid for IPv4 blocks.
+	Base  netip.Addr
+	Start uint32
+	End   uint32
+}
+
+// splitAddr returns the block base and offset of an address.
+func splitAddr(a netip.Addr) (netip.Addr, uint32) {
+	if a.Is4() {
+		b := a.As4()
+		return netip.Addr{}, binary.BigEndian.Uint32(b[:])
+	}
+	b := a.As16()
+	n := binary.BigEndian.Uint32(b[12:])
+	clear(b[12:])
+	return netip.AddrFrom16(b), n
+}
+
+// ParseIPBlock parses an inclusive range from start and end addresses.
+func ParseIPBlock(start, end string) (IPBlock, error) {
+	s, err := parseAddr(start)
+	if err != nil {
+		return IPBlock{}, err
+	}
+	e, err := parseAddr(end)
+	if err != nil {
+		return IPBlock{}, err
+	}
+	if s.Is4() != e.Is4() {
+		return IPBlock{}, fmt.Errorf("start %s and end %s are different IP families", start, end)
+	}
+	sb, sn := splitAddr(s)
+	eb, en := splitAddr(e)
+	if sb != eb {
+		return IPBlock{}, fmt.Errorf("range %s-%s spans more than one /96", start, end)
+	}
+	b := IPBlock{Base: sb, Start: sn, End: en}
+	if b.Start > b.End {
+		return IPBlock{}, fmt.Errorf("start %s is after end %s", start, end)
+	}
+	return b, nil
+}
+
+// ParseCIDRBlock parses a CIDR into the block of every address in it.
+// IPv6 prefixes shorter than /96 yield their first /96.
+func ParseCIDRBlock(cidr string) (IPBlock, error) {
+	p, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return IPBlock{}, fmt.Errorf("invalid CIDR %q: %w", cidr, err)
+	}
+	p = p.Masked()
+	base, start := splitAddr(p.Addr().Unmap())
+	hostBits := p.Addr().BitLen() - p.Bits()
+	if p.Addr().Is4In6() {
+		hostBits = 128 - p.Bits()
+	}
+	if hostBits >= 32 {
+		return IPBlock{Base: base, Start: start, End: start | (1<<32 - 1)}, nil
+	}
+	return IPBlock{Base: base, Start: start, End: start | (1<<uint(hostBits) - 1)}, nil
+}
+
+// Family returns the address family of the block.
+func (b IPBlock) Family() IPFamily {
+	if b.Base.IsValid() {
+		return IPFamilyIPv6
+	}
+	return IPFamilyIPv4
+}
+
+// addr returns the address at offset n in the block's family.
+func (b IPBlock) addr(n uint32) netip.Addr {
+	if !b.Base.IsValid() {
+		var v [4]byte
+		binary.BigEndian.PutUint32(v[:], n)
+		return netip.AddrFrom4(v)
+	}
+	v := b.Base.As16()
+	binary.BigEndian.PutUint32(v[12:], n)
+	return netip.AddrFrom16(v)
+}
+
+// Size returns the number of addresses in the block.
+func (b IPBlock) Size() uint64 {
+	return uint64(b.End) - uint64(b.Start) + 1
+}
+
+// StartIP returns the first address of the block.
+func (b IPBlock) StartIP() string {
+	return b.addr(b.Start).String()
+}
+
+// EndIP returns the last address of the block.
+func (b IPBlock) EndIP() string {
+	return b.addr(b.End).String()
+}
+
+// Overlaps returns true if the blocks share any address.
+func (b IPBlock) Overlaps(o IPBlock) bool {
+	return b.Base == o.Base && b.Start <= o.End && o.Start <= b.End
+}
+
+// String returns the block as "start-end".
+func (b IPBlock) String() string {
+	return b.StartIP() + "-" + b.EndIP()
+}
+
+// AllocatableBlock returns the range the pool allocates from: the
+// tenantAllocation range if set, otherwise the CIDR without its network and
+// broadcast addresses. IPv6 pools skip the subnet-router anycast address
+// and allocate from the first /96 of the CIDR.
+func (p *NetworkPool) AllocatableBlock() (IPBlock, error) {
+	if ta := p.Spec.TenantAllocation; ta != nil {
+		return ParseIPBlock(ta.Start, ta.End)
+	}
+	b, err := ParseCIDRBlock(p.Spec.CIDR)
+	if err != nil {
+		return IPBlock{}, err
+	}
+	switch {
+	case b.Family() == IPFamilyIPv6 && b.Size() > 1:
+		b.Start++
+	case b.Size() > 2:
+		b.Start++
+		b.End--
+	}
+	return b, nil
+}
+
+// FreeBlocks returns the free blocks of the pool in address order, given
+// the blocks already allocated. Reserved ranges, static assignments, and
+// addresses recorded in status.conflicts are excluded.
+func (p *NetworkPool) FreeBlocks(allocated []IPBlock) ([]IPBlock, error) {
+	pool, err := p.AllocatableBlock()
+	if err != nil {
+		return nil, err
+	}
+	used := make([]IPBlock, 0, len(p.Spec.Reserved)+len(allocated))
+	for _, r := range p.Spec.Reserved {
+		b, err := ParseCIDRBlock(r.CIDR)
+		if err != nil {
+			return nil, fmt.Errorf("reserved range: %w", err)
+		}
+		used = append(used, b)
+	}
+	for _, a := range p.Spec.StaticAssignments {
+		b, err := ParseIPBlock(a.Range.StartAddress, a.Range.EndAddress)
+		if err != nil {
+			return nil, fmt.Errorf("static assignment %s: %w", a.Name, err)
+		}
+		used = append(used, b)
+	}
+	for _, c := range p.Status.Conflicts {
+		if a, err := parseAddr(c.Address); err == nil {
+			base, n := splitAddr(a)
+			used = append(used, IPBlock{Base: base, Start: n, End: n})
+		}
+	}
+	used = append(used, allocated...)
+	return subtractBlocks(pool, used), nil
+}
+
+// CheckPinnedRange returns nil if the pinned range of alloc lies in a free
+// block of the pool, given the blocks already allocated to other
+// IPAllocations. A static assignment with the same range, tenantClusterRef,
+// and type as alloc is not counted as used, so the IPAllocation returned by
+// MigrateStaticAssignment can be allocated while the assignment is still
+// listed.
+func (p *NetworkPool) CheckPinnedRange(alloc *IPAllocation, allocated []IPBlock) error {
+	r := alloc.Spec.PinnedRange
+	if r == nil {
+		return fmt.Errorf("IPAllocation %s has no pinned range", alloc.Name)
+	}
+	want, err := ParseIPBlock(r.StartAddress, r.EndAddress)
+	if err != nil {
+		return err
+	}
+	q := *p
+	q.Spec.StaticAssignments = nil
+	for _, a := range p.Spec.StaticAssignments {
+		if !a.migratesTo(alloc, want) {
+			q.Spec.StaticAssignments = append(q.Spec.StaticAssignments, a)
+		}
+	}
+	free, err := q.FreeBlocks(allocated)
+	if err != nil {
+		return err
+	}
+	for _, f := range free {
+		if f.Base == want.Base && f.Start <= want.Start && want.End <= f.End {
+			return nil
+		}
+	}
+	return fmt.Errorf("pinned range %s is not free in network pool %s", want, p.Name)
+}
+
+// migratesTo returns true if alloc, pinned to block, is the migration of
+// the assignment.
+func (a *StaticIPAssignment) migratesTo(alloc *IPAllocation, block IPBlock) bool {
+	if a.TenantClusterRef == nil || alloc.Spec.TenantClusterRef == nil ||
+		*a.TenantClusterRef != *alloc.Spec.TenantClusterRef || a.Type != alloc.Spec.Type {
+		return false
+	}
+	b, err := ParseIPBlock(a.Range.StartAddress, a.Range.EndAddress)
+	return err == nil && b == block
+}
+
+// subtractBlocks returns the parts of pool not covered by used. Used blocks
+// in another family or /96 are ignored.
+func subtractBlocks(pool IPBlock, used []IPBlock) []IPBlock {
+	sort.Slice(used, func(i, j int) bool { return used[i].Start < used[j].Start })
+	var free []IPBlock
+	next := uint64(pool.Start)
+	for _, u := range used {
+		if u.Base != pool.Base || uint64(u.End) < next || u.Start > pool.End {
+			continue
+		}
+		if uint64(u.Start) > next {
+			free = append(free, IPBlock{Base: pool.Base, Start: uint32(next), End: u.Start - 1})
+		}
+		next = uint64(u.End) + 1
+	}
+	if next <= uint64(pool.End) {
+		free = append(free, IPBlock{Base: pool.Base, Start: uint32(next), End: pool.End})
+	}
+	return free
+}
+
+// Candidates returns blocks of count contiguous addresses from free, in the
+// order the strategy prefers them. The controller verifies candidates in
+// order and uses the first that passes. FirstFit and BestFit offer one
+// candidate per fitting free block; Random offers up to its configured
+// number of attempts. Returns nil if no free block fits.
+func (s *AllocationStrategy) Candidates(free []IPBlock, count int32, rnd *rand.Rand) []IPBlock {
+	if count < 1 {
+		return nil
+	}
+	size := uint64(count)
+	align := uint64(s.GetAlignment())
+
+	// Each fitting block contributes the aligned start positions that keep
+	// the allocation inside the block.
+	type window struct {
+		block       IPBlock
+		first, last uint64
+	}
+	var windows []window
+	for _, b := range free {
+		if b.Size() < size {
+			continue
+		}
+		first := (uint64(b.Start) + align - 1) / align * align
+		last := (uint64(b.End) + 1 - size) / align * align
+		if first > last {
+			continue
+		}
+		windows = append(windows, window{block: b, first: first, last: last})
+	}
+	if len(windows) == 0 {
+		return nil
+	}
+	at := func(w window, start uint64) IPBlock {
+		return IPBlock{Base: w.block.Base, Start: uint32(start), End: uint32(start + size - 1)}
+	}
+
+	var out []IPBlock
+	switch s.GetType() {
+	case AllocationStrategyBestFit:
+		var minRemainder uint64
+		if s.BestFit != nil {
+			minRemainder = uint64(s.BestFit.MinRemainder)
+		}
+		sliver := func(w window) bool {
+			r := w.block.Size() - size
+			return r > 0 && r < minRemainder
+		}
+		sort.SliceStable(windows, func(i, j int) bool {
+			if si, sj := sliver(windows[i]), sliver(windows[j]); si != sj {
+				return sj
+			}
+			return windows[i].block.Size() < windows[j].block.Size()
+		})
+		for _, w := range windows {
+			out = append(out, at(w, w.first))
+		}
+	case AllocationStrategyRandom:
+		var total uint64
+		for _, w := range windows {
+			total += (w.last-w.first)/align + 1
+		}
+		attempts := s.GetRandomAttempts()
+		if uint64(attempts) > total {
+			attempts = int(total)
+		}
+		seen := make(map[uint64]bool, attempts)
+		for len(out) < attempts {
+			var n uint64
+			if rnd != nil {
+				n = uint64(rnd.Int63n(int64(total)))
+			} else {
+				n = uint64(rand.Int63n(int64(total)))
+			}
+			if seen[n] {
+				continue
+			}
+			seen[n] = true
+			for _, w := range windows {
+				positions := (w.last-w.first)/align + 1
+				if n < positions {
+					out = append(out, at(w, w.first+n*align))
+					break
+				}
+				n -= positions
+			}
+		}
+	default:
+		if s != nil && s.FirstFit != nil && s.FirstFit.Reverse {
+			for i := len(windows) - 1; i >= 0; i-- {
+				out = append(out, at(windows[i], windows[i].last))
+			}
+		} else {
+			for _, w := range windows {
+				out = append(out, at(w, w.first))
+			}
+		}
+	}
+	return out
+}
+
+// Allocate returns the strategy's preferred block of count contiguous
+// addresses from free.
+func (s *AllocationStrategy) Allocate(free []IPBlock, count int32, rnd *rand.Rand) (IPBlock, error) {
+	c := s.Candidates(free, count, rnd)
+	if len(c) == 0 {
+		return IPBlock{}, fmt.Errorf("no free block of %d addresses", count)
+	}
+	return c[0], nil
+}
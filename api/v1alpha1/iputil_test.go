@@ -0,0 +1,306 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"math/rand"
+	"net/netip"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func mustBlock(t *testing.T, start, end string) IPBlock {
+	t.Helper()
+	b, err := ParseIPBlock(start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestNetworkPoolFreeBlocks(t *testing.T) {
+	pool := &NetworkPool{Spec: NetworkPoolSpec{
+		CIDR:     "10.40.0.0/24",
+		Reserved: []ReservedRange{{CIDR: "10.40.0.0/28"}},
+		StaticAssignments: []StaticIPAssignment{
+			{Name: "legacy", Range: PinnedIPRange{StartAddress: "10.40.0.105", EndAddress: "10.40.0.119"}, Owner: "legacy-prod"},
+		},
+	}}
+	allocated := []IPBlock{
+		mustBlock(t, "10.40.0.100", "10.40.0.109"),
+		mustBlock(t, "10.40.0.20", "10.40.0.29"),
+	}
+	got, err := pool.FreeBlocks(allocated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []IPBlock{
+		mustBlock(t, "10.40.0.16", "10.40.0.19"),
+		mustBlock(t, "10.40.0.30", "10.40.0.99"),
+		mustBlock(t, "10.40.0.120", "10.40.0.254"),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FreeBlocks() = %v, want %v", got, want)
+	}
+
+	pool.Spec.StaticAssignments = nil
+	pool.Spec.TenantAllocation = &TenantAllocationConfig{Start: "10.40.0.50", End: "10.40.0.59"}
+	got, err = pool.FreeBlocks(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []IPBlock{mustBlock(t, "10.40.0.50", "10.40.0.59")}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FreeBlocks() with tenantAllocation = %v, want %v", got, want)
+	}
+
+	pool.Status.Conflicts = []IPVerificationResult{
+		{Address: "10.40.0.52", Method: IPVerificationARP, Result: IPVerificationResultInUse},
+	}
+	got, err = pool.FreeBlocks(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = []IPBlock{mustBlock(t, "10.40.0.50", "10.40.0.51"), mustBlock(t, "10.40.0.53", "10.40.0.59")}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FreeBlocks() with conflicts = %v, want %v", got, want)
+	}
+}
+
+func TestAllocationStrategyAllocate(t *testing.T) {
+	free := []IPBlock{
+		mustBlock(t, "10.0.0.10", "10.0.0.19"),
+		mustBlock(t, "10.0.0.30", "10.0.0.34"),
+		mustBlock(t, "10.0.0.50", "10.0.0.56"),
+	}
+
+	tests := []struct {
+		name     string
+		strategy *AllocationStrategy
+		count    int32
+		want     string
+		wantErr  bool
+	}{
+		{name: "default is first fit", count: 4, want: "10.0.0.10-10.0.0.13"},
+		{name: "first fit reverse", strategy: &AllocationStrategy{FirstFit: &FirstFitStrategy{Reverse: true}}, count: 4, want: "10.0.0.53-10.0.0.56"},
+		{name: "first fit aligned", strategy: &AllocationStrategy{Alignment: 8}, count: 4, want: "10.0.0.16-10.0.0.19"},
+		{name: "best fit picks smallest block", strategy: &AllocationStrategy{Type: AllocationStrategyBestFit}, count: 4, want: "10.0.0.30-10.0.0.33"},
+		{name: "best fit exact", strategy: &AllocationStrategy{Type: AllocationStrategyBestFit}, count: 7, want: "10.0.0.50-10.0.0.56"},
+		{
+			name:     "best fit avoids slivers",
+			strategy: &AllocationStrategy{Type: AllocationStrategyBestFit, BestFit: &BestFitStrategy{MinRemainder: 2}},
+			count:    4,
+			want:     "10.0.0.50-10.0.0.53",
+		},
+		{name: "too large", count: 11, wantErr: true},
+		{name: "aligned too large", strategy: &AllocationStrategy{Alignment: 16}, count: 8, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.strategy.Allocate(free, tt.count, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Allocate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("Allocate() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAllocationStrategyRandomCandidates(t *testing.T) {
+	free := []IPBlock{
+		mustBlock(t, "10.0.0.10", "10.0.0.19"),
+		mustBlock(t, "10.0.0.40", "10.0.0.47"),
+	}
+	s := &AllocationStrategy{Type: AllocationStrategyRandom, Alignment: 2, Random: &RandomStrategy{Attempts: 4}}
+	got := s.Candidates(free, 2, rand.New(rand.NewSource(1)))
+	if len(got) != 4 {
+		t.Fatalf("Candidates() returned %d blocks, want 4", len(got))
+	}
+	seen := map[IPBlock]bool{}
+	for _, b := range got {
+		if seen[b] {
+			t.Errorf("duplicate candidate %s", b)
+		}
+		seen[b] = true
+		if b.Start%2 != 0 || b.Size() != 2 {
+			t.Errorf("candidate %s is not an aligned block of 2", b)
+		}
+		inFree := false
+		for _, f := range free {
+			if b.Start >= f.Start && b.End <= f.End {
+				inFree = true
+			}
+		}
+		if !inFree {
+			t.Errorf("candidate %s is outside the free blocks", b)
+		}
+	}
+
+	// Fewer positions than attempts returns every position once.
+	one := []IPBlock{mustBlock(t, "10.0.0.10", "10.0.0.13")}
+	if got := s.Candidates(one, 2, rand.New(rand.NewSource(1))); len(got) != 2 {
+		t.Errorf("Candidates() on small block = %v, want 2 positions", got)
+	}
+}
+
+func TestNetworkPoolFreeBlocksIPv6(t *testing.T) {
+	pool := &NetworkPool{Spec: NetworkPoolSpec{
+		CIDR:          "fd00:10::/64",
+		AddressFamily: IPFamilyIPv6,
+		Reserved:      []ReservedRange{{CIDR: "fd00:10::/120"}},
+	}}
+	allocated := []IPBlock{
+		mustBlock(t, "fd00:10::200", "fd00:10::20f"),
+		mustBlock(t, "10.0.0.1", "10.0.0.9"),
+	}
+	got, err := pool.FreeBlocks(allocated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []IPBlock{
+		mustBlock(t, "fd00:10::100", "fd00:10::1ff"),
+		mustBlock(t, "fd00:10::210", "fd00:10::ffff:ffff"),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FreeBlocks() = %v, want %v", got, want)
+	}
+
+	block, err := (&AllocationStrategy{Alignment: 16}).Allocate(got, 4, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "fd00:10::100-fd00:10::103"; block.String() != want || block.Family() != IPFamilyIPv6 {
+		t.Errorf("Allocate() = %s (%s), want %s (IPv6)", block, block.Family(), want)
+	}
+}
+
+func TestParseIPBlockErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		start, end string
+	}{
+		{"mixed families", "10.0.0.1", "fd00::1"},
+		{"spans a /96", "fd00::1", "fd00::1:0:0"},
+		{"reversed", "fd00::9", "fd00::1"},
+		{"zone", "fe80::1%eth0", "fe80::2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseIPBlock(tt.start, tt.end); err == nil {
+				t.Errorf("ParseIPBlock(%q, %q) succeeded, want error", tt.start, tt.end)
+			}
+		})
+	}
+}
+
+func TestNthAddr(t *testing.T) {
+	tests := []struct {
+		prefix string
+		n      uint64
+		want   string
+	}{
+		{"10.96.0.0/12", 10, "10.96.0.10"},
+		{"10.96.0.0/12", 256, "10.96.1.0"},
+		{"10.100.5.7/24", 10, "10.100.5.10"},
+		{"192.168.0.0/29", 7, "192.168.0.7"},
+		{"192.168.0.0/29", 8, ""},
+		{"255.255.255.255/32", 1, ""},
+		{"fd00:96::/108", 10, "fd00:96::a"},
+		{"fd00::/64", 1 << 32, "fd00::1:0:0"},
+	}
+
+	for _, tt := range tests {
+		got := nthAddr(netip.MustParsePrefix(tt.prefix), tt.n)
+		if tt.want == "" {
+			if got.IsValid() {
+				t.Errorf("nthAddr(%s, %d) = %s, want invalid", tt.prefix, tt.n, got)
+			}
+			continue
+		}
+		if got.String() != tt.want {
+			t.Errorf("nthAddr(%s, %d) = %s, want %s", tt.prefix, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestNetworkPoolStaticAssignmentMigration(t *testing.T) {
+	pool := &NetworkPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "lab", Namespace: "butler-system"},
+		Spec: NetworkPoolSpec{
+			CIDR: "10.40.0.0/24",
+			StaticAssignments: []StaticIPAssignment{
+				{
+					Name:             "legacy-prod",
+					Range:            PinnedIPRange{StartAddress: "10.40.0.10", EndAddress: "10.40.0.14"},
+					TenantClusterRef: &NamespacedObjectReference{Name: "prod", Namespace: "team-a"},
+					Type:             IPAllocationTypeNodes,
+				},
+				{Name: "printers", Range: PinnedIPRange{StartAddress: "10.40.0.20", EndAddress: "10.40.0.21"}},
+			},
+		},
+	}
+
+	// The migrated allocation's range is still listed as a static
+	// assignment, which must not block it.
+	alloc, err := pool.MigrateStaticAssignment("legacy-prod")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := pool.CheckPinnedRange(alloc, nil); err != nil {
+		t.Fatalf("CheckPinnedRange() for migrated allocation: %v", err)
+	}
+
+	// Other allocations still conflict with the assignments.
+	for _, r := range []PinnedIPRange{
+		{StartAddress: "10.40.0.12", EndAddress: "10.40.0.12"},
+		{StartAddress: "10.40.0.10", EndAddress: "10.40.0.15"},
+		{StartAddress: "10.40.0.20", EndAddress: "10.40.0.21"},
+	} {
+		other := alloc.DeepCopy()
+		other.Spec.PinnedRange = &r
+		if err := pool.CheckPinnedRange(other, nil); err == nil {
+			t.Errorf("CheckPinnedRange(%s-%s) succeeded, want conflict", r.StartAddress, r.EndAddress)
+		}
+	}
+	other := alloc.DeepCopy()
+	other.Spec.TenantClusterRef.Name = "staging"
+	if err := pool.CheckPinnedRange(other, nil); err == nil {
+		t.Error("CheckPinnedRange() for another cluster succeeded, want conflict")
+	}
+
+	// Once the allocation is Allocated the assignment is removed, and the
+	// range stays excluded through the allocation.
+	pinned := mustBlock(t, "10.40.0.10", "10.40.0.14")
+	pool.Spec.StaticAssignments = pool.Spec.StaticAssignments[1:]
+	free, err := pool.FreeBlocks([]IPBlock{pinned})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range free {
+		if f.Overlaps(pinned) {
+			t.Errorf("FreeBlocks() = %v, want %s excluded", free, pinned)
+		}
+	}
+	if err := pool.CheckPinnedRange(alloc, []IPBlock{pinned}); err == nil {
+		t.Error("CheckPinnedRange() for an allocated range succeeded, want conflict")
+	}
+}
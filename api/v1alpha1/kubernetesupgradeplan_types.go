@@ -0,0 +1,576 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/version"
+)
+
+// KubernetesUpgradePlanPhase represents the current phase of a KubernetesUpgradePlan.
+// +kubebuilder:validation:Enum=Pending;PreflightChecking;WaitingForWindow;UpgradingControlPlane;UpgradingWorkers;Paused;Completed;Failed
+type KubernetesUpgradePlanPhase string
+
+const (
+	// KubernetesUpgradePlanPhasePending indicates the plan has not started.
+	KubernetesUpgradePlanPhasePending KubernetesUpgradePlanPhase = "Pending"
+
+	// KubernetesUpgradePlanPhasePreflightChecking indicates pre-flight
+	// checks are running.
+	KubernetesUpgradePlanPhasePreflightChecking KubernetesUpgradePlanPhase = "PreflightChecking"
+
+	// KubernetesUpgradePlanPhaseWaitingForWindow indicates checks passed and
+	// the plan is waiting for the maintenance window to open.
+	KubernetesUpgradePlanPhaseWaitingForWindow KubernetesUpgradePlanPhase = "WaitingForWindow"
+
+	// KubernetesUpgradePlanPhaseUpgradingControlPlane indicates the control
+	// plane is being upgraded.
+	KubernetesUpgradePlanPhaseUpgradingControlPlane KubernetesUpgradePlanPhase = "UpgradingControlPlane"
+
+	// KubernetesUpgradePlanPhaseUpgradingWorkers indicates worker machines
+	// are being replaced.
+	KubernetesUpgradePlanPhaseUpgradingWorkers KubernetesUpgradePlanPhase = "UpgradingWorkers"
+
+	// KubernetesUpgradePlanPhasePaused indicates the rollout is paused by
+	// spec.paused.
+	KubernetesUpgradePlanPhasePaused KubernetesUpgradePlanPhase = "Paused"
+
+	// KubernetesUpgradePlanPhaseCompleted indicates every component runs
+	// the target version.
+	KubernetesUpgradePlanPhaseCompleted KubernetesUpgradePlanPhase = "Completed"
+
+	// KubernetesUpgradePlanPhaseFailed indicates the upgrade failed.
+	KubernetesUpgradePlanPhaseFailed KubernetesUpgradePlanPhase = "Failed"
+)
+
+// PreflightCheckResult is the outcome of a pre-flight check.
+// +kubebuilder:validation:Enum=Passed;Warning;Failed
+type PreflightCheckResult string
+
+const (
+	// PreflightCheckPassed indicates the check passed.
+	PreflightCheckPassed PreflightCheckResult = "Passed"
+
+	// PreflightCheckWarning indicates a problem that does not block the upgrade.
+	PreflightCheckWarning PreflightCheckResult = "Warning"
+
+	// PreflightCheckFailed indicates a problem that blocks the upgrade
+	// unless preflightPolicy is Warn.
+	PreflightCheckFailed PreflightCheckResult = "Failed"
+)
+
+// Well-known pre-flight check names.
+const (
+	// PreflightCheckNameDeprecatedAPIs fails when the cluster serves
+	// objects through APIs removed in the target Kubernetes version.
+	PreflightCheckNameDeprecatedAPIs = "DeprecatedAPIs"
+
+	// PreflightCheckNamePDBCoverage warns about workloads with several
+	// replicas and no PodDisruptionBudget, and fails when a budget allows
+	// no disruptions, since it would block node drains.
+	PreflightCheckNamePDBCoverage = "PDBCoverage"
+
+	// PreflightCheckNameNodeDiskSpace fails when a node lacks the free
+	// disk space needed to pull new images.
+	PreflightCheckNameNodeDiskSpace = "NodeDiskSpace"
+
+	// PreflightCheckNameNodeHealth fails when nodes are not Ready.
+	PreflightCheckNameNodeHealth = "NodeHealth"
+
+	// PreflightCheckNameAddonCompatibility fails when an installed addon
+	// version is not compatible with the target version.
+	PreflightCheckNameAddonCompatibility = "AddonCompatibility"
+)
+
+// PreflightTargetType is the kind of upgrade pre-flight checks run for.
+// +kubebuilder:validation:Enum=Kubernetes;Talos;Addon
+type PreflightTargetType string
+
+const (
+	// PreflightTargetKubernetes is a Kubernetes version upgrade.
+	PreflightTargetKubernetes PreflightTargetType = "Kubernetes"
+
+	// PreflightTargetTalos is a Talos OS upgrade.
+	PreflightTargetTalos PreflightTargetType = "Talos"
+
+	// PreflightTargetAddon is an addon chart upgrade.
+	PreflightTargetAddon PreflightTargetType = "Addon"
+)
+
+// PreflightPolicy controls how failed pre-flight checks are handled.
+// +kubebuilder:validation:Enum=Enforce;Warn
+type PreflightPolicy string
+
+const (
+	// PreflightPolicyEnforce blocks the upgrade when a check fails.
+	PreflightPolicyEnforce PreflightPolicy = "Enforce"
+
+	// PreflightPolicyWarn records failed checks and upgrades anyway.
+	PreflightPolicyWarn PreflightPolicy = "Warn"
+)
+
+// Weekday is a day of the week.
+// +kubebuilder:validation:Enum=Monday;Tuesday;Wednesday;Thursday;Friday;Saturday;Sunday
+type Weekday string
+
+// KubernetesUpgradePlan condition types.
+const (
+	// KubernetesUpgradePlanConditionPreflightPassed indicates no pre-flight
+	// check failed.
+	KubernetesUpgradePlanConditionPreflightPassed = "PreflightPassed"
+
+	// KubernetesUpgradePlanConditionProgressing indicates the rollout is
+	// actively upgrading machines.
+	KubernetesUpgradePlanConditionProgressing = "Progressing"
+)
+
+// KubernetesUpgradePlanSpec defines the desired state of KubernetesUpgradePlan.
+// +kubebuilder:validation:XValidation:rule="self.clusterRef == oldSelf.clusterRef && self.targetVersion == oldSelf.targetVersion",message="clusterRef and targetVersion are immutable; create a new plan"
+type KubernetesUpgradePlanSpec struct {
+	// ClusterRef references the TenantCluster in the same namespace.
+	// A cluster may have only one plan in progress at a time.
+	// +kubebuilder:validation:Required
+	ClusterRef LocalObjectReference `json:"clusterRef"`
+
+	// TargetVersion is the Kubernetes version to upgrade to. It must be
+	// newer than the cluster's version and at most one minor version ahead.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^v\d+\.\d+\.\d+$`
+	TargetVersion string `json:"targetVersion"`
+
+	// Strategy controls the rollout.
+	// +optional
+	Strategy *UpgradeStrategy `json:"strategy,omitempty"`
+
+	// MaintenanceWindow limits when upgrade phases may start. A phase that
+	// started inside the window runs to completion. If unset, the upgrade
+	// starts as soon as pre-flight checks pass.
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
+	// PreflightPolicy controls whether failed pre-flight checks block the upgrade.
+	// +kubebuilder:default="Enforce"
+	// +optional
+	PreflightPolicy PreflightPolicy `json:"preflightPolicy,omitempty"`
+
+	// Paused stops the rollout after the machine currently being replaced.
+	// Set back to false to resume.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// UpgradeStrategy controls the order and pace of an upgrade. The control
+// plane is always upgraded before workers, as required by the Kubernetes
+// version skew policy.
+type UpgradeStrategy struct {
+	// PauseAfterControlPlane pauses the rollout once the control plane runs
+	// the target version, so it can be verified before workers are replaced.
+	// The controller sets spec.paused to true at that point; set it back to
+	// false to resume.
+	// +optional
+	PauseAfterControlPlane bool `json:"pauseAfterControlPlane,omitempty"`
+
+	// Workers bounds worker replacement. Overrides the cluster's
+	// spec.workers.updateStrategy.rollingUpdate for this upgrade.
+	// +optional
+	Workers *MachineRollingUpdate `json:"workers,omitempty"`
+
+	// WorkerPoolOrder lists worker pools to upgrade first, in order.
+	// "default" names the pool from spec.workers. Pools not listed are
+	// upgraded afterwards in spec order.
+	// +optional
+	// +listType=set
+	WorkerPoolOrder []string `json:"workerPoolOrder,omitempty"`
+}
+
+// MaintenanceWindow is a recurring weekly time window.
+type MaintenanceWindow struct {
+	// Days the window opens on. Empty means every day.
+	// +optional
+	// +listType=set
+	Days []Weekday `json:"days,omitempty"`
+
+	// Start is the time of day the window opens, as HH:MM.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^([01]\d|2[0-3]):[0-5]\d$`
+	Start string `json:"start"`
+
+	// Duration is how long the window stays open.
+	// +kubebuilder:validation:Required
+	Duration metav1.Duration `json:"duration"`
+
+	// TimeZone is the IANA time zone of Start (e.g., "Europe/Berlin").
+	// +kubebuilder:default="UTC"
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// PreflightCheck is the result of a single pre-flight check.
+type PreflightCheck struct {
+	// Name of the check (e.g., "DeprecatedAPIs", "PDBCoverage").
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Result of the check.
+	// +kubebuilder:validation:Required
+	Result PreflightCheckResult `json:"result"`
+
+	// Message describes what was found.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Remediation suggests how to resolve a warning or failure
+	// (e.g., "add a PodDisruptionBudget to Deployment team-a/web").
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	Remediation string `json:"remediation,omitempty"`
+
+	// AffectedObjects lists up to 20 objects the check found problems
+	// with, as "Kind namespace/name".
+	// +optional
+	// +kubebuilder:validation:MaxItems=20
+	AffectedObjects []string `json:"affectedObjects,omitempty"`
+}
+
+// PreflightTarget identifies the upgrade pre-flight checks ran for.
+type PreflightTarget struct {
+	// Type is the kind of upgrade.
+	// +kubebuilder:validation:Required
+	Type PreflightTargetType `json:"type"`
+
+	// Name is the addon name for Addon upgrades.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Version is the target version.
+	// +kubebuilder:validation:Required
+	Version string `json:"version"`
+}
+
+// PreflightStatus reports the most recent pre-flight run for an upgrade.
+type PreflightStatus struct {
+	// Target is the upgrade the checks ran for.
+	// +optional
+	Target *PreflightTarget `json:"target,omitempty"`
+
+	// Result is the worst result of any check.
+	// +optional
+	Result PreflightCheckResult `json:"result,omitempty"`
+
+	// Checks are the per-check results.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Checks []PreflightCheck `json:"checks,omitempty"`
+
+	// CheckTime is when the checks ran.
+	// +optional
+	CheckTime *metav1.Time `json:"checkTime,omitempty"`
+
+	// UpgradePlanRef is the KubernetesUpgradePlan that requested the
+	// checks, if any.
+	// +optional
+	UpgradePlanRef *LocalObjectReference `json:"upgradePlanRef,omitempty"`
+}
+
+// KubernetesUpgradePlanStatus defines the observed state of KubernetesUpgradePlan.
+type KubernetesUpgradePlanStatus struct {
+	// Conditions represent the latest available observations.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Phase represents the current phase of the upgrade.
+	// +optional
+	Phase KubernetesUpgradePlanPhase `json:"phase,omitempty"`
+
+	// FromVersion is the cluster's Kubernetes version when the plan started.
+	// +optional
+	FromVersion string `json:"fromVersion,omitempty"`
+
+	// PreflightChecks are the results of the most recent pre-flight run.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	PreflightChecks []PreflightCheck `json:"preflightChecks,omitempty"`
+
+	// PreflightCheckTime is when pre-flight checks last ran.
+	// +optional
+	PreflightCheckTime *metav1.Time `json:"preflightCheckTime,omitempty"`
+
+	// ControlPlaneVersion is the version the control plane runs.
+	// +optional
+	ControlPlaneVersion string `json:"controlPlaneVersion,omitempty"`
+
+	// CurrentWorkerPool is the worker pool being upgraded.
+	// +optional
+	CurrentWorkerPool string `json:"currentWorkerPool,omitempty"`
+
+	// UpdatedWorkers is the number of workers running the target version.
+	// +optional
+	UpdatedWorkers int32 `json:"updatedWorkers,omitempty"`
+
+	// TotalWorkers is the number of workers to upgrade.
+	// +optional
+	TotalWorkers int32 `json:"totalWorkers,omitempty"`
+
+	// NextWindowStart is when the maintenance window next opens, while
+	// waiting for it.
+	// +optional
+	NextWindowStart *metav1.Time `json:"nextWindowStart,omitempty"`
+
+	// StartTime is when the control plane upgrade started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is when the upgrade completed or failed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Message provides human-readable status information.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// ObservedGeneration is the last observed generation.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=kup,categories=butler
+// +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".spec.clusterRef.name",description="Tenant cluster"
+// +kubebuilder:printcolumn:name="From",type="string",JSONPath=".status.fromVersion",description="Starting version"
+// +kubebuilder:printcolumn:name="Target",type="string",JSONPath=".spec.targetVersion",description="Target version"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Upgrade phase"
+// +kubebuilder:printcolumn:name="Upgraded",type="integer",JSONPath=".status.updatedWorkers",description="Upgraded workers",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// KubernetesUpgradePlan upgrades a TenantCluster to a new Kubernetes
+// version under operator control. The controller runs pre-flight checks,
+// waits for the maintenance window, upgrades the control plane, and then
+// replaces workers pool by pool. On completion it sets the cluster's
+// spec.kubernetesVersion to the target version.
+type KubernetesUpgradePlan struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KubernetesUpgradePlanSpec   `json:"spec,omitempty"`
+	Status KubernetesUpgradePlanStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KubernetesUpgradePlanList contains a list of KubernetesUpgradePlan.
+type KubernetesUpgradePlanList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KubernetesUpgradePlan `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&KubernetesUpgradePlan{}, &KubernetesUpgradePlanList{})
+}
+
+// Helper methods
+
+// IsTerminal returns true if the upgrade has completed or failed.
+func (p *KubernetesUpgradePlan) IsTerminal() bool {
+	return p.Status.Phase == KubernetesUpgradePlanPhaseCompleted || p.Status.Phase == KubernetesUpgradePlanPhaseFailed
+}
+
+// GetPreflightPolicy returns the pre-flight policy, defaulting to Enforce.
+func (p *KubernetesUpgradePlan) GetPreflightPolicy() PreflightPolicy {
+	if p.Spec.PreflightPolicy == "" {
+		return PreflightPolicyEnforce
+	}
+	return p.Spec.PreflightPolicy
+}
+
+// FailedPreflightChecks returns the names of failed pre-flight checks.
+func (p *KubernetesUpgradePlan) FailedPreflightChecks() []string {
+	return failedPreflightChecks(p.Status.PreflightChecks)
+}
+
+func failedPreflightChecks(checks []PreflightCheck) []string {
+	var names []string
+	for _, c := range checks {
+		if c.Result == PreflightCheckFailed {
+			names = append(names, c.Name)
+		}
+	}
+	return names
+}
+
+// WorstPreflightResult returns the most severe result of checks, or
+// Passed if there are none.
+func WorstPreflightResult(checks []PreflightCheck) PreflightCheckResult {
+	worst := PreflightCheckPassed
+	for _, c := range checks {
+		switch c.Result {
+		case PreflightCheckFailed:
+			return PreflightCheckFailed
+		case PreflightCheckWarning:
+			worst = PreflightCheckWarning
+		}
+	}
+	return worst
+}
+
+// SetChecks records the results of a pre-flight run at now.
+func (s *PreflightStatus) SetChecks(checks []PreflightCheck, now time.Time) {
+	t := metav1.NewTime(now)
+	s.Checks = checks
+	s.Result = WorstPreflightResult(checks)
+	s.CheckTime = &t
+}
+
+// FailedChecks returns the names of failed checks.
+func (s *PreflightStatus) FailedChecks() []string {
+	return failedPreflightChecks(s.Checks)
+}
+
+// Blocks returns true if failed checks block the upgrade under policy.
+func (s *PreflightStatus) Blocks(policy PreflightPolicy) bool {
+	return policy != PreflightPolicyWarn && s.Result == PreflightCheckFailed
+}
+
+// IsFor returns true if the status reports checks for target.
+func (s *PreflightStatus) IsFor(target PreflightTarget) bool {
+	return s.Target != nil && *s.Target == target
+}
+
+// PreflightBlocked returns true if failed pre-flight checks block the
+// upgrade under the plan's pre-flight policy.
+func (p *KubernetesUpgradePlan) PreflightBlocked() bool {
+	return p.GetPreflightPolicy() == PreflightPolicyEnforce && len(p.FailedPreflightChecks()) > 0
+}
+
+// IsControlPlaneUpgraded returns true if the control plane runs the target version.
+func (p *KubernetesUpgradePlan) IsControlPlaneUpgraded() bool {
+	return p.Status.ControlPlaneVersion == p.Spec.TargetVersion
+}
+
+// WorkerPoolUpgradeOrder returns pools, given in spec order, reordered so
+// that pools in strategy.workerPoolOrder come first.
+func (p *KubernetesUpgradePlan) WorkerPoolUpgradeOrder(pools []string) []string {
+	var order []string
+	if s := p.Spec.Strategy; s != nil {
+		for _, name := range s.WorkerPoolOrder {
+			if slices.Contains(pools, name) {
+				order = append(order, name)
+			}
+		}
+	}
+	for _, name := range pools {
+		if !slices.Contains(order, name) {
+			order = append(order, name)
+		}
+	}
+	return order
+}
+
+// CheckUpgradePath returns an error if to is not a valid upgrade from
+// from: it must be newer and may not skip a minor version.
+func CheckUpgradePath(from, to string) error {
+	f, err := version.ParseSemantic(from)
+	if err != nil {
+		return fmt.Errorf("invalid current version %q: %w", from, err)
+	}
+	t, err := version.ParseSemantic(to)
+	if err != nil {
+		return fmt.Errorf("invalid target version %q: %w", to, err)
+	}
+	if !f.LessThan(t) {
+		return fmt.Errorf("target version %s is not newer than %s", to, from)
+	}
+	if t.Major() != f.Major() || t.Minor() > f.Minor()+1 {
+		return fmt.Errorf("upgrading from %s to %s skips a minor version; upgrade one minor version at a time", from, to)
+	}
+	return nil
+}
+
+// IsOpen returns true if the window is open at now.
+func (w *MaintenanceWindow) IsOpen(now time.Time) (bool, error) {
+	start, err := w.lastStart(now)
+	if err != nil {
+		return false, err
+	}
+	return !start.IsZero() && now.Before(start.Add(w.Duration.Duration)), nil
+}
+
+// NextStart returns the next time the window opens strictly after now.
+func (w *MaintenanceWindow) NextStart(now time.Time) (time.Time, error) {
+	loc, hour, minute, err := w.parse()
+	if err != nil {
+		return time.Time{}, err
+	}
+	local := now.In(loc)
+	for i := 0; i <= 7; i++ {
+		d := local.AddDate(0, 0, i)
+		t := time.Date(d.Year(), d.Month(), d.Day(), hour, minute, 0, 0, loc)
+		if t.After(now) && w.opensOn(t.Weekday()) {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("maintenance window never opens")
+}
+
+// lastStart returns the most recent window start at or before now, looking
+// back far enough to cover windows longer than a day. Returns the zero time
+// if there is none.
+func (w *MaintenanceWindow) lastStart(now time.Time) (time.Time, error) {
+	loc, hour, minute, err := w.parse()
+	if err != nil {
+		return time.Time{}, err
+	}
+	local := now.In(loc)
+	days := 7 + int(w.Duration.Duration/(24*time.Hour))
+	for i := 0; i <= days; i++ {
+		d := local.AddDate(0, 0, -i)
+		t := time.Date(d.Year(), d.Month(), d.Day(), hour, minute, 0, 0, loc)
+		if !t.After(now) && w.opensOn(t.Weekday()) {
+			return t, nil
+		}
+	}
+	return time.Time{}, nil
+}
+
+func (w *MaintenanceWindow) parse() (*time.Location, int, int, error) {
+	tz := w.TimeZone
+	if tz == "" {
+		tz = "UTC"
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("invalid time zone %q: %w", tz, err)
+	}
+	t, err := time.Parse("15:04", w.Start)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("invalid start %q: %w", w.Start, err)
+	}
+	return loc, t.Hour(), t.Minute(), nil
+}
+
+func (w *MaintenanceWindow) opensOn(day time.Weekday) bool {
+	return len(w.Days) == 0 || slices.Contains(w.Days, Weekday(day.String()))
+}
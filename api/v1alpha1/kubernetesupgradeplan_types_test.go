@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCheckUpgradePath(t *testing.T) {
+	tests := []struct {
+		from, to string
+		wantErr  bool
+	}{
+		{"v1.31.4", "v1.31.5", false},
+		{"v1.31.4", "v1.32.0", false},
+		{"v1.31.4", "v1.33.0", true},
+		{"v1.31.4", "v1.31.4", true},
+		{"v1.32.0", "v1.31.9", true},
+		{"v1.31.4", "v2.0.0", true},
+		{"bogus", "v1.32.0", true},
+	}
+	for _, tt := range tests {
+		err := CheckUpgradePath(tt.from, tt.to)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("CheckUpgradePath(%s, %s) error = %v, wantErr %v", tt.from, tt.to, err, tt.wantErr)
+		}
+	}
+}
+
+func TestMaintenanceWindow(t *testing.T) {
+	// Saturday and Sunday, 22:00-04:00 UTC.
+	w := &MaintenanceWindow{
+		Days:     []Weekday{"Saturday", "Sunday"},
+		Start:    "22:00",
+		Duration: metav1.Duration{Duration: 6 * time.Hour},
+	}
+	sat := time.Date(2026, 5, 2, 0, 0, 0, 0, time.UTC) // a Saturday
+
+	tests := []struct {
+		name     string
+		now      time.Time
+		wantOpen bool
+		wantNext time.Time
+	}{
+		{"friday evening", sat.Add(-2 * time.Hour), false, sat.Add(22 * time.Hour)},
+		{"saturday night", sat.Add(23 * time.Hour), true, sat.Add(46 * time.Hour)},
+		{"early sunday from saturday window", sat.Add(27 * time.Hour), true, sat.Add(46 * time.Hour)},
+		{"sunday midday", sat.Add(36 * time.Hour), false, sat.Add(46 * time.Hour)},
+		{"early monday from sunday window", sat.Add(49 * time.Hour), true, sat.Add(7*24*time.Hour + 22*time.Hour)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			open, err := w.IsOpen(tt.now)
+			if err != nil {
+				t.Fatalf("IsOpen() error = %v", err)
+			}
+			if open != tt.wantOpen {
+				t.Errorf("IsOpen() = %v, want %v", open, tt.wantOpen)
+			}
+			next, err := w.NextStart(tt.now)
+			if err != nil {
+				t.Fatalf("NextStart() error = %v", err)
+			}
+			if !next.Equal(tt.wantNext) {
+				t.Errorf("NextStart() = %v, want %v", next, tt.wantNext)
+			}
+		})
+	}
+
+	w.TimeZone = "Not/AZone"
+	if _, err := w.IsOpen(sat); err == nil {
+		t.Error("IsOpen() with invalid time zone: expected error")
+	}
+}
+
+func TestKubernetesUpgradePlanPreflight(t *testing.T) {
+	p := &KubernetesUpgradePlan{Status: KubernetesUpgradePlanStatus{PreflightChecks: []PreflightCheck{
+		{Name: "DeprecatedAPIs", Result: PreflightCheckFailed},
+		{Name: "AddonCompatibility", Result: PreflightCheckWarning},
+		{Name: "NodeHealth", Result: PreflightCheckPassed},
+	}}}
+	if got := p.FailedPreflightChecks(); !slices.Equal(got, []string{"DeprecatedAPIs"}) {
+		t.Errorf("FailedPreflightChecks() = %v, want [DeprecatedAPIs]", got)
+	}
+	if !p.PreflightBlocked() {
+		t.Error("PreflightBlocked() = false with default Enforce policy")
+	}
+	p.Spec.PreflightPolicy = PreflightPolicyWarn
+	if p.PreflightBlocked() {
+		t.Error("PreflightBlocked() = true with Warn policy")
+	}
+}
+
+func TestPreflightStatus(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	target := PreflightTarget{Type: PreflightTargetKubernetes, Version: "v1.32.1"}
+	s := &PreflightStatus{Target: &target}
+	if !s.IsFor(target) || s.IsFor(PreflightTarget{Type: PreflightTargetTalos, Version: "v1.32.1"}) {
+		t.Error("IsFor() mismatch")
+	}
+
+	s.SetChecks([]PreflightCheck{
+		{Name: PreflightCheckNameNodeDiskSpace, Result: PreflightCheckPassed},
+		{Name: PreflightCheckNamePDBCoverage, Result: PreflightCheckWarning, Remediation: "add a PodDisruptionBudget"},
+	}, now)
+	if s.Result != PreflightCheckWarning || !s.CheckTime.Time.Equal(now) || s.Blocks(PreflightPolicyEnforce) {
+		t.Errorf("warning run: %+v", s)
+	}
+
+	s.SetChecks(append(s.Checks, PreflightCheck{Name: PreflightCheckNameDeprecatedAPIs, Result: PreflightCheckFailed}), now)
+	if s.Result != PreflightCheckFailed || !s.Blocks(PreflightPolicyEnforce) || s.Blocks(PreflightPolicyWarn) {
+		t.Errorf("failed run: %+v", s)
+	}
+	if got := s.FailedChecks(); !slices.Equal(got, []string{PreflightCheckNameDeprecatedAPIs}) {
+		t.Errorf("FailedChecks() = %v", got)
+	}
+	if got := WorstPreflightResult(nil); got != PreflightCheckPassed {
+		t.Errorf("WorstPreflightResult(nil) = %s, want Passed", got)
+	}
+}
+
+func TestKubernetesUpgradePlanWorkerPoolUpgradeOrder(t *testing.T) {
+	p := &KubernetesUpgradePlan{Spec: KubernetesUpgradePlanSpec{
+		Strategy: &UpgradeStrategy{WorkerPoolOrder: []string{"canary", "missing"}},
+	}}
+	got := p.WorkerPoolUpgradeOrder([]string{"default", "gpu", "canary"})
+	if want := []string{"canary", "default", "gpu"}; !slices.Equal(got, want) {
+		t.Errorf("WorkerPoolUpgradeOrder() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/version"
+)
+
+// KubernetesVersionCatalogSpec defines the Kubernetes versions offered by the platform.
+// +kubebuilder:validation:XValidation:rule="self.versions.filter(v, has(v.default) && v.default).size() <= 1",message="at most one version may be the default"
+type KubernetesVersionCatalogSpec struct {
+	// Versions lists the Kubernetes versions known to the platform.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=128
+	// +listType=map
+	// +listMapKey=version
+	Versions []KubernetesVersionEntry `json:"versions"`
+}
+
+// KubernetesVersionEntry describes a single Kubernetes version.
+// +kubebuilder:validation:XValidation:rule="!has(self.default) || !self.default || !has(self.supported) || self.supported",message="the default version must be supported"
+type KubernetesVersionEntry struct {
+	// Version is the Kubernetes version (e.g., "v1.31.4").
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^v\d+\.\d+\.\d+$`
+	Version string `json:"version"`
+
+	// ReleaseDate is when the version was released upstream.
+	// +optional
+	ReleaseDate *metav1.Time `json:"releaseDate,omitempty"`
+
+	// EndOfLife is when upstream support ends. Clusters running the version
+	// after this date get the KubernetesVersionEndOfLife condition.
+	// +optional
+	EndOfLife *metav1.Time `json:"endOfLife,omitempty"`
+
+	// Supported allows new clusters and upgrades to use this version.
+	// Existing clusters on an unsupported version keep running.
+	// +kubebuilder:default=true
+	// +optional
+	Supported *bool `json:"supported,omitempty"`
+
+	// Default marks the version preselected for new clusters.
+	// +optional
+	Default bool `json:"default,omitempty"`
+
+	// AddonMinimums lists minimum addon versions required on this
+	// Kubernetes version.
+	// +optional
+	// +listType=map
+	// +listMapKey=addon
+	AddonMinimums []AddonVersionRequirement `json:"addonMinimums,omitempty"`
+}
+
+// AddonVersionRequirement is a minimum addon version.
+type AddonVersionRequirement struct {
+	// Addon is the addon name (e.g., "cilium", "cert-manager").
+	// +kubebuilder:validation:Required
+	Addon string `json:"addon"`
+
+	// MinVersion is the minimum addon version (e.g., "v1.16.0").
+	// +kubebuilder:validation:Required
+	MinVersion string `json:"minVersion"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=kvc,categories=butler
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// KubernetesVersionCatalog lists the Kubernetes versions offered by the
+// platform, with their support window and addon requirements. Admission
+// and the console version picker read the catalog named "default".
+// Team AllowedKubernetesVersions further restrict the catalog.
+type KubernetesVersionCatalog struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec KubernetesVersionCatalogSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KubernetesVersionCatalogList contains a list of KubernetesVersionCatalog.
+type KubernetesVersionCatalogList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KubernetesVersionCatalog `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&KubernetesVersionCatalog{}, &KubernetesVersionCatalogList{})
+}
+
+// DefaultKubernetesVersionCatalogName is the name of the catalog read by
+// admission and the console.
+const DefaultKubernetesVersionCatalogName = "default"
+
+// Helper methods
+
+// IsSupported returns true if new clusters may use the version.
+func (e *KubernetesVersionEntry) IsSupported() bool {
+	return e.Supported == nil || *e.Supported
+}
+
+// IsEOL returns true if the version is past its end of life at now.
+func (e *KubernetesVersionEntry) IsEOL(now time.Time) bool {
+	return e.EndOfLife != nil && !now.Before(e.EndOfLife.Time)
+}
+
+// GetVersion returns the catalog entry for v, or nil if not listed.
+func (c *KubernetesVersionCatalog) GetVersion(v string) *KubernetesVersionEntry {
+	for i := range c.Spec.Versions {
+		if c.Spec.Versions[i].Version == v {
+			return &c.Spec.Versions[i]
+		}
+	}
+	return nil
+}
+
+// AvailableVersions returns the versions new clusters may use at now,
+// newest first. If allowed is non-empty, only those versions are returned.
+func (c *KubernetesVersionCatalog) AvailableVersions(now time.Time, allowed []string) []string {
+	var out []string
+	for i := range c.Spec.Versions {
+		e := &c.Spec.Versions[i]
+		if !e.IsSupported() || e.IsEOL(now) {
+			continue
+		}
+		if len(allowed) > 0 && !slices.Contains(allowed, e.Version) {
+			continue
+		}
+		out = append(out, e.Version)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		a, errA := version.ParseSemantic(out[i])
+		b, errB := version.ParseSemantic(out[j])
+		if errA != nil || errB != nil {
+			return out[i] > out[j]
+		}
+		return b.LessThan(a)
+	})
+	return out
+}
+
+// DefaultVersion returns the version preselected for new clusters: the
+// entry marked default if it is available, else the newest available
+// version. Returns "" if none is available.
+func (c *KubernetesVersionCatalog) DefaultVersion(now time.Time, allowed []string) string {
+	available := c.AvailableVersions(now, allowed)
+	for i := range c.Spec.Versions {
+		if e := &c.Spec.Versions[i]; e.Default && slices.Contains(available, e.Version) {
+			return e.Version
+		}
+	}
+	if len(available) == 0 {
+		return ""
+	}
+	return available[0]
+}
+
+// CheckVersion returns an error if new clusters or upgrades may not use v at now.
+func (c *KubernetesVersionCatalog) CheckVersion(v string, now time.Time) error {
+	e := c.GetVersion(v)
+	switch {
+	case e == nil:
+		return fmt.Errorf("kubernetes version %s is not in the version catalog", v)
+	case !e.IsSupported():
+		return fmt.Errorf("kubernetes version %s is no longer supported", v)
+	case e.IsEOL(now):
+		return fmt.Errorf("kubernetes version %s reached end of life on %s", v, e.EndOfLife.Format("2006-01-02"))
+	}
+	return nil
+}
+
+// CheckAddonMinimums returns a violation for each addon in installed, keyed
+// by addon name with its version as value, that is older than the
+// minimum required for Kubernetes version v.
+func (c *KubernetesVersionCatalog) CheckAddonMinimums(v string, installed map[string]string) []string {
+	e := c.GetVersion(v)
+	if e == nil {
+		return nil
+	}
+	var violations []string
+	for _, req := range e.AddonMinimums {
+		have, ok := installed[req.Addon]
+		if !ok {
+			continue
+		}
+		min, err := version.ParseGeneric(req.MinVersion)
+		if err != nil {
+			continue
+		}
+		got, err := version.ParseGeneric(have)
+		if err != nil || got.LessThan(min) {
+			violations = append(violations, fmt.Sprintf("%s %s is below the minimum %s for kubernetes %s", req.Addon, have, req.MinVersion, v))
+		}
+	}
+	return violations
+}
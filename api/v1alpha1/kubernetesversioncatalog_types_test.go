@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestKubernetesVersionCatalog(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	eol := metav1.NewTime(now.Add(-24 * time.Hour))
+	no := false
+	catalog := &KubernetesVersionCatalog{Spec: KubernetesVersionCatalogSpec{Versions: []KubernetesVersionEntry{
+		{Version: "v1.29.12", EndOfLife: &eol},
+		{Version: "v1.30.8", Supported: &no},
+		{Version: "v1.31.4", Default: true, AddonMinimums: []AddonVersionRequirement{{Addon: "cilium", MinVersion: "v1.16.0"}}},
+		{Version: "v1.32.0"},
+		{Version: "v1.9.0"},
+	}}}
+
+	if got, want := catalog.AvailableVersions(now, nil), []string{"v1.32.0", "v1.31.4", "v1.9.0"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("AvailableVersions() = %v, want %v", got, want)
+	}
+	if got := catalog.DefaultVersion(now, nil); got != "v1.31.4" {
+		t.Errorf("DefaultVersion() = %s, want v1.31.4", got)
+	}
+	if got := catalog.DefaultVersion(now, []string{"v1.32.0", "v1.29.12"}); got != "v1.32.0" {
+		t.Errorf("DefaultVersion() with allowed list = %s, want v1.32.0", got)
+	}
+
+	for v, wantErr := range map[string]bool{"v1.31.4": false, "v1.29.12": true, "v1.30.8": true, "v1.28.0": true} {
+		if err := catalog.CheckVersion(v, now); (err != nil) != wantErr {
+			t.Errorf("CheckVersion(%s) error = %v, wantErr %v", v, err, wantErr)
+		}
+	}
+
+	if got := catalog.CheckAddonMinimums("v1.31.4", map[string]string{"cilium": "1.15.6"}); len(got) != 1 {
+		t.Errorf("CheckAddonMinimums() with old cilium = %v, want 1 violation", got)
+	}
+	if got := catalog.CheckAddonMinimums("v1.31.4", map[string]string{"cilium": "v1.16.3", "longhorn": "v1.7.0"}); len(got) != 0 {
+		t.Errorf("CheckAddonMinimums() = %v, want none", got)
+	}
+}
@@ -17,6 +17,14 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -32,6 +40,61 @@ const (
 	MachineRoleWorker MachineRole = "worker"
 )
 
+// FirmwareType is the boot firmware of a machine.
+// +kubebuilder:validation:Enum=BIOS;UEFI
+type FirmwareType string
+
+const (
+	// FirmwareTypeBIOS boots the machine with legacy BIOS.
+	FirmwareTypeBIOS FirmwareType = "BIOS"
+
+	// FirmwareTypeUEFI boots the machine with UEFI firmware.
+	FirmwareTypeUEFI FirmwareType = "UEFI"
+)
+
+// UserDataFormat is the format of MachineRequest user data, which decides
+// how the provider injects it.
+// +kubebuilder:validation:Enum=CloudConfig;Ignition;TalosMachineConfig;BottlerocketTOML
+type UserDataFormat string
+
+const (
+	// UserDataFormatCloudConfig is cloud-init user data: a #cloud-config
+	// document, a script, or a MIME multipart archive. Used by Rocky and Kairos.
+	UserDataFormatCloudConfig UserDataFormat = "CloudConfig"
+
+	// UserDataFormatIgnition is an Ignition JSON config. Used by Flatcar.
+	UserDataFormatIgnition UserDataFormat = "Ignition"
+
+	// UserDataFormatTalosMachineConfig is a Talos machine configuration.
+	UserDataFormatTalosMachineConfig UserDataFormat = "TalosMachineConfig"
+
+	// UserDataFormatBottlerocketTOML is Bottlerocket TOML settings.
+	UserDataFormatBottlerocketTOML UserDataFormat = "BottlerocketTOML"
+)
+
+// PowerState is the power state of a machine.
+// +kubebuilder:validation:Enum=Running;Stopped;Restarting
+type PowerState string
+
+const (
+	// PowerStateRunning is a powered-on machine.
+	PowerStateRunning PowerState = "Running"
+
+	// PowerStateStopped is a powered-off machine. Its disks, addresses,
+	// and provider identity are kept.
+	PowerStateStopped PowerState = "Stopped"
+
+	// PowerStateRestarting requests a single reboot, or reports one in progress.
+	PowerStateRestarting PowerState = "Restarting"
+)
+
+// MachineRequest condition types.
+const (
+	// MachineRequestConditionPowerStateSynced indicates the machine's power
+	// state matches spec.powerState.
+	MachineRequestConditionPowerStateSynced = "PowerStateSynced"
+)
+
 // MachinePhase represents the lifecycle phase of a MachineRequest.
 // +kubebuilder:validation:Enum=Pending;Creating;Running;Failed;Deleting;Deleted;Unknown
 type MachinePhase string
@@ -62,6 +125,9 @@ const (
 // MachineRequestSpec defines the desired state of MachineRequest.
 // This is the interface contract between the bootstrap controller and
 // infrastructure provider controllers.
+// +kubebuilder:validation:XValidation:rule="!has(self.userDataFormat) || has(self.userData)",message="userDataFormat requires userData"
+// +kubebuilder:validation:XValidation:rule="!(has(self.networkData) && has(self.networkConfig))",message="networkData and networkConfig are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!has(self.powerState) || self.powerState != 'Restarting' || has(self.powerStateRequestedAt)",message="powerStateRequestedAt is required for Restarting"
 type MachineRequestSpec struct {
 	// ProviderRef references the ProviderConfig to use for this machine.
 	// +kubebuilder:validation:Required
@@ -104,21 +170,409 @@ type MachineRequestSpec struct {
 	// - harvester: "namespace/image-name"
 	// - nutanix: UUID
 	// - proxmox: template ID or image name
+	// - vsphere: template name or inventory path
+	// - openstack: Glance image name or ID
+	// - baremetal: OS image URL written to the root device
 	// +optional
 	Image string `json:"image,omitempty"`
 
-	// UserData is cloud-init user data to configure the machine.
+	// UserData is the data used to configure the machine on first boot,
+	// in the format given by UserDataFormat.
 	// This typically contains the Talos machine configuration.
 	// +optional
 	UserData string `json:"userData,omitempty"`
 
+	// UserDataFormat is the format of UserData. Providers use it to choose
+	// the injection mechanism, such as a cloud-init NoCloud disk or an
+	// Ignition config drive. If not set, it is detected from the content.
+	// +optional
+	UserDataFormat UserDataFormat `json:"userDataFormat,omitempty"`
+
 	// NetworkData is cloud-init network configuration.
+	// Prefer NetworkConfig, which providers render in the format the image
+	// expects. Mutually exclusive with NetworkConfig.
 	// +optional
 	NetworkData string `json:"networkData,omitempty"`
 
+	// NetworkConfig is structured configuration for the primary interface.
+	// If neither NetworkConfig nor NetworkData is set, the primary
+	// interface uses DHCP.
+	// +optional
+	NetworkConfig *MachineNetworkConfig `json:"networkConfig,omitempty"`
+
+	// Networks attaches secondary NICs in addition to the provider's
+	// primary network. Interfaces are attached in list order.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=8
+	Networks []MachineNetworkInterface `json:"networks,omitempty"`
+
 	// Labels are key-value pairs to apply to the VM in the provider.
 	// +optional
 	Labels map[string]string `json:"labels,omitempty"`
+
+	// Drain controls draining the machine's node before the VM is deleted.
+	// If not set, the machine is deleted without draining.
+	// +optional
+	Drain *DrainPolicy `json:"drain,omitempty"`
+
+	// Firmware configures boot firmware, secure boot, and the virtual TPM.
+	// If not set, the provider's default firmware is used.
+	// +optional
+	Firmware *FirmwareSpec `json:"firmware,omitempty"`
+
+	// Devices requests GPUs, PCI passthrough devices, and huge pages.
+	// +optional
+	Devices *MachineDevices `json:"devices,omitempty"`
+
+	// Placement constrains where the machine is placed on the provider,
+	// such as spreading a control plane across hypervisor hosts.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="placement is immutable"
+	// +optional
+	Placement *PlacementSpec `json:"placement,omitempty"`
+
+	// PowerState is the desired power state of the machine. Changing it
+	// stops, starts, or reboots the machine without recreating it.
+	// Restarting reboots once per powerStateRequestedAt; once the reboot
+	// completes the machine is kept Running.
+	// +kubebuilder:default=Running
+	// +optional
+	PowerState PowerState `json:"powerState,omitempty"`
+
+	// PowerStateRequestedAt is when PowerState was last set. It
+	// distinguishes repeated restart requests, so it must change for
+	// every restart. Set by RequestPowerState.
+	// Required when PowerState is Restarting.
+	// +optional
+	PowerStateRequestedAt *metav1.Time `json:"powerStateRequestedAt,omitempty"`
+
+	// BareMetalHost pins the machine to a named host of a bare-metal
+	// ProviderConfig. If empty, any free host with enough CPU, memory, and
+	// disk is claimed. Ignored by other providers.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="bareMetalHost is immutable"
+	// +optional
+	BareMetalHost string `json:"bareMetalHost,omitempty"`
+}
+
+// FirmwareSpec configures the boot firmware of a machine.
+// Providers that cannot satisfy a setting fail the MachineRequest rather
+// than silently ignoring it; see ProviderFirmwareCapabilities.
+// +kubebuilder:validation:XValidation:rule="!has(self.secureBoot) || !self.secureBoot || !has(self.type) || self.type == 'UEFI'",message="secureBoot requires type UEFI"
+type FirmwareSpec struct {
+	// Type is the boot firmware.
+	// +kubebuilder:default=UEFI
+	// +optional
+	Type FirmwareType `json:"type,omitempty"`
+
+	// SecureBoot enables UEFI secure boot. The OS image must be signed;
+	// for Talos, use a SecureBoot image from the Image Factory.
+	// +optional
+	SecureBoot bool `json:"secureBoot,omitempty"`
+
+	// TPM attaches a virtual TPM 2.0 device, used for disk encryption
+	// sealed to the boot state.
+	// +optional
+	TPM bool `json:"tpm,omitempty"`
+}
+
+// HostSpreadPolicy controls how machines in an anti-affinity group are
+// spread across hypervisor hosts.
+// +kubebuilder:validation:Enum=None;Preferred;Required
+type HostSpreadPolicy string
+
+const (
+	// HostSpreadNone places machines without regard to the group.
+	HostSpreadNone HostSpreadPolicy = "None"
+
+	// HostSpreadPreferred spreads machines across hosts when capacity
+	// allows, and otherwise places them on a shared host.
+	HostSpreadPreferred HostSpreadPolicy = "Preferred"
+
+	// HostSpreadRequired never places two machines of the group on the
+	// same host. The MachineRequest fails if no eligible host remains.
+	HostSpreadRequired HostSpreadPolicy = "Required"
+)
+
+// PlacementSpec constrains where a machine is placed. Providers map it to
+// their native mechanism, such as KubeVirt pod anti-affinity on Harvester,
+// DRS rules on vSphere, or spread placement groups on AWS. Providers that
+// cannot honor a Required constraint fail the MachineRequest.
+type PlacementSpec struct {
+	// AntiAffinityGroup names a group of machines that are spread across
+	// hosts according to HostSpread. Groups are scoped to the ProviderConfig.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +optional
+	AntiAffinityGroup string `json:"antiAffinityGroup,omitempty"`
+
+	// HostSpread controls how machines in the group are spread across hosts.
+	// Defaults to Preferred when antiAffinityGroup is set.
+	// +optional
+	HostSpread HostSpreadPolicy `json:"hostSpread,omitempty"`
+
+	// FailureDomain is the ProviderConfig failure domain the machine is
+	// assigned to. Set by controllers when distributing a worker pool; the
+	// domain's zone and host selector are already applied to this spec.
+	// +optional
+	FailureDomain string `json:"failureDomain,omitempty"`
+
+	// AvailabilityZone is the provider availability zone or failure domain
+	// for the machine (e.g., a cloud zone, a Nova availability zone, or a
+	// Nutanix cluster). Defaults to the provider's zone.
+	// +optional
+	AvailabilityZone string `json:"availabilityZone,omitempty"`
+
+	// HostSelector restricts placement to hosts with these labels, such as
+	// Harvester node labels or vSphere host tags.
+	// +optional
+	HostSelector map[string]string `json:"hostSelector,omitempty"`
+}
+
+// HugePageSize is the size of a huge page.
+// +kubebuilder:validation:Enum="2Mi";"1Gi"
+type HugePageSize string
+
+const (
+	// HugePageSize2Mi is a 2 MiB huge page.
+	HugePageSize2Mi HugePageSize = "2Mi"
+
+	// HugePageSize1Gi is a 1 GiB huge page.
+	HugePageSize1Gi HugePageSize = "1Gi"
+)
+
+// MachineDevices requests accelerators and other host devices for a
+// machine. Providers that cannot attach a requested device fail the
+// MachineRequest rather than creating the machine without it.
+type MachineDevices struct {
+	// GPUs requests whole GPUs passed through to the machine, or vGPU
+	// slices when a profile is set.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=8
+	GPUs []GPUDeviceRequest `json:"gpus,omitempty"`
+
+	// PCIDevices passes other host PCI devices through to the machine,
+	// such as SR-IOV NICs or FPGAs.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	PCIDevices []PCIDeviceRequest `json:"pciDevices,omitempty"`
+
+	// HugePages backs part of the machine memory with huge pages.
+	// +optional
+	HugePages *HugePagesSpec `json:"hugePages,omitempty"`
+}
+
+// GPUDeviceRequest requests GPUs of one model.
+type GPUDeviceRequest struct {
+	// Name identifies the request (e.g., "training").
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// Model is the GPU model (e.g., "nvidia-a100-80gb", "nvidia-l4").
+	// Matched against host devices when no provider mapping is set.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Model string `json:"model"`
+
+	// Count is the number of GPUs, or vGPU slices when VGPUProfile is set.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=16
+	// +optional
+	Count int32 `json:"count,omitempty"`
+
+	// VGPUProfile requests a mediated vGPU slice instead of a whole GPU
+	// (e.g., "nvidia-a100-2g.10gb" or "grid_a100-4c").
+	// +optional
+	VGPUProfile string `json:"vgpuProfile,omitempty"`
+
+	// ProviderMapping overrides how the model is resolved on each provider.
+	// +optional
+	ProviderMapping *DeviceProviderMapping `json:"providerMapping,omitempty"`
+}
+
+// PCIDeviceRequest requests host PCI devices for passthrough.
+type PCIDeviceRequest struct {
+	// Name identifies the request (e.g., "sriov-nic").
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// DeviceID is the PCI vendor and device ID as "vendor:device"
+	// (e.g., "15b3:101e").
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[0-9a-f]{4}:[0-9a-f]{4}$`
+	DeviceID string `json:"deviceID"`
+
+	// Count is the number of devices.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=16
+	// +optional
+	Count int32 `json:"count,omitempty"`
+
+	// ProviderMapping overrides how the device is resolved on each provider.
+	// +optional
+	ProviderMapping *DeviceProviderMapping `json:"providerMapping,omitempty"`
+}
+
+// DeviceProviderMapping names a device in provider terms.
+type DeviceProviderMapping struct {
+	// Harvester is the PCIDevice or vGPU device name.
+	// +optional
+	Harvester string `json:"harvester,omitempty"`
+
+	// Nutanix is the GPU or vGPU profile device ID.
+	// +optional
+	Nutanix string `json:"nutanix,omitempty"`
+
+	// Proxmox is the cluster resource mapping name.
+	// +optional
+	Proxmox string `json:"proxmox,omitempty"`
+
+	// VSphere is the vGPU profile or DirectPath I/O device name.
+	// +optional
+	VSphere string `json:"vsphere,omitempty"`
+
+	// OpenStack is the PCI alias configured in Nova.
+	// +optional
+	OpenStack string `json:"openstack,omitempty"`
+
+	// AWS is the instance type providing the device (e.g., "g5.2xlarge").
+	// +optional
+	AWS string `json:"aws,omitempty"`
+
+	// Azure is the VM size providing the device (e.g., "Standard_NC6s_v3").
+	// +optional
+	Azure string `json:"azure,omitempty"`
+
+	// GCP is the accelerator type (e.g., "nvidia-tesla-t4").
+	// +optional
+	GCP string `json:"gcp,omitempty"`
+}
+
+// HugePagesSpec backs part of the machine memory with huge pages.
+type HugePagesSpec struct {
+	// PageSize is the huge page size.
+	// +kubebuilder:default="2Mi"
+	// +optional
+	PageSize HugePageSize `json:"pageSize,omitempty"`
+
+	// Size is the total memory backed by huge pages. Must be a multiple
+	// of the page size and no more than the machine memory.
+	// +kubebuilder:validation:Required
+	Size resource.Quantity `json:"size"`
+}
+
+// MachineNetworkInterface is a secondary NIC on a machine.
+type MachineNetworkInterface struct {
+	// Name identifies the interface (e.g., "storage", "dmz").
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=15
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// ProviderNetwork is the provider network to attach.
+	// Format is provider-specific:
+	// - harvester: "namespace/name" of a VM network
+	// - nutanix: subnet UUID
+	// - proxmox: bridge name, optionally with VLAN tag ("vmbr1.40")
+	// - vsphere: port group name
+	// - openstack: Neutron network ID
+	// - baremetal: MAC address of the host NIC
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ProviderNetwork string `json:"providerNetwork"`
+
+	// Address is the static address in CIDR notation (e.g., "10.60.0.21/24").
+	// If empty, the interface uses DHCP.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="isCIDR(self)",message="address must be in CIDR notation"
+	Address string `json:"address,omitempty"`
+
+	// Gateway is the default gateway of the interface. Usually only set on
+	// the primary interface.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="isIP(self)",message="gateway must be a valid IP address"
+	Gateway string `json:"gateway,omitempty"`
+
+	// VLAN tags traffic on the interface with this VLAN ID.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4094
+	VLAN *int32 `json:"vlan,omitempty"`
+
+	// MTU of the interface. If not set, the provider network's MTU is used.
+	// +optional
+	// +kubebuilder:validation:Minimum=576
+	// +kubebuilder:validation:Maximum=9216
+	MTU *int32 `json:"mtu,omitempty"`
+}
+
+// MachineNetworkConfig is structured network configuration for the primary
+// interface of a machine. The address is either set statically or taken
+// from an IPAllocation; if neither is set, the interface uses DHCP.
+// +kubebuilder:validation:XValidation:rule="!(has(self.address) && has(self.ipAllocationRef))",message="address and ipAllocationRef are mutually exclusive"
+type MachineNetworkConfig struct {
+	// Address is the static address in CIDR notation (e.g., "10.40.0.21/24").
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="isCIDR(self)",message="address must be in CIDR notation"
+	Address string `json:"address,omitempty"`
+
+	// IPAllocationRef takes the address from an IPAllocation in the
+	// MachineRequest's namespace. The prefix length comes from the
+	// allocation's NetworkPool.
+	// +optional
+	IPAllocationRef *IPAllocationAddressReference `json:"ipAllocationRef,omitempty"`
+
+	// Gateway is the default gateway.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="isIP(self)",message="gateway must be a valid IP address"
+	Gateway string `json:"gateway,omitempty"`
+
+	// DNSServers are the nameservers for the machine.
+	// +optional
+	// +kubebuilder:validation:MaxItems=3
+	// +kubebuilder:validation:items:XValidation:rule="isIP(self)",message="dnsServers must be valid IP addresses"
+	DNSServers []string `json:"dnsServers,omitempty"`
+
+	// SearchDomains are the DNS search domains for the machine.
+	// +optional
+	// +kubebuilder:validation:MaxItems=6
+	SearchDomains []string `json:"searchDomains,omitempty"`
+
+	// VLAN tags traffic on the primary interface with this VLAN ID.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4094
+	VLAN *int32 `json:"vlan,omitempty"`
+
+	// MTU of the primary interface. If not set, the provider network's MTU is used.
+	// +optional
+	// +kubebuilder:validation:Minimum=576
+	// +kubebuilder:validation:Maximum=9216
+	MTU *int32 `json:"mtu,omitempty"`
+}
+
+// IPAllocationAddressReference selects one address of an IPAllocation.
+type IPAllocationAddressReference struct {
+	// Name of the IPAllocation.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Index selects the address in status.addresses.
+	// +kubebuilder:default=0
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Index int32 `json:"index,omitempty"`
 }
 
 // DiskSpec defines an additional disk to attach to a machine.
@@ -144,6 +598,10 @@ type MachineRequestStatus struct {
 	// +optional
 	ProviderID string `json:"providerID,omitempty"`
 
+	// BareMetalHost is the name of the claimed host for bare-metal machines.
+	// +optional
+	BareMetalHost string `json:"bareMetalHost,omitempty"`
+
 	// IPAddress is the primary IP address of the machine.
 	// This is set when the machine reaches the Running phase.
 	// +optional
@@ -157,6 +615,20 @@ type MachineRequestStatus struct {
 	// +optional
 	MACAddress string `json:"macAddress,omitempty"`
 
+	// PowerState is the observed power state of the machine.
+	// Phase tracks provisioning, so a stopped machine stays in the Running phase.
+	// +optional
+	PowerState PowerState `json:"powerState,omitempty"`
+
+	// LastPowerTransitionTime is when PowerState last changed.
+	// +optional
+	LastPowerTransitionTime *metav1.Time `json:"lastPowerTransitionTime,omitempty"`
+
+	// ObservedPowerStateRequestedAt is the spec.powerStateRequestedAt of
+	// the last power request the controller completed.
+	// +optional
+	ObservedPowerStateRequestedAt *metav1.Time `json:"observedPowerStateRequestedAt,omitempty"`
+
 	// FailureReason provides a machine-readable failure reason.
 	// +optional
 	FailureReason string `json:"failureReason,omitempty"`
@@ -179,15 +651,20 @@ type MachineRequestStatus struct {
 	// ObservedGeneration is the generation most recently observed by the controller.
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Reconcile reports controller reconcile diagnostics.
+	// +optional
+	Reconcile *ReconcileStatus `json:"reconcile,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:shortName=mr
+// +kubebuilder:resource:shortName=mr,categories=butler
 // +kubebuilder:printcolumn:name="Machine",type="string",JSONPath=".spec.machineName",description="VM name"
 // +kubebuilder:printcolumn:name="Role",type="string",JSONPath=".spec.role",description="Machine role"
 // +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Current phase"
 // +kubebuilder:printcolumn:name="IP",type="string",JSONPath=".status.ipAddress",description="IP address"
+// +kubebuilder:printcolumn:name="Power",type="string",JSONPath=".status.powerState",description="Observed power state"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // MachineRequest is the Schema for the machinerequests API.
@@ -217,6 +694,336 @@ func init() {
 
 // Helper methods for MachineRequest
 
+var (
+	talosVersionPattern       = regexp.MustCompile(`(?m)^version:\s*v1alpha1\s*$`)
+	talosMachinePattern       = regexp.MustCompile(`(?m)^machine:\s*$`)
+	bottlerocketSettingsTable = regexp.MustCompile(`(?m)^\[settings(\.[^\]]+)?\]\s*$`)
+)
+
+// DetectUserDataFormat returns the format of user data from its content,
+// or "" if the format is not recognized.
+func DetectUserDataFormat(data string) UserDataFormat {
+	s := strings.TrimSpace(data)
+	switch {
+	case s == "":
+		return ""
+	case strings.HasPrefix(s, "{"):
+		var cfg struct {
+			Ignition struct {
+				Version string `json:"version"`
+			} `json:"ignition"`
+		}
+		if json.Unmarshal([]byte(s), &cfg) == nil && cfg.Ignition.Version != "" {
+			return UserDataFormatIgnition
+		}
+	case strings.HasPrefix(s, "#cloud-config"), strings.HasPrefix(s, "#!"), strings.HasPrefix(s, "#include"),
+		strings.HasPrefix(s, "## template: jinja"), strings.HasPrefix(strings.ToLower(s), "content-type: multipart/"):
+		return UserDataFormatCloudConfig
+	case talosVersionPattern.MatchString(s) && talosMachinePattern.MatchString(s):
+		return UserDataFormatTalosMachineConfig
+	case bottlerocketSettingsTable.MatchString(s):
+		return UserDataFormatBottlerocketTOML
+	}
+	return ""
+}
+
+// UserDataFormatForOS returns the user data format an OS consumes, for
+// controllers that render user data to set on MachineRequests.
+func UserDataFormatForOS(os OSType) UserDataFormat {
+	switch os {
+	case OSTypeFlatcar:
+		return UserDataFormatIgnition
+	case OSTypeTalos:
+		return UserDataFormatTalosMachineConfig
+	case OSTypeBottlerocket:
+		return UserDataFormatBottlerocketTOML
+	default:
+		return UserDataFormatCloudConfig
+	}
+}
+
+// GetUserDataFormat returns UserDataFormat, or the format detected from
+// UserData when it is not set.
+func (s *MachineRequestSpec) GetUserDataFormat() UserDataFormat {
+	if s.UserDataFormat != "" {
+		return s.UserDataFormat
+	}
+	return DetectUserDataFormat(s.UserData)
+}
+
+// ValidateUserData returns an error if UserData does not match
+// UserDataFormat, or if the format is not set and cannot be detected.
+func (s *MachineRequestSpec) ValidateUserData() error {
+	if s.UserData == "" {
+		return nil
+	}
+	detected := DetectUserDataFormat(s.UserData)
+	switch {
+	case s.UserDataFormat == "" && detected == "":
+		return fmt.Errorf("userData format is not recognized; set userDataFormat")
+	case s.UserDataFormat != "" && detected != s.UserDataFormat:
+		if detected == "" {
+			return fmt.Errorf("userData is not valid %s", s.UserDataFormat)
+		}
+		return fmt.Errorf("userData is %s but userDataFormat is %s", detected, s.UserDataFormat)
+	}
+	return nil
+}
+
+// GetType returns the firmware type, defaulting to UEFI.
+func (f *FirmwareSpec) GetType() FirmwareType {
+	if f.Type == "" {
+		return FirmwareTypeUEFI
+	}
+	return f.Type
+}
+
+// FirmwareCapabilities describes the firmware settings a provider supports.
+// +kubebuilder:object:generate=false
+type FirmwareCapabilities struct {
+	// Types lists the supported firmware types.
+	Types []FirmwareType
+
+	// SecureBoot is true if the provider supports UEFI secure boot.
+	SecureBoot bool
+
+	// TPM is true if the provider supports a virtual TPM.
+	TPM bool
+
+	// TPMRequiresUEFI is true if a virtual TPM is only available with UEFI.
+	TPMRequiresUEFI bool
+}
+
+// providerFirmwareCapabilities lists firmware support per provider. GCP
+// boots every supported image with UEFI. The cloud providers, Nutanix, and
+// vSphere only offer a virtual TPM on UEFI machines.
+var providerFirmwareCapabilities = map[ProviderType]FirmwareCapabilities{
+	ProviderTypeHarvester: {Types: []FirmwareType{FirmwareTypeBIOS, FirmwareTypeUEFI}, SecureBoot: true, TPM: true},
+	ProviderTypeNutanix:   {Types: []FirmwareType{FirmwareTypeBIOS, FirmwareTypeUEFI}, SecureBoot: true, TPM: true, TPMRequiresUEFI: true},
+	ProviderTypeProxmox:   {Types: []FirmwareType{FirmwareTypeBIOS, FirmwareTypeUEFI}, SecureBoot: true, TPM: true},
+	ProviderTypeVSphere:   {Types: []FirmwareType{FirmwareTypeBIOS, FirmwareTypeUEFI}, SecureBoot: true, TPM: true, TPMRequiresUEFI: true},
+	ProviderTypeOpenStack: {Types: []FirmwareType{FirmwareTypeBIOS, FirmwareTypeUEFI}, SecureBoot: true, TPM: true},
+	ProviderTypeBareMetal: {Types: []FirmwareType{FirmwareTypeBIOS, FirmwareTypeUEFI}, SecureBoot: true, TPM: true},
+	ProviderTypeAWS:       {Types: []FirmwareType{FirmwareTypeBIOS, FirmwareTypeUEFI}, SecureBoot: true, TPM: true, TPMRequiresUEFI: true},
+	ProviderTypeAzure:     {Types: []FirmwareType{FirmwareTypeBIOS, FirmwareTypeUEFI}, SecureBoot: true, TPM: true, TPMRequiresUEFI: true},
+	ProviderTypeGCP:       {Types: []FirmwareType{FirmwareTypeUEFI}, SecureBoot: true, TPM: true, TPMRequiresUEFI: true},
+}
+
+// ProviderFirmwareCapabilities returns the firmware capabilities of a
+// provider. ok is false for unknown providers.
+func ProviderFirmwareCapabilities(provider ProviderType) (caps FirmwareCapabilities, ok bool) {
+	caps, ok = providerFirmwareCapabilities[provider]
+	return caps, ok
+}
+
+// ValidateForProvider returns an error if the provider cannot satisfy the
+// firmware settings. Unknown providers are not checked.
+func (f *FirmwareSpec) ValidateForProvider(provider ProviderType) error {
+	if f == nil {
+		return nil
+	}
+	caps, ok := ProviderFirmwareCapabilities(provider)
+	if !ok {
+		return nil
+	}
+	t := f.GetType()
+	if !slices.Contains(caps.Types, t) {
+		return fmt.Errorf("provider %s does not support %s firmware", provider, t)
+	}
+	if f.SecureBoot && (!caps.SecureBoot || t != FirmwareTypeUEFI) {
+		return fmt.Errorf("provider %s does not support secure boot with %s firmware", provider, t)
+	}
+	if f.TPM && (!caps.TPM || (caps.TPMRequiresUEFI && t != FirmwareTypeUEFI)) {
+		return fmt.Errorf("provider %s does not support a virtual TPM with %s firmware", provider, t)
+	}
+	return nil
+}
+
+// GetHostSpread returns the host spread policy. It is None without an
+// anti-affinity group and defaults to Preferred with one.
+func (p *PlacementSpec) GetHostSpread() HostSpreadPolicy {
+	if p == nil || p.AntiAffinityGroup == "" {
+		return HostSpreadNone
+	}
+	if p.HostSpread != "" {
+		return p.HostSpread
+	}
+	return HostSpreadPreferred
+}
+
+// ForProvider returns the device name for the provider, or "" if none is mapped.
+func (m *DeviceProviderMapping) ForProvider(provider ProviderType) string {
+	if m == nil {
+		return ""
+	}
+	switch provider {
+	case ProviderTypeHarvester:
+		return m.Harvester
+	case ProviderTypeNutanix:
+		return m.Nutanix
+	case ProviderTypeProxmox:
+		return m.Proxmox
+	case ProviderTypeVSphere:
+		return m.VSphere
+	case ProviderTypeOpenStack:
+		return m.OpenStack
+	case ProviderTypeAWS:
+		return m.AWS
+	case ProviderTypeAzure:
+		return m.Azure
+	case ProviderTypeGCP:
+		return m.GCP
+	}
+	return ""
+}
+
+// GetCount returns the number of GPUs or vGPU slices, defaulting to 1.
+func (g *GPUDeviceRequest) GetCount() int32 {
+	if g.Count > 0 {
+		return g.Count
+	}
+	return 1
+}
+
+// GetPageSize returns the huge page size, defaulting to 2Mi.
+func (h *HugePagesSpec) GetPageSize() HugePageSize {
+	if h.PageSize != "" {
+		return h.PageSize
+	}
+	return HugePageSize2Mi
+}
+
+// TotalGPUs returns the number of whole GPUs and vGPU slices requested.
+func (d *MachineDevices) TotalGPUs() int32 {
+	if d == nil {
+		return 0
+	}
+	var n int32
+	for i := range d.GPUs {
+		n += d.GPUs[i].GetCount()
+	}
+	return n
+}
+
+// Validate checks that huge pages fit the machine memory in megabytes.
+func (d *MachineDevices) Validate(memoryMB int32) error {
+	if d == nil || d.HugePages == nil {
+		return nil
+	}
+	h := d.HugePages
+	page := resource.MustParse(string(h.GetPageSize()))
+	size := h.Size.Value()
+	if size <= 0 || size%page.Value() != 0 {
+		return fmt.Errorf("hugePages size %s must be a positive multiple of the %s page size", h.Size.String(), h.GetPageSize())
+	}
+	if size > int64(memoryMB)*1024*1024 {
+		return fmt.Errorf("hugePages size %s exceeds machine memory of %dMi", h.Size.String(), memoryMB)
+	}
+	return nil
+}
+
+// IsStatic returns true if the primary address is static or allocated
+// rather than obtained with DHCP.
+func (c *MachineNetworkConfig) IsStatic() bool {
+	return c != nil && (c.Address != "" || c.IPAllocationRef != nil)
+}
+
+// Validate checks that the gateway is on the static address's subnet.
+// Allocated addresses are checked when they are resolved.
+func (c *MachineNetworkConfig) Validate() error {
+	if c == nil || c.Address == "" || c.Gateway == "" {
+		return nil
+	}
+	return checkGateway(c.Address, c.Gateway)
+}
+
+// ResolveAddress returns the primary address in CIDR notation. Static
+// addresses are returned as is. Allocated addresses are read from alloc,
+// which must be the referenced IPAllocation, with the prefix length of
+// poolCIDR. Returns "" for DHCP.
+func (c *MachineNetworkConfig) ResolveAddress(alloc *IPAllocation, poolCIDR string) (string, error) {
+	switch {
+	case c == nil:
+		return "", nil
+	case c.Address != "":
+		return c.Address, nil
+	case c.IPAllocationRef == nil:
+		return "", nil
+	}
+	ref := c.IPAllocationRef
+	if alloc == nil || alloc.Name != ref.Name {
+		return "", fmt.Errorf("IPAllocation %q not provided", ref.Name)
+	}
+	if alloc.Status.Phase != IPAllocationPhaseAllocated {
+		return "", fmt.Errorf("IPAllocation %q is not allocated", ref.Name)
+	}
+	if int(ref.Index) >= len(alloc.Status.Addresses) {
+		return "", fmt.Errorf("IPAllocation %q has %d addresses, index %d is out of range", ref.Name, len(alloc.Status.Addresses), ref.Index)
+	}
+	pool, err := netip.ParsePrefix(poolCIDR)
+	if err != nil {
+		return "", fmt.Errorf("invalid pool CIDR %q: %w", poolCIDR, err)
+	}
+	addr, err := parseAddr(alloc.Status.Addresses[ref.Index])
+	if err != nil {
+		return "", err
+	}
+	if !pool.Contains(addr) {
+		return "", fmt.Errorf("address %s is outside pool %s", addr, poolCIDR)
+	}
+	cidr := netip.PrefixFrom(addr, pool.Bits()).String()
+	if c.Gateway != "" {
+		if err := checkGateway(cidr, c.Gateway); err != nil {
+			return "", err
+		}
+	}
+	return cidr, nil
+}
+
+// checkGateway returns an error if gateway is not on the subnet of cidr.
+func checkGateway(cidr, gateway string) error {
+	p, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return fmt.Errorf("invalid address %q: %w", cidr, err)
+	}
+	gw, err := parseAddr(gateway)
+	if err != nil {
+		return err
+	}
+	if !p.Masked().Contains(gw) {
+		return fmt.Errorf("gateway %s is not on subnet %s", gateway, p.Masked())
+	}
+	return nil
+}
+
+// ValidateForProvider checks that the provider can attach the requested
+// devices. Cloud providers offer GPUs through instance types or
+// accelerator types, so they need a provider mapping and support neither
+// vGPU profiles nor PCI passthrough. Unknown providers are not checked.
+func (d *MachineDevices) ValidateForProvider(provider ProviderType) error {
+	if d == nil {
+		return nil
+	}
+	switch provider {
+	case ProviderTypeAWS, ProviderTypeAzure, ProviderTypeGCP:
+	default:
+		return nil
+	}
+	for i := range d.GPUs {
+		g := &d.GPUs[i]
+		if g.VGPUProfile != "" {
+			return fmt.Errorf("provider %s does not support vGPU profiles (gpus %q)", provider, g.Name)
+		}
+		if g.ProviderMapping.ForProvider(provider) == "" {
+			return fmt.Errorf("gpus %q needs a providerMapping for provider %s", g.Name, provider)
+		}
+	}
+	if len(d.PCIDevices) > 0 {
+		return fmt.Errorf("provider %s does not support PCI passthrough", provider)
+	}
+	return nil
+}
+
 // IsReady returns true if the machine is in the Running phase with an IP address.
 func (mr *MachineRequest) IsReady() bool {
 	return mr.Status.Phase == MachinePhaseRunning && mr.Status.IPAddress != ""
@@ -245,3 +1052,60 @@ func (mr *MachineRequest) SetFailure(reason, message string) {
 	mr.Status.FailureMessage = message
 	mr.SetPhase(MachinePhaseFailed)
 }
+
+// RequestPowerState sets the desired power state and records the request
+// time, so a repeated Restarting request triggers another reboot.
+func (mr *MachineRequest) RequestPowerState(state PowerState, now metav1.Time) {
+	mr.Spec.PowerState = state
+	mr.Spec.PowerStateRequestedAt = &now
+}
+
+// DesiredPowerState returns the power state the machine should converge
+// to. A Restarting request that has already been completed resolves to
+// Running.
+func (mr *MachineRequest) DesiredPowerState() PowerState {
+	switch mr.Spec.PowerState {
+	case "":
+		return PowerStateRunning
+	case PowerStateRestarting:
+		if !mr.IsPowerRequestPending() {
+			return PowerStateRunning
+		}
+	}
+	return mr.Spec.PowerState
+}
+
+// IsPowerRequestPending returns true if the latest power request has not
+// been completed by the controller.
+func (mr *MachineRequest) IsPowerRequestPending() bool {
+	req, obs := mr.Spec.PowerStateRequestedAt, mr.Status.ObservedPowerStateRequestedAt
+	if req == nil {
+		return mr.Spec.PowerState != "" && mr.Spec.PowerState != mr.Status.PowerState
+	}
+	return obs == nil || !obs.Equal(req)
+}
+
+// SetPowerState records the observed power state and, on change, the
+// transition time.
+func (mr *MachineRequest) SetPowerState(state PowerState, now metav1.Time) {
+	if mr.Status.PowerState != state {
+		mr.Status.PowerState = state
+		mr.Status.LastPowerTransitionTime = &now
+	}
+}
+
+// CompletePowerRequest marks the current power request as done.
+func (mr *MachineRequest) CompletePowerRequest() {
+	if mr.Spec.PowerStateRequestedAt == nil {
+		mr.Status.ObservedPowerStateRequestedAt = nil
+		return
+	}
+	t := *mr.Spec.PowerStateRequestedAt
+	mr.Status.ObservedPowerStateRequestedAt = &t
+}
+
+// IsPoweredOn returns true if the machine is observed running. Machines
+// that have not reported a power state are assumed to be on.
+func (mr *MachineRequest) IsPoweredOn() bool {
+	return mr.Status.PowerState == "" || mr.Status.PowerState == PowerStateRunning
+}
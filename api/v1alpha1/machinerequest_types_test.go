@@ -0,0 +1,289 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFirmwareSpecValidateForProvider(t *testing.T) {
+	tests := []struct {
+		name     string
+		firmware *FirmwareSpec
+		provider ProviderType
+		wantErr  string
+	}{
+		{"nil firmware", nil, ProviderTypeGCP, ""},
+		{"default type is UEFI", &FirmwareSpec{SecureBoot: true, TPM: true}, ProviderTypeGCP, ""},
+		{"BIOS on GCP", &FirmwareSpec{Type: FirmwareTypeBIOS}, ProviderTypeGCP, "does not support BIOS firmware"},
+		{"BIOS with TPM on Proxmox", &FirmwareSpec{Type: FirmwareTypeBIOS, TPM: true}, ProviderTypeProxmox, ""},
+		{"BIOS with TPM on AWS", &FirmwareSpec{Type: FirmwareTypeBIOS, TPM: true}, ProviderTypeAWS, "virtual TPM with BIOS"},
+		{"BIOS with TPM on vSphere", &FirmwareSpec{Type: FirmwareTypeBIOS, TPM: true}, ProviderTypeVSphere, "virtual TPM with BIOS"},
+		{"secure boot with BIOS", &FirmwareSpec{Type: FirmwareTypeBIOS, SecureBoot: true}, ProviderTypeHarvester, "secure boot with BIOS"},
+		{"unknown provider", &FirmwareSpec{Type: FirmwareTypeBIOS, TPM: true}, "unknown", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.firmware.ValidateForProvider(tt.provider)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMachineRequestSpecValidateUserData(t *testing.T) {
+	const (
+		cloudConfig = "#cloud-config\nusers:\n  - name: butler\n"
+		ignition    = `{"ignition":{"version":"3.4.0"},"passwd":{}}`
+		talos       = "version: v1alpha1\ndebug: false\nmachine:\n  type: worker\n"
+		bottlerock  = "[settings.kubernetes]\napi-server = \"https://10.0.0.5:6443\"\n"
+	)
+	tests := []struct {
+		name     string
+		data     string
+		format   UserDataFormat
+		detected UserDataFormat
+		wantErr  string
+	}{
+		{"empty", "", "", "", ""},
+		{"cloud-config detected", cloudConfig, "", UserDataFormatCloudConfig, ""},
+		{"ignition detected", ignition, "", UserDataFormatIgnition, ""},
+		{"talos detected", talos, "", UserDataFormatTalosMachineConfig, ""},
+		{"bottlerocket detected", bottlerock, "", UserDataFormatBottlerocketTOML, ""},
+		{"mime multipart", "Content-Type: multipart/mixed; boundary=x\n", "", UserDataFormatCloudConfig, ""},
+		{"explicit match", ignition, UserDataFormatIgnition, UserDataFormatIgnition, ""},
+		{"mismatch", talos, UserDataFormatIgnition, UserDataFormatTalosMachineConfig, "userData is TalosMachineConfig but userDataFormat is Ignition"},
+		{"json without ignition", `{"foo":1}`, UserDataFormatIgnition, "", "not valid Ignition"},
+		{"unrecognized", "hello", "", "", "not recognized"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DetectUserDataFormat(tt.data); got != tt.detected {
+				t.Errorf("DetectUserDataFormat() = %q, want %q", got, tt.detected)
+			}
+			spec := &MachineRequestSpec{UserData: tt.data, UserDataFormat: tt.format}
+			err := spec.ValidateUserData()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMachineDevicesValidate(t *testing.T) {
+	hugePages := func(size string, page HugePageSize) *MachineDevices {
+		return &MachineDevices{HugePages: &HugePagesSpec{Size: resource.MustParse(size), PageSize: page}}
+	}
+	tests := []struct {
+		name     string
+		devices  *MachineDevices
+		memoryMB int32
+		wantErr  string
+	}{
+		{"nil devices", nil, 4096, ""},
+		{"2Mi pages", hugePages("1Gi", ""), 4096, ""},
+		{"1Gi pages", hugePages("4Gi", HugePageSize1Gi), 8192, ""},
+		{"three 1Gi pages", hugePages("3Gi", HugePageSize1Gi), 8192, ""},
+		{"partial page", hugePages("1536Mi", HugePageSize1Gi), 8192, "multiple of the 1Gi page size"},
+		{"exceeds memory", hugePages("8Gi", ""), 4096, "exceeds machine memory"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.devices.Validate(tt.memoryMB)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMachineDevicesValidateForProvider(t *testing.T) {
+	gpu := GPUDeviceRequest{Name: "training", Model: "nvidia-a100-80gb", Count: 2}
+	tests := []struct {
+		name     string
+		devices  *MachineDevices
+		provider ProviderType
+		wantErr  string
+	}{
+		{"passthrough on Harvester", &MachineDevices{GPUs: []GPUDeviceRequest{gpu}}, ProviderTypeHarvester, ""},
+		{"GPU on GCP without mapping", &MachineDevices{GPUs: []GPUDeviceRequest{gpu}}, ProviderTypeGCP, "needs a providerMapping"},
+		{"GPU on GCP with mapping", &MachineDevices{GPUs: []GPUDeviceRequest{{
+			Name: "inference", Model: "nvidia-l4", ProviderMapping: &DeviceProviderMapping{GCP: "nvidia-l4"},
+		}}}, ProviderTypeGCP, ""},
+		{"vGPU on AWS", &MachineDevices{GPUs: []GPUDeviceRequest{{
+			Name: "inference", Model: "nvidia-a10g", VGPUProfile: "grid_a10-4c", ProviderMapping: &DeviceProviderMapping{AWS: "g5.xlarge"},
+		}}}, ProviderTypeAWS, "does not support vGPU profiles"},
+		{"PCI passthrough on Azure", &MachineDevices{PCIDevices: []PCIDeviceRequest{{Name: "nic", DeviceID: "15b3:101e"}}}, ProviderTypeAzure, "PCI passthrough"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.devices.ValidateForProvider(tt.provider)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+	if got := (&MachineDevices{GPUs: []GPUDeviceRequest{gpu, {Name: "small", Model: "nvidia-l4"}}}).TotalGPUs(); got != 3 {
+		t.Errorf("TotalGPUs() = %d, want 3", got)
+	}
+}
+
+func TestPlacementSpecGetHostSpread(t *testing.T) {
+	tests := []struct {
+		name      string
+		placement *PlacementSpec
+		want      HostSpreadPolicy
+	}{
+		{"nil placement", nil, HostSpreadNone},
+		{"no group", &PlacementSpec{HostSpread: HostSpreadRequired}, HostSpreadNone},
+		{"group defaults to Preferred", &PlacementSpec{AntiAffinityGroup: "etcd"}, HostSpreadPreferred},
+		{"explicit Required", &PlacementSpec{AntiAffinityGroup: "etcd", HostSpread: HostSpreadRequired}, HostSpreadRequired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.placement.GetHostSpread(); got != tt.want {
+				t.Errorf("GetHostSpread() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMachineNetworkConfigResolveAddress(t *testing.T) {
+	alloc := &IPAllocation{}
+	alloc.Name = "cp-0-node"
+	alloc.Status.Phase = IPAllocationPhaseAllocated
+	alloc.Status.Addresses = []string{"10.40.0.21", "10.40.0.22"}
+
+	tests := []struct {
+		name    string
+		config  *MachineNetworkConfig
+		want    string
+		wantErr string
+	}{
+		{name: "dhcp", config: nil, want: ""},
+		{name: "static", config: &MachineNetworkConfig{Address: "10.40.0.9/24"}, want: "10.40.0.9/24"},
+		{
+			name:   "allocated",
+			config: &MachineNetworkConfig{IPAllocationRef: &IPAllocationAddressReference{Name: "cp-0-node", Index: 1}, Gateway: "10.40.0.1"},
+			want:   "10.40.0.22/24",
+		},
+		{
+			name:    "index out of range",
+			config:  &MachineNetworkConfig{IPAllocationRef: &IPAllocationAddressReference{Name: "cp-0-node", Index: 2}},
+			wantErr: "out of range",
+		},
+		{
+			name:    "gateway off subnet",
+			config:  &MachineNetworkConfig{IPAllocationRef: &IPAllocationAddressReference{Name: "cp-0-node"}, Gateway: "10.41.0.1"},
+			wantErr: "not on subnet",
+		},
+		{
+			name:    "wrong allocation",
+			config:  &MachineNetworkConfig{IPAllocationRef: &IPAllocationAddressReference{Name: "other"}},
+			wantErr: "not provided",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.config.ResolveAddress(alloc, "10.40.0.0/24")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ResolveAddress() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ResolveAddress() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ResolveAddress() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	pending := alloc.DeepCopy()
+	pending.Status.Phase = IPAllocationPhasePending
+	cfg := &MachineNetworkConfig{IPAllocationRef: &IPAllocationAddressReference{Name: "cp-0-node"}}
+	if _, err := cfg.ResolveAddress(pending, "10.40.0.0/24"); err == nil {
+		t.Error("ResolveAddress() succeeded for a pending allocation")
+	}
+}
+
+func TestMachineRequestPowerState(t *testing.T) {
+	mr := &MachineRequest{}
+	if got := mr.DesiredPowerState(); got != PowerStateRunning {
+		t.Fatalf("DesiredPowerState() = %q, want Running by default", got)
+	}
+
+	t0 := metav1.Unix(1000, 0)
+	mr.RequestPowerState(PowerStateRestarting, t0)
+	if !mr.IsPowerRequestPending() || mr.DesiredPowerState() != PowerStateRestarting {
+		t.Fatalf("restart request not pending: desired %q", mr.DesiredPowerState())
+	}
+
+	mr.SetPowerState(PowerStateRestarting, t0)
+	mr.SetPowerState(PowerStateRunning, metav1.Unix(1060, 0))
+	mr.CompletePowerRequest()
+	if mr.IsPowerRequestPending() {
+		t.Error("IsPowerRequestPending() = true after the restart completed")
+	}
+	if got := mr.DesiredPowerState(); got != PowerStateRunning {
+		t.Errorf("DesiredPowerState() = %q after restart, want Running", got)
+	}
+	if got := mr.Status.LastPowerTransitionTime; got == nil || got.Unix() != 1060 {
+		t.Errorf("LastPowerTransitionTime = %v, want 1060", got)
+	}
+
+	mr.RequestPowerState(PowerStateRestarting, metav1.Unix(2000, 0))
+	if !mr.IsPowerRequestPending() {
+		t.Error("second restart request not pending")
+	}
+
+	mr.RequestPowerState(PowerStateStopped, metav1.Unix(3000, 0))
+	if got := mr.DesiredPowerState(); got != PowerStateStopped {
+		t.Errorf("DesiredPowerState() = %q, want Stopped", got)
+	}
+}
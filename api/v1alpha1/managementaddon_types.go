@@ -48,6 +48,8 @@ type ManagementAddonSpec struct {
 
 	// Values are the Helm values to use for installation.
 	// These are merged with any default values from the AddonDefinition.
+	// String values may reference cluster variables as ${butler.<name>}
+	// (e.g., ${butler.vip}); see ValuesContext for the full list.
 	// +optional
 	// +kubebuilder:pruning:PreserveUnknownFields
 	Values *runtime.RawExtension `json:"values,omitempty"`
@@ -91,7 +93,7 @@ type ManagementAddonStatus struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:scope=Cluster,shortName=ma;maddon
+// +kubebuilder:resource:scope=Cluster,shortName=ma;maddon,categories=butler
 // +kubebuilder:printcolumn:name="Addon",type="string",JSONPath=".spec.addon",description="Addon name"
 // +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".spec.version",description="Requested version"
 // +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Current phase"
@@ -17,6 +17,8 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"fmt"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -24,7 +26,8 @@ import (
 type ReservedRange struct {
 	// CIDR is the reserved range in CIDR notation.
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:Pattern=`^(\d{1,3}\.){3}\d{1,3}/\d{1,2}$`
+	// +kubebuilder:validation:MaxLength=43
+	// +kubebuilder:validation:XValidation:rule="isCIDR(self)",message="must be a valid CIDR"
 	CIDR string `json:"cidr"`
 
 	// Description explains why this range is reserved.
@@ -48,13 +51,18 @@ type TenantAllocationDefaults struct {
 }
 
 // TenantAllocationConfig defines the allocatable sub-range and defaults.
+// +kubebuilder:validation:XValidation:rule="!isIP(self.start) || !isIP(self.end) || ip(self.start).family() == ip(self.end).family()",message="start and end must be the same IP family"
 type TenantAllocationConfig struct {
 	// Start is the first allocatable IP address.
 	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=39
+	// +kubebuilder:validation:XValidation:rule="isIP(self)",message="must be a valid IP address"
 	Start string `json:"start"`
 
 	// End is the last allocatable IP address.
 	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=39
+	// +kubebuilder:validation:XValidation:rule="isIP(self)",message="must be a valid IP address"
 	End string `json:"end"`
 
 	// Defaults defines default allocation sizes per tenant.
@@ -62,14 +70,197 @@ type TenantAllocationConfig struct {
 	Defaults TenantAllocationDefaults `json:"defaults,omitempty"`
 }
 
+// AllocationStrategyType selects how the allocator places new allocations.
+// +kubebuilder:validation:Enum=FirstFit;BestFit;Random
+type AllocationStrategyType string
+
+const (
+	// AllocationStrategyFirstFit places allocations in the first free block
+	// large enough, packing the pool from the start of the range.
+	AllocationStrategyFirstFit AllocationStrategyType = "FirstFit"
+
+	// AllocationStrategyBestFit places allocations in the smallest free block
+	// large enough, keeping large blocks available and reducing fragmentation.
+	AllocationStrategyBestFit AllocationStrategyType = "BestFit"
+
+	// AllocationStrategyRandom places allocations at a random free position,
+	// reducing conflicts with addresses handed out by external DHCP servers.
+	AllocationStrategyRandom AllocationStrategyType = "Random"
+)
+
+// AllocationStrategy configures how the allocator places allocations in the pool.
+// +kubebuilder:validation:XValidation:rule="!has(self.firstFit) || !has(self.type) || self.type == 'FirstFit'",message="firstFit may only be set when type is FirstFit"
+// +kubebuilder:validation:XValidation:rule="!has(self.bestFit) || (has(self.type) && self.type == 'BestFit')",message="bestFit may only be set when type is BestFit"
+// +kubebuilder:validation:XValidation:rule="!has(self.random) || (has(self.type) && self.type == 'Random')",message="random may only be set when type is Random"
+type AllocationStrategy struct {
+	// Type of allocation strategy.
+	// +kubebuilder:default=FirstFit
+	// +optional
+	Type AllocationStrategyType `json:"type,omitempty"`
+
+	// Alignment aligns the first address of each allocation to a multiple
+	// of this many addresses (e.g., 8 places allocations on /29 boundaries).
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=256
+	// +optional
+	Alignment int32 `json:"alignment,omitempty"`
+
+	// FirstFit configures the FirstFit strategy.
+	// +optional
+	FirstFit *FirstFitStrategy `json:"firstFit,omitempty"`
+
+	// BestFit configures the BestFit strategy.
+	// +optional
+	BestFit *BestFitStrategy `json:"bestFit,omitempty"`
+
+	// Random configures the Random strategy.
+	// +optional
+	Random *RandomStrategy `json:"random,omitempty"`
+}
+
+// FirstFitStrategy configures first-fit allocation.
+type FirstFitStrategy struct {
+	// Reverse packs the pool from the end of the range instead of the start.
+	// Useful when the low end of the range is shared with static assignments.
+	// +optional
+	Reverse bool `json:"reverse,omitempty"`
+}
+
+// BestFitStrategy configures best-fit allocation.
+type BestFitStrategy struct {
+	// MinRemainder avoids leaving free slivers smaller than this many
+	// addresses. A block that would leave a non-zero remainder below
+	// MinRemainder is only used when no other block fits.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinRemainder int32 `json:"minRemainder,omitempty"`
+}
+
+// RandomStrategy configures random allocation.
+type RandomStrategy struct {
+	// Attempts is the number of distinct random positions offered to the
+	// controller before it gives up. Positions that fail verification are
+	// skipped.
+	// +kubebuilder:default=8
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=64
+	// +optional
+	Attempts int32 `json:"attempts,omitempty"`
+}
+
+// IPVerificationMode selects how the allocator checks that free addresses
+// are not already in use before assigning them.
+// +kubebuilder:validation:Enum=icmp;arp;off
+type IPVerificationMode string
+
+const (
+	// IPVerificationICMP pings each candidate address. Works across routed
+	// networks but misses hosts that drop ICMP.
+	IPVerificationICMP IPVerificationMode = "icmp"
+
+	// IPVerificationARP sends ARP requests for each candidate address.
+	// Detects hosts that drop ICMP but requires the controller to be on the
+	// pool's layer 2 segment.
+	IPVerificationARP IPVerificationMode = "arp"
+
+	// IPVerificationOff assigns free addresses without checking them.
+	IPVerificationOff IPVerificationMode = "off"
+)
+
+// IPVerificationResultType is the outcome of verifying a single address.
+// +kubebuilder:validation:Enum=Free;InUse;Error
+type IPVerificationResultType string
+
+const (
+	// IPVerificationResultFree indicates nothing answered for the address.
+	IPVerificationResultFree IPVerificationResultType = "Free"
+
+	// IPVerificationResultInUse indicates a device answered for the address.
+	IPVerificationResultInUse IPVerificationResultType = "InUse"
+
+	// IPVerificationResultError indicates the check could not be performed.
+	IPVerificationResultError IPVerificationResultType = "Error"
+)
+
+// IPVerificationResult records the verification of a single address.
+type IPVerificationResult struct {
+	// Address is the verified IP address.
+	// +kubebuilder:validation:Required
+	Address string `json:"address"`
+
+	// Method is the verification method used.
+	// +kubebuilder:validation:Required
+	Method IPVerificationMode `json:"method"`
+
+	// Result is the outcome of the check.
+	// +kubebuilder:validation:Required
+	Result IPVerificationResultType `json:"result"`
+
+	// MACAddress is the hardware address that answered an ARP request.
+	// +optional
+	MACAddress string `json:"macAddress,omitempty"`
+
+	// CheckedAt is when the address was verified.
+	// +optional
+	CheckedAt *metav1.Time `json:"checkedAt,omitempty"`
+
+	// Message provides detail for InUse and Error results.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// StaticIPAssignment records addresses already in use outside Butler, such
+// as by a legacy cluster, so the allocator never hands them out.
+// +kubebuilder:validation:XValidation:rule="!has(self.tenantClusterRef) || has(self.type)",message="type is required when tenantClusterRef is set"
+type StaticIPAssignment struct {
+	// Name identifies the assignment within the pool.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// Range is the assigned address range.
+	// +kubebuilder:validation:Required
+	Range PinnedIPRange `json:"range"`
+
+	// Owner describes who uses the addresses (e.g., "legacy-prod VMs, ticket OPS-142").
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Owner string `json:"owner"`
+
+	// TenantClusterRef identifies the TenantCluster the addresses belong to
+	// once the legacy cluster is adopted. Required to migrate the assignment
+	// to a pinned IPAllocation.
+	// +optional
+	TenantClusterRef *NamespacedObjectReference `json:"tenantClusterRef,omitempty"`
+
+	// Type is the allocation type to use when migrating the assignment.
+	// +optional
+	Type IPAllocationType `json:"type,omitempty"`
+}
+
 // NetworkPoolSpec defines the desired state of NetworkPool.
+// +kubebuilder:validation:XValidation:rule="!isCIDR(self.cidr) || (has(self.addressFamily) && self.addressFamily == 'IPv6') == (cidr(self.cidr).ip().family() == 6)",message="cidr must match addressFamily"
+// +kubebuilder:validation:XValidation:rule="!isCIDR(self.cidr) || !has(self.tenantAllocation) || !isIP(self.tenantAllocation.start) || !isIP(self.tenantAllocation.end) || (cidr(self.cidr).containsIP(self.tenantAllocation.start) && cidr(self.cidr).containsIP(self.tenantAllocation.end))",message="tenantAllocation range must be within cidr"
+// +kubebuilder:validation:XValidation:rule="!isCIDR(self.cidr) || !has(self.reserved) || self.reserved.all(r, !isCIDR(r.cidr) || cidr(self.cidr).containsCIDR(r.cidr))",message="reserved ranges must be within cidr"
+// +kubebuilder:validation:XValidation:rule="!isCIDR(self.cidr) || !has(self.staticAssignments) || self.staticAssignments.all(a, !isIP(a.range.startAddress) || !isIP(a.range.endAddress) || (cidr(self.cidr).containsIP(a.range.startAddress) && cidr(self.cidr).containsIP(a.range.endAddress)))",message="static assignments must be within cidr"
 type NetworkPoolSpec struct {
 	// CIDR is the network range in CIDR notation.
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:Pattern=`^(\d{1,3}\.){3}\d{1,3}/\d{1,2}$`
+	// +kubebuilder:validation:MaxLength=43
+	// +kubebuilder:validation:XValidation:rule="isCIDR(self)",message="must be a valid CIDR"
 	CIDR string `json:"cidr"`
 
+	// AddressFamily is the IP family of the pool. IPv6 pools allocate from
+	// the first /96 of CIDR.
+	// +kubebuilder:default=IPv4
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="addressFamily is immutable"
+	// +optional
+	AddressFamily IPFamily `json:"addressFamily,omitempty"`
+
 	// Reserved defines ranges excluded from allocation.
+	// +kubebuilder:validation:MaxItems=64
 	// +optional
 	Reserved []ReservedRange `json:"reserved,omitempty"`
 
@@ -77,6 +268,38 @@ type NetworkPoolSpec struct {
 	// If not specified, the entire CIDR (minus reserved ranges) is allocatable.
 	// +optional
 	TenantAllocation *TenantAllocationConfig `json:"tenantAllocation,omitempty"`
+
+	// StaticAssignments lists addresses already in use outside Butler.
+	// They are excluded from allocation without creating IPAllocations and
+	// can later be migrated to pinned IPAllocations.
+	// +kubebuilder:validation:MaxItems=256
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	StaticAssignments []StaticIPAssignment `json:"staticAssignments,omitempty"`
+
+	// AllocationStrategy controls where new allocations are placed.
+	// Defaults to FirstFit. Changing the strategy only affects new allocations.
+	// +optional
+	AllocationStrategy *AllocationStrategy `json:"allocationStrategy,omitempty"`
+
+	// VerifyBeforeAllocate checks candidate addresses before assigning them.
+	// Addresses that answer are recorded in status.conflicts and skipped.
+	// +kubebuilder:default="off"
+	// +optional
+	VerifyBeforeAllocate IPVerificationMode `json:"verifyBeforeAllocate,omitempty"`
+
+	// Scope restricts which teams may allocate from this pool.
+	// Defaults to platform, which allows every team.
+	// +optional
+	Scope *ProviderConfigScope `json:"scope,omitempty"`
+
+	// ProviderRefs restricts which ProviderConfigs may reference this pool
+	// in spec.network.poolRefs. If empty, any ProviderConfig may. Namespace
+	// defaults to the pool's namespace.
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	ProviderRefs []ProviderReference `json:"providerRefs,omitempty"`
 }
 
 // NetworkPoolStatus defines the observed state of NetworkPool.
@@ -99,6 +322,12 @@ type NetworkPoolStatus struct {
 	// +optional
 	AvailableIPs int32 `json:"availableIPs,omitempty"`
 
+	// UtilizationPercent is the share of usable IPs that are allocated (0-100).
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	UtilizationPercent *int32 `json:"utilizationPercent,omitempty"`
+
 	// AllocationCount is the total number of IPAllocations from this pool.
 	// +optional
 	AllocationCount int32 `json:"allocationCount,omitempty"`
@@ -113,21 +342,40 @@ type NetworkPoolStatus struct {
 	// +optional
 	LargestFreeBlock int32 `json:"largestFreeBlock,omitempty"`
 
+	// Conflicts lists unallocated addresses found in use by verification.
+	// They are excluded from allocation until they verify as free again or
+	// are removed from this list by an operator.
+	// +optional
+	// +listType=map
+	// +listMapKey=address
+	Conflicts []IPVerificationResult `json:"conflicts,omitempty"`
+
 	// ObservedGeneration is the last observed generation.
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Reconcile reports controller reconcile diagnostics.
+	// +optional
+	Reconcile *ReconcileStatus `json:"reconcile,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:shortName=np
+// +kubebuilder:resource:shortName=np,categories=butler
 // +kubebuilder:printcolumn:name="CIDR",type="string",JSONPath=".spec.cidr",description="Network CIDR"
+// +kubebuilder:printcolumn:name="Scope",type="string",JSONPath=".spec.scope.type",description="Visibility scope"
+// +kubebuilder:printcolumn:name="Strategy",type="string",JSONPath=".spec.allocationStrategy.type",description="Allocation strategy",priority=1
 // +kubebuilder:printcolumn:name="Available",type="integer",JSONPath=".status.availableIPs",description="Available IPs"
 // +kubebuilder:printcolumn:name="Allocated",type="integer",JSONPath=".status.allocatedIPs",description="Allocated IPs"
 // +kubebuilder:printcolumn:name="Total",type="integer",JSONPath=".status.totalIPs",description="Total usable IPs"
+// +kubebuilder:printcolumn:name="Used%",type="integer",JSONPath=".status.utilizationPercent",description="Allocated share of usable IPs"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
-// NetworkPool defines a platform-level IP pool for on-prem IPAM.
+// NetworkPool defines an IP pool for on-prem IPAM. Pools are available to
+// every team unless scoped to a single team, and to every ProviderConfig
+// unless restricted with providerRefs. The admission webhook rejects
+// IPAllocations and ProviderConfigs that the pool does not allow, and
+// changes to spec.cidr while allocations from the pool exist.
 type NetworkPool struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -148,3 +396,134 @@ type NetworkPoolList struct {
 func init() {
 	SchemeBuilder.Register(&NetworkPool{}, &NetworkPoolList{})
 }
+
+// Helper methods
+
+// GetType returns the strategy type, defaulting to FirstFit.
+func (s *AllocationStrategy) GetType() AllocationStrategyType {
+	if s == nil || s.Type == "" {
+		return AllocationStrategyFirstFit
+	}
+	return s.Type
+}
+
+// GetAlignment returns the allocation alignment, defaulting to 1.
+func (s *AllocationStrategy) GetAlignment() uint32 {
+	if s == nil || s.Alignment < 1 {
+		return 1
+	}
+	return uint32(s.Alignment)
+}
+
+// GetVerifyBeforeAllocate returns the verification mode, defaulting to off.
+func (p *NetworkPool) GetVerifyBeforeAllocate() IPVerificationMode {
+	if p.Spec.VerifyBeforeAllocate == "" {
+		return IPVerificationOff
+	}
+	return p.Spec.VerifyBeforeAllocate
+}
+
+// GetRandomAttempts returns the number of random positions to offer,
+// defaulting to 8.
+func (s *AllocationStrategy) GetRandomAttempts() int {
+	if s == nil || s.Random == nil || s.Random.Attempts < 1 {
+		return 8
+	}
+	return int(s.Random.Attempts)
+}
+
+// ComputeUtilizationPercent returns the share of usable IPs that are
+// allocated, rounded down, or nil if the pool has no usable IPs.
+func (s *NetworkPoolStatus) ComputeUtilizationPercent() *int32 {
+	if s.TotalIPs <= 0 {
+		return nil
+	}
+	pct := int32(int64(s.AllocatedIPs) * 100 / int64(s.TotalIPs))
+	pct = min(max(pct, 0), 100)
+	return &pct
+}
+
+// AllowsTeam returns true if the named team may allocate from the pool.
+// An empty team is only allowed on platform-scoped pools.
+func (p *NetworkPool) AllowsTeam(team string) bool {
+	scope := p.Spec.Scope
+	if scope == nil || scope.Type != ProviderConfigScopeTeam {
+		return true
+	}
+	return scope.TeamRef != nil && scope.TeamRef.Name == team
+}
+
+// AllowsProvider returns true if the ProviderConfig may reference the pool.
+func (p *NetworkPool) AllowsProvider(name, namespace string) bool {
+	if len(p.Spec.ProviderRefs) == 0 {
+		return true
+	}
+	for _, ref := range p.Spec.ProviderRefs {
+		ns := ref.Namespace
+		if ns == "" {
+			ns = p.Namespace
+		}
+		if ref.Name == name && ns == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckAllocation returns an error if team, allocating through the named
+// ProviderConfig, may not allocate from the pool.
+func (p *NetworkPool) CheckAllocation(team, providerName, providerNamespace string) error {
+	if !p.AllowsTeam(team) {
+		return fmt.Errorf("network pool %s is not available to team %q", p.Name, team)
+	}
+	if !p.AllowsProvider(providerName, providerNamespace) {
+		return fmt.Errorf("network pool %s does not allow provider %s/%s", p.Name, providerNamespace, providerName)
+	}
+	return nil
+}
+
+// GetStaticAssignment returns the named static assignment, or nil if not found.
+func (p *NetworkPool) GetStaticAssignment(name string) *StaticIPAssignment {
+	for i := range p.Spec.StaticAssignments {
+		if p.Spec.StaticAssignments[i].Name == name {
+			return &p.Spec.StaticAssignments[i]
+		}
+	}
+	return nil
+}
+
+// MigrateStaticAssignment returns a pinned IPAllocation equivalent to the
+// named static assignment. The caller creates the IPAllocation and removes
+// the assignment from the pool once the allocation is Allocated; until then
+// both exclude the same addresses. CheckPinnedRange ignores the assignment
+// when checking the allocation's range, so it does not conflict with the
+// addresses it is replacing.
+func (p *NetworkPool) MigrateStaticAssignment(name string) (*IPAllocation, error) {
+	a := p.GetStaticAssignment(name)
+	if a == nil {
+		return nil, fmt.Errorf("network pool %s has no static assignment %q", p.Name, name)
+	}
+	if a.TenantClusterRef == nil || a.Type == "" {
+		return nil, fmt.Errorf("static assignment %q needs tenantClusterRef and type to be migrated", name)
+	}
+	r := a.Range
+	return &IPAllocation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      p.Name + "-" + a.Name,
+			Namespace: p.Namespace,
+			Labels: map[string]string{
+				LabelNetworkPool:    p.Name,
+				LabelAllocationType: string(a.Type),
+			},
+			Annotations: map[string]string{
+				AnnotationDescription: a.Owner,
+			},
+		},
+		Spec: IPAllocationSpec{
+			PoolRef:          LocalObjectReference{Name: p.Name},
+			TenantClusterRef: a.TenantClusterRef.DeepCopy(),
+			Type:             a.Type,
+			PinnedRange:      &r,
+		},
+	}, nil
+}
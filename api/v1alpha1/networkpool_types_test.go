@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNetworkPoolCheckAllocation(t *testing.T) {
+	pool := func(scope *ProviderConfigScope, refs ...ProviderReference) *NetworkPool {
+		return &NetworkPool{
+			ObjectMeta: metav1.ObjectMeta{Name: "lab", Namespace: "butler-system"},
+			Spec:       NetworkPoolSpec{CIDR: "10.40.0.0/24", Scope: scope, ProviderRefs: refs},
+		}
+	}
+	teamA := &ProviderConfigScope{Type: ProviderConfigScopeTeam, TeamRef: &LocalObjectReference{Name: "team-a"}}
+
+	tests := []struct {
+		name      string
+		pool      *NetworkPool
+		team      string
+		provider  string
+		namespace string
+		wantErr   bool
+	}{
+		{name: "unscoped pool", pool: pool(nil), team: "team-b", provider: "harvester", namespace: "butler-system"},
+		{name: "platform scope", pool: pool(&ProviderConfigScope{Type: ProviderConfigScopePlatform}), team: "team-b", provider: "harvester", namespace: "butler-system"},
+		{name: "owning team", pool: pool(teamA), team: "team-a", provider: "harvester", namespace: "butler-system"},
+		{name: "other team", pool: pool(teamA), team: "team-b", provider: "harvester", namespace: "butler-system", wantErr: true},
+		{name: "no team on team pool", pool: pool(teamA), provider: "harvester", namespace: "butler-system", wantErr: true},
+		{name: "listed provider defaults namespace", pool: pool(nil, ProviderReference{Name: "harvester"}), provider: "harvester", namespace: "butler-system"},
+		{name: "listed provider in other namespace", pool: pool(nil, ProviderReference{Name: "harvester", Namespace: "team-a"}), provider: "harvester", namespace: "butler-system", wantErr: true},
+		{name: "unlisted provider", pool: pool(nil, ProviderReference{Name: "nutanix"}), provider: "harvester", namespace: "butler-system", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.pool.CheckAllocation(tt.team, tt.provider, tt.namespace)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CheckAllocation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNetworkPoolMigrateStaticAssignment(t *testing.T) {
+	pool := &NetworkPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "lab", Namespace: "butler-system"},
+		Spec: NetworkPoolSpec{
+			CIDR: "10.40.0.0/24",
+			StaticAssignments: []StaticIPAssignment{
+				{
+					Name:             "legacy-prod",
+					Range:            PinnedIPRange{StartAddress: "10.40.0.10", EndAddress: "10.40.0.14"},
+					Owner:            "legacy prod cluster",
+					TenantClusterRef: &NamespacedObjectReference{Name: "prod", Namespace: "team-a"},
+					Type:             IPAllocationTypeNodes,
+				},
+				{Name: "printers", Range: PinnedIPRange{StartAddress: "10.40.0.20", EndAddress: "10.40.0.21"}, Owner: "facilities"},
+			},
+		},
+	}
+
+	alloc, err := pool.MigrateStaticAssignment("legacy-prod")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if alloc.Name != "lab-legacy-prod" || alloc.Namespace != "butler-system" {
+		t.Errorf("IPAllocation = %s/%s, want butler-system/lab-legacy-prod", alloc.Namespace, alloc.Name)
+	}
+	if r := alloc.Spec.PinnedRange; r == nil || r.StartAddress != "10.40.0.10" || r.EndAddress != "10.40.0.14" {
+		t.Errorf("PinnedRange = %v, want 10.40.0.10-10.40.0.14", r)
+	}
+	if alloc.Spec.TenantClusterRef == nil || alloc.Spec.TenantClusterRef.Name != "prod" || alloc.Spec.Type != IPAllocationTypeNodes {
+		t.Errorf("Spec = %+v, want tenantClusterRef prod and type nodes", alloc.Spec)
+	}
+
+	if _, err := pool.MigrateStaticAssignment("printers"); err == nil {
+		t.Error("MigrateStaticAssignment() without tenantClusterRef succeeded, want error")
+	}
+	if _, err := pool.MigrateStaticAssignment("missing"); err == nil {
+		t.Error("MigrateStaticAssignment() for unknown assignment succeeded, want error")
+	}
+}
+
+func TestNetworkPoolComputeUtilizationPercent(t *testing.T) {
+	if got := (&NetworkPoolStatus{}).ComputeUtilizationPercent(); got != nil {
+		t.Errorf("empty pool = %d, want nil", *got)
+	}
+	s := &NetworkPoolStatus{TotalIPs: 240, AllocatedIPs: 61}
+	if got := s.ComputeUtilizationPercent(); got == nil || *got != 25 {
+		t.Errorf("ComputeUtilizationPercent() = %v, want 25", got)
+	}
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NodeOperationType is a maintenance operation on a tenant node.
+// +kubebuilder:validation:Enum=Cordon;Uncordon;Drain;Reboot
+type NodeOperationType string
+
+const (
+	// NodeOperationCordon marks the node unschedulable.
+	NodeOperationCordon NodeOperationType = "Cordon"
+
+	// NodeOperationUncordon marks the node schedulable again.
+	NodeOperationUncordon NodeOperationType = "Uncordon"
+
+	// NodeOperationDrain cordons the node and evicts its pods. The node
+	// stays cordoned afterwards.
+	NodeOperationDrain NodeOperationType = "Drain"
+
+	// NodeOperationReboot cordons and drains the node, reboots it through
+	// Talos or the infrastructure provider, waits for it to become Ready,
+	// and uncordons it.
+	NodeOperationReboot NodeOperationType = "Reboot"
+)
+
+// NodeOperationPhase represents the current phase of a NodeOperation.
+// +kubebuilder:validation:Enum=Pending;Cordoning;Draining;Rebooting;Uncordoning;Succeeded;Failed
+type NodeOperationPhase string
+
+const (
+	// NodeOperationPhasePending indicates the operation has not started.
+	NodeOperationPhasePending NodeOperationPhase = "Pending"
+
+	// NodeOperationPhaseCordoning indicates the node is being cordoned.
+	NodeOperationPhaseCordoning NodeOperationPhase = "Cordoning"
+
+	// NodeOperationPhaseDraining indicates pods are being evicted.
+	NodeOperationPhaseDraining NodeOperationPhase = "Draining"
+
+	// NodeOperationPhaseRebooting indicates the node is rebooting.
+	NodeOperationPhaseRebooting NodeOperationPhase = "Rebooting"
+
+	// NodeOperationPhaseUncordoning indicates the node is being uncordoned.
+	NodeOperationPhaseUncordoning NodeOperationPhase = "Uncordoning"
+
+	// NodeOperationPhaseSucceeded indicates the operation completed.
+	NodeOperationPhaseSucceeded NodeOperationPhase = "Succeeded"
+
+	// NodeOperationPhaseFailed indicates the operation failed.
+	NodeOperationPhaseFailed NodeOperationPhase = "Failed"
+)
+
+// NodeOperationSpec defines the desired state of NodeOperation.
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec is immutable"
+// +kubebuilder:validation:XValidation:rule="!has(self.drain) || self.operation == 'Drain' || self.operation == 'Reboot'",message="drain may only be set for Drain and Reboot operations"
+type NodeOperationSpec struct {
+	// ClusterRef references the TenantCluster in the same namespace.
+	// +kubebuilder:validation:Required
+	ClusterRef LocalObjectReference `json:"clusterRef"`
+
+	// NodeName is the name of the worker node in the tenant cluster.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	NodeName string `json:"nodeName"`
+
+	// Operation to perform.
+	// +kubebuilder:validation:Required
+	Operation NodeOperationType `json:"operation"`
+
+	// Drain overrides the cluster's spec.workers.drain policy for this
+	// operation. Fields left unset fall back to the cluster policy.
+	// +optional
+	Drain *DrainPolicy `json:"drain,omitempty"`
+
+	// Reason explains why the operation is needed. Recorded in events.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// TTLAfterCompletion deletes the NodeOperation this long after it
+	// succeeds or fails.
+	// +kubebuilder:default="24h"
+	// +optional
+	TTLAfterCompletion *metav1.Duration `json:"ttlAfterCompletion,omitempty"`
+}
+
+// NodeOperationStatus defines the observed state of NodeOperation.
+type NodeOperationStatus struct {
+	// Conditions represent the latest available observations.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Phase represents the current phase of the operation.
+	// +optional
+	Phase NodeOperationPhase `json:"phase,omitempty"`
+
+	// PodsToEvict is the number of pods selected for eviction.
+	// +optional
+	PodsToEvict int32 `json:"podsToEvict,omitempty"`
+
+	// PodsEvicted is the number of pods evicted so far.
+	// +optional
+	PodsEvicted int32 `json:"podsEvicted,omitempty"`
+
+	// BlockedPods lists pods whose eviction is blocked, as "namespace/name".
+	// +optional
+	BlockedPods []string `json:"blockedPods,omitempty"`
+
+	// StartTime is when the operation started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is when the operation finished.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Message provides human-readable status information.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// ObservedGeneration is the last observed generation.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=nop,categories=butler
+// +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".spec.clusterRef.name",description="Tenant cluster"
+// +kubebuilder:printcolumn:name="Node",type="string",JSONPath=".spec.nodeName",description="Target node"
+// +kubebuilder:printcolumn:name="Operation",type="string",JSONPath=".spec.operation",description="Operation"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Operation phase"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// NodeOperation cordons, drains, or reboots a single tenant worker node
+// through the management plane, so operators do not need the tenant
+// kubeconfig. NodeOperations are created in the TenantCluster's namespace.
+// Only one NodeOperation runs per node at a time; later ones stay Pending.
+type NodeOperation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NodeOperationSpec   `json:"spec,omitempty"`
+	Status NodeOperationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NodeOperationList contains a list of NodeOperation.
+type NodeOperationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NodeOperation `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NodeOperation{}, &NodeOperationList{})
+}
+
+// Helper methods
+
+// IsComplete returns true if the operation has finished.
+func (o *NodeOperation) IsComplete() bool {
+	return o.Status.Phase == NodeOperationPhaseSucceeded || o.Status.Phase == NodeOperationPhaseFailed
+}
+
+// RequiresDrain returns true if the operation evicts pods.
+func (o *NodeOperation) RequiresDrain() bool {
+	return o.Spec.Operation == NodeOperationDrain || o.Spec.Operation == NodeOperationReboot
+}
+
+// GetDrainPolicy merges the operation's drain overrides over the cluster
+// policy. Either may be nil.
+func (o *NodeOperation) GetDrainPolicy(cluster *DrainPolicy) *DrainPolicy {
+	merged := &DrainPolicy{}
+	if cluster != nil {
+		merged = cluster.DeepCopy()
+	}
+	d := o.Spec.Drain
+	if d == nil {
+		return merged
+	}
+	if d.GracePeriod != nil {
+		merged.GracePeriod = d.GracePeriod
+	}
+	if d.IgnoreDaemonSets != nil {
+		merged.IgnoreDaemonSets = d.IgnoreDaemonSets
+	}
+	if d.DeleteEmptyDirData != nil {
+		merged.DeleteEmptyDirData = d.DeleteEmptyDirData
+	}
+	if d.Timeout != nil {
+		merged.Timeout = d.Timeout
+	}
+	if d.PDBViolation != "" {
+		merged.PDBViolation = d.PDBViolation
+	}
+	return merged
+}
+
+// IsExpired returns true if the operation finished more than its TTL ago.
+func (o *NodeOperation) IsExpired(now time.Time) bool {
+	if !o.IsComplete() || o.Status.CompletionTime == nil {
+		return false
+	}
+	ttl := 24 * time.Hour
+	if o.Spec.TTLAfterCompletion != nil {
+		ttl = o.Spec.TTLAfterCompletion.Duration
+	}
+	return !now.Before(o.Status.CompletionTime.Add(ttl))
+}
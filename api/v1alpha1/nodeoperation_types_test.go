@@ -0,0 +1,52 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNodeOperationGetDrainPolicy(t *testing.T) {
+	yes := true
+	cluster := &DrainPolicy{
+		Timeout:      &metav1.Duration{Duration: 20 * time.Minute},
+		PDBViolation: PDBViolationPolicyAbort,
+	}
+	op := &NodeOperation{Spec: NodeOperationSpec{
+		Operation: NodeOperationDrain,
+		Drain:     &DrainPolicy{DeleteEmptyDirData: &yes, PDBViolation: PDBViolationPolicyForce},
+	}}
+
+	got := op.GetDrainPolicy(cluster)
+	if got.GetTimeout() != 20*time.Minute {
+		t.Errorf("GetTimeout() = %s, want cluster timeout 20m", got.GetTimeout())
+	}
+	if !got.ShouldDeleteEmptyDirData() || got.GetPDBViolationPolicy() != PDBViolationPolicyForce {
+		t.Errorf("GetDrainPolicy() = %+v, want operation overrides applied", got)
+	}
+	if cluster.PDBViolation != PDBViolationPolicyAbort {
+		t.Error("GetDrainPolicy() modified the cluster policy")
+	}
+
+	op.Spec.Drain = nil
+	if got := op.GetDrainPolicy(nil); got.GetTimeout() != 10*time.Minute {
+		t.Errorf("GetDrainPolicy(nil) timeout = %s, want default 10m", got.GetTimeout())
+	}
+}
@@ -0,0 +1,419 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NodePoolPhase represents the current phase of a NodePool.
+// +kubebuilder:validation:Enum=Pending;Provisioning;Scaling;Updating;Ready;Failed;Deleting
+type NodePoolPhase string
+
+const (
+	// NodePoolPhasePending indicates the pool is waiting for its TenantCluster.
+	NodePoolPhasePending NodePoolPhase = "Pending"
+
+	// NodePoolPhaseProvisioning indicates the pool's first machines are being created.
+	NodePoolPhaseProvisioning NodePoolPhase = "Provisioning"
+
+	// NodePoolPhaseScaling indicates machines are being added or removed.
+	NodePoolPhaseScaling NodePoolPhase = "Scaling"
+
+	// NodePoolPhaseUpdating indicates machines are being replaced.
+	NodePoolPhaseUpdating NodePoolPhase = "Updating"
+
+	// NodePoolPhaseReady indicates all desired machines are ready and up to date.
+	NodePoolPhaseReady NodePoolPhase = "Ready"
+
+	// NodePoolPhaseFailed indicates the pool cannot be reconciled.
+	NodePoolPhaseFailed NodePoolPhase = "Failed"
+
+	// NodePoolPhaseDeleting indicates the pool's machines are being removed.
+	NodePoolPhaseDeleting NodePoolPhase = "Deleting"
+)
+
+// NodePool condition types.
+const (
+	// NodePoolConditionReady indicates all desired machines are ready.
+	NodePoolConditionReady = "Ready"
+
+	// NodePoolConditionMachinesUpToDate indicates every machine matches the
+	// current machine template.
+	NodePoolConditionMachinesUpToDate = "MachinesUpToDate"
+)
+
+// NodePoolSpec defines the desired state of NodePool.
+// +kubebuilder:validation:XValidation:rule="has(self.replicas) || has(self.autoscaling)",message="replicas is required unless autoscaling is set"
+// +kubebuilder:validation:XValidation:rule="!has(self.autoscaling) || !has(self.distribution) || self.distribution.policy != 'Explicit'",message="distribution policy Explicit cannot be used with autoscaling"
+// +kubebuilder:validation:XValidation:rule="!has(self.machineTemplate.networks) || !has(self.replicas) || self.machineTemplate.networks.all(n, !has(n.addresses) || size(n.addresses) >= self.replicas)",message="static network addresses must cover every replica"
+type NodePoolSpec struct {
+	// ClusterRef references the TenantCluster in the same namespace.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="clusterRef is immutable"
+	ClusterRef LocalObjectReference `json:"clusterRef"`
+
+	// Replicas is the desired number of nodes. When autoscaling is set,
+	// this is the initial size and is then managed by the autoscaler.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Autoscaling sets bounds for the cluster autoscaler.
+	// +optional
+	Autoscaling *NodePoolAutoscaling `json:"autoscaling,omitempty"`
+
+	// MachineTemplate defines the VM specification for the pool.
+	// +kubebuilder:validation:Required
+	MachineTemplate MachineTemplateSpec `json:"machineTemplate"`
+
+	// Labels are applied to nodes in the pool, in addition to
+	// LabelWorkerPool set to the NodePool name.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Taints are applied to nodes in the pool when they register.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Taints []NodeTaint `json:"taints,omitempty"`
+
+	// InfrastructureOverride overrides provider settings for the pool.
+	// Defaults to the TenantCluster's spec.infrastructureOverride.
+	// +optional
+	InfrastructureOverride *InfrastructureOverride `json:"infrastructureOverride,omitempty"`
+
+	// UpdateStrategy controls how nodes in the pool are replaced.
+	// Defaults to the TenantCluster's spec.workers.updateStrategy.
+	// +optional
+	UpdateStrategy *MachineUpdateStrategy `json:"updateStrategy,omitempty"`
+
+	// Drain controls how nodes in the pool are drained.
+	// Defaults to the TenantCluster's spec.workers.drain.
+	// +optional
+	Drain *DrainPolicy `json:"drain,omitempty"`
+
+	// Distribution assigns the pool's machines to the provider's failure
+	// domains. Defaults to spreading across all domains.
+	// +optional
+	Distribution *FailureDomainDistribution `json:"distribution,omitempty"`
+
+	// Paused stops reconciliation of the pool.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// NodePoolAutoscaling sets cluster autoscaler bounds for a NodePool.
+// The controller writes them to the node group size annotations on the
+// pool's MachineDeployment.
+// +kubebuilder:validation:XValidation:rule="self.minReplicas <= self.maxReplicas",message="minReplicas must not exceed maxReplicas"
+type NodePoolAutoscaling struct {
+	// MinReplicas is the lower bound. Zero allows scale to zero where the
+	// provider supports it.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=0
+	MinReplicas int32 `json:"minReplicas"`
+
+	// MaxReplicas is the upper bound.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+}
+
+// FailureDomainDistributionPolicy controls how a pool's machines are
+// assigned to the provider's failure domains.
+// +kubebuilder:validation:Enum=Spread;Pack;Explicit
+type FailureDomainDistributionPolicy string
+
+const (
+	// FailureDomainDistributionSpread balances machines evenly across
+	// domains. Domains earlier in the list get the remainder.
+	FailureDomainDistributionSpread FailureDomainDistributionPolicy = "Spread"
+
+	// FailureDomainDistributionPack places every machine in the first
+	// domain, for workloads that need low latency between nodes.
+	FailureDomainDistributionPack FailureDomainDistributionPolicy = "Pack"
+
+	// FailureDomainDistributionExplicit places the given number of
+	// machines in each domain.
+	FailureDomainDistributionExplicit FailureDomainDistributionPolicy = "Explicit"
+)
+
+// FailureDomainDistribution assigns a pool's machines to failure domains.
+// It has no effect on providers without failure domains.
+// +kubebuilder:validation:XValidation:rule="(self.policy == 'Explicit') == has(self.counts)",message="counts is required for, and only allowed with, policy Explicit"
+type FailureDomainDistribution struct {
+	// Policy is the distribution policy.
+	// +kubebuilder:default="Spread"
+	// +optional
+	Policy FailureDomainDistributionPolicy `json:"policy,omitempty"`
+
+	// Domains limits Spread and Pack to these failure domains, in order.
+	// If empty, all of the provider's failure domains are used.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=16
+	Domains []string `json:"domains,omitempty"`
+
+	// Counts is the number of machines per domain for Explicit. The counts
+	// must add up to the pool's replicas.
+	// +optional
+	// +listType=map
+	// +listMapKey=domain
+	// +kubebuilder:validation:MaxItems=16
+	Counts []FailureDomainCount `json:"counts,omitempty"`
+}
+
+// FailureDomainCount is a number of machines in a failure domain.
+type FailureDomainCount struct {
+	// Domain is the failure domain name.
+	// +kubebuilder:validation:Required
+	Domain string `json:"domain"`
+
+	// Replicas is the number of machines in the domain.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=0
+	Replicas int32 `json:"replicas"`
+}
+
+// FailureDomainReplicas reports a pool's machines in a failure domain.
+type FailureDomainReplicas struct {
+	// Domain is the failure domain name.
+	Domain string `json:"domain"`
+
+	// DesiredReplicas is the number of machines the distribution assigns
+	// to the domain.
+	// +optional
+	DesiredReplicas int32 `json:"desiredReplicas,omitempty"`
+
+	// Replicas is the current number of machines in the domain.
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// ReadyReplicas is the number of machines in the domain whose nodes are Ready.
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+}
+
+// NodePoolStatus defines the observed state of NodePool.
+type NodePoolStatus struct {
+	// Conditions represent the latest available observations.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Phase represents the current phase of the pool.
+	// +optional
+	Phase NodePoolPhase `json:"phase,omitempty"`
+
+	// Replicas is the current number of machines.
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// ReadyReplicas is the number of machines whose nodes are Ready.
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+
+	// UpdatedReplicas is the number of machines matching the current template.
+	// +optional
+	UpdatedReplicas int32 `json:"updatedReplicas,omitempty"`
+
+	// Selector is the label selector for the pool's machines, in string
+	// form. Used by the scale subresource.
+	// +optional
+	Selector string `json:"selector,omitempty"`
+
+	// FailureDomains reports machines per failure domain.
+	// +optional
+	// +listType=map
+	// +listMapKey=domain
+	FailureDomains []FailureDomainReplicas `json:"failureDomains,omitempty"`
+
+	// MachineDeploymentRef is the CAPI MachineDeployment backing the pool.
+	// +optional
+	MachineDeploymentRef *NamespacedObjectReference `json:"machineDeploymentRef,omitempty"`
+
+	// Message provides human-readable status information.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// ObservedGeneration is the last observed generation.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:specpath=.spec.replicas,statuspath=.status.replicas,selectorpath=.status.selector
+// +kubebuilder:resource:shortName=np,categories=butler
+// +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".spec.clusterRef.name",description="Tenant cluster"
+// +kubebuilder:printcolumn:name="Desired",type="integer",JSONPath=".spec.replicas",description="Desired nodes"
+// +kubebuilder:printcolumn:name="Ready",type="integer",JSONPath=".status.readyReplicas",description="Ready nodes"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Pool phase"
+// +kubebuilder:printcolumn:name="Min",type="integer",JSONPath=".spec.autoscaling.minReplicas",description="Autoscaling minimum",priority=1
+// +kubebuilder:printcolumn:name="Max",type="integer",JSONPath=".spec.autoscaling.maxReplicas",description="Autoscaling maximum",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// NodePool is a group of worker nodes for a TenantCluster managed
+// independently of the TenantCluster spec, similar to a CAPI
+// MachineDeployment. NodePools are created in the TenantCluster's
+// namespace. The NodePool name is the pool name on nodes and must not
+// match an entry in the cluster's spec.workerPools or "default".
+type NodePool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NodePoolSpec   `json:"spec,omitempty"`
+	Status NodePoolStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NodePoolList contains a list of NodePool.
+type NodePoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NodePool `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NodePool{}, &NodePoolList{})
+}
+
+// Helper methods
+
+// IsReady returns true if the pool is ready.
+func (p *NodePool) IsReady() bool {
+	return p.Status.Phase == NodePoolPhaseReady
+}
+
+// IsAutoscaled returns true if the pool has autoscaling bounds.
+func (p *NodePool) IsAutoscaled() bool {
+	return p.Spec.Autoscaling != nil
+}
+
+// GetReplicas returns the desired replica count. Without spec.replicas, an
+// autoscaled pool starts at its minimum.
+func (p *NodePool) GetReplicas() int32 {
+	if p.Spec.Replicas != nil {
+		return p.ClampReplicas(*p.Spec.Replicas)
+	}
+	if p.Spec.Autoscaling != nil {
+		return p.Spec.Autoscaling.MinReplicas
+	}
+	return 0
+}
+
+// ClampReplicas returns n limited to the autoscaling bounds, if any.
+func (p *NodePool) ClampReplicas(n int32) int32 {
+	a := p.Spec.Autoscaling
+	if a == nil {
+		return n
+	}
+	return max(a.MinReplicas, min(n, a.MaxReplicas))
+}
+
+// WorkerPool returns the pool as a WorkerPoolSpec named after the NodePool,
+// with unset update strategy, drain, and infrastructure overrides taken
+// from the cluster, so NodePools and spec.workerPools are reconciled alike.
+func (p *NodePool) WorkerPool(cluster *TenantClusterSpec) WorkerPoolSpec {
+	s := p.Spec.DeepCopy()
+	pool := WorkerPoolSpec{
+		Name:                   p.Name,
+		Replicas:               p.GetReplicas(),
+		MachineTemplate:        s.MachineTemplate,
+		Labels:                 s.Labels,
+		Taints:                 s.Taints,
+		InfrastructureOverride: s.InfrastructureOverride,
+		UpdateStrategy:         s.UpdateStrategy,
+		Drain:                  s.Drain,
+		Distribution:           s.Distribution,
+	}
+	if cluster == nil {
+		return pool
+	}
+	if pool.UpdateStrategy == nil {
+		pool.UpdateStrategy = cluster.Workers.UpdateStrategy.DeepCopy()
+	}
+	if pool.Drain == nil {
+		pool.Drain = cluster.Workers.Drain.DeepCopy()
+	}
+	if pool.InfrastructureOverride == nil {
+		pool.InfrastructureOverride = cluster.InfrastructureOverride.DeepCopy()
+	}
+	return pool
+}
+
+// GetPolicy returns the distribution policy, defaulting to Spread.
+func (d *FailureDomainDistribution) GetPolicy() FailureDomainDistributionPolicy {
+	if d == nil || d.Policy == "" {
+		return FailureDomainDistributionSpread
+	}
+	return d.Policy
+}
+
+// Distribute assigns replicas to the provider's failure domains. It
+// returns nil when the provider has no failure domains, and an error if
+// the distribution names an unknown domain or explicit counts do not add
+// up to replicas.
+func (d *FailureDomainDistribution) Distribute(replicas int32, available []string) ([]FailureDomainCount, error) {
+	if len(available) == 0 {
+		return nil, nil
+	}
+	if d.GetPolicy() == FailureDomainDistributionExplicit {
+		var total int32
+		for _, c := range d.Counts {
+			if !slices.Contains(available, c.Domain) {
+				return nil, fmt.Errorf("unknown failure domain %q", c.Domain)
+			}
+			total += c.Replicas
+		}
+		if total != replicas {
+			return nil, fmt.Errorf("explicit counts add up to %d, want %d replicas", total, replicas)
+		}
+		return slices.Clone(d.Counts), nil
+	}
+
+	domains := available
+	if d != nil && len(d.Domains) > 0 {
+		for _, name := range d.Domains {
+			if !slices.Contains(available, name) {
+				return nil, fmt.Errorf("unknown failure domain %q", name)
+			}
+		}
+		domains = d.Domains
+	}
+	counts := make([]FailureDomainCount, len(domains))
+	for i, name := range domains {
+		counts[i].Domain = name
+	}
+	if d.GetPolicy() == FailureDomainDistributionPack {
+		counts[0].Replicas = replicas
+		return counts, nil
+	}
+	n := int32(len(domains))
+	for i := range counts {
+		counts[i].Replicas = replicas / n
+		if int32(i) < replicas%n {
+			counts[i].Replicas++
+		}
+	}
+	return counts, nil
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"slices"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNodePoolGetReplicas(t *testing.T) {
+	int32Ptr := func(v int32) *int32 { return &v }
+	bounds := &NodePoolAutoscaling{MinReplicas: 2, MaxReplicas: 5}
+
+	tests := []struct {
+		name        string
+		replicas    *int32
+		autoscaling *NodePoolAutoscaling
+		want        int32
+	}{
+		{"fixed", int32Ptr(3), nil, 3},
+		{"autoscaled without replicas", nil, bounds, 2},
+		{"autoscaled within bounds", int32Ptr(4), bounds, 4},
+		{"autoscaled above max", int32Ptr(9), bounds, 5},
+		{"autoscaled below min", int32Ptr(0), bounds, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &NodePool{Spec: NodePoolSpec{Replicas: tt.replicas, Autoscaling: tt.autoscaling}}
+			if got := p.GetReplicas(); got != tt.want {
+				t.Errorf("GetReplicas() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNodePoolWorkerPool(t *testing.T) {
+	onDelete := &MachineUpdateStrategy{Type: MachineUpdateStrategyOnDelete}
+	cluster := &TenantClusterSpec{
+		Workers:                WorkersSpec{Replicas: 3, UpdateStrategy: onDelete},
+		InfrastructureOverride: &InfrastructureOverride{Harvester: &HarvesterOverride{Namespace: "vms"}},
+	}
+	replicas := int32(2)
+	np := &NodePool{
+		ObjectMeta: metav1.ObjectMeta{Name: "gpu"},
+		Spec: NodePoolSpec{
+			Replicas: &replicas,
+			Drain:    &DrainPolicy{},
+		},
+	}
+
+	pool := np.WorkerPool(cluster)
+	if pool.Name != "gpu" || pool.Replicas != 2 {
+		t.Errorf("WorkerPool() name=%q replicas=%d, want gpu and 2", pool.Name, pool.Replicas)
+	}
+	if pool.UpdateStrategy.GetType() != MachineUpdateStrategyOnDelete {
+		t.Errorf("update strategy = %v, want inherited OnDelete", pool.UpdateStrategy)
+	}
+	if pool.InfrastructureOverride == nil || pool.InfrastructureOverride.Harvester.Namespace != "vms" {
+		t.Errorf("infrastructure override = %+v, want inherited", pool.InfrastructureOverride)
+	}
+	if pool.Drain == nil {
+		t.Error("drain policy was not kept")
+	}
+	pool.InfrastructureOverride.Harvester.Namespace = "changed"
+	if cluster.InfrastructureOverride.Harvester.Namespace != "vms" {
+		t.Error("WorkerPool() aliases the cluster spec")
+	}
+}
+
+func TestFailureDomainDistributionDistribute(t *testing.T) {
+	domains := []string{"az-a", "az-b", "az-c"}
+	counts := func(c ...int32) []FailureDomainCount {
+		out := make([]FailureDomainCount, len(c))
+		for i, n := range c {
+			out[i] = FailureDomainCount{Domain: domains[i], Replicas: n}
+		}
+		return out
+	}
+
+	tests := []struct {
+		name     string
+		d        *FailureDomainDistribution
+		replicas int32
+		want     []FailureDomainCount
+		wantErr  bool
+	}{
+		{"default spread", nil, 5, counts(2, 2, 1), false},
+		{"spread over subset", &FailureDomainDistribution{Domains: []string{"az-a", "az-b"}}, 3, counts(2, 1), false},
+		{"pack", &FailureDomainDistribution{Policy: FailureDomainDistributionPack}, 4, counts(4, 0, 0), false},
+		{"explicit", &FailureDomainDistribution{Policy: FailureDomainDistributionExplicit, Counts: counts(1, 3)}, 4, counts(1, 3), false},
+		{"explicit mismatch", &FailureDomainDistribution{Policy: FailureDomainDistributionExplicit, Counts: counts(1, 3)}, 5, nil, true},
+		{"unknown domain", &FailureDomainDistribution{Domains: []string{"az-z"}}, 3, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.d.Distribute(tt.replicas, domains)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Distribute() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Distribute() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got, err := (*FailureDomainDistribution)(nil).Distribute(3, nil); got != nil || err != nil {
+		t.Errorf("Distribute() without domains = %v, %v", got, err)
+	}
+}
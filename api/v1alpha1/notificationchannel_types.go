@@ -0,0 +1,420 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// NotificationChannelType is the delivery mechanism of a NotificationChannel.
+// +kubebuilder:validation:Enum=slack;email;pagerduty;webhook
+type NotificationChannelType string
+
+const (
+	// NotificationChannelSlack posts to a Slack incoming webhook.
+	NotificationChannelSlack NotificationChannelType = "slack"
+
+	// NotificationChannelEmail sends email over SMTP.
+	NotificationChannelEmail NotificationChannelType = "email"
+
+	// NotificationChannelPagerDuty sends PagerDuty Events API v2 alerts.
+	NotificationChannelPagerDuty NotificationChannelType = "pagerduty"
+
+	// NotificationChannelWebhook posts JSON to a generic webhook.
+	NotificationChannelWebhook NotificationChannelType = "webhook"
+)
+
+// NotificationEventType identifies a platform event that can be delivered.
+// +kubebuilder:validation:Enum=ClusterReady;ClusterFailed;ClusterDeleted;QuotaExceeded;UpgradeCompleted;UpgradeFailed;BackupFailed;CredentialsExpiring
+type NotificationEventType string
+
+const (
+	// NotificationEventClusterReady is sent when a TenantCluster becomes Ready.
+	NotificationEventClusterReady NotificationEventType = "ClusterReady"
+
+	// NotificationEventClusterFailed is sent when a TenantCluster enters the Failed phase.
+	NotificationEventClusterFailed NotificationEventType = "ClusterFailed"
+
+	// NotificationEventClusterDeleted is sent when a TenantCluster is deleted.
+	NotificationEventClusterDeleted NotificationEventType = "ClusterDeleted"
+
+	// NotificationEventQuotaExceeded is sent when a request is rejected by a
+	// Team quota.
+	NotificationEventQuotaExceeded NotificationEventType = "QuotaExceeded"
+
+	// NotificationEventUpgradeCompleted is sent when a Kubernetes upgrade completes.
+	NotificationEventUpgradeCompleted NotificationEventType = "UpgradeCompleted"
+
+	// NotificationEventUpgradeFailed is sent when a Kubernetes upgrade fails.
+	NotificationEventUpgradeFailed NotificationEventType = "UpgradeFailed"
+
+	// NotificationEventBackupFailed is sent when a scheduled backup fails.
+	NotificationEventBackupFailed NotificationEventType = "BackupFailed"
+
+	// NotificationEventCredentialsExpiring is sent when ProviderConfig
+	// credentials are within their expiry warning.
+	NotificationEventCredentialsExpiring NotificationEventType = "CredentialsExpiring"
+)
+
+// NotificationSeverity is the severity of a notification event.
+// +kubebuilder:validation:Enum=Info;Warning;Critical
+type NotificationSeverity string
+
+const (
+	// NotificationSeverityInfo is for routine lifecycle events.
+	NotificationSeverityInfo NotificationSeverity = "Info"
+
+	// NotificationSeverityWarning is for events that need attention soon.
+	NotificationSeverityWarning NotificationSeverity = "Warning"
+
+	// NotificationSeverityCritical is for failures that need action now.
+	NotificationSeverityCritical NotificationSeverity = "Critical"
+)
+
+// NotificationChannel condition types.
+const (
+	// NotificationChannelConditionReady indicates the channel's settings
+	// and secrets were resolved.
+	NotificationChannelConditionReady = "Ready"
+
+	// NotificationChannelConditionDelivering indicates the last delivery
+	// succeeded.
+	NotificationChannelConditionDelivering = "Delivering"
+)
+
+// NotificationChannelSpec defines the desired state of NotificationChannel.
+// +kubebuilder:validation:XValidation:rule="self.type != 'slack' || has(self.slack)",message="slack is required for slack channels"
+// +kubebuilder:validation:XValidation:rule="self.type != 'email' || has(self.email)",message="email is required for email channels"
+// +kubebuilder:validation:XValidation:rule="self.type != 'pagerduty' || has(self.pagerDuty)",message="pagerDuty is required for pagerduty channels"
+// +kubebuilder:validation:XValidation:rule="self.type != 'webhook' || has(self.webhook)",message="webhook is required for webhook channels"
+type NotificationChannelSpec struct {
+	// Type is the delivery mechanism.
+	// +kubebuilder:validation:Required
+	Type NotificationChannelType `json:"type"`
+
+	// Slack configures a Slack channel.
+	// +optional
+	Slack *SlackChannelConfig `json:"slack,omitempty"`
+
+	// Email configures an email channel.
+	// +optional
+	Email *EmailChannelConfig `json:"email,omitempty"`
+
+	// PagerDuty configures a PagerDuty channel.
+	// +optional
+	PagerDuty *PagerDutyChannelConfig `json:"pagerDuty,omitempty"`
+
+	// Webhook configures a generic webhook channel.
+	// +optional
+	Webhook *WebhookChannelConfig `json:"webhook,omitempty"`
+
+	// Routes select the events delivered to the channel. An event is
+	// delivered if any route matches. If empty, every event in the
+	// channel's scope is delivered.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Routes []NotificationRoute `json:"routes,omitempty"`
+
+	// Suspend stops delivery without deleting the channel.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+}
+
+// SlackChannelConfig configures delivery to Slack.
+type SlackChannelConfig struct {
+	// WebhookSecretRef references a Secret holding the incoming webhook URL.
+	// Key defaults to "url".
+	// +kubebuilder:validation:Required
+	WebhookSecretRef SecretReference `json:"webhookSecretRef"`
+
+	// Channel overrides the webhook's default channel (e.g., "#platform-alerts").
+	// +optional
+	Channel string `json:"channel,omitempty"`
+}
+
+// EmailChannelConfig configures delivery by email.
+type EmailChannelConfig struct {
+	// Recipients lists email addresses.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	Recipients []string `json:"recipients"`
+
+	// SMTP overrides the platform SMTP settings in
+	// ButlerConfig.spec.notifications.smtp.
+	// +optional
+	SMTP *SMTPConfig `json:"smtp,omitempty"`
+}
+
+// SMTPConfig configures an SMTP relay.
+type SMTPConfig struct {
+	// Host is the SMTP server host.
+	// +kubebuilder:validation:Required
+	Host string `json:"host"`
+
+	// Port is the SMTP server port.
+	// +kubebuilder:default=587
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port int32 `json:"port,omitempty"`
+
+	// From is the sender address.
+	// +kubebuilder:validation:Required
+	From string `json:"from"`
+
+	// CredentialsSecretRef references a Secret with "username" and
+	// "password" keys. If not set, the relay is used without authentication.
+	// +optional
+	CredentialsSecretRef *SecretReference `json:"credentialsSecretRef,omitempty"`
+
+	// StartTLS upgrades the connection with STARTTLS.
+	// +kubebuilder:default=true
+	// +optional
+	StartTLS *bool `json:"startTLS,omitempty"`
+}
+
+// PagerDutyChannelConfig configures delivery to PagerDuty.
+type PagerDutyChannelConfig struct {
+	// RoutingKeySecretRef references a Secret holding the Events API v2
+	// integration key. Key defaults to "routingKey".
+	// +kubebuilder:validation:Required
+	RoutingKeySecretRef SecretReference `json:"routingKeySecretRef"`
+}
+
+// WebhookChannelConfig configures delivery to a generic webhook.
+type WebhookChannelConfig struct {
+	// URLSecretRef references a Secret holding the webhook URL.
+	// Key defaults to "url".
+	// +kubebuilder:validation:Required
+	URLSecretRef SecretReference `json:"urlSecretRef"`
+}
+
+// NotificationRoute selects events for a channel.
+type NotificationRoute struct {
+	// Events limits the route to these event types. If empty, all events match.
+	// +optional
+	// +listType=set
+	Events []NotificationEventType `json:"events,omitempty"`
+
+	// MinSeverity is the lowest severity delivered.
+	// +kubebuilder:default="Info"
+	// +optional
+	MinSeverity NotificationSeverity `json:"minSeverity,omitempty"`
+
+	// Teams limits the route to events from these Teams. Only honored on
+	// platform channels; channels in a Team namespace only receive that
+	// Team's events. If empty, events from all Teams match.
+	// +optional
+	// +listType=set
+	Teams []string `json:"teams,omitempty"`
+
+	// ClusterSelector limits the route to events about TenantClusters with
+	// matching labels. Events not about a cluster never match a route with
+	// a selector.
+	// +optional
+	ClusterSelector *metav1.LabelSelector `json:"clusterSelector,omitempty"`
+}
+
+// NotificationChannelStatus defines the observed state of NotificationChannel.
+type NotificationChannelStatus struct {
+	// Conditions represent the latest available observations.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// LastDeliveryTime is when a notification was last delivered.
+	// +optional
+	LastDeliveryTime *metav1.Time `json:"lastDeliveryTime,omitempty"`
+
+	// LastFailureTime is when a delivery last failed.
+	// +optional
+	LastFailureTime *metav1.Time `json:"lastFailureTime,omitempty"`
+
+	// ConsecutiveFailures is the number of failed deliveries since the
+	// last success.
+	// +optional
+	ConsecutiveFailures int32 `json:"consecutiveFailures,omitempty"`
+
+	// Message provides human-readable status information, such as the
+	// last delivery error.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// ObservedGeneration is the last observed generation.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=nc,categories=butler
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type",description="Delivery mechanism"
+// +kubebuilder:printcolumn:name="Suspended",type="boolean",JSONPath=".spec.suspend",description="Delivery suspended"
+// +kubebuilder:printcolumn:name="Last Delivery",type="date",JSONPath=".status.lastDeliveryTime",description="Last successful delivery"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// NotificationChannel delivers platform events, such as cluster lifecycle
+// changes and quota rejections, to operators. Channels in the platform
+// namespace receive events from every Team, filtered by their routes.
+// Channels in a Team namespace receive only that Team's events; Team
+// bootstrap creates one named "default" from spec.bootstrap.notificationChannel.
+type NotificationChannel struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NotificationChannelSpec   `json:"spec,omitempty"`
+	Status NotificationChannelStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NotificationChannelList contains a list of NotificationChannel.
+type NotificationChannelList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NotificationChannel `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NotificationChannel{}, &NotificationChannelList{})
+}
+
+// NotificationEvent is a platform event to be routed to channels.
+// +kubebuilder:object:generate=false
+type NotificationEvent struct {
+	// Type is the event type.
+	Type NotificationEventType
+
+	// Severity is the event severity.
+	Severity NotificationSeverity
+
+	// Team is the Team the event belongs to. Empty for platform events.
+	Team string
+
+	// Namespace is the namespace of the object the event is about.
+	Namespace string
+
+	// ClusterLabels are the labels of the TenantCluster the event is
+	// about, or nil if the event is not about a cluster.
+	ClusterLabels map[string]string
+}
+
+// Helper methods
+
+// severityRank orders severities from least to most severe.
+func severityRank(s NotificationSeverity) int {
+	switch s {
+	case NotificationSeverityWarning:
+		return 1
+	case NotificationSeverityCritical:
+		return 2
+	}
+	return 0
+}
+
+// DefaultNotificationEventSeverity returns the severity an event type is
+// sent with.
+func DefaultNotificationEventSeverity(t NotificationEventType) NotificationSeverity {
+	switch t {
+	case NotificationEventClusterFailed, NotificationEventUpgradeFailed, NotificationEventBackupFailed:
+		return NotificationSeverityCritical
+	case NotificationEventQuotaExceeded, NotificationEventCredentialsExpiring:
+		return NotificationSeverityWarning
+	}
+	return NotificationSeverityInfo
+}
+
+// Matches returns true if the route selects the event.
+func (r *NotificationRoute) Matches(e NotificationEvent) bool {
+	if len(r.Events) > 0 && !slices.Contains(r.Events, e.Type) {
+		return false
+	}
+	if severityRank(e.Severity) < severityRank(r.MinSeverity) {
+		return false
+	}
+	if len(r.Teams) > 0 && !slices.Contains(r.Teams, e.Team) {
+		return false
+	}
+	if r.ClusterSelector != nil {
+		if e.ClusterLabels == nil {
+			return false
+		}
+		selector, err := metav1.LabelSelectorAsSelector(r.ClusterSelector)
+		if err != nil || !selector.Matches(labels.Set(e.ClusterLabels)) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsPlatformChannel returns true if the channel is in the platform
+// namespace and receives events from every Team.
+func (c *NotificationChannel) IsPlatformChannel(platformNamespace string) bool {
+	return c.Namespace == platformNamespace
+}
+
+// ShouldDeliver returns true if the event is in the channel's scope and
+// matches one of its routes. Team channels only receive events from
+// objects in their own namespace, and ignore route Team filters.
+func (c *NotificationChannel) ShouldDeliver(e NotificationEvent, platformNamespace string) bool {
+	if c.Spec.Suspend {
+		return false
+	}
+	platform := c.IsPlatformChannel(platformNamespace)
+	if !platform && e.Namespace != c.Namespace {
+		return false
+	}
+	if len(c.Spec.Routes) == 0 {
+		return true
+	}
+	for _, r := range c.Spec.Routes {
+		if !platform {
+			r.Teams = nil
+		}
+		if r.Matches(e) {
+			return true
+		}
+	}
+	return false
+}
+
+// ChannelSpec returns the spec of the Team's default NotificationChannel.
+// Slack and webhook URLs are read from the "url" key of the Secret.
+func (b *TeamNotificationChannelBootstrap) ChannelSpec() NotificationChannelSpec {
+	spec := NotificationChannelSpec{Type: NotificationChannelType(b.Type)}
+	var ref SecretReference
+	if b.SecretRef != nil {
+		ref = *b.SecretRef
+		if ref.Key == "" {
+			ref.Key = "url"
+		}
+	}
+	switch b.Type {
+	case TeamNotificationChannelSlack:
+		spec.Slack = &SlackChannelConfig{WebhookSecretRef: ref}
+	case TeamNotificationChannelEmail:
+		spec.Email = &EmailChannelConfig{Recipients: slices.Clone(b.Recipients)}
+	case TeamNotificationChannelWebhook:
+		spec.Webhook = &WebhookChannelConfig{URLSecretRef: ref}
+	}
+	return spec
+}
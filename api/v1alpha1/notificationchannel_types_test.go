@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNotificationChannelShouldDeliver(t *testing.T) {
+	failed := NotificationEvent{
+		Type:          NotificationEventClusterFailed,
+		Severity:      DefaultNotificationEventSeverity(NotificationEventClusterFailed),
+		Team:          "team-a",
+		Namespace:     "team-a",
+		ClusterLabels: map[string]string{"tier": "prod"},
+	}
+	ready := failed
+	ready.Type = NotificationEventClusterReady
+	ready.Severity = DefaultNotificationEventSeverity(NotificationEventClusterReady)
+	quota := NotificationEvent{Type: NotificationEventQuotaExceeded, Severity: NotificationSeverityWarning, Team: "team-b", Namespace: "team-b"}
+
+	critical := []NotificationRoute{{MinSeverity: NotificationSeverityCritical}}
+	teamB := []NotificationRoute{{Teams: []string{"team-b"}}}
+	prod := []NotificationRoute{{ClusterSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "prod"}}}}
+
+	tests := []struct {
+		name      string
+		namespace string
+		routes    []NotificationRoute
+		suspend   bool
+		event     NotificationEvent
+		want      bool
+	}{
+		{"platform channel without routes", "butler-system", nil, false, quota, true},
+		{"team channel ignores other teams", "team-a", nil, false, quota, false},
+		{"team channel gets own events", "team-a", nil, false, ready, true},
+		{"severity below minimum", "butler-system", critical, false, ready, false},
+		{"severity at minimum", "butler-system", critical, false, failed, true},
+		{"platform route team filter", "butler-system", teamB, false, failed, false},
+		{"team channel ignores route team filter", "team-a", teamB, false, failed, true},
+		{"cluster selector", "butler-system", prod, false, failed, true},
+		{"cluster selector on non-cluster event", "butler-system", prod, false, quota, false},
+		{"event type filter", "butler-system", []NotificationRoute{{Events: []NotificationEventType{NotificationEventClusterReady}}}, false, failed, false},
+		{"suspended", "butler-system", nil, true, failed, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &NotificationChannel{
+				ObjectMeta: metav1.ObjectMeta{Name: "ops", Namespace: tt.namespace},
+				Spec:       NotificationChannelSpec{Type: NotificationChannelWebhook, Routes: tt.routes, Suspend: tt.suspend},
+			}
+			if got := c.ShouldDeliver(tt.event, "butler-system"); got != tt.want {
+				t.Errorf("ShouldDeliver() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTeamNotificationChannelBootstrapChannelSpec(t *testing.T) {
+	b := &TeamNotificationChannelBootstrap{Type: TeamNotificationChannelSlack, SecretRef: &SecretReference{Name: "slack"}}
+	spec := b.ChannelSpec()
+	if spec.Type != NotificationChannelSlack || spec.Slack == nil || spec.Slack.WebhookSecretRef.Key != "url" {
+		t.Errorf("ChannelSpec() = %+v", spec)
+	}
+
+	b = &TeamNotificationChannelBootstrap{Type: TeamNotificationChannelEmail, Recipients: []string{"ops@example.com"}}
+	spec = b.ChannelSpec()
+	if spec.Email == nil || len(spec.Email.Recipients) != 1 || spec.Slack != nil {
+		t.Errorf("ChannelSpec() = %+v", spec)
+	}
+
+	smtp := &SMTPConfig{Host: "smtp.example.com", From: "butler@example.com"}
+	cfg := &ButlerConfig{Spec: ButlerConfigSpec{Notifications: &NotificationsConfig{SMTP: smtp}}}
+	if cfg.GetNotificationsSMTP(spec.Email) != smtp {
+		t.Error("GetNotificationsSMTP() should fall back to the platform relay")
+	}
+	own := &SMTPConfig{Host: "mail.team-a.example.com", From: "team-a@example.com"}
+	if cfg.GetNotificationsSMTP(&EmailChannelConfig{SMTP: own}) != own {
+		t.Error("GetNotificationsSMTP() should prefer the channel relay")
+	}
+}
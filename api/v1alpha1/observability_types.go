@@ -16,6 +16,13 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	"fmt"
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 // ObservabilityConfig configures platform-level observability.
 // This is stored in ButlerConfig and used as the default for all observability operations.
 type ObservabilityConfig struct {
@@ -123,3 +130,92 @@ type ObservabilityStatus struct {
 	// +optional
 	TotalCount int32 `json:"totalCount,omitempty"`
 }
+
+// SLOIndicator is the signal an SLO measures.
+// +kubebuilder:validation:Enum=APIServer;Ingress
+type SLOIndicator string
+
+const (
+	// SLOIndicatorAPIServer measures the fraction of successful API server
+	// health probes.
+	SLOIndicatorAPIServer SLOIndicator = "APIServer"
+
+	// SLOIndicatorIngress measures the fraction of non-5xx responses served
+	// by the cluster ingress controller.
+	SLOIndicatorIngress SLOIndicator = "Ingress"
+)
+
+// SLOSpec declares an availability objective for a TenantCluster.
+type SLOSpec struct {
+	// Target is the availability objective as a percentage (e.g., "99.9").
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[0-9]{1,2}(\.[0-9]{1,4})?$`
+	Target string `json:"target"`
+
+	// Window is the rolling measurement window.
+	// +kubebuilder:default="720h"
+	// +optional
+	Window *metav1.Duration `json:"window,omitempty"`
+
+	// Indicator is the signal measured.
+	// +kubebuilder:default=APIServer
+	// +optional
+	Indicator SLOIndicator `json:"indicator,omitempty"`
+}
+
+// SLOStatus reports observed SLO posture. Written from data fed by the
+// observability pipeline. Percentages and rates are decimal strings.
+type SLOStatus struct {
+	// ObservedAvailability is the measured availability over the window as
+	// a percentage (e.g., "99.953").
+	// +optional
+	ObservedAvailability string `json:"observedAvailability,omitempty"`
+
+	// ErrorBudgetRemaining is the percentage of the error budget left.
+	// Negative once the budget is exhausted.
+	// +optional
+	ErrorBudgetRemaining string `json:"errorBudgetRemaining,omitempty"`
+
+	// BurnRate is how fast the error budget is consumed relative to the
+	// rate that would exactly exhaust it over the window. 1 is on budget.
+	// +optional
+	BurnRate string `json:"burnRate,omitempty"`
+
+	// LastUpdated is when the pipeline last reported.
+	// +optional
+	LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
+}
+
+// GetTarget returns the availability target as a fraction (0.999 for "99.9").
+func (s *SLOSpec) GetTarget() (float64, error) {
+	t, err := strconv.ParseFloat(s.Target, 64)
+	if err != nil || t <= 0 || t >= 100 {
+		return 0, fmt.Errorf("invalid SLO target %q", s.Target)
+	}
+	return t / 100, nil
+}
+
+// NewSLOStatus computes SLO status from an observed availability fraction
+// over the window (0.9995 for 99.95%).
+func (s *SLOSpec) NewSLOStatus(observed float64, now metav1.Time) (*SLOStatus, error) {
+	target, err := s.GetTarget()
+	if err != nil {
+		return nil, err
+	}
+	burn := (1 - observed) / (1 - target)
+	return &SLOStatus{
+		ObservedAvailability: strconv.FormatFloat(observed*100, 'f', 3, 64),
+		ErrorBudgetRemaining: strconv.FormatFloat((1-burn)*100, 'f', 1, 64),
+		BurnRate:             strconv.FormatFloat(burn, 'f', 2, 64),
+		LastUpdated:          &now,
+	}, nil
+}
+
+// IsBudgetExhausted returns true if the error budget has been used up.
+func (s *SLOStatus) IsBudgetExhausted() bool {
+	if s == nil || s.ErrorBudgetRemaining == "" {
+		return false
+	}
+	r, err := strconv.ParseFloat(s.ErrorBudgetRemaining, 64)
+	return err == nil && r <= 0
+}
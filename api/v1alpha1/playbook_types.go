@@ -0,0 +1,481 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PlaybookStepType identifies the operation a Playbook step performs.
+// +kubebuilder:validation:Enum=RotateKubeconfig;DrainNode;ReplaceNode;UpgradeAddon;ScaleWorkers;Approval;Wait
+type PlaybookStepType string
+
+const (
+	// PlaybookStepRotateKubeconfig regenerates the admin kubeconfig of each target cluster.
+	PlaybookStepRotateKubeconfig PlaybookStepType = "RotateKubeconfig"
+
+	// PlaybookStepDrainNode cordons and drains a node in each target cluster.
+	PlaybookStepDrainNode PlaybookStepType = "DrainNode"
+
+	// PlaybookStepReplaceNode drains a node and replaces its machine.
+	PlaybookStepReplaceNode PlaybookStepType = "ReplaceNode"
+
+	// PlaybookStepUpgradeAddon upgrades an addon on each target cluster.
+	PlaybookStepUpgradeAddon PlaybookStepType = "UpgradeAddon"
+
+	// PlaybookStepScaleWorkers sets the worker replica count on each target cluster.
+	PlaybookStepScaleWorkers PlaybookStepType = "ScaleWorkers"
+
+	// PlaybookStepApproval pauses execution until the step is approved in status.approvals.
+	PlaybookStepApproval PlaybookStepType = "Approval"
+
+	// PlaybookStepWait pauses execution for a fixed duration.
+	PlaybookStepWait PlaybookStepType = "Wait"
+)
+
+// PlaybookFailurePolicy defines how a Playbook reacts to a failed step.
+// +kubebuilder:validation:Enum=Abort;Continue
+type PlaybookFailurePolicy string
+
+const (
+	// PlaybookFailurePolicyAbort stops the Playbook at the first failed step.
+	PlaybookFailurePolicyAbort PlaybookFailurePolicy = "Abort"
+
+	// PlaybookFailurePolicyContinue records the failure and runs the next step.
+	PlaybookFailurePolicyContinue PlaybookFailurePolicy = "Continue"
+)
+
+// PlaybookPhase represents the current phase of a Playbook run.
+// +kubebuilder:validation:Enum=Pending;Running;WaitingApproval;Paused;Succeeded;Failed
+type PlaybookPhase string
+
+const (
+	// PlaybookPhasePending indicates the Playbook has not started.
+	PlaybookPhasePending PlaybookPhase = "Pending"
+
+	// PlaybookPhaseRunning indicates a step is executing.
+	PlaybookPhaseRunning PlaybookPhase = "Running"
+
+	// PlaybookPhaseWaitingApproval indicates an Approval step is waiting.
+	PlaybookPhaseWaitingApproval PlaybookPhase = "WaitingApproval"
+
+	// PlaybookPhasePaused indicates spec.paused is set.
+	// Execution resumes from status.currentStep when unpaused.
+	PlaybookPhasePaused PlaybookPhase = "Paused"
+
+	// PlaybookPhaseSucceeded indicates all steps completed.
+	PlaybookPhaseSucceeded PlaybookPhase = "Succeeded"
+
+	// PlaybookPhaseFailed indicates a step failed under the Abort policy
+	// or an approval expired.
+	PlaybookPhaseFailed PlaybookPhase = "Failed"
+)
+
+// PlaybookStepPhase represents the phase of a single step.
+// +kubebuilder:validation:Enum=Pending;Running;Succeeded;Failed;Skipped
+type PlaybookStepPhase string
+
+const (
+	// PlaybookStepPhasePending indicates the step has not started.
+	PlaybookStepPhasePending PlaybookStepPhase = "Pending"
+
+	// PlaybookStepPhaseRunning indicates the step is executing.
+	PlaybookStepPhaseRunning PlaybookStepPhase = "Running"
+
+	// PlaybookStepPhaseSucceeded indicates the step completed on all targets.
+	PlaybookStepPhaseSucceeded PlaybookStepPhase = "Succeeded"
+
+	// PlaybookStepPhaseFailed indicates the step failed on at least one target.
+	PlaybookStepPhaseFailed PlaybookStepPhase = "Failed"
+
+	// PlaybookStepPhaseSkipped indicates the step was skipped.
+	PlaybookStepPhaseSkipped PlaybookStepPhase = "Skipped"
+)
+
+// Playbook condition types.
+const (
+	// PlaybookConditionTargetsResolved indicates the target selector was resolved.
+	PlaybookConditionTargetsResolved = "TargetsResolved"
+
+	// PlaybookConditionComplete indicates the Playbook finished running.
+	PlaybookConditionComplete = "Complete"
+)
+
+// PlaybookSpec defines the desired state of Playbook.
+type PlaybookSpec struct {
+	// Description explains what the Playbook does.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Targets selects the TenantClusters the steps run against.
+	// +kubebuilder:validation:Required
+	Targets PlaybookTargets `json:"targets"`
+
+	// Steps are executed in order. Each step runs against every target
+	// before the next step starts.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=50
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="steps are immutable; create a new Playbook to change them"
+	// +listType=map
+	// +listMapKey=name
+	Steps []PlaybookStep `json:"steps"`
+
+	// FailurePolicy controls what happens when a step fails.
+	// +kubebuilder:default="Abort"
+	// +optional
+	FailurePolicy PlaybookFailurePolicy `json:"failurePolicy,omitempty"`
+
+	// MaxConcurrency is the number of targets a step runs against in parallel.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxConcurrency int32 `json:"maxConcurrency,omitempty"`
+
+	// Paused suspends execution after the current step finishes.
+	// Clearing it resumes from status.currentStep.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// PlaybookTargets selects TenantClusters for a Playbook.
+// +kubebuilder:validation:XValidation:rule="(has(self.clusterRefs) && size(self.clusterRefs) > 0) || has(self.clusterSelector)",message="one of clusterRefs or clusterSelector must be set"
+type PlaybookTargets struct {
+	// ClusterRefs lists TenantClusters explicitly.
+	// +optional
+	ClusterRefs []NamespacedObjectReference `json:"clusterRefs,omitempty"`
+
+	// ClusterSelector selects TenantClusters by label.
+	// Limited to the Playbook's namespace unless the Playbook lives in
+	// the platform namespace.
+	// +optional
+	ClusterSelector *metav1.LabelSelector `json:"clusterSelector,omitempty"`
+}
+
+// PlaybookStep is a single typed operation.
+// +kubebuilder:validation:XValidation:rule="self.type != 'DrainNode' || has(self.drainNode)",message="drainNode is required for DrainNode steps"
+// +kubebuilder:validation:XValidation:rule="self.type != 'ReplaceNode' || has(self.replaceNode)",message="replaceNode is required for ReplaceNode steps"
+// +kubebuilder:validation:XValidation:rule="self.type != 'UpgradeAddon' || has(self.upgradeAddon)",message="upgradeAddon is required for UpgradeAddon steps"
+// +kubebuilder:validation:XValidation:rule="self.type != 'ScaleWorkers' || has(self.scaleWorkers)",message="scaleWorkers is required for ScaleWorkers steps"
+// +kubebuilder:validation:XValidation:rule="self.type != 'Approval' || has(self.approval)",message="approval is required for Approval steps"
+// +kubebuilder:validation:XValidation:rule="self.type != 'Wait' || has(self.wait)",message="wait is required for Wait steps"
+type PlaybookStep struct {
+	// Name uniquely identifies the step within the Playbook.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// Type is the operation to perform.
+	// +kubebuilder:validation:Required
+	Type PlaybookStepType `json:"type"`
+
+	// Timeout bounds how long the step may run per target.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// DrainNode configures a DrainNode step.
+	// +optional
+	DrainNode *PlaybookNodeStep `json:"drainNode,omitempty"`
+
+	// ReplaceNode configures a ReplaceNode step.
+	// +optional
+	ReplaceNode *PlaybookNodeStep `json:"replaceNode,omitempty"`
+
+	// UpgradeAddon configures an UpgradeAddon step.
+	// +optional
+	UpgradeAddon *PlaybookUpgradeAddonStep `json:"upgradeAddon,omitempty"`
+
+	// ScaleWorkers configures a ScaleWorkers step.
+	// +optional
+	ScaleWorkers *PlaybookScaleWorkersStep `json:"scaleWorkers,omitempty"`
+
+	// Approval configures an Approval step.
+	// +optional
+	Approval *PlaybookApprovalStep `json:"approval,omitempty"`
+
+	// Wait configures a Wait step.
+	// +optional
+	Wait *PlaybookWaitStep `json:"wait,omitempty"`
+}
+
+// PlaybookNodeStep selects nodes for DrainNode and ReplaceNode steps.
+// +kubebuilder:validation:XValidation:rule="has(self.nodeName) || has(self.nodeSelector)",message="one of nodeName or nodeSelector must be set"
+type PlaybookNodeStep struct {
+	// NodeName selects a single node by name.
+	// +optional
+	NodeName string `json:"nodeName,omitempty"`
+
+	// NodeSelector selects nodes by label. Matching nodes are processed one at a time.
+	// +optional
+	NodeSelector *metav1.LabelSelector `json:"nodeSelector,omitempty"`
+
+	// GracePeriod overrides the pod termination grace period during drain.
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+
+	// IgnoreDaemonSets skips DaemonSet-managed pods during drain.
+	// +kubebuilder:default=true
+	// +optional
+	IgnoreDaemonSets *bool `json:"ignoreDaemonSets,omitempty"`
+}
+
+// PlaybookUpgradeAddonStep configures an addon upgrade.
+type PlaybookUpgradeAddonStep struct {
+	// Addon is the addon name (AddonDefinition name or built-in addon).
+	// +kubebuilder:validation:Required
+	Addon string `json:"addon"`
+
+	// Version is the target version.
+	// +kubebuilder:validation:Required
+	Version string `json:"version"`
+}
+
+// PlaybookScaleWorkersStep configures a worker scale operation.
+type PlaybookScaleWorkersStep struct {
+	// Replicas is the desired worker count.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	Replicas int32 `json:"replicas"`
+}
+
+// PlaybookApprovalStep configures an approval gate.
+type PlaybookApprovalStep struct {
+	// Approvers lists users or groups allowed to approve.
+	// If empty, any user allowed to update the Playbook status may approve.
+	// +optional
+	Approvers []string `json:"approvers,omitempty"`
+
+	// Message is shown to approvers.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Timeout fails the Playbook if no approval is recorded in time.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// PlaybookWaitStep configures a fixed delay.
+type PlaybookWaitStep struct {
+	// Duration to wait.
+	// +kubebuilder:validation:Required
+	Duration metav1.Duration `json:"duration"`
+}
+
+// PlaybookApproval records an approval for an Approval step. Approvers add
+// an entry with the step name through the status subresource; the
+// admission webhook sets ApprovedBy, Groups, and ApprovedAt from the
+// authenticated user, rejects approvers the step does not list, and
+// rejects changes to recorded approvals.
+type PlaybookApproval struct {
+	// Step is the name of the Approval step.
+	// +kubebuilder:validation:Required
+	Step string `json:"step"`
+
+	// ApprovedBy is the user who approved. Set by the admission webhook.
+	// +optional
+	ApprovedBy string `json:"approvedBy,omitempty"`
+
+	// Groups are the approver's groups when the approval was recorded.
+	// Set by the admission webhook.
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+
+	// ApprovedAt is when the approval was recorded. Set by the admission
+	// webhook.
+	// +optional
+	ApprovedAt *metav1.Time `json:"approvedAt,omitempty"`
+
+	// Comment is an optional note from the approver.
+	// +optional
+	Comment string `json:"comment,omitempty"`
+}
+
+// PlaybookTargetStatus is the outcome of a step on a single target.
+type PlaybookTargetStatus struct {
+	// Cluster is the target TenantCluster.
+	Cluster NamespacedObjectReference `json:"cluster"`
+
+	// Phase of the step on this target.
+	// +optional
+	Phase PlaybookStepPhase `json:"phase,omitempty"`
+
+	// Message provides details, typically the failure reason.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// PlaybookStepStatus is the observed state of a step.
+type PlaybookStepStatus struct {
+	// Name of the step.
+	Name string `json:"name"`
+
+	// Phase of the step.
+	// +optional
+	Phase PlaybookStepPhase `json:"phase,omitempty"`
+
+	// StartTime is when the step started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is when the step finished.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Targets reports per-target results.
+	// +optional
+	Targets []PlaybookTargetStatus `json:"targets,omitempty"`
+
+	// Message provides human-readable status information.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// PlaybookStatus defines the observed state of Playbook.
+type PlaybookStatus struct {
+	// Conditions represent the latest available observations.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Phase represents the current phase of the run.
+	// +optional
+	Phase PlaybookPhase `json:"phase,omitempty"`
+
+	// CurrentStep is the index of the step being executed, or the step to
+	// resume from when paused.
+	// +optional
+	CurrentStep int32 `json:"currentStep,omitempty"`
+
+	// Steps reports per-step progress.
+	// +optional
+	Steps []PlaybookStepStatus `json:"steps,omitempty"`
+
+	// Approvals records approvals for Approval steps.
+	// +optional
+	// +listType=map
+	// +listMapKey=step
+	Approvals []PlaybookApproval `json:"approvals,omitempty"`
+
+	// ResolvedTargets lists the TenantClusters selected when the run started.
+	// Targets are fixed for the lifetime of the run.
+	// +optional
+	ResolvedTargets []NamespacedObjectReference `json:"resolvedTargets,omitempty"`
+
+	// StartTime is when the run started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is when the run finished.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// ObservedGeneration is the last observed generation.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=pb,categories=butler
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Run phase"
+// +kubebuilder:printcolumn:name="Step",type="integer",JSONPath=".status.currentStep",description="Current step index"
+// +kubebuilder:printcolumn:name="Paused",type="boolean",JSONPath=".spec.paused",description="Execution paused"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// Playbook codifies a day-2 operation as an ordered list of typed steps
+// run against a set of TenantClusters. Progress is persisted in status so
+// a run survives controller restarts and can be paused and resumed.
+// Playbooks in a team namespace may only target that team's clusters.
+type Playbook struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PlaybookSpec   `json:"spec,omitempty"`
+	Status PlaybookStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PlaybookList contains a list of Playbook.
+type PlaybookList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Playbook `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Playbook{}, &PlaybookList{})
+}
+
+// Helper methods
+
+// IsComplete returns true if the run has finished.
+func (p *Playbook) IsComplete() bool {
+	return p.Status.Phase == PlaybookPhaseSucceeded || p.Status.Phase == PlaybookPhaseFailed
+}
+
+// IsStepApproved returns true if status.approvals holds an approval for the
+// step from a user the step allows to approve it.
+func (p *Playbook) IsStepApproved(step string) bool {
+	a := p.GetApproval(step)
+	return a != nil && a.ApprovedBy != "" && p.CanApprove(step, a.ApprovedBy, a.Groups)
+}
+
+// CanApprove returns true if the user, a member of groups, may approve the
+// named step. Returns false if the step is not an Approval step.
+func (p *Playbook) CanApprove(step, user string, groups []string) bool {
+	s := p.GetStep(step)
+	if s == nil || s.Type != PlaybookStepApproval {
+		return false
+	}
+	if s.Approval == nil || len(s.Approval.Approvers) == 0 {
+		return true
+	}
+	return listsApprover(s.Approval.Approvers, user, groups)
+}
+
+// GetStep returns the named step, or nil.
+func (p *Playbook) GetStep(name string) *PlaybookStep {
+	for i := range p.Spec.Steps {
+		if p.Spec.Steps[i].Name == name {
+			return &p.Spec.Steps[i]
+		}
+	}
+	return nil
+}
+
+// GetApproval returns the recorded approval for the named step, or nil.
+func (p *Playbook) GetApproval(step string) *PlaybookApproval {
+	for i := range p.Status.Approvals {
+		if p.Status.Approvals[i].Step == step {
+			return &p.Status.Approvals[i]
+		}
+	}
+	return nil
+}
+
+// GetStepStatus returns the status entry for the named step, or nil.
+func (p *Playbook) GetStepStatus(step string) *PlaybookStepStatus {
+	for i := range p.Status.Steps {
+		if p.Status.Steps[i].Name == step {
+			return &p.Status.Steps[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import "testing"
+
+func approvalPlaybook(approvers ...string) *Playbook {
+	return &Playbook{
+		Spec: PlaybookSpec{
+			Steps: []PlaybookStep{
+				{Name: "drain", Type: PlaybookStepDrainNode, DrainNode: &PlaybookNodeStep{NodeName: "worker-0"}},
+				{Name: "sign-off", Type: PlaybookStepApproval, Approval: &PlaybookApprovalStep{Approvers: approvers}},
+				{Name: "replace", Type: PlaybookStepReplaceNode, ReplaceNode: &PlaybookNodeStep{NodeName: "worker-0"}},
+			},
+		},
+	}
+}
+
+func TestPlaybookIsStepApproved(t *testing.T) {
+	tests := []struct {
+		name      string
+		approvers []string
+		approval  *PlaybookApproval
+		want      bool
+	}{
+		{name: "no approval", approvers: []string{"alice@example.com"}},
+		{
+			name:      "listed user",
+			approvers: []string{"alice@example.com"},
+			approval:  &PlaybookApproval{Step: "sign-off", ApprovedBy: "alice@example.com"},
+			want:      true,
+		},
+		{
+			name:      "listed group",
+			approvers: []string{"sre"},
+			approval:  &PlaybookApproval{Step: "sign-off", ApprovedBy: "bob@example.com", Groups: []string{"dev", "sre"}},
+			want:      true,
+		},
+		{
+			name:      "unlisted user",
+			approvers: []string{"alice@example.com", "sre"},
+			approval:  &PlaybookApproval{Step: "sign-off", ApprovedBy: "mallory@example.com", Groups: []string{"dev"}},
+		},
+		{
+			name:     "no approvers listed",
+			approval: &PlaybookApproval{Step: "sign-off", ApprovedBy: "bob@example.com"},
+			want:     true,
+		},
+		{
+			name:     "approver not recorded",
+			approval: &PlaybookApproval{Step: "sign-off"},
+		},
+		{
+			name:     "not an approval step",
+			approval: &PlaybookApproval{Step: "drain", ApprovedBy: "bob@example.com"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := approvalPlaybook(tt.approvers...)
+			step := "sign-off"
+			if tt.approval != nil {
+				p.Status.Approvals = []PlaybookApproval{*tt.approval}
+				step = tt.approval.Step
+			}
+			if got := p.IsStepApproved(step); got != tt.want {
+				t.Errorf("IsStepApproved(%q) = %v, want %v", step, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlaybookProgress(t *testing.T) {
+	p := approvalPlaybook()
+	if p.IsComplete() {
+		t.Error("new Playbook is complete")
+	}
+	if p.GetStepStatus("drain") != nil {
+		t.Error("GetStepStatus(drain) returned a status before the run started")
+	}
+
+	// A restarted controller resumes from the persisted status.
+	p.Status = PlaybookStatus{
+		Phase:       PlaybookPhasePaused,
+		CurrentStep: 1,
+		Steps: []PlaybookStepStatus{
+			{Name: "drain", Phase: PlaybookStepPhaseSucceeded},
+			{Name: "sign-off", Phase: PlaybookStepPhasePending},
+		},
+	}
+	if p.IsComplete() {
+		t.Error("paused Playbook is complete")
+	}
+	if s := p.GetStepStatus("sign-off"); s == nil || s.Name != p.Spec.Steps[p.Status.CurrentStep].Name {
+		t.Errorf("GetStepStatus(sign-off) = %+v, want the status of the current step", s)
+	}
+	p.GetStepStatus("sign-off").Phase = PlaybookStepPhaseRunning
+	if got := p.Status.Steps[1].Phase; got != PlaybookStepPhaseRunning {
+		t.Errorf("GetStepStatus did not return a pointer into status; phase = %s", got)
+	}
+	if p.GetStep("missing") != nil || p.GetApproval("sign-off") != nil {
+		t.Error("lookup of a missing step or approval returned an entry")
+	}
+
+	for _, phase := range []PlaybookPhase{PlaybookPhaseSucceeded, PlaybookPhaseFailed} {
+		p.Status.Phase = phase
+		if !p.IsComplete() {
+			t.Errorf("IsComplete() = false in phase %s", phase)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"slices"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PolicyEngine is the admission policy engine an exception applies to.
+// +kubebuilder:validation:Enum=kyverno;gatekeeper
+type PolicyEngine string
+
+const (
+	// PolicyEngineKyverno creates a Kyverno PolicyException.
+	PolicyEngineKyverno PolicyEngine = "kyverno"
+
+	// PolicyEngineGatekeeper adds the namespaces to the constraint's
+	// excludedNamespaces.
+	PolicyEngineGatekeeper PolicyEngine = "gatekeeper"
+)
+
+// PolicyExceptionExpiryWarning is how long before expiry an exception is
+// reported by the PolicyExceptionsExpiring condition.
+const PolicyExceptionExpiryWarning = 7 * 24 * time.Hour
+
+// PolicyException grants a temporary exception from an admission policy in
+// a tenant cluster. Exceptions are applied to the policy engine in the
+// tenant cluster and removed by the controller at expiry; expired entries
+// remain in spec until deleted so that the approval stays on record.
+// +kubebuilder:validation:XValidation:rule="!has(self.rules) || self.engine == 'kyverno'",message="rules are only supported for kyverno"
+type PolicyException struct {
+	// Name identifies the exception.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+
+	// Engine is the policy engine enforcing the policy.
+	// +kubebuilder:validation:Required
+	Engine PolicyEngine `json:"engine"`
+
+	// Policy is the Kyverno ClusterPolicy name, or the Gatekeeper
+	// constraint as "{kind}/{name}".
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Policy string `json:"policy"`
+
+	// Rules limits a Kyverno exception to these rules of the policy.
+	// If empty, every rule is excepted.
+	// +optional
+	// +listType=set
+	Rules []string `json:"rules,omitempty"`
+
+	// Namespaces limits the exception to these tenant cluster namespaces.
+	// If empty, the exception applies cluster-wide.
+	// +optional
+	// +listType=set
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// Justification is the reason recorded for auditors.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=512
+	Justification string `json:"justification"`
+
+	// Approver is the security reviewer who approved the exception.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=254
+	Approver string `json:"approver"`
+
+	// ExpiresAt is when the exception is removed from the tenant cluster.
+	// +kubebuilder:validation:Required
+	ExpiresAt metav1.Time `json:"expiresAt"`
+}
+
+// PolicyExceptionStatus records the lifetime of a PolicyException.
+type PolicyExceptionStatus struct {
+	// Name identifies the exception.
+	Name string `json:"name"`
+
+	// Policy is the excepted policy.
+	// +optional
+	Policy string `json:"policy,omitempty"`
+
+	// AppliedAt is when the exception was applied to the tenant cluster.
+	// +optional
+	AppliedAt *metav1.Time `json:"appliedAt,omitempty"`
+
+	// RemovedAt is when the exception was removed, either at expiry or
+	// because it was deleted from spec.
+	// +optional
+	RemovedAt *metav1.Time `json:"removedAt,omitempty"`
+}
+
+// IsExpired returns true if the exception has passed its expiry time.
+func (e *PolicyException) IsExpired(now time.Time) bool {
+	return !now.Before(e.ExpiresAt.Time)
+}
+
+// ExpiredPolicyExceptions returns the names of exceptions expired at now.
+// Drives the PolicyExceptionsExpired condition.
+func ExpiredPolicyExceptions(exceptions []PolicyException, now time.Time) []string {
+	var names []string
+	for i := range exceptions {
+		if exceptions[i].IsExpired(now) {
+			names = append(names, exceptions[i].Name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// ExpiringPolicyExceptions returns the names of active exceptions that
+// expire within PolicyExceptionExpiryWarning of now. Drives the
+// PolicyExceptionsExpiring condition.
+func ExpiringPolicyExceptions(exceptions []PolicyException, now time.Time) []string {
+	var names []string
+	for i := range exceptions {
+		e := &exceptions[i]
+		if !e.IsExpired(now) && e.ExpiresAt.Sub(now) <= PolicyExceptionExpiryWarning {
+			names = append(names, e.Name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// NextPolicyExceptionTransition returns the earliest time after now at
+// which an exception expires or enters the expiry warning window, so the
+// controller can requeue to update conditions. Returns nil if none.
+func NextPolicyExceptionTransition(exceptions []PolicyException, now time.Time) *time.Time {
+	var next *time.Time
+	consider := func(t time.Time) {
+		if t.After(now) && (next == nil || t.Before(*next)) {
+			next = &t
+		}
+	}
+	for i := range exceptions {
+		expires := exceptions[i].ExpiresAt.Time
+		consider(expires)
+		consider(expires.Add(-PolicyExceptionExpiryWarning))
+	}
+	return next
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPolicyExceptionExpiry(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) metav1.Time { return metav1.NewTime(now.Add(d)) }
+	exceptions := []PolicyException{
+		{Name: "privileged-csi", ExpiresAt: at(30 * 24 * time.Hour)},
+		{Name: "host-path", ExpiresAt: at(-time.Hour)},
+		{Name: "latest-tag", ExpiresAt: at(2 * 24 * time.Hour)},
+		{Name: "run-as-root", ExpiresAt: at(0)},
+	}
+
+	if got, want := ExpiredPolicyExceptions(exceptions, now), []string{"host-path", "run-as-root"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ExpiredPolicyExceptions() = %v, want %v", got, want)
+	}
+	if got, want := ExpiringPolicyExceptions(exceptions, now), []string{"latest-tag"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ExpiringPolicyExceptions() = %v, want %v", got, want)
+	}
+
+	next := NextPolicyExceptionTransition(exceptions, now)
+	if want := now.Add(2 * 24 * time.Hour); next == nil || !next.Equal(want) {
+		t.Errorf("NextPolicyExceptionTransition() = %v, want %v", next, want)
+	}
+	if next := NextPolicyExceptionTransition(exceptions[1:2], now); next != nil {
+		t.Errorf("NextPolicyExceptionTransition() = %v, want nil when all expired", next)
+	}
+}
@@ -0,0 +1,347 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PreviewIsolation selects how a preview environment is isolated in the
+// tenant cluster.
+// +kubebuilder:validation:Enum=Namespace;VCluster
+type PreviewIsolation string
+
+const (
+	// PreviewIsolationNamespace deploys into a dedicated namespace.
+	PreviewIsolationNamespace PreviewIsolation = "Namespace"
+
+	// PreviewIsolationVCluster deploys into a virtual cluster hosted in a
+	// dedicated namespace, for changes that need cluster-scoped resources.
+	PreviewIsolationVCluster PreviewIsolation = "VCluster"
+)
+
+// PullRequestState is the state of the pull request backing a preview.
+// +kubebuilder:validation:Enum=Open;Closed;Merged
+type PullRequestState string
+
+const (
+	// PullRequestStateOpen indicates the pull request is open.
+	PullRequestStateOpen PullRequestState = "Open"
+
+	// PullRequestStateClosed indicates the pull request was closed without merging.
+	PullRequestStateClosed PullRequestState = "Closed"
+
+	// PullRequestStateMerged indicates the pull request was merged.
+	PullRequestStateMerged PullRequestState = "Merged"
+)
+
+// PreviewEnvironmentPhase represents the current phase of a PreviewEnvironment.
+// +kubebuilder:validation:Enum=Pending;Provisioning;Ready;Failed;Expired;Deleting
+type PreviewEnvironmentPhase string
+
+const (
+	// PreviewEnvironmentPhasePending indicates the environment has not been created yet.
+	PreviewEnvironmentPhasePending PreviewEnvironmentPhase = "Pending"
+
+	// PreviewEnvironmentPhaseProvisioning indicates the namespace or vcluster
+	// is being created or manifests are being applied.
+	PreviewEnvironmentPhaseProvisioning PreviewEnvironmentPhase = "Provisioning"
+
+	// PreviewEnvironmentPhaseReady indicates the head commit is deployed.
+	PreviewEnvironmentPhaseReady PreviewEnvironmentPhase = "Ready"
+
+	// PreviewEnvironmentPhaseFailed indicates the deployment failed.
+	PreviewEnvironmentPhaseFailed PreviewEnvironmentPhase = "Failed"
+
+	// PreviewEnvironmentPhaseExpired indicates the TTL elapsed. The
+	// environment is torn down and the object deleted.
+	PreviewEnvironmentPhaseExpired PreviewEnvironmentPhase = "Expired"
+
+	// PreviewEnvironmentPhaseDeleting indicates the environment is being torn down.
+	PreviewEnvironmentPhaseDeleting PreviewEnvironmentPhase = "Deleting"
+)
+
+// PreviewEnvironment condition types.
+const (
+	// PreviewEnvironmentConditionReady indicates the head commit is deployed
+	// and the URL is reachable.
+	PreviewEnvironmentConditionReady = "Ready"
+
+	// PreviewEnvironmentConditionUpToDate indicates the deployed commit
+	// matches spec.source.headSHA.
+	PreviewEnvironmentConditionUpToDate = "UpToDate"
+)
+
+// PreviewEnvironmentSpec defines the desired state of PreviewEnvironment.
+type PreviewEnvironmentSpec struct {
+	// ClusterRef references the TenantCluster in the same namespace that
+	// hosts the environment.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="clusterRef is immutable"
+	ClusterRef LocalObjectReference `json:"clusterRef"`
+
+	// Isolation selects a namespace or a vcluster in the tenant cluster.
+	// +kubebuilder:default=Namespace
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="isolation is immutable"
+	// +optional
+	Isolation PreviewIsolation `json:"isolation,omitempty"`
+
+	// Source identifies the pull request the environment previews.
+	// +kubebuilder:validation:Required
+	Source PreviewSource `json:"source"`
+
+	// Manifests seeds the environment from the pull request head.
+	// +kubebuilder:validation:Required
+	Manifests PreviewManifests `json:"manifests"`
+
+	// Hostname is the public host of the environment. Reported as
+	// status.url. If empty, the first Ingress host in the environment
+	// is reported instead.
+	// +optional
+	Hostname string `json:"hostname,omitempty"`
+
+	// TTL is how long the environment lives after the last deployment.
+	// Each new head commit restarts the TTL.
+	// +kubebuilder:default="72h"
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+
+	// DeleteOnClose deletes the environment when the pull request is
+	// closed or merged.
+	// +kubebuilder:default=true
+	// +optional
+	DeleteOnClose *bool `json:"deleteOnClose,omitempty"`
+}
+
+// PreviewSource identifies a pull request.
+// Pull request webhooks from the provider update headSHA on new commits
+// and record the closed or merged state in status. Webhooks are verified
+// with ButlerConfig.spec.gitProvider.webhookSecretRef.
+type PreviewSource struct {
+	// Provider is the Git provider hosting the repository.
+	// +kubebuilder:validation:Required
+	Provider GitProviderType `json:"provider"`
+
+	// Repository is the repository path (e.g., "acme/storefront").
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="repository is immutable"
+	Repository string `json:"repository"`
+
+	// PullRequest is the pull request (or merge request) number.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="pullRequest is immutable"
+	PullRequest int32 `json:"pullRequest"`
+
+	// HeadRef is the source branch of the pull request.
+	// +optional
+	HeadRef string `json:"headRef,omitempty"`
+
+	// HeadSHA is the commit to deploy. Updated by webhooks on each push.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[0-9a-f]{40}$`
+	HeadSHA string `json:"headSHA"`
+}
+
+// PreviewManifests describes what is deployed into a preview environment.
+// +kubebuilder:validation:XValidation:rule="has(self.path) || has(self.helm)",message="path or helm is required"
+type PreviewManifests struct {
+	// Path is a directory in the repository holding plain manifests or a
+	// kustomization, applied at the head commit.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Helm installs a chart into the environment.
+	// +optional
+	Helm *HelmChartSpec `json:"helm,omitempty"`
+
+	// Values are Helm values for the chart. String values may reference
+	// cluster variables as ${butler.<name>}; see ValuesContext.
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Values *ExtensionValues `json:"values,omitempty"`
+}
+
+// PreviewEnvironmentStatus defines the observed state of PreviewEnvironment.
+type PreviewEnvironmentStatus struct {
+	// Conditions represent the latest available observations.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Phase represents the current phase of the environment.
+	// +optional
+	Phase PreviewEnvironmentPhase `json:"phase,omitempty"`
+
+	// URL is where the environment is reachable.
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// Namespace is the namespace in the tenant cluster holding the
+	// environment, or the vcluster.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// DeployedSHA is the commit currently deployed.
+	// +optional
+	DeployedSHA string `json:"deployedSHA,omitempty"`
+
+	// LastDeployedAt is when DeployedSHA was deployed.
+	// +optional
+	LastDeployedAt *metav1.Time `json:"lastDeployedAt,omitempty"`
+
+	// ExpiresAt is when the environment is torn down unless a new commit
+	// is deployed.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// PullRequestState is the last state reported by webhooks.
+	// +optional
+	PullRequestState PullRequestState `json:"pullRequestState,omitempty"`
+
+	// Message provides human-readable status information.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// ObservedGeneration is the last observed generation.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=pvenv,categories=butler
+// +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".spec.clusterRef.name",description="Tenant cluster"
+// +kubebuilder:printcolumn:name="Repository",type="string",JSONPath=".spec.source.repository",description="Repository"
+// +kubebuilder:printcolumn:name="PR",type="integer",JSONPath=".spec.source.pullRequest",description="Pull request"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Environment phase"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",description="Environment URL"
+// +kubebuilder:printcolumn:name="Expires",type="date",JSONPath=".status.expiresAt",description="Expiry time",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// PreviewEnvironment is a short-lived environment for a pull request,
+// deployed into a namespace or vcluster of a TenantCluster. CI creates it
+// in the TenantCluster's namespace, named with PreviewEnvironmentName;
+// provider webhooks then keep it on the pull request head and remove it
+// when the pull request closes.
+type PreviewEnvironment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PreviewEnvironmentSpec   `json:"spec,omitempty"`
+	Status PreviewEnvironmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PreviewEnvironmentList contains a list of PreviewEnvironment.
+type PreviewEnvironmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PreviewEnvironment `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PreviewEnvironment{}, &PreviewEnvironmentList{})
+}
+
+// Helper methods
+
+// previewNameInvalid matches runs of characters not allowed in a DNS label.
+var previewNameInvalid = regexp.MustCompile(`[^a-z0-9]+`)
+
+// PreviewEnvironmentName returns the deterministic object name for a pull
+// request, so webhooks can find the environment without a list call.
+// Names have the form "{repo}-pr-{number}-{hash}" and fit in a DNS label.
+func PreviewEnvironmentName(provider GitProviderType, repository string, pullRequest int32) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s/%s#%d", provider, repository, pullRequest)))
+	repo := repository
+	if i := strings.LastIndex(repo, "/"); i >= 0 {
+		repo = repo[i+1:]
+	}
+	repo = strings.Trim(previewNameInvalid.ReplaceAllString(strings.ToLower(repo), "-"), "-")
+	suffix := fmt.Sprintf("-pr-%d-%s", pullRequest, hex.EncodeToString(sum[:])[:8])
+	if max := 63 - len(suffix); len(repo) > max {
+		repo = strings.TrimRight(repo[:max], "-")
+	}
+	if repo == "" {
+		return suffix[1:]
+	}
+	return repo + suffix
+}
+
+// GetTTL returns the environment TTL, defaulting to 72h.
+func (p *PreviewEnvironment) GetTTL() time.Duration {
+	if p.Spec.TTL == nil {
+		return 72 * time.Hour
+	}
+	return p.Spec.TTL.Duration
+}
+
+// ShouldDeleteOnClose returns true if the environment is removed when its
+// pull request closes. Defaults to true.
+func (p *PreviewEnvironment) ShouldDeleteOnClose() bool {
+	return p.Spec.DeleteOnClose == nil || *p.Spec.DeleteOnClose
+}
+
+// ComputeExpiry returns when the environment expires if deployedAt was the
+// last deployment.
+func (p *PreviewEnvironment) ComputeExpiry(deployedAt time.Time) metav1.Time {
+	return metav1.NewTime(deployedAt.Add(p.GetTTL()))
+}
+
+// IsExpired returns true if the environment has passed its expiry time.
+func (p *PreviewEnvironment) IsExpired(now time.Time) bool {
+	return p.Status.ExpiresAt != nil && !now.Before(p.Status.ExpiresAt.Time)
+}
+
+// ShouldTearDown returns true if the environment has expired, or its pull
+// request is closed or merged and DeleteOnClose is set.
+func (p *PreviewEnvironment) ShouldTearDown(now time.Time) bool {
+	if p.IsExpired(now) {
+		return true
+	}
+	closed := p.Status.PullRequestState == PullRequestStateClosed || p.Status.PullRequestState == PullRequestStateMerged
+	return closed && p.ShouldDeleteOnClose()
+}
+
+// IsUpToDate returns true if the head commit is deployed.
+func (p *PreviewEnvironment) IsUpToDate() bool {
+	return p.Status.DeployedSHA != "" && p.Status.DeployedSHA == p.Spec.Source.HeadSHA
+}
+
+// TargetNamespace returns the namespace in the tenant cluster that holds
+// the environment, defaulting to "preview-{name}".
+func (p *PreviewEnvironment) TargetNamespace() string {
+	if p.Status.Namespace != "" {
+		return p.Status.Namespace
+	}
+	name := "preview-" + p.Name
+	if len(name) > 63 {
+		name = strings.TrimRight(name[:63], "-")
+	}
+	return name
+}
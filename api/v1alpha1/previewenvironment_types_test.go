@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPreviewEnvironmentName(t *testing.T) {
+	tests := []struct {
+		name       string
+		repository string
+		pr         int32
+		wantPrefix string
+	}{
+		{"simple", "acme/storefront", 42, "storefront-pr-42-"},
+		{"sanitized", "acme/Store_Front.v2", 7, "store-front-v2-pr-7-"},
+		{"nested group", "acme/platform/api", 1, "api-pr-1-"},
+		{"truncated", "acme/" + strings.Repeat("a", 80), 12345, strings.Repeat("a", 45) + "-pr-12345-"},
+		{"no usable characters", "acme/___", 3, "pr-3-"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PreviewEnvironmentName(GitProviderTypeGitHub, tt.repository, tt.pr)
+			if !strings.HasPrefix(got, tt.wantPrefix) {
+				t.Errorf("PreviewEnvironmentName() = %q, want prefix %q", got, tt.wantPrefix)
+			}
+			if len(got) > 63 {
+				t.Errorf("PreviewEnvironmentName() = %q, longer than 63 characters", got)
+			}
+			if again := PreviewEnvironmentName(GitProviderTypeGitHub, tt.repository, tt.pr); again != got {
+				t.Errorf("PreviewEnvironmentName() not deterministic: %q != %q", again, got)
+			}
+		})
+	}
+
+	if PreviewEnvironmentName(GitProviderTypeGitHub, "a/app", 1) == PreviewEnvironmentName(GitProviderTypeGitHub, "b/app", 1) {
+		t.Error("PreviewEnvironmentName() collides for repositories with the same base name")
+	}
+}
+
+func TestPreviewEnvironmentShouldTearDown(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	past := metav1.NewTime(now.Add(-time.Minute))
+	future := metav1.NewTime(now.Add(time.Hour))
+	keep := false
+
+	tests := []struct {
+		name          string
+		expiresAt     *metav1.Time
+		state         PullRequestState
+		deleteOnClose *bool
+		want          bool
+	}{
+		{"open and live", &future, PullRequestStateOpen, nil, false},
+		{"expired", &past, PullRequestStateOpen, nil, true},
+		{"merged", &future, PullRequestStateMerged, nil, true},
+		{"closed", nil, PullRequestStateClosed, nil, true},
+		{"closed but kept", &future, PullRequestStateClosed, &keep, false},
+		{"kept but expired", &past, PullRequestStateClosed, &keep, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &PreviewEnvironment{
+				Spec:   PreviewEnvironmentSpec{DeleteOnClose: tt.deleteOnClose},
+				Status: PreviewEnvironmentStatus{ExpiresAt: tt.expiresAt, PullRequestState: tt.state},
+			}
+			if got := p.ShouldTearDown(now); got != tt.want {
+				t.Errorf("ShouldTearDown() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPreviewEnvironmentComputeExpiry(t *testing.T) {
+	deployed := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	p := &PreviewEnvironment{}
+	if got := p.ComputeExpiry(deployed); !got.Time.Equal(deployed.Add(72 * time.Hour)) {
+		t.Errorf("ComputeExpiry() default = %v, want 72h after deploy", got)
+	}
+
+	p.Spec.TTL = &metav1.Duration{Duration: 4 * time.Hour}
+	if got := p.ComputeExpiry(deployed); !got.Time.Equal(deployed.Add(4 * time.Hour)) {
+		t.Errorf("ComputeExpiry() = %v, want 4h after deploy", got)
+	}
+}
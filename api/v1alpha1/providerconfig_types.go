@@ -17,11 +17,15 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"net"
+	"strconv"
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // ProviderType defines the supported infrastructure providers.
-// +kubebuilder:validation:Enum=harvester;nutanix;proxmox;azure;aws;gcp
+// +kubebuilder:validation:Enum=harvester;nutanix;proxmox;vsphere;openstack;baremetal;azure;aws;gcp
 type ProviderType string
 
 const (
@@ -34,6 +38,15 @@ const (
 	// ProviderTypeProxmox is the Proxmox VE provider.
 	ProviderTypeProxmox ProviderType = "proxmox"
 
+	// ProviderTypeVSphere is the VMware vSphere provider.
+	ProviderTypeVSphere ProviderType = "vsphere"
+
+	// ProviderTypeOpenStack is the OpenStack provider.
+	ProviderTypeOpenStack ProviderType = "openstack"
+
+	// ProviderTypeBareMetal provisions physical servers through their BMC.
+	ProviderTypeBareMetal ProviderType = "baremetal"
+
 	// ProviderTypeAzure is the Microsoft Azure provider.
 	ProviderTypeAzure ProviderType = "azure"
 
@@ -48,6 +61,7 @@ const (
 type ProviderConfigSpec struct {
 	// Provider specifies the infrastructure provider type.
 	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="provider is immutable"
 	Provider ProviderType `json:"provider"`
 
 	// CredentialsRef references the Secret containing provider credentials.
@@ -55,10 +69,21 @@ type ProviderConfigSpec struct {
 	// - harvester: "kubeconfig" (Harvester kubeconfig)
 	// - nutanix: "username", "password"
 	// - proxmox: "username", "password" or "token"
+	// - vsphere: "username", "password"
+	// - openstack: "applicationCredentialID", "applicationCredentialSecret"
+	// - baremetal: "username", "password" (default BMC credentials)
 	// - gcp: "serviceAccountKey" (JSON service account key)
 	// +kubebuilder:validation:Required
 	CredentialsRef SecretReference `json:"credentialsRef"`
 
+	// CredentialExpiryWarning is how long before the credentials expire the
+	// CredentialsExpiringSoon condition is set, so rotation can be planned.
+	// Expiry is detected from token and certificate metadata where the
+	// provider exposes it.
+	// +kubebuilder:default="336h"
+	// +optional
+	CredentialExpiryWarning *metav1.Duration `json:"credentialExpiryWarning,omitempty"`
+
 	// Harvester contains Harvester-specific configuration.
 	// Required when provider is "harvester".
 	// +optional
@@ -74,6 +99,21 @@ type ProviderConfigSpec struct {
 	// +optional
 	Proxmox *ProxmoxProviderConfig `json:"proxmox,omitempty"`
 
+	// VSphere contains vSphere-specific configuration.
+	// Required when provider is "vsphere".
+	// +optional
+	VSphere *VSphereProviderConfig `json:"vsphere,omitempty"`
+
+	// OpenStack contains OpenStack-specific configuration.
+	// Required when provider is "openstack".
+	// +optional
+	OpenStack *OpenStackProviderConfig `json:"openstack,omitempty"`
+
+	// BareMetal contains bare-metal configuration.
+	// Required when provider is "baremetal".
+	// +optional
+	BareMetal *BareMetalProviderConfig `json:"baremetal,omitempty"`
+
 	// Azure contains Azure-specific configuration.
 	// Required when provider is "azure".
 	// +optional
@@ -102,6 +142,274 @@ type ProviderConfigSpec struct {
 	// Limits defines resource limits enforced per-team on this provider.
 	// +optional
 	Limits *ProviderLimits `json:"limits,omitempty"`
+
+	// Bastion configures an SSH jump host for out-of-band access to the
+	// infrastructure, such as uploading images to hypervisors or
+	// troubleshooting. Used by controllers and the console's connect features.
+	// +optional
+	Bastion *BastionConfig `json:"bastion,omitempty"`
+
+	// ImageCache pre-pulls machine images to the provider so machines boot
+	// from a local copy instead of downloading the image per provision.
+	// +optional
+	ImageCache *ImageCacheConfig `json:"imageCache,omitempty"`
+
+	// ClientRateLimit tunes how controllers call the provider API. Providers
+	// such as Prism Central and Harvester throttle aggressive clients.
+	// Changes take effect without restarting controllers.
+	// +optional
+	ClientRateLimit *ProviderClientRateLimit `json:"clientRateLimit,omitempty"`
+
+	// FreezeWindows are maintenance windows during which no new
+	// MachineRequests are fulfilled on this provider. Requests created during
+	// a window stay Pending until it ends.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=32
+	FreezeWindows []FreezeWindow `json:"freezeWindows,omitempty"`
+
+	// FailureDomains lists independent failure domains on this provider,
+	// such as availability zones, racks, or hypervisor clusters. Worker
+	// pools are distributed across them according to their distribution
+	// policy. If empty, machines are placed without regard to failure domains.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	FailureDomains []FailureDomain `json:"failureDomains,omitempty"`
+}
+
+// BastionConfig configures an SSH jump host.
+type BastionConfig struct {
+	// Host is the hostname or IP address of the jump host.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Host string `json:"host"`
+
+	// Port is the SSH port of the jump host.
+	// +kubebuilder:default=22
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port int32 `json:"port,omitempty"`
+
+	// User is the SSH user on the jump host.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	User string `json:"user"`
+
+	// PrivateKeySecretRef references the Secret containing the SSH private key.
+	// Key defaults to "ssh-privatekey", matching kubernetes.io/ssh-auth Secrets.
+	// +kubebuilder:validation:Required
+	PrivateKeySecretRef SecretReference `json:"privateKeySecretRef"`
+
+	// HostKey is the jump host's public key in authorized_keys format
+	// (e.g., "ssh-ed25519 AAAA..."). If empty, the key presented on first
+	// connection is recorded in status.bastionHostKey and required thereafter.
+	// +optional
+	HostKey string `json:"hostKey,omitempty"`
+}
+
+// ImageCacheConfig configures image pre-pulling on a provider.
+// The controller maintains an ImageSync per image and re-checks the factory
+// for rebuilt artifacts every refresh interval.
+type ImageCacheConfig struct {
+	// Enabled controls whether images are pre-pulled.
+	// +kubebuilder:default=true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Images lists images to keep cached on the provider.
+	// +kubebuilder:validation:MaxItems=64
+	// +optional
+	Images []ImageFactoryRef `json:"images,omitempty"`
+
+	// RefreshInterval is how often cached images are re-verified against
+	// the factory.
+	// +kubebuilder:default="24h"
+	// +optional
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
+
+	// StorageTarget is the provider storage that holds cached images:
+	// a StorageClass on Harvester, a storage ID on Proxmox, a datastore on
+	// vSphere, or a storage container on Nutanix. Defaults to the
+	// provider's image storage.
+	// +optional
+	StorageTarget string `json:"storageTarget,omitempty"`
+}
+
+// CachedImageStatus reports the cache state of a single image.
+type CachedImageStatus struct {
+	// FactoryRef identifies the cached image.
+	FactoryRef ImageFactoryRef `json:"factoryRef"`
+
+	// ImageSyncName is the name of the ImageSync maintaining the image.
+	// +optional
+	ImageSyncName string `json:"imageSyncName,omitempty"`
+
+	// Phase is the phase of the ImageSync.
+	// +optional
+	Phase ImageSyncPhase `json:"phase,omitempty"`
+
+	// ProviderImageRef is the provider-specific image reference once cached.
+	// +optional
+	ProviderImageRef string `json:"providerImageRef,omitempty"`
+
+	// LastRefreshTime is when the image was last verified.
+	// +optional
+	LastRefreshTime *metav1.Time `json:"lastRefreshTime,omitempty"`
+}
+
+// ImageCacheStatus reports the state of the provider image cache.
+type ImageCacheStatus struct {
+	// Images reports per-image cache state.
+	// +optional
+	Images []CachedImageStatus `json:"images,omitempty"`
+
+	// ReadyImages is the number of images available on the provider.
+	// +optional
+	ReadyImages int32 `json:"readyImages,omitempty"`
+
+	// LastRefreshTime is when the cache was last refreshed.
+	// +optional
+	LastRefreshTime *metav1.Time `json:"lastRefreshTime,omitempty"`
+}
+
+// ProviderClientRateLimit configures client-side throttling for a provider
+// API endpoint. Limits apply per ProviderConfig across all controllers.
+// +kubebuilder:validation:XValidation:rule="!has(self.qps) || !has(self.burst) || self.burst >= self.qps",message="burst must be greater than or equal to qps"
+type ProviderClientRateLimit struct {
+	// QPS is the sustained request rate to the provider API.
+	// +kubebuilder:default=10
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1000
+	// +optional
+	QPS int32 `json:"qps,omitempty"`
+
+	// Burst is the maximum request burst above QPS.
+	// +kubebuilder:default=20
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=2000
+	// +optional
+	Burst int32 `json:"burst,omitempty"`
+
+	// MaxConcurrentOperations caps long-running provider operations in
+	// flight, such as VM creation and image uploads.
+	// +kubebuilder:default=10
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=500
+	// +optional
+	MaxConcurrentOperations int32 `json:"maxConcurrentOperations,omitempty"`
+
+	// RequestTimeout is the timeout for a single provider API request.
+	// +kubebuilder:default="30s"
+	// +optional
+	RequestTimeout *metav1.Duration `json:"requestTimeout,omitempty"`
+}
+
+// CredentialExpiryStatus reports when the provider credentials expire.
+type CredentialExpiryStatus struct {
+	// ExpiresAt is when the credentials expire.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// DaysRemaining is the number of whole days until expiry at the last
+	// check. Negative once expired.
+	// +optional
+	DaysRemaining *int32 `json:"daysRemaining,omitempty"`
+
+	// Source describes what the expiry was read from
+	// (e.g., "proxmox-api-token", "kubeconfig-client-certificate").
+	// +optional
+	Source string `json:"source,omitempty"`
+
+	// LastCheckTime is when expiry was last checked.
+	// +optional
+	LastCheckTime *metav1.Time `json:"lastCheckTime,omitempty"`
+}
+
+// FreezeWindow is a time range during which provisioning is blocked.
+// +kubebuilder:validation:XValidation:rule="self.end > self.start",message="end must be after start"
+type FreezeWindow struct {
+	// Name identifies the window.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+
+	// Start is when the freeze begins.
+	// +kubebuilder:validation:Required
+	Start metav1.Time `json:"start"`
+
+	// End is when the freeze ends.
+	// +kubebuilder:validation:Required
+	End metav1.Time `json:"end"`
+
+	// Reason describes the maintenance (e.g., "SAN firmware upgrade").
+	// +optional
+	// +kubebuilder:validation:MaxLength=256
+	Reason string `json:"reason,omitempty"`
+
+	// BlockDeletions also holds machine deletions until the window ends.
+	// +optional
+	BlockDeletions bool `json:"blockDeletions,omitempty"`
+}
+
+// IsActive returns true if now falls within the window.
+func (w *FreezeWindow) IsActive(now time.Time) bool {
+	return !now.Before(w.Start.Time) && now.Before(w.End.Time)
+}
+
+// FailureDomain is a set of provider resources that fail independently
+// of other domains. Machines assigned to a domain are placed using its
+// zone, host selector, and provider-specific parameters.
+type FailureDomain struct {
+	// Name identifies the domain. Recorded on machines and nodes as the
+	// topology.kubernetes.io/zone label.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// Zone is the provider availability zone for the domain (e.g., a cloud
+	// zone, a Nova availability zone, or a Nutanix cluster).
+	// +optional
+	Zone string `json:"zone,omitempty"`
+
+	// HostSelector restricts the domain to hosts with these labels, such
+	// as Harvester node labels or vSphere host tags.
+	// +optional
+	HostSelector map[string]string `json:"hostSelector,omitempty"`
+
+	// Parameters are provider-specific placement settings for the domain,
+	// such as a Proxmox node, a vSphere compute cluster and datastore, or
+	// an OpenStack network.
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
+}
+
+// Placement returns base with the machine assigned to the domain. The
+// domain's zone replaces base's zone, and its host selector is merged
+// into base's, with the domain taking precedence.
+func (d *FailureDomain) Placement(base *PlacementSpec) *PlacementSpec {
+	p := base.DeepCopy()
+	if p == nil {
+		p = &PlacementSpec{}
+	}
+	p.FailureDomain = d.Name
+	if d.Zone != "" {
+		p.AvailabilityZone = d.Zone
+	}
+	if len(d.HostSelector) > 0 {
+		if p.HostSelector == nil {
+			p.HostSelector = map[string]string{}
+		}
+		for k, v := range d.HostSelector {
+			p.HostSelector[k] = v
+		}
+	}
+	return p
 }
 
 // HarvesterProviderConfig contains Harvester-specific configuration.
@@ -207,6 +515,285 @@ type VMIDRange struct {
 	End int32 `json:"end"`
 }
 
+// VSphereProviderConfig contains vSphere-specific configuration.
+// Inventory objects may be given by name or by inventory path
+// (e.g., "/dc1/host/cluster1/Resources/butler").
+type VSphereProviderConfig struct {
+	// Server is the vCenter server address.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Server string `json:"server"`
+
+	// Insecure allows insecure TLS connections.
+	// +kubebuilder:default=false
+	// +optional
+	Insecure bool `json:"insecure,omitempty"`
+
+	// Thumbprint is the SHA-1 thumbprint of the vCenter server certificate.
+	// Pins the certificate when vCenter uses a self-signed certificate.
+	// +kubebuilder:validation:Pattern=`^([0-9A-Fa-f]{2}:){19}[0-9A-Fa-f]{2}$`
+	// +optional
+	Thumbprint string `json:"thumbprint,omitempty"`
+
+	// Datacenter is the vSphere datacenter for VMs.
+	// +kubebuilder:validation:Required
+	Datacenter string `json:"datacenter"`
+
+	// Datastore is the default datastore for VM disks.
+	// +kubebuilder:validation:Required
+	Datastore string `json:"datastore"`
+
+	// ResourcePool is the resource pool for VMs.
+	// Defaults to the root resource pool of the datacenter's only cluster.
+	// +optional
+	ResourcePool string `json:"resourcePool,omitempty"`
+
+	// Folder is the VM folder for VMs.
+	// Defaults to the datacenter's root VM folder.
+	// +optional
+	Folder string `json:"folder,omitempty"`
+
+	// Network is the port group or distributed port group for the primary NIC.
+	// +kubebuilder:validation:Required
+	Network string `json:"network"`
+
+	// Template is the default VM template to clone.
+	// Used when MachineRequest doesn't specify an image.
+	// +optional
+	Template string `json:"template,omitempty"`
+}
+
+// OpenStackProviderConfig contains OpenStack-specific configuration.
+type OpenStackProviderConfig struct {
+	// AuthURL is the Keystone identity endpoint (e.g., "https://keystone.example.com:5000/v3").
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^https?://`
+	AuthURL string `json:"authURL"`
+
+	// Region is the OpenStack region (e.g., "RegionOne").
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// ProjectID is the project (tenant) the VMs are created in.
+	// +kubebuilder:validation:Required
+	ProjectID string `json:"projectID"`
+
+	// CACertSecretRef references a Secret with a "ca.crt" key used to verify
+	// the OpenStack API endpoints.
+	// +optional
+	CACertSecretRef *SecretReference `json:"caCertSecretRef,omitempty"`
+
+	// AvailabilityZone is the Nova availability zone for VMs.
+	// +optional
+	AvailabilityZone string `json:"availabilityZone,omitempty"`
+
+	// Flavor is the default Nova flavor for worker nodes (e.g., "m1.large").
+	// +kubebuilder:validation:Required
+	Flavor string `json:"flavor"`
+
+	// ControlPlaneFlavor is the default Nova flavor for control plane nodes.
+	// Defaults to Flavor.
+	// +optional
+	ControlPlaneFlavor string `json:"controlPlaneFlavor,omitempty"`
+
+	// NetworkID is the Neutron network ID for the primary NIC.
+	// +kubebuilder:validation:Required
+	NetworkID string `json:"networkID"`
+
+	// SubnetID is the Neutron subnet ID within the network.
+	// Defaults to the network's only subnet.
+	// +optional
+	SubnetID string `json:"subnetID,omitempty"`
+
+	// SecurityGroupIDs are the Neutron security group IDs applied to VM ports.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIDs,omitempty"`
+
+	// FloatingIPNetworkID is the external network used to allocate floating
+	// IPs for load balancers. If empty, no floating IPs are allocated.
+	// +optional
+	FloatingIPNetworkID string `json:"floatingIPNetworkID,omitempty"`
+
+	// Image is the default Glance image name or ID.
+	// Used when MachineRequest doesn't specify an image.
+	// +optional
+	Image string `json:"image,omitempty"`
+}
+
+// GetControlPlaneFlavor returns the control plane flavor, falling back to Flavor.
+func (c *OpenStackProviderConfig) GetControlPlaneFlavor() string {
+	if c.ControlPlaneFlavor != "" {
+		return c.ControlPlaneFlavor
+	}
+	return c.Flavor
+}
+
+// BMCProtocol is the protocol used to manage a server through its BMC.
+// +kubebuilder:validation:Enum=Redfish;IPMI
+type BMCProtocol string
+
+const (
+	// BMCProtocolRedfish uses the DMTF Redfish API.
+	BMCProtocolRedfish BMCProtocol = "Redfish"
+
+	// BMCProtocolIPMI uses IPMI over LAN. Virtual media is not available
+	// over IPMI, so hosts must boot with iPXE.
+	BMCProtocolIPMI BMCProtocol = "IPMI"
+)
+
+// BareMetalBootMethod is how a host boots the provisioning image.
+// +kubebuilder:validation:Enum=VirtualMedia;iPXE
+type BareMetalBootMethod string
+
+const (
+	// BareMetalBootMethodVirtualMedia attaches a boot ISO through the BMC.
+	BareMetalBootMethodVirtualMedia BareMetalBootMethod = "VirtualMedia"
+
+	// BareMetalBootMethodIPXE network boots the host with an iPXE script.
+	BareMetalBootMethodIPXE BareMetalBootMethod = "iPXE"
+)
+
+// BareMetalProviderConfig contains bare-metal configuration. Each
+// MachineRequest claims one host from the inventory, which is powered on
+// and booted through its BMC.
+type BareMetalProviderConfig struct {
+	// Protocol is the default BMC protocol for hosts.
+	// +kubebuilder:default="Redfish"
+	// +optional
+	Protocol BMCProtocol `json:"protocol,omitempty"`
+
+	// Boot configures how hosts boot the provisioning image.
+	// +kubebuilder:validation:Required
+	Boot BareMetalBootConfig `json:"boot"`
+
+	// InventoryRef references a ConfigMap in the ProviderConfig namespace
+	// whose "hosts.yaml" key holds a list of hosts in the same format as
+	// Hosts. Hosts listed in both are taken from Hosts.
+	// +optional
+	InventoryRef *LocalObjectReference `json:"inventoryRef,omitempty"`
+
+	// Hosts lists the servers available to this provider.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Hosts []BareMetalHost `json:"hosts,omitempty"`
+}
+
+// BareMetalBootConfig configures how hosts boot the provisioning image.
+// +kubebuilder:validation:XValidation:rule="self.method != 'VirtualMedia' || has(self.isoURL)",message="isoURL is required for VirtualMedia"
+// +kubebuilder:validation:XValidation:rule="self.method != 'iPXE' || has(self.ipxeScriptURL)",message="ipxeScriptURL is required for iPXE"
+type BareMetalBootConfig struct {
+	// Method is how hosts boot the provisioning image.
+	// +kubebuilder:default="VirtualMedia"
+	// +optional
+	Method BareMetalBootMethod `json:"method,omitempty"`
+
+	// ISOURL is the boot ISO served to the BMC for VirtualMedia boot.
+	// +kubebuilder:validation:Pattern=`^https?://`
+	// +optional
+	ISOURL string `json:"isoURL,omitempty"`
+
+	// IPXEScriptURL is the iPXE script chained from the DHCP boot for iPXE boot.
+	// +kubebuilder:validation:Pattern=`^https?://`
+	// +optional
+	IPXEScriptURL string `json:"ipxeScriptURL,omitempty"`
+
+	// UEFI boots hosts in UEFI mode. Set to false for legacy BIOS boot.
+	// +kubebuilder:default=true
+	// +optional
+	UEFI *bool `json:"uefi,omitempty"`
+}
+
+// BareMetalHost is a physical server in the bare-metal inventory.
+type BareMetalHost struct {
+	// Name identifies the host. MachineRequests pin a host by this name.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// BMC configures access to the host's baseboard management controller.
+	// +kubebuilder:validation:Required
+	BMC BMCConfig `json:"bmc"`
+
+	// BootMACAddress is the MAC address of the NIC the host boots from.
+	// Used to match DHCP requests during iPXE boot.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^([0-9A-Fa-f]{2}:){5}[0-9A-Fa-f]{2}$`
+	BootMACAddress string `json:"bootMACAddress"`
+
+	// IPAddress is the static IP address assigned to the host.
+	// If empty, the address is allocated from the provider's IPAM pool.
+	// +kubebuilder:validation:XValidation:rule="isIP(self)",message="ipAddress must be a valid IP address"
+	// +optional
+	IPAddress string `json:"ipAddress,omitempty"`
+
+	// RootDeviceHint selects the disk to install to (e.g., "/dev/nvme0n1").
+	// Defaults to the smallest disk of at least the requested size.
+	// +optional
+	RootDeviceHint string `json:"rootDeviceHint,omitempty"`
+
+	// Labels describe the host's hardware for scheduling (e.g., "gpu": "a100").
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// BMCConfig configures access to a host's BMC.
+type BMCConfig struct {
+	// Address is the BMC host name or IP address, optionally with a port.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Address string `json:"address"`
+
+	// Protocol overrides the provider's default BMC protocol.
+	// +optional
+	Protocol BMCProtocol `json:"protocol,omitempty"`
+
+	// SystemID is the Redfish system ID (e.g., "1" or "System.Embedded.1").
+	// Defaults to the only system exposed by the BMC.
+	// +optional
+	SystemID string `json:"systemID,omitempty"`
+
+	// CredentialsRef overrides the provider's credentials for this host.
+	// The Secret must contain "username" and "password" keys.
+	// +optional
+	CredentialsRef *SecretReference `json:"credentialsRef,omitempty"`
+
+	// InsecureSkipVerify skips TLS verification of the Redfish endpoint.
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+}
+
+// FindHost returns the inline host with the given name, or nil.
+func (c *BareMetalProviderConfig) FindHost(name string) *BareMetalHost {
+	for i := range c.Hosts {
+		if c.Hosts[i].Name == name {
+			return &c.Hosts[i]
+		}
+	}
+	return nil
+}
+
+// GetProtocol returns the BMC protocol for the host, falling back to the
+// provider default and then Redfish.
+func (c *BareMetalProviderConfig) GetProtocol(h *BareMetalHost) BMCProtocol {
+	if h != nil && h.BMC.Protocol != "" {
+		return h.BMC.Protocol
+	}
+	if c.Protocol != "" {
+		return c.Protocol
+	}
+	return BMCProtocolRedfish
+}
+
+// GetMethod returns the boot method, defaulting to VirtualMedia.
+func (b *BareMetalBootConfig) GetMethod() BareMetalBootMethod {
+	if b.Method != "" {
+		return b.Method
+	}
+	return BareMetalBootMethodVirtualMedia
+}
+
 // AzureProviderConfig contains Azure-specific configuration.
 type AzureProviderConfig struct {
 	// SubscriptionID is the Azure subscription ID.
@@ -322,7 +909,8 @@ const (
 	ProviderConfigScopeTeam ProviderConfigScopeType = "team"
 )
 
-// ProviderConfigScope defines the visibility of a ProviderConfig.
+// ProviderConfigScope defines the visibility of a ProviderConfig or NetworkPool.
+// +kubebuilder:validation:XValidation:rule="!has(self.type) || self.type != 'team' || has(self.teamRef)",message="teamRef is required when type is team"
 type ProviderConfigScope struct {
 	// Type is the scope type.
 	// +kubebuilder:default="platform"
@@ -355,10 +943,15 @@ type ProviderNetworkConfig struct {
 	Subnet string `json:"subnet,omitempty"`
 
 	// Gateway is the network gateway address.
+	// +kubebuilder:validation:MaxLength=45
+	// +kubebuilder:validation:XValidation:rule="isIP(self)",message="gateway must be a valid IP address"
 	// +optional
 	Gateway string `json:"gateway,omitempty"`
 
 	// DNSServers are the DNS server addresses.
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:MaxLength=45
+	// +kubebuilder:validation:XValidation:rule="self.all(s, isIP(s))",message="dnsServers must be valid IP addresses"
 	// +optional
 	DNSServers []string `json:"dnsServers,omitempty"`
 
@@ -487,15 +1080,57 @@ type ProviderConfigStatus struct {
 	// Capacity reports the available capacity of this provider.
 	// +optional
 	Capacity *ProviderCapacity `json:"capacity,omitempty"`
+
+	// ActiveFreezeWindow is the name of the freeze window in effect, if any.
+	// +optional
+	ActiveFreezeWindow string `json:"activeFreezeWindow,omitempty"`
+
+	// CredentialExpiry reports when the credentials expire. Nil when the
+	// provider does not expose an expiry or the credentials never expire.
+	// +optional
+	CredentialExpiry *CredentialExpiryStatus `json:"credentialExpiry,omitempty"`
+
+	// ImageCache reports the state of the image cache.
+	// +optional
+	ImageCache *ImageCacheStatus `json:"imageCache,omitempty"`
+
+	// BastionHostKey is the bastion host key recorded on first connection
+	// when spec.bastion.hostKey is not set. Clear it to accept a new key.
+	// +optional
+	BastionHostKey string `json:"bastionHostKey,omitempty"`
+
+	// Reconcile reports controller reconcile diagnostics.
+	// +optional
+	Reconcile *ReconcileStatus `json:"reconcile,omitempty"`
 }
 
+// ProviderConfig condition types.
+const (
+	// ProviderConfigConditionFrozen is True while a freeze window is active.
+	ProviderConfigConditionFrozen = "Frozen"
+
+	// ProviderConfigConditionBastionReachable indicates the bastion accepted
+	// an SSH connection with the configured key and host key.
+	ProviderConfigConditionBastionReachable = "BastionReachable"
+
+	// ProviderConfigConditionCredentialsExpiringSoon is True when the
+	// credentials expire within spec.credentialExpiryWarning.
+	ProviderConfigConditionCredentialsExpiringSoon = "CredentialsExpiringSoon"
+
+	// ProviderConfigConditionImageCacheReady is True when every image in
+	// spec.imageCache is available on the provider.
+	ProviderConfigConditionImageCacheReady = "ImageCacheReady"
+)
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:shortName=pc
+// +kubebuilder:resource:shortName=pc,categories=butler
+// +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Provider",type="string",JSONPath=".spec.provider",description="Infrastructure provider type"
 // +kubebuilder:printcolumn:name="Scope",type="string",JSONPath=".spec.scope.type",description="Visibility scope"
 // +kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready",description="Provider ready"
 // +kubebuilder:printcolumn:name="Validated",type="boolean",JSONPath=".status.validated",description="Configuration validated"
+// +kubebuilder:printcolumn:name="Credentials Expire",type="date",JSONPath=".status.credentialExpiry.expiresAt",description="Credential expiry",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // ProviderConfig defines the configuration for an infrastructure provider.
@@ -521,3 +1156,180 @@ type ProviderConfigList struct {
 func init() {
 	SchemeBuilder.Register(&ProviderConfig{}, &ProviderConfigList{})
 }
+
+// Helper methods
+
+// ActiveFreezeWindow returns the freeze window in effect at now, or nil.
+// When windows overlap, the one ending last is returned.
+func (p *ProviderConfig) ActiveFreezeWindow(now time.Time) *FreezeWindow {
+	var active *FreezeWindow
+	for i := range p.Spec.FreezeWindows {
+		w := &p.Spec.FreezeWindows[i]
+		if w.IsActive(now) && (active == nil || w.End.After(active.End.Time)) {
+			active = w
+		}
+	}
+	return active
+}
+
+// IsFrozen returns true if provisioning on this provider is blocked at now.
+func (p *ProviderConfig) IsFrozen(now time.Time) bool {
+	return p.ActiveFreezeWindow(now) != nil
+}
+
+// FailureDomainNames returns the names of the provider's failure domains, in order.
+func (p *ProviderConfig) FailureDomainNames() []string {
+	names := make([]string, 0, len(p.Spec.FailureDomains))
+	for _, d := range p.Spec.FailureDomains {
+		names = append(names, d.Name)
+	}
+	return names
+}
+
+// GetFailureDomain returns the failure domain with the given name, or nil.
+func (p *ProviderConfig) GetFailureDomain(name string) *FailureDomain {
+	for i := range p.Spec.FailureDomains {
+		if p.Spec.FailureDomains[i].Name == name {
+			return &p.Spec.FailureDomains[i]
+		}
+	}
+	return nil
+}
+
+// GetCredentialExpiryWarning returns the expiry warning threshold,
+// defaulting to 14 days.
+func (p *ProviderConfig) GetCredentialExpiryWarning() time.Duration {
+	if w := p.Spec.CredentialExpiryWarning; w != nil && w.Duration > 0 {
+		return w.Duration
+	}
+	return 14 * 24 * time.Hour
+}
+
+// CredentialDaysRemaining returns the whole days until the credentials
+// expire at now, and false if no expiry is known.
+func (p *ProviderConfig) CredentialDaysRemaining(now time.Time) (int32, bool) {
+	ce := p.Status.CredentialExpiry
+	if ce == nil || ce.ExpiresAt == nil {
+		return 0, false
+	}
+	d := ce.ExpiresAt.Sub(now)
+	days := int32(d / (24 * time.Hour))
+	if d < 0 && d%(24*time.Hour) != 0 {
+		days--
+	}
+	return days, true
+}
+
+// CredentialsExpiringSoon returns true if the credentials expire within
+// the warning threshold of now, including credentials already expired.
+func (p *ProviderConfig) CredentialsExpiringSoon(now time.Time) bool {
+	ce := p.Status.CredentialExpiry
+	if ce == nil || ce.ExpiresAt == nil {
+		return false
+	}
+	return ce.ExpiresAt.Sub(now) < p.GetCredentialExpiryWarning()
+}
+
+// IsEnabled returns true if image pre-pulling is enabled.
+func (c *ImageCacheConfig) IsEnabled() bool {
+	return c != nil && (c.Enabled == nil || *c.Enabled)
+}
+
+// GetRefreshInterval returns the refresh interval, defaulting to 24h.
+func (c *ImageCacheConfig) GetRefreshInterval() time.Duration {
+	if c == nil || c.RefreshInterval == nil || c.RefreshInterval.Duration <= 0 {
+		return 24 * time.Hour
+	}
+	return c.RefreshInterval.Duration
+}
+
+// NeedsImageCacheRefresh returns true if the image cache is enabled and
+// has not been refreshed within the refresh interval.
+func (p *ProviderConfig) NeedsImageCacheRefresh(now time.Time) bool {
+	if !p.Spec.ImageCache.IsEnabled() {
+		return false
+	}
+	st := p.Status.ImageCache
+	if st == nil || st.LastRefreshTime == nil {
+		return true
+	}
+	return !now.Before(st.LastRefreshTime.Add(p.Spec.ImageCache.GetRefreshInterval()))
+}
+
+// CachedImage returns the provider image reference for a cached image, or
+// "" if the image is not cached and ready. An empty arch or platform in
+// ref matches the ImageFactoryRef defaults.
+func (p *ProviderConfig) CachedImage(ref ImageFactoryRef) string {
+	if p.Status.ImageCache == nil {
+		return ""
+	}
+	for _, img := range p.Status.ImageCache.Images {
+		if img.Phase == ImageSyncPhaseReady && img.FactoryRef.matches(ref) {
+			return img.ProviderImageRef
+		}
+	}
+	return ""
+}
+
+// GetQPS returns the sustained request rate, defaulting to 10.
+func (l *ProviderClientRateLimit) GetQPS() int32 {
+	if l == nil || l.QPS < 1 {
+		return 10
+	}
+	return l.QPS
+}
+
+// GetBurst returns the request burst, defaulting to 20 and never below QPS.
+func (l *ProviderClientRateLimit) GetBurst() int32 {
+	burst := int32(20)
+	if l != nil && l.Burst > 0 {
+		burst = l.Burst
+	}
+	if qps := l.GetQPS(); burst < qps {
+		return qps
+	}
+	return burst
+}
+
+// GetMaxConcurrentOperations returns the operation concurrency limit, defaulting to 10.
+func (l *ProviderClientRateLimit) GetMaxConcurrentOperations() int32 {
+	if l == nil || l.MaxConcurrentOperations < 1 {
+		return 10
+	}
+	return l.MaxConcurrentOperations
+}
+
+// GetRequestTimeout returns the request timeout, defaulting to 30s.
+func (l *ProviderClientRateLimit) GetRequestTimeout() time.Duration {
+	if l == nil || l.RequestTimeout == nil || l.RequestTimeout.Duration <= 0 {
+		return 30 * time.Second
+	}
+	return l.RequestTimeout.Duration
+}
+
+// Address returns the bastion address as "host:port".
+func (b *BastionConfig) Address() string {
+	port := b.Port
+	if port == 0 {
+		port = 22
+	}
+	return net.JoinHostPort(b.Host, strconv.Itoa(int(port)))
+}
+
+// GetPrivateKeyKey returns the Secret key holding the private key.
+func (b *BastionConfig) GetPrivateKeyKey() string {
+	if b.PrivateKeySecretRef.Key == "" {
+		return "ssh-privatekey"
+	}
+	return b.PrivateKeySecretRef.Key
+}
+
+// ExpectedHostKey returns the host key the bastion must present: the
+// configured key, else the key recorded on first connection. Empty means
+// the next connection records the key.
+func (p *ProviderConfig) ExpectedHostKey() string {
+	if p.Spec.Bastion != nil && p.Spec.Bastion.HostKey != "" {
+		return p.Spec.Bastion.HostKey
+	}
+	return p.Status.BastionHostKey
+}
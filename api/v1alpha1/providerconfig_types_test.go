@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestProviderConfigImageCache(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	ref := ImageFactoryRef{SchematicID: "376567988ad370138ad8b2698212367b", Version: "v1.12.4"}
+	pc := &ProviderConfig{
+		Spec: ProviderConfigSpec{ImageCache: &ImageCacheConfig{Images: []ImageFactoryRef{ref}}},
+	}
+
+	if !pc.NeedsImageCacheRefresh(now) {
+		t.Error("NeedsImageCacheRefresh() = false before first refresh, want true")
+	}
+	if got := pc.CachedImage(ref); got != "" {
+		t.Errorf("CachedImage() before refresh = %q, want empty", got)
+	}
+
+	last := metav1.NewTime(now.Add(-time.Hour))
+	pc.Status.ImageCache = &ImageCacheStatus{
+		LastRefreshTime: &last,
+		Images: []CachedImageStatus{{
+			FactoryRef:       ImageFactoryRef{SchematicID: ref.SchematicID, Version: ref.Version, Arch: "amd64", Platform: "nocloud"},
+			Phase:            ImageSyncPhaseReady,
+			ProviderImageRef: "default/talos-v1-12-4",
+		}},
+	}
+	if pc.NeedsImageCacheRefresh(now) {
+		t.Error("NeedsImageCacheRefresh() = true within interval, want false")
+	}
+	if !pc.NeedsImageCacheRefresh(now.Add(23 * time.Hour)) {
+		t.Error("NeedsImageCacheRefresh() = false after interval, want true")
+	}
+	if got := pc.CachedImage(ref); got != "default/talos-v1-12-4" {
+		t.Errorf("CachedImage() = %q, want default/talos-v1-12-4", got)
+	}
+	if got := pc.CachedImage(ImageFactoryRef{SchematicID: ref.SchematicID, Version: ref.Version, Arch: "arm64"}); got != "" {
+		t.Errorf("CachedImage() for arm64 = %q, want empty", got)
+	}
+
+	disabled := false
+	pc.Spec.ImageCache.Enabled = &disabled
+	pc.Status.ImageCache = nil
+	if pc.NeedsImageCacheRefresh(now) {
+		t.Error("NeedsImageCacheRefresh() = true when disabled, want false")
+	}
+}
+
+func TestProviderClientRateLimitDefaults(t *testing.T) {
+	var unset *ProviderClientRateLimit
+	if unset.GetQPS() != 10 || unset.GetBurst() != 20 || unset.GetMaxConcurrentOperations() != 10 || unset.GetRequestTimeout() != 30*time.Second {
+		t.Errorf("nil limits = %d/%d/%d/%s, want 10/20/10/30s",
+			unset.GetQPS(), unset.GetBurst(), unset.GetMaxConcurrentOperations(), unset.GetRequestTimeout())
+	}
+
+	l := &ProviderClientRateLimit{QPS: 50, RequestTimeout: &metav1.Duration{Duration: time.Minute}}
+	if got := l.GetBurst(); got != 50 {
+		t.Errorf("GetBurst() with qps above default burst = %d, want 50", got)
+	}
+	if got := l.GetRequestTimeout(); got != time.Minute {
+		t.Errorf("GetRequestTimeout() = %s, want 1m", got)
+	}
+}
+
+func TestProviderConfigCredentialExpiry(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	pc := &ProviderConfig{}
+	if pc.CredentialsExpiringSoon(now) {
+		t.Error("CredentialsExpiringSoon() without expiry = true, want false")
+	}
+	if _, ok := pc.CredentialDaysRemaining(now); ok {
+		t.Error("CredentialDaysRemaining() without expiry reported a value")
+	}
+
+	tests := []struct {
+		name     string
+		expires  time.Duration
+		warning  *metav1.Duration
+		wantDays int32
+		wantSoon bool
+	}{
+		{name: "outside default threshold", expires: 30 * 24 * time.Hour, wantDays: 30},
+		{name: "inside default threshold", expires: 10*24*time.Hour + time.Hour, wantDays: 10, wantSoon: true},
+		{name: "custom threshold", expires: 10 * 24 * time.Hour, warning: &metav1.Duration{Duration: 7 * 24 * time.Hour}, wantDays: 10},
+		{name: "expired", expires: -36 * time.Hour, wantDays: -2, wantSoon: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expiresAt := metav1.NewTime(now.Add(tt.expires))
+			pc := &ProviderConfig{
+				Spec:   ProviderConfigSpec{CredentialExpiryWarning: tt.warning},
+				Status: ProviderConfigStatus{CredentialExpiry: &CredentialExpiryStatus{ExpiresAt: &expiresAt}},
+			}
+			if days, _ := pc.CredentialDaysRemaining(now); days != tt.wantDays {
+				t.Errorf("CredentialDaysRemaining() = %d, want %d", days, tt.wantDays)
+			}
+			if got := pc.CredentialsExpiringSoon(now); got != tt.wantSoon {
+				t.Errorf("CredentialsExpiringSoon() = %v, want %v", got, tt.wantSoon)
+			}
+		})
+	}
+}
+
+func TestOpenStackControlPlaneFlavor(t *testing.T) {
+	c := &OpenStackProviderConfig{Flavor: "m1.large"}
+	if got := c.GetControlPlaneFlavor(); got != "m1.large" {
+		t.Errorf("GetControlPlaneFlavor() = %q, want m1.large", got)
+	}
+	c.ControlPlaneFlavor = "m1.xlarge"
+	if got := c.GetControlPlaneFlavor(); got != "m1.xlarge" {
+		t.Errorf("GetControlPlaneFlavor() = %q, want m1.xlarge", got)
+	}
+}
+
+func TestFailureDomainPlacement(t *testing.T) {
+	pc := &ProviderConfig{Spec: ProviderConfigSpec{FailureDomains: []FailureDomain{
+		{Name: "rack-a", Zone: "zone-1", HostSelector: map[string]string{"rack": "a"}},
+		{Name: "rack-b"},
+	}}}
+	if got := pc.FailureDomainNames(); len(got) != 2 || got[1] != "rack-b" {
+		t.Errorf("FailureDomainNames() = %v", got)
+	}
+	if pc.GetFailureDomain("rack-c") != nil {
+		t.Error("GetFailureDomain() found unknown domain")
+	}
+
+	base := &PlacementSpec{AntiAffinityGroup: "workers", AvailabilityZone: "zone-0", HostSelector: map[string]string{"gpu": "true", "rack": "b"}}
+	p := pc.GetFailureDomain("rack-a").Placement(base)
+	if p.FailureDomain != "rack-a" || p.AvailabilityZone != "zone-1" || p.AntiAffinityGroup != "workers" {
+		t.Errorf("Placement() = %+v", p)
+	}
+	if p.HostSelector["rack"] != "a" || p.HostSelector["gpu"] != "true" {
+		t.Errorf("Placement().HostSelector = %v", p.HostSelector)
+	}
+	if base.HostSelector["rack"] != "b" {
+		t.Error("Placement() modified base")
+	}
+	if p := pc.GetFailureDomain("rack-b").Placement(nil); p.FailureDomain != "rack-b" || p.AvailabilityZone != "" {
+		t.Errorf("Placement(nil) = %+v", p)
+	}
+}
@@ -0,0 +1,317 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ProvisioningPlanPhase represents the current phase of a ProvisioningPlan.
+// +kubebuilder:validation:Enum=Pending;Evaluating;Complete;Failed
+type ProvisioningPlanPhase string
+
+const (
+	// ProvisioningPlanPhasePending indicates the plan has not been evaluated yet.
+	ProvisioningPlanPhasePending ProvisioningPlanPhase = "Pending"
+
+	// ProvisioningPlanPhaseEvaluating indicates the controller is gathering
+	// provider capacity, pool availability, and quota usage.
+	ProvisioningPlanPhaseEvaluating ProvisioningPlanPhase = "Evaluating"
+
+	// ProvisioningPlanPhaseComplete indicates the plan has been evaluated.
+	// A complete plan may still be infeasible; see status.feasible.
+	ProvisioningPlanPhaseComplete ProvisioningPlanPhase = "Complete"
+
+	// ProvisioningPlanPhaseFailed indicates the plan could not be evaluated.
+	ProvisioningPlanPhaseFailed ProvisioningPlanPhase = "Failed"
+)
+
+// ProvisioningPlan condition types.
+const (
+	// ProvisioningPlanConditionEvaluated indicates the plan has been evaluated
+	// against the current state of the platform.
+	ProvisioningPlanConditionEvaluated = "Evaluated"
+
+	// ProvisioningPlanConditionFeasible indicates at least one candidate
+	// provider can satisfy the requested cluster.
+	ProvisioningPlanConditionFeasible = "Feasible"
+)
+
+// ProvisioningPlanSpec defines the desired state of ProvisioningPlan.
+type ProvisioningPlanSpec struct {
+	// Cluster is the TenantCluster spec to evaluate.
+	// The plan is a dry run: no infrastructure or IP allocations are created.
+	// +kubebuilder:validation:Required
+	Cluster TenantClusterSpec `json:"cluster"`
+
+	// ProviderConfigRefs limits evaluation to these ProviderConfigs.
+	// If empty, every ProviderConfig the team has access to is evaluated,
+	// including cluster.providerConfigRef when set.
+	// +optional
+	ProviderConfigRefs []ProviderReference `json:"providerConfigRefs,omitempty"`
+
+	// TTLAfterCompletion deletes the plan this long after it reaches the
+	// Complete or Failed phase. Plans are point-in-time snapshots and go
+	// stale quickly as capacity changes.
+	// +kubebuilder:default="1h"
+	// +optional
+	TTLAfterCompletion *metav1.Duration `json:"ttlAfterCompletion,omitempty"`
+}
+
+// ProvisioningRequirements is the aggregate footprint of a cluster spec.
+type ProvisioningRequirements struct {
+	// Nodes is the number of worker machines.
+	// +optional
+	Nodes int32 `json:"nodes,omitempty"`
+
+	// CPU is the total worker CPU cores.
+	// +optional
+	CPU *resource.Quantity `json:"cpu,omitempty"`
+
+	// Memory is the total worker memory.
+	// +optional
+	Memory *resource.Quantity `json:"memory,omitempty"`
+
+	// Storage is the total worker root disk size.
+	// +optional
+	Storage *resource.Quantity `json:"storage,omitempty"`
+
+	// NodeIPs is the number of node IPs required from IPAM.
+	// +optional
+	NodeIPs int32 `json:"nodeIPs,omitempty"`
+
+	// LoadBalancerIPs is the number of load balancer IPs required from IPAM.
+	// +optional
+	LoadBalancerIPs int32 `json:"loadBalancerIPs,omitempty"`
+}
+
+// PoolAvailability reports free capacity in a NetworkPool for a plan.
+type PoolAvailability struct {
+	// PoolRef references the NetworkPool.
+	PoolRef LocalObjectReference `json:"poolRef"`
+
+	// AvailableIPs is the number of free IPs in the pool.
+	// +optional
+	AvailableIPs int32 `json:"availableIPs,omitempty"`
+
+	// LargestFreeBlock is the largest contiguous free block in the pool.
+	// Allocations are contiguous, so this bounds what a single request can get.
+	// +optional
+	LargestFreeBlock int32 `json:"largestFreeBlock,omitempty"`
+
+	// Sufficient indicates the pool can satisfy the plan's IP requirements.
+	// +optional
+	Sufficient bool `json:"sufficient,omitempty"`
+}
+
+// QuotaHeadroom reports how much of the team quota would remain after the
+// planned cluster is created. Negative values mean the quota would be exceeded.
+// Nil fields mean the corresponding limit is not enforced.
+type QuotaHeadroom struct {
+	// Clusters is the number of clusters that could still be created.
+	// +optional
+	Clusters *int32 `json:"clusters,omitempty"`
+
+	// Nodes is the number of worker nodes that could still be added.
+	// +optional
+	Nodes *int32 `json:"nodes,omitempty"`
+
+	// CPU is the remaining CPU quota.
+	// +optional
+	CPU *resource.Quantity `json:"cpu,omitempty"`
+
+	// Memory is the remaining memory quota.
+	// +optional
+	Memory *resource.Quantity `json:"memory,omitempty"`
+
+	// Storage is the remaining storage quota.
+	// +optional
+	Storage *resource.Quantity `json:"storage,omitempty"`
+}
+
+// CostEstimate is an estimated run-rate for a planned cluster.
+// Amounts are decimal quantities in the given currency.
+type CostEstimate struct {
+	// Currency is the ISO 4217 currency code (e.g., "USD").
+	// +optional
+	Currency string `json:"currency,omitempty"`
+
+	// Hourly is the estimated hourly cost.
+	// +optional
+	Hourly *resource.Quantity `json:"hourly,omitempty"`
+
+	// Monthly is the estimated monthly cost.
+	// +optional
+	Monthly *resource.Quantity `json:"monthly,omitempty"`
+
+	// Source describes where pricing came from (e.g., "provider-list-price").
+	// +optional
+	Source string `json:"source,omitempty"`
+}
+
+// ProviderPlanResult is the feasibility result for a single provider.
+type ProviderPlanResult struct {
+	// ProviderConfigRef references the evaluated ProviderConfig.
+	ProviderConfigRef ProviderReference `json:"providerConfigRef"`
+
+	// Feasible indicates the cluster can be provisioned on this provider.
+	Feasible bool `json:"feasible"`
+
+	// Reasons lists why the provider is infeasible, or warnings when feasible.
+	// +optional
+	Reasons []string `json:"reasons,omitempty"`
+
+	// Pools reports IP availability for each NetworkPool referenced by the provider.
+	// Empty for providers in cloud network mode.
+	// +optional
+	Pools []PoolAvailability `json:"pools,omitempty"`
+
+	// QuotaHeadroom reports remaining team quota on this provider.
+	// +optional
+	QuotaHeadroom *QuotaHeadroom `json:"quotaHeadroom,omitempty"`
+
+	// EstimatedCost is the estimated run-rate on this provider.
+	// +optional
+	EstimatedCost *CostEstimate `json:"estimatedCost,omitempty"`
+}
+
+// ProvisioningPlanStatus defines the observed state of ProvisioningPlan.
+type ProvisioningPlanStatus struct {
+	// Conditions represent the latest available observations.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Phase represents the current phase of the plan.
+	// +optional
+	Phase ProvisioningPlanPhase `json:"phase,omitempty"`
+
+	// Feasible indicates at least one provider can satisfy the plan.
+	// +optional
+	Feasible bool `json:"feasible,omitempty"`
+
+	// RecommendedProvider is the name of the best feasible ProviderConfig.
+	// +optional
+	RecommendedProvider string `json:"recommendedProvider,omitempty"`
+
+	// Requirements is the computed footprint of the planned cluster.
+	// +optional
+	Requirements *ProvisioningRequirements `json:"requirements,omitempty"`
+
+	// Providers lists per-provider feasibility results.
+	// +optional
+	Providers []ProviderPlanResult `json:"providers,omitempty"`
+
+	// EvaluatedAt is when the plan was evaluated.
+	// +optional
+	EvaluatedAt *metav1.Time `json:"evaluatedAt,omitempty"`
+
+	// Message provides human-readable status information.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// ObservedGeneration is the last observed generation.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=pp,categories=butler
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Plan phase"
+// +kubebuilder:printcolumn:name="Feasible",type="boolean",JSONPath=".status.feasible",description="At least one provider fits"
+// +kubebuilder:printcolumn:name="Recommended",type="string",JSONPath=".status.recommendedProvider",description="Recommended provider"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ProvisioningPlan is the Schema for the provisioningplans API.
+// It answers "will this cluster fit?" before a TenantCluster is created by
+// evaluating a candidate spec against provider capacity, NetworkPool
+// availability, and team quotas. Plans are created in the team namespace
+// and are read-only snapshots once complete.
+type ProvisioningPlan struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProvisioningPlanSpec   `json:"spec,omitempty"`
+	Status ProvisioningPlanStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProvisioningPlanList contains a list of ProvisioningPlan.
+type ProvisioningPlanList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProvisioningPlan `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ProvisioningPlan{}, &ProvisioningPlanList{})
+}
+
+// Helper methods
+
+// IsComplete returns true if the plan has finished evaluation, successfully or not.
+func (p *ProvisioningPlan) IsComplete() bool {
+	return p.Status.Phase == ProvisioningPlanPhaseComplete || p.Status.Phase == ProvisioningPlanPhaseFailed
+}
+
+// FeasibleProviders returns the names of providers that can satisfy the plan.
+func (p *ProvisioningPlan) FeasibleProviders() []string {
+	var names []string
+	for _, r := range p.Status.Providers {
+		if r.Feasible {
+			names = append(names, r.ProviderConfigRef.Name)
+		}
+	}
+	return names
+}
+
+// ComputeProvisioningRequirements returns the aggregate worker footprint of a
+// TenantCluster spec across all worker pools. LoadBalancerIPs is taken from
+// networking.lbPoolSize and is zero when unset; callers fall back to the
+// provider default in that case.
+func ComputeProvisioningRequirements(spec *TenantClusterSpec) ProvisioningRequirements {
+	cpu := resource.NewQuantity(0, resource.DecimalSI)
+	memory := resource.NewQuantity(0, resource.BinarySI)
+	storage := resource.NewQuantity(0, resource.BinarySI)
+	for _, pool := range spec.AllWorkerPools() {
+		mt := pool.MachineTemplate
+		cpu.Add(*resource.NewQuantity(int64(pool.Replicas)*int64(mt.CPU), resource.DecimalSI))
+		m := mt.Memory.DeepCopy()
+		m.Mul(int64(pool.Replicas))
+		memory.Add(m)
+		d := mt.DiskSize.DeepCopy()
+		d.Mul(int64(pool.Replicas))
+		storage.Add(d)
+	}
+
+	replicas := spec.TotalWorkerReplicas()
+	req := ProvisioningRequirements{
+		Nodes:   replicas,
+		CPU:     cpu,
+		Memory:  memory,
+		Storage: storage,
+		NodeIPs: replicas,
+	}
+	if spec.Networking.LBPoolSize != nil {
+		req.LoadBalancerIPs = *spec.Networking.LBPoolSize
+	}
+	return req
+}
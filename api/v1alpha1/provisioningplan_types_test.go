@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"slices"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestComputeProvisioningRequirements(t *testing.T) {
+	spec := &TenantClusterSpec{
+		Workers: WorkersSpec{
+			Replicas:        3,
+			MachineTemplate: MachineTemplateSpec{CPU: 4, Memory: resource.MustParse("16Gi")},
+		},
+		WorkerPools: []WorkerPoolSpec{
+			{Name: "gpu", Replicas: 2, MachineTemplate: MachineTemplateSpec{CPU: 16, Memory: resource.MustParse("64Gi")}},
+		},
+	}
+
+	req := ComputeProvisioningRequirements(spec)
+	if req.Nodes != 5 || req.CPU.Value() != 44 {
+		t.Errorf("requirements nodes=%d cpu=%s, want 5 and 44", req.Nodes, req.CPU)
+	}
+	if want := resource.MustParse("176Gi"); req.Memory.Cmp(want) != 0 {
+		t.Errorf("requirements memory = %s, want %s", req.Memory, &want)
+	}
+}
+
+func TestProvisioningPlanIsComplete(t *testing.T) {
+	tests := []struct {
+		phase ProvisioningPlanPhase
+		want  bool
+	}{
+		{"", false},
+		{ProvisioningPlanPhasePending, false},
+		{ProvisioningPlanPhaseEvaluating, false},
+		{ProvisioningPlanPhaseComplete, true},
+		{ProvisioningPlanPhaseFailed, true},
+	}
+	for _, tt := range tests {
+		p := &ProvisioningPlan{Status: ProvisioningPlanStatus{Phase: tt.phase}}
+		if got := p.IsComplete(); got != tt.want {
+			t.Errorf("IsComplete() with phase %q = %v, want %v", tt.phase, got, tt.want)
+		}
+	}
+}
+
+func TestProvisioningPlanFeasibleProviders(t *testing.T) {
+	result := func(name string, feasible bool) ProviderPlanResult {
+		return ProviderPlanResult{ProviderConfigRef: ProviderReference{Name: name}, Feasible: feasible}
+	}
+	tests := []struct {
+		name      string
+		providers []ProviderPlanResult
+		want      []string
+	}{
+		{name: "no results"},
+		{
+			name:      "none feasible",
+			providers: []ProviderPlanResult{result("harvester", false), result("nutanix", false)},
+		},
+		{
+			name:      "mixed keeps status order",
+			providers: []ProviderPlanResult{result("nutanix", true), result("harvester", false), result("aws", true)},
+			want:      []string{"nutanix", "aws"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &ProvisioningPlan{Status: ProvisioningPlanStatus{Providers: tt.providers}}
+			if got := p.FeasibleProviders(); !slices.Equal(got, tt.want) {
+				t.Errorf("FeasibleProviders() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ResourceClaimPhase represents the current phase of a ResourceClaim.
+// +kubebuilder:validation:Enum=Pending;Reserved;Bound;Denied;Expired;Released
+type ResourceClaimPhase string
+
+const (
+	// ResourceClaimPhasePending indicates the claim is queued for evaluation.
+	ResourceClaimPhasePending ResourceClaimPhase = "Pending"
+
+	// ResourceClaimPhaseReserved indicates quota is held for the claim.
+	// Reserved quota counts against the team until the claim is bound,
+	// released, or expires.
+	ResourceClaimPhaseReserved ResourceClaimPhase = "Reserved"
+
+	// ResourceClaimPhaseBound indicates the consumer exists and the
+	// reservation has been converted into actual usage.
+	ResourceClaimPhaseBound ResourceClaimPhase = "Bound"
+
+	// ResourceClaimPhaseDenied indicates the claim would exceed team quota.
+	ResourceClaimPhaseDenied ResourceClaimPhase = "Denied"
+
+	// ResourceClaimPhaseExpired indicates the claim was not bound before expiry.
+	ResourceClaimPhaseExpired ResourceClaimPhase = "Expired"
+
+	// ResourceClaimPhaseReleased indicates the reservation was given up.
+	ResourceClaimPhaseReleased ResourceClaimPhase = "Released"
+)
+
+// ResourceClaimPurpose describes what the reserved quota is for.
+// +kubebuilder:validation:Enum=Create;ScaleUp
+type ResourceClaimPurpose string
+
+const (
+	// ResourceClaimPurposeCreate reserves quota for a new cluster.
+	ResourceClaimPurposeCreate ResourceClaimPurpose = "Create"
+
+	// ResourceClaimPurposeScaleUp reserves quota for growing an existing cluster.
+	ResourceClaimPurposeScaleUp ResourceClaimPurpose = "ScaleUp"
+)
+
+// ResourceClaimSpec defines the desired state of ResourceClaim.
+type ResourceClaimSpec struct {
+	// TeamRef references the Team whose quota is reserved.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="teamRef is immutable"
+	TeamRef LocalObjectReference `json:"teamRef"`
+
+	// ConsumerRef references the TenantCluster the quota is reserved for.
+	// For Create claims the cluster may not exist yet.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="consumerRef is immutable"
+	ConsumerRef LocalObjectReference `json:"consumerRef"`
+
+	// Purpose describes what the quota is for.
+	// +kubebuilder:default=Create
+	// +optional
+	Purpose ResourceClaimPurpose `json:"purpose,omitempty"`
+
+	// Resources is the quota to reserve. For ScaleUp claims this is the
+	// increase, not the new total.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="resources are immutable"
+	Resources ProvisioningRequirements `json:"resources"`
+
+	// TTL is how long the reservation is held if it is not bound.
+	// +kubebuilder:default="15m"
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+}
+
+// ResourceClaimStatus defines the observed state of ResourceClaim.
+type ResourceClaimStatus struct {
+	// Conditions represent the latest available observations.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Phase represents the current phase of the claim.
+	// +optional
+	Phase ResourceClaimPhase `json:"phase,omitempty"`
+
+	// ReservedAt is when quota was reserved.
+	// +optional
+	ReservedAt *metav1.Time `json:"reservedAt,omitempty"`
+
+	// ExpiresAt is when an unbound reservation expires.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// Headroom is the team quota remaining after this claim was evaluated.
+	// +optional
+	Headroom *QuotaHeadroom `json:"headroom,omitempty"`
+
+	// Message provides human-readable status information.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// ObservedGeneration is the last observed generation.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=rclaim,categories=butler
+// +kubebuilder:printcolumn:name="Team",type="string",JSONPath=".spec.teamRef.name",description="Team"
+// +kubebuilder:printcolumn:name="Consumer",type="string",JSONPath=".spec.consumerRef.name",description="Cluster the quota is for"
+// +kubebuilder:printcolumn:name="Purpose",type="string",JSONPath=".spec.purpose",description="Create or ScaleUp"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Claim phase"
+// +kubebuilder:printcolumn:name="Expires",type="date",JSONPath=".status.expiresAt",description="Reservation expiry",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ResourceClaim is the Schema for the resourceclaims API.
+// It reserves team quota for a pending cluster or scale-up. The Team
+// controller evaluates claims one at a time in creation order, so
+// concurrent requests cannot both pass a quota check that only one fits.
+// Admission of TenantCluster creates and scale-ups requires a Reserved
+// claim for the same consumer.
+type ResourceClaim struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ResourceClaimSpec   `json:"spec,omitempty"`
+	Status ResourceClaimStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResourceClaimList contains a list of ResourceClaim.
+type ResourceClaimList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResourceClaim `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ResourceClaim{}, &ResourceClaimList{})
+}
+
+// Helper methods
+
+// IsExpired returns true if the claim is Reserved and past its expiry.
+func (c *ResourceClaim) IsExpired(now time.Time) bool {
+	return c.Status.Phase == ResourceClaimPhaseReserved &&
+		c.Status.ExpiresAt != nil && !now.Before(c.Status.ExpiresAt.Time)
+}
+
+// HoldsQuota returns true if the claim's resources count against team quota
+// as a reservation. Bound claims are counted as actual usage instead.
+func (c *ResourceClaim) HoldsQuota(now time.Time) bool {
+	return c.Status.Phase == ResourceClaimPhaseReserved && !c.IsExpired(now)
+}
+
+// GetTTL returns the reservation TTL, defaulting to 15 minutes.
+func (c *ResourceClaim) GetTTL() time.Duration {
+	if c.Spec.TTL == nil {
+		return 15 * time.Minute
+	}
+	return c.Spec.TTL.Duration
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConditionSilence acknowledges a known degraded condition so that it
+// stops paging. Silences are stored in the AnnotationSilences annotation of
+// the object whose conditions they match, so on-call can silence without
+// write access to status.
+// +kubebuilder:object:generate=false
+type ConditionSilence struct {
+	// ConditionType is the condition type to silence, or "*" for any.
+	ConditionType string `json:"conditionType"`
+
+	// ReasonPattern is a shell glob matched against the condition reason
+	// (e.g., "Addon*Degraded"). Empty matches any reason.
+	ReasonPattern string `json:"reasonPattern,omitempty"`
+
+	// Until is when the silence expires.
+	Until metav1.Time `json:"until"`
+
+	// Author is the user who created the silence.
+	Author string `json:"author"`
+
+	// Comment explains why the condition is silenced.
+	Comment string `json:"comment,omitempty"`
+}
+
+// IsActive returns true if the silence has not expired at now.
+func (s *ConditionSilence) IsActive(now time.Time) bool {
+	return now.Before(s.Until.Time)
+}
+
+// Matches returns true if the silence applies to the condition. Expiry is
+// not checked.
+func (s *ConditionSilence) Matches(c metav1.Condition) bool {
+	if s.ConditionType != "*" && s.ConditionType != c.Type {
+		return false
+	}
+	if s.ReasonPattern == "" {
+		return true
+	}
+	ok, err := path.Match(s.ReasonPattern, c.Reason)
+	return err == nil && ok
+}
+
+// Validate checks that the silence is well formed.
+func (s *ConditionSilence) Validate() error {
+	if s.ConditionType == "" {
+		return fmt.Errorf("conditionType is required")
+	}
+	if s.Author == "" {
+		return fmt.Errorf("author is required")
+	}
+	if s.Until.IsZero() {
+		return fmt.Errorf("until is required")
+	}
+	if _, err := path.Match(s.ReasonPattern, ""); err != nil {
+		return fmt.Errorf("invalid reasonPattern %q: %w", s.ReasonPattern, err)
+	}
+	return nil
+}
+
+// GetSilences returns the silences recorded on obj.
+func GetSilences(obj metav1.Object) ([]ConditionSilence, error) {
+	raw := obj.GetAnnotations()[AnnotationSilences]
+	if raw == "" {
+		return nil, nil
+	}
+	var silences []ConditionSilence
+	if err := json.Unmarshal([]byte(raw), &silences); err != nil {
+		return nil, fmt.Errorf("parsing %s annotation: %w", AnnotationSilences, err)
+	}
+	for i := range silences {
+		if err := silences[i].Validate(); err != nil {
+			return nil, fmt.Errorf("silence %d: %w", i, err)
+		}
+	}
+	return silences, nil
+}
+
+// SetSilences records silences on obj, dropping those expired at now.
+// The annotation is removed when no silences remain.
+func SetSilences(obj metav1.Object, silences []ConditionSilence, now time.Time) error {
+	var active []ConditionSilence
+	for i := range silences {
+		if err := silences[i].Validate(); err != nil {
+			return fmt.Errorf("silence %d: %w", i, err)
+		}
+		if silences[i].IsActive(now) {
+			active = append(active, silences[i])
+		}
+	}
+	annotations := obj.GetAnnotations()
+	if len(active) == 0 {
+		if _, ok := annotations[AnnotationSilences]; ok {
+			delete(annotations, AnnotationSilences)
+			obj.SetAnnotations(annotations)
+		}
+		return nil
+	}
+	raw, err := json.Marshal(active)
+	if err != nil {
+		return err
+	}
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[AnnotationSilences] = string(raw)
+	obj.SetAnnotations(annotations)
+	return nil
+}
+
+// FindSilence returns the first silence on obj active at now that matches
+// the condition, or nil. Notification controllers skip paging for a
+// condition when a silence is returned. A malformed annotation silences
+// nothing and is reported as an error.
+func FindSilence(obj metav1.Object, c metav1.Condition, now time.Time) (*ConditionSilence, error) {
+	silences, err := GetSilences(obj)
+	if err != nil {
+		return nil, err
+	}
+	for i := range silences {
+		if silences[i].IsActive(now) && silences[i].Matches(c) {
+			return &silences[i], nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFindSilence(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	tc := &TenantCluster{}
+	silences := []ConditionSilence{
+		{ConditionType: TenantClusterConditionAddonsReady, ReasonPattern: "Longhorn*", Until: metav1.NewTime(now.Add(time.Hour)), Author: "oncall@example.com"},
+		{ConditionType: "*", Until: metav1.NewTime(now.Add(-time.Hour)), Author: "oncall@example.com"},
+	}
+	if err := SetSilences(tc, silences, now); err != nil {
+		t.Fatal(err)
+	}
+	got, err := GetSilences(tc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 {
+		t.Fatalf("GetSilences() returned %d silences, want expired silence dropped", len(got))
+	}
+
+	tests := []struct {
+		name string
+		cond metav1.Condition
+		at   time.Time
+		want bool
+	}{
+		{name: "matching reason", cond: metav1.Condition{Type: TenantClusterConditionAddonsReady, Reason: "LonghornDegraded"}, at: now, want: true},
+		{name: "other reason", cond: metav1.Condition{Type: TenantClusterConditionAddonsReady, Reason: "CiliumDegraded"}, at: now},
+		{name: "other type", cond: metav1.Condition{Type: TenantClusterConditionWorkersReady, Reason: "LonghornDegraded"}, at: now},
+		{name: "expired", cond: metav1.Condition{Type: TenantClusterConditionAddonsReady, Reason: "LonghornDegraded"}, at: now.Add(2 * time.Hour)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := FindSilence(tc, tt.cond, tt.at)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (s != nil) != tt.want {
+				t.Errorf("FindSilence() = %v, want silenced %v", s, tt.want)
+			}
+		})
+	}
+
+	if err := SetSilences(tc, nil, now); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := tc.Annotations[AnnotationSilences]; ok {
+		t.Error("SetSilences(nil) left the annotation in place")
+	}
+
+	tc.Annotations = map[string]string{AnnotationSilences: `[{"conditionType":"Ready"}]`}
+	if _, err := GetSilences(tc); err == nil {
+		t.Error("GetSilences() with missing author and until succeeded, want error")
+	}
+}
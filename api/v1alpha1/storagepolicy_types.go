@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// StorageDataLocality controls whether a volume keeps a replica on the node
+// running its workload.
+// +kubebuilder:validation:Enum=disabled;best-effort;strict-local
+type StorageDataLocality string
+
+const (
+	// StorageDataLocalityDisabled places replicas without regard to the workload.
+	StorageDataLocalityDisabled StorageDataLocality = "disabled"
+
+	// StorageDataLocalityBestEffort tries to keep a replica on the workload's node.
+	StorageDataLocalityBestEffort StorageDataLocality = "best-effort"
+
+	// StorageDataLocalityStrictLocal keeps the only replica on the workload's
+	// node. Requires a replica count of 1.
+	StorageDataLocalityStrictLocal StorageDataLocality = "strict-local"
+)
+
+// DefaultStorageReplicaCount is the replica count used when neither a
+// policy nor the storage addon sets one.
+const DefaultStorageReplicaCount int32 = 3
+
+// DefaultStoragePolicyName is the name of the policy, and StorageClass,
+// used when no policies are listed.
+const DefaultStoragePolicyName = "longhorn"
+
+// StoragePolicy describes a class of volumes. Each policy is rendered as a
+// StorageClass of the same name. DataLocality and snapshot settings apply
+// to Longhorn only.
+// +kubebuilder:validation:XValidation:rule="!has(self.dataLocality) || self.dataLocality != 'strict-local' || (has(self.replicaCount) && self.replicaCount == 1)",message="strict-local data locality requires replicaCount 1"
+// +kubebuilder:validation:XValidation:rule="!has(self.snapshotRetain) || has(self.snapshotSchedule)",message="snapshotRetain requires snapshotSchedule"
+type StoragePolicy struct {
+	// Name of the policy and its StorageClass.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// ReplicaCount is the number of replicas per volume. Defaults to the
+	// storage addon replica count. Capped at the number of nodes that can
+	// hold replicas.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	ReplicaCount *int32 `json:"replicaCount,omitempty"`
+
+	// DataLocality controls whether a replica is kept on the workload's node.
+	// +kubebuilder:default="disabled"
+	// +optional
+	DataLocality StorageDataLocality `json:"dataLocality,omitempty"`
+
+	// SnapshotSchedule takes recurring volume snapshots, in cron format
+	// (e.g., "0 */6 * * *"). Times are UTC.
+	// +kubebuilder:validation:Pattern=`^(\S+\s+){4}\S+$`
+	// +optional
+	SnapshotSchedule string `json:"snapshotSchedule,omitempty"`
+
+	// SnapshotRetain is the number of scheduled snapshots kept per volume.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	SnapshotRetain *int32 `json:"snapshotRetain,omitempty"`
+
+	// Default marks the StorageClass as the cluster default.
+	// At most one policy may be the default.
+	// +optional
+	Default bool `json:"default,omitempty"`
+}
+
+// GetDataLocality returns the data locality, defaulting to disabled.
+func (p *StoragePolicy) GetDataLocality() StorageDataLocality {
+	if p.DataLocality == "" {
+		return StorageDataLocalityDisabled
+	}
+	return p.DataLocality
+}
+
+// resolveStoragePolicies returns copies of policies with ReplicaCount set,
+// defaulting to defaultReplicas and capped at maxReplicas when it is
+// positive. When policies is empty a single default policy named
+// DefaultStoragePolicyName is returned. If no policy is marked default,
+// the first one is.
+func resolveStoragePolicies(policies []StoragePolicy, defaultReplicas, maxReplicas int32) []StoragePolicy {
+	if len(policies) == 0 {
+		policies = []StoragePolicy{{Name: DefaultStoragePolicyName}}
+	}
+	out := make([]StoragePolicy, len(policies))
+	hasDefault := false
+	for i := range policies {
+		p := *policies[i].DeepCopy()
+		replicas := defaultReplicas
+		if p.ReplicaCount != nil {
+			replicas = *p.ReplicaCount
+		}
+		if maxReplicas > 0 && replicas > maxReplicas {
+			replicas = maxReplicas
+		}
+		p.ReplicaCount = &replicas
+		hasDefault = hasDefault || p.Default
+		out[i] = p
+	}
+	if !hasDefault {
+		out[0].Default = true
+	}
+	return out
+}
+
+// DefaultStoragePolicy returns the policy marked default, or nil if none is.
+func DefaultStoragePolicy(policies []StoragePolicy) *StoragePolicy {
+	for i := range policies {
+		if policies[i].Default {
+			return &policies[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import "testing"
+
+func TestClusterBootstrapGetStoragePolicies(t *testing.T) {
+	two, five := int32(2), int32(5)
+	tests := []struct {
+		name         string
+		topology     ClusterTopology
+		storage      *StorageAddonSpec
+		wantNames    []string
+		wantReplicas []int32
+		wantDefault  string
+	}{
+		{
+			name:         "no storage addon",
+			wantNames:    []string{DefaultStoragePolicyName},
+			wantReplicas: []int32{3},
+			wantDefault:  DefaultStoragePolicyName,
+		},
+		{
+			name:         "legacy replica count",
+			storage:      &StorageAddonSpec{ReplicaCount: &two},
+			wantNames:    []string{DefaultStoragePolicyName},
+			wantReplicas: []int32{2},
+			wantDefault:  DefaultStoragePolicyName,
+		},
+		{
+			name: "policies inherit replica count",
+			storage: &StorageAddonSpec{ReplicaCount: &two, Policies: []StoragePolicy{
+				{Name: "fast"},
+				{Name: "replicated", ReplicaCount: &five, Default: true},
+			}},
+			wantNames:    []string{"fast", "replicated"},
+			wantReplicas: []int32{2, 5},
+			wantDefault:  "replicated",
+		},
+		{
+			name:     "single node caps replicas",
+			topology: ClusterTopologySingleNode,
+			storage: &StorageAddonSpec{Policies: []StoragePolicy{
+				{Name: "replicated", ReplicaCount: &five},
+			}},
+			wantNames:    []string{"replicated"},
+			wantReplicas: []int32{1},
+			wantDefault:  "replicated",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cb := &ClusterBootstrap{}
+			cb.Spec.Cluster.Topology = tt.topology
+			cb.Spec.Addons.Storage = tt.storage
+			got := cb.GetStoragePolicies()
+			if len(got) != len(tt.wantNames) {
+				t.Fatalf("GetStoragePolicies() returned %d policies, want %d", len(got), len(tt.wantNames))
+			}
+			for i := range got {
+				if got[i].Name != tt.wantNames[i] || *got[i].ReplicaCount != tt.wantReplicas[i] {
+					t.Errorf("policy %d = %s/%d, want %s/%d", i, got[i].Name, *got[i].ReplicaCount, tt.wantNames[i], tt.wantReplicas[i])
+				}
+			}
+			if d := DefaultStoragePolicy(got); d == nil || d.Name != tt.wantDefault {
+				t.Errorf("DefaultStoragePolicy() = %v, want %s", d, tt.wantDefault)
+			}
+			if got := cb.GetStorageReplicaCount(); got != *DefaultStoragePolicy(cb.GetStoragePolicies()).ReplicaCount {
+				t.Errorf("GetStorageReplicaCount() = %d, want the default policy's replica count", got)
+			}
+		})
+	}
+}
+
+func TestTenantClusterGetStoragePolicies(t *testing.T) {
+	five := int32(5)
+	tc := &TenantCluster{}
+	if got := tc.GetStoragePolicies(); got != nil {
+		t.Errorf("GetStoragePolicies() without storage addon = %v, want nil", got)
+	}
+
+	tc.Spec.Workers.Replicas = 2
+	tc.Spec.Addons.Storage = &StorageSpec{Policies: []StoragePolicy{{Name: "replicated", ReplicaCount: &five}}}
+	got := tc.GetStoragePolicies()
+	if len(got) != 1 || *got[0].ReplicaCount != 2 || !got[0].Default {
+		t.Errorf("GetStoragePolicies() = %+v, want one default policy capped at 2 replicas", got)
+	}
+	if *tc.Spec.Addons.Storage.Policies[0].ReplicaCount != 5 {
+		t.Error("GetStoragePolicies() modified the spec")
+	}
+}
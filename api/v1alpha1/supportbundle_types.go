@@ -0,0 +1,246 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"regexp"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SupportBundleScope determines which resources a SupportBundle collects.
+// +kubebuilder:validation:Enum=Cluster;Team;Platform
+type SupportBundleScope string
+
+const (
+	// SupportBundleScopeCluster collects a single TenantCluster and the
+	// objects generated for it.
+	SupportBundleScopeCluster SupportBundleScope = "Cluster"
+
+	// SupportBundleScopeTeam collects a Team and all of its clusters.
+	SupportBundleScopeTeam SupportBundleScope = "Team"
+
+	// SupportBundleScopePlatform collects platform-wide configuration
+	// (ButlerConfig, ProviderConfigs, NetworkPools) and controller state.
+	SupportBundleScopePlatform SupportBundleScope = "Platform"
+)
+
+// SupportBundlePhase represents the current phase of a SupportBundle.
+// +kubebuilder:validation:Enum=Pending;Collecting;Complete;Failed
+type SupportBundlePhase string
+
+const (
+	// SupportBundlePhasePending indicates collection has not started.
+	SupportBundlePhasePending SupportBundlePhase = "Pending"
+
+	// SupportBundlePhaseCollecting indicates collection is in progress.
+	SupportBundlePhaseCollecting SupportBundlePhase = "Collecting"
+
+	// SupportBundlePhaseComplete indicates the bundle is stored.
+	SupportBundlePhaseComplete SupportBundlePhase = "Complete"
+
+	// SupportBundlePhaseFailed indicates collection failed.
+	SupportBundlePhaseFailed SupportBundlePhase = "Failed"
+)
+
+// SupportBundleSpec defines the desired state of SupportBundle.
+// +kubebuilder:validation:XValidation:rule="self.scope != 'Cluster' || has(self.clusterRef)",message="clusterRef is required for Cluster scope"
+// +kubebuilder:validation:XValidation:rule="self.scope != 'Team' || has(self.teamRef)",message="teamRef is required for Team scope"
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec is immutable; create a new SupportBundle instead"
+type SupportBundleSpec struct {
+	// Scope determines which resources are collected.
+	// +kubebuilder:validation:Required
+	Scope SupportBundleScope `json:"scope"`
+
+	// ClusterRef references the TenantCluster for Cluster scope.
+	// +optional
+	ClusterRef *NamespacedObjectReference `json:"clusterRef,omitempty"`
+
+	// TeamRef references the Team for Team scope.
+	// +optional
+	TeamRef *LocalObjectReference `json:"teamRef,omitempty"`
+
+	// IncludeLogs collects logs from Butler controllers and, for Cluster
+	// scope, the tenant control plane pods.
+	// +kubebuilder:default=true
+	// +optional
+	IncludeLogs *bool `json:"includeLogs,omitempty"`
+
+	// LogSince limits logs to this window before collection.
+	// +kubebuilder:default="24h"
+	// +optional
+	LogSince *metav1.Duration `json:"logSince,omitempty"`
+
+	// IncludeEvents collects Kubernetes events for collected objects.
+	// +kubebuilder:default=true
+	// +optional
+	IncludeEvents *bool `json:"includeEvents,omitempty"`
+
+	// Redaction controls removal of sensitive data before the bundle is stored.
+	// Secret data is never collected regardless of these rules.
+	// +optional
+	Redaction *SupportBundleRedaction `json:"redaction,omitempty"`
+
+	// TTL deletes the bundle and its stored artifact this long after completion.
+	// +kubebuilder:default="168h"
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+}
+
+// SupportBundleRedaction configures redaction rules.
+type SupportBundleRedaction struct {
+	// RedactIPs replaces IP addresses with stable placeholders.
+	// +optional
+	RedactIPs bool `json:"redactIPs,omitempty"`
+
+	// RedactHostnames replaces hostnames and endpoints with stable placeholders.
+	// +optional
+	RedactHostnames bool `json:"redactHostnames,omitempty"`
+
+	// Patterns are RE2 regular expressions whose matches are replaced with
+	// "***" in collected objects and logs.
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:items:MaxLength=256
+	Patterns []string `json:"patterns,omitempty"`
+}
+
+// SupportBundleStatus defines the observed state of SupportBundle.
+type SupportBundleStatus struct {
+	// Conditions represent the latest available observations.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Phase represents the current phase of the bundle.
+	// +optional
+	Phase SupportBundlePhase `json:"phase,omitempty"`
+
+	// Artifact points at the stored bundle.
+	// +optional
+	Artifact *SupportBundleArtifact `json:"artifact,omitempty"`
+
+	// CollectedObjects is the number of objects in the bundle.
+	// +optional
+	CollectedObjects int32 `json:"collectedObjects,omitempty"`
+
+	// CompletedAt is when collection finished.
+	// +optional
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+
+	// Message provides human-readable status information.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// ObservedGeneration is the last observed generation.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// SupportBundleArtifact locates a stored bundle.
+type SupportBundleArtifact struct {
+	// Location is the URL of the bundle archive (e.g., an object storage URL).
+	// +optional
+	Location string `json:"location,omitempty"`
+
+	// SecretRef references a Secret holding the archive when no external
+	// storage is configured. Only used for small bundles.
+	// +optional
+	SecretRef *SecretReference `json:"secretRef,omitempty"`
+
+	// Size is the archive size in bytes.
+	// +optional
+	Size int64 `json:"size,omitempty"`
+
+	// SHA256 is the hex-encoded checksum of the archive.
+	// +optional
+	SHA256 string `json:"sha256,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=sb,categories=butler
+// +kubebuilder:printcolumn:name="Scope",type="string",JSONPath=".spec.scope",description="Collection scope"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Bundle phase"
+// +kubebuilder:printcolumn:name="Location",type="string",JSONPath=".status.artifact.location",description="Stored bundle",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// SupportBundle is the Schema for the supportbundles API.
+// It collects Butler resources, events, and controller logs for a cluster,
+// team, or the whole platform into a single archive for troubleshooting.
+type SupportBundle struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SupportBundleSpec   `json:"spec,omitempty"`
+	Status SupportBundleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SupportBundleList contains a list of SupportBundle.
+type SupportBundleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SupportBundle `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SupportBundle{}, &SupportBundleList{})
+}
+
+// Helper methods
+
+// IsComplete returns true if collection has finished, successfully or not.
+func (b *SupportBundle) IsComplete() bool {
+	return b.Status.Phase == SupportBundlePhaseComplete || b.Status.Phase == SupportBundlePhaseFailed
+}
+
+// IsExpired returns true if the bundle completed more than TTL ago.
+func (b *SupportBundle) IsExpired(now time.Time) bool {
+	if b.Status.CompletedAt == nil {
+		return false
+	}
+	ttl := 168 * time.Hour
+	if b.Spec.TTL != nil {
+		ttl = b.Spec.TTL.Duration
+	}
+	return !now.Before(b.Status.CompletedAt.Add(ttl))
+}
+
+// ShouldIncludeLogs returns whether logs are collected, defaulting to true.
+func (b *SupportBundle) ShouldIncludeLogs() bool {
+	return b.Spec.IncludeLogs == nil || *b.Spec.IncludeLogs
+}
+
+// CompilePatterns compiles the redaction patterns.
+func (r *SupportBundleRedaction) CompilePatterns() ([]*regexp.Regexp, error) {
+	if r == nil {
+		return nil, nil
+	}
+	out := make([]*regexp.Regexp, 0, len(r.Patterns))
+	for _, p := range r.Patterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, re)
+	}
+	return out, nil
+}
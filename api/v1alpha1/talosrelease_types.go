@@ -0,0 +1,193 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/version"
+)
+
+// DefaultTalosFactoryURL is the public Talos Image Factory.
+const DefaultTalosFactoryURL = "https://factory.talos.dev"
+
+// TalosReleaseSpec defines a Talos version and image schematic.
+type TalosReleaseSpec struct {
+	// Version is the Talos version (e.g., "v1.9.2").
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^v[0-9]+\.[0-9]+\.[0-9]+$`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="version is immutable"
+	Version string `json:"version"`
+
+	// SchematicID is the Image Factory schematic ID (SHA-256 hex).
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-f0-9]{64}$`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="schematicID is immutable; create a new TalosRelease instead"
+	SchematicID string `json:"schematicID"`
+
+	// Extensions lists the system extensions included in the schematic
+	// (e.g., "siderolabs/iscsi-tools"). Must match the schematic; the
+	// controller verifies it against the factory.
+	// +optional
+	Extensions []string `json:"extensions,omitempty"`
+
+	// KubernetesVersions is the range of Kubernetes versions supported by
+	// this Talos version.
+	// +kubebuilder:validation:Required
+	KubernetesVersions KubernetesVersionRange `json:"kubernetesVersions"`
+
+	// FactoryURL is the Image Factory serving the schematic.
+	// +kubebuilder:default="https://factory.talos.dev"
+	// +optional
+	FactoryURL string `json:"factoryURL,omitempty"`
+
+	// Deprecated hides the release from new bootstraps. Existing
+	// references keep working.
+	// +optional
+	Deprecated bool `json:"deprecated,omitempty"`
+}
+
+// KubernetesVersionRange is an inclusive range of Kubernetes minor versions.
+type KubernetesVersionRange struct {
+	// Min is the oldest supported version (e.g., "v1.29").
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^v[0-9]+\.[0-9]+(\.[0-9]+)?$`
+	Min string `json:"min"`
+
+	// Max is the newest supported version (e.g., "v1.32").
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^v[0-9]+\.[0-9]+(\.[0-9]+)?$`
+	Max string `json:"max"`
+}
+
+// TalosReleaseStatus defines the observed state of TalosRelease.
+type TalosReleaseStatus struct {
+	// Conditions represent the latest available observations.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// SchematicVerified indicates the factory knows the schematic and its
+	// extensions match spec.extensions.
+	// +optional
+	SchematicVerified bool `json:"schematicVerified,omitempty"`
+
+	// InstallerImage is the installer image for this release.
+	// +optional
+	InstallerImage string `json:"installerImage,omitempty"`
+
+	// ObservedGeneration is the last observed generation.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// TalosRelease condition types.
+const (
+	// TalosReleaseConditionSchematicVerified indicates the schematic was
+	// verified against the Image Factory.
+	TalosReleaseConditionSchematicVerified = "SchematicVerified"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=tr,categories=butler
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".spec.version",description="Talos version"
+// +kubebuilder:printcolumn:name="K8s Min",type="string",JSONPath=".spec.kubernetesVersions.min",description="Oldest supported Kubernetes version"
+// +kubebuilder:printcolumn:name="K8s Max",type="string",JSONPath=".spec.kubernetesVersions.max",description="Newest supported Kubernetes version"
+// +kubebuilder:printcolumn:name="Verified",type="boolean",JSONPath=".status.schematicVerified",description="Schematic verified"
+// +kubebuilder:printcolumn:name="Schematic",type="string",JSONPath=".spec.schematicID",description="Schematic ID",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// TalosRelease is a catalog entry pairing a Talos version with an Image
+// Factory schematic. ClusterBootstraps reference releases by name so the
+// controller can check schematic, extension, and Kubernetes compatibility
+// before imaging machines.
+type TalosRelease struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TalosReleaseSpec   `json:"spec,omitempty"`
+	Status TalosReleaseStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TalosReleaseList contains a list of TalosRelease.
+type TalosReleaseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TalosRelease `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TalosRelease{}, &TalosReleaseList{})
+}
+
+// Helper methods
+
+// GetFactoryURL returns the Image Factory URL, defaulting to the public factory.
+func (r *TalosRelease) GetFactoryURL() string {
+	if r.Spec.FactoryURL == "" {
+		return DefaultTalosFactoryURL
+	}
+	return r.Spec.FactoryURL
+}
+
+// GetInstallerImage returns the factory installer image for the release
+// (e.g., "factory.talos.dev/installer/<schematic>:v1.9.2").
+func (r *TalosRelease) GetInstallerImage() string {
+	host := strings.TrimPrefix(strings.TrimPrefix(r.GetFactoryURL(), "https://"), "http://")
+	return fmt.Sprintf("%s/installer/%s:%s", host, r.Spec.SchematicID, r.Spec.Version)
+}
+
+// SupportsKubernetes returns true if v is within the supported Kubernetes
+// range. Versions are compared by major and minor only.
+func (r *TalosRelease) SupportsKubernetes(v string) bool {
+	got, err := version.ParseGeneric(v)
+	if err != nil {
+		return false
+	}
+	lo, errLo := version.ParseGeneric(r.Spec.KubernetesVersions.Min)
+	hi, errHi := version.ParseGeneric(r.Spec.KubernetesVersions.Max)
+	if errLo != nil || errHi != nil {
+		return false
+	}
+	minor := version.MajorMinor(got.Major(), got.Minor())
+	return !minor.LessThan(version.MajorMinor(lo.Major(), lo.Minor())) &&
+		!version.MajorMinor(hi.Major(), hi.Minor()).LessThan(minor)
+}
+
+// CheckCompatibility returns the reasons the release cannot run
+// kubernetesVersion with the required extensions. An empty
+// kubernetesVersion skips the version check.
+func (r *TalosRelease) CheckCompatibility(kubernetesVersion string, requiredExtensions []string) []string {
+	var problems []string
+	if kubernetesVersion != "" && !r.SupportsKubernetes(kubernetesVersion) {
+		problems = append(problems, fmt.Sprintf("talos %s supports kubernetes %s to %s, not %s",
+			r.Spec.Version, r.Spec.KubernetesVersions.Min, r.Spec.KubernetesVersions.Max, kubernetesVersion))
+	}
+	for _, ext := range requiredExtensions {
+		if !slices.Contains(r.Spec.Extensions, ext) {
+			problems = append(problems, fmt.Sprintf("schematic %s does not include extension %s", r.Spec.SchematicID, ext))
+		}
+	}
+	return problems
+}
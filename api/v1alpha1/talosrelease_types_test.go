@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestTalosReleaseCheckCompatibility(t *testing.T) {
+	r := &TalosRelease{Spec: TalosReleaseSpec{
+		Version:            "v1.9.2",
+		SchematicID:        "376567988ad370138ad8b2698212367b8edcb69b5fd68c80be1f2ec7d603b4ba",
+		Extensions:         []string{"siderolabs/iscsi-tools", "siderolabs/qemu-guest-agent"},
+		KubernetesVersions: KubernetesVersionRange{Min: "v1.27", Max: "v1.32"},
+	}}
+
+	tests := []struct {
+		name       string
+		version    string
+		extensions []string
+		want       int
+	}{
+		{name: "compatible", version: "v1.32.1", extensions: []string{"siderolabs/iscsi-tools"}},
+		{name: "lower bound", version: "v1.27.0"},
+		{name: "too new", version: "v1.33.0", want: 1},
+		{name: "missing extension", version: "v1.30.4", extensions: []string{"siderolabs/nvidia-container-toolkit"}, want: 1},
+		{name: "version skipped", extensions: []string{"siderolabs/qemu-guest-agent"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := r.CheckCompatibility(tt.version, tt.extensions); len(got) != tt.want {
+				t.Errorf("CheckCompatibility() = %v, want %d problems", got, tt.want)
+			}
+		})
+	}
+
+	if got, want := r.GetInstallerImage(), "factory.talos.dev/installer/"+r.Spec.SchematicID+":v1.9.2"; got != want {
+		t.Errorf("GetInstallerImage() = %s, want %s", got, want)
+	}
+}
+
+func TestClusterBootstrapResolveTalos(t *testing.T) {
+	release := &TalosRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: "talos-1-9"},
+		Spec:       TalosReleaseSpec{Version: "v1.9.2", SchematicID: "abc"},
+	}
+	cb := &ClusterBootstrap{Spec: ClusterBootstrapSpec{Talos: ClusterBootstrapTalosSpec{
+		ReleaseRef: &LocalObjectReference{Name: "talos-1-9"},
+	}}}
+	if v, s, err := cb.ResolveTalos(release); err != nil || v != "v1.9.2" || s != "abc" {
+		t.Errorf("ResolveTalos() = %s, %s, %v, want v1.9.2, abc", v, s, err)
+	}
+	if _, _, err := cb.ResolveTalos(nil); err == nil {
+		t.Error("ResolveTalos(nil) with releaseRef succeeded, want error")
+	}
+
+	cb.Spec.Talos = ClusterBootstrapTalosSpec{Version: "v1.8.0", Schematic: "def"}
+	if v, s, err := cb.ResolveTalos(nil); err != nil || v != "v1.8.0" || s != "def" {
+		t.Errorf("ResolveTalos() inline = %s, %s, %v, want v1.8.0, def", v, s, err)
+	}
+}
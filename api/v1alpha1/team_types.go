@@ -17,6 +17,8 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -79,6 +81,25 @@ type TeamSpec struct {
 	// +listType=map
 	// +listMapKey=name
 	Environments []EnvironmentSpec `json:"environments,omitempty"`
+
+	// DeletionPolicy controls what happens to the Team's resources when the
+	// Team is deleted. Enforced by the admission webhook and the Team finalizer.
+	// +kubebuilder:default=Forbid
+	// +optional
+	DeletionPolicy TeamDeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// DeletionGracePeriod delays CascadeDelete after the Team is deleted.
+	// During the grace period the Team is Terminating, new resources are
+	// rejected, and members are notified so they can export what they need.
+	// +kubebuilder:default="24h"
+	// +optional
+	DeletionGracePeriod *metav1.Duration `json:"deletionGracePeriod,omitempty"`
+
+	// Bootstrap lists resources provisioned for the Team when it is created.
+	// Items are created once; later edits to the created resources are not
+	// reverted, and removing an item does not delete what it created.
+	// +optional
+	Bootstrap *TeamBootstrapSpec `json:"bootstrap,omitempty"`
 }
 
 // EnvironmentSpec defines an environment within a Team.
@@ -228,6 +249,149 @@ type TeamGroup struct {
 	IdentityProvider string `json:"identityProvider,omitempty"`
 }
 
+// TeamDeletionPolicy controls Team deletion when the Team owns resources.
+// +kubebuilder:validation:Enum=Forbid;CascadeDelete;OrphanToNamespace
+type TeamDeletionPolicy string
+
+const (
+	// TeamDeletionPolicyForbid rejects deletion while the Team has
+	// TenantClusters or Workspaces. They must be deleted first.
+	TeamDeletionPolicyForbid TeamDeletionPolicy = "Forbid"
+
+	// TeamDeletionPolicyCascadeDelete deletes the Team's TenantClusters,
+	// Workspaces, and namespace after DeletionGracePeriod.
+	TeamDeletionPolicyCascadeDelete TeamDeletionPolicy = "CascadeDelete"
+
+	// TeamDeletionPolicyOrphanToNamespace removes the Team but keeps its
+	// namespace and everything in it. Team RBAC is removed, so orphaned
+	// resources are only reachable by platform admins.
+	TeamDeletionPolicyOrphanToNamespace TeamDeletionPolicy = "OrphanToNamespace"
+)
+
+// TeamBootstrapSpec defines resources provisioned for a new Team.
+type TeamBootstrapSpec struct {
+	// NetworkPool carves a dedicated NetworkPool for the Team out of a
+	// platform NetworkPool.
+	// +optional
+	NetworkPool *TeamNetworkPoolBootstrap `json:"networkPool,omitempty"`
+
+	// ProviderConfigs lists platform ProviderConfigs to bind to the Team.
+	// For each, a team-scoped ProviderConfig named "{team}-{name}" is created
+	// in the Team namespace from the source. When NetworkPool is set, the
+	// copies allocate from the Team's pool.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	ProviderConfigs []ProviderReference `json:"providerConfigs,omitempty"`
+
+	// WorkspaceTemplates lists cluster-scoped WorkspaceTemplates to copy into
+	// the Team namespace as team-scoped starter templates.
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	WorkspaceTemplates []LocalObjectReference `json:"workspaceTemplates,omitempty"`
+
+	// NotificationChannel creates the Team's default NotificationChannel,
+	// named "default", in the Team namespace.
+	// +optional
+	NotificationChannel *TeamNotificationChannelBootstrap `json:"notificationChannel,omitempty"`
+}
+
+// TeamNetworkPoolBootstrap configures the Team's NetworkPool carve-out.
+type TeamNetworkPoolBootstrap struct {
+	// ParentPoolRef references the platform NetworkPool to carve from.
+	// +kubebuilder:validation:Required
+	ParentPoolRef LocalObjectReference `json:"parentPoolRef"`
+
+	// PrefixLength is the size of the carved subnet (e.g., 24 for a /24).
+	// +kubebuilder:default=24
+	// +kubebuilder:validation:Minimum=16
+	// +kubebuilder:validation:Maximum=29
+	// +optional
+	PrefixLength int32 `json:"prefixLength,omitempty"`
+}
+
+// TeamNotificationChannelType is the delivery mechanism for Team notifications.
+// Values match NotificationChannelType.
+// +kubebuilder:validation:Enum=slack;email;webhook
+type TeamNotificationChannelType string
+
+const (
+	// TeamNotificationChannelSlack posts to a Slack incoming webhook.
+	TeamNotificationChannelSlack TeamNotificationChannelType = "slack"
+
+	// TeamNotificationChannelEmail sends email via the platform SMTP settings.
+	TeamNotificationChannelEmail TeamNotificationChannelType = "email"
+
+	// TeamNotificationChannelWebhook posts JSON to a generic webhook.
+	TeamNotificationChannelWebhook TeamNotificationChannelType = "webhook"
+)
+
+// TeamNotificationChannelBootstrap configures the Team's default notification channel.
+// +kubebuilder:validation:XValidation:rule="self.type != 'email' || (has(self.recipients) && size(self.recipients) > 0)",message="recipients are required for email channels"
+// +kubebuilder:validation:XValidation:rule="self.type == 'email' || has(self.secretRef)",message="secretRef is required for slack and webhook channels"
+type TeamNotificationChannelBootstrap struct {
+	// Type is the delivery mechanism.
+	// +kubebuilder:validation:Required
+	Type TeamNotificationChannelType `json:"type"`
+
+	// SecretRef references a Secret with a "url" key holding the webhook URL.
+	// Required for slack and webhook channels.
+	// +optional
+	SecretRef *SecretReference `json:"secretRef,omitempty"`
+
+	// Recipients lists email addresses. Required for email channels.
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	Recipients []string `json:"recipients,omitempty"`
+}
+
+// TeamBootstrapItemPhase is the provisioning state of a bootstrap item.
+// +kubebuilder:validation:Enum=Pending;Ready;Failed
+type TeamBootstrapItemPhase string
+
+const (
+	// TeamBootstrapItemPending indicates the item has not been created yet.
+	TeamBootstrapItemPending TeamBootstrapItemPhase = "Pending"
+
+	// TeamBootstrapItemReady indicates the item was created.
+	TeamBootstrapItemReady TeamBootstrapItemPhase = "Ready"
+
+	// TeamBootstrapItemFailed indicates the item could not be created.
+	TeamBootstrapItemFailed TeamBootstrapItemPhase = "Failed"
+)
+
+// TeamBootstrapItemStatus reports the state of a single bootstrap item.
+type TeamBootstrapItemStatus struct {
+	// Kind of the created resource (e.g., "NetworkPool", "ProviderConfig").
+	Kind string `json:"kind"`
+
+	// Name of the created resource.
+	Name string `json:"name"`
+
+	// Namespace of the created resource. Empty for cluster-scoped resources.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Phase is the provisioning state.
+	Phase TeamBootstrapItemPhase `json:"phase"`
+
+	// Message explains a Failed phase.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// TeamResourceReference identifies a resource owned by a Team.
+type TeamResourceReference struct {
+	// Kind of the resource (e.g., "TenantCluster").
+	Kind string `json:"kind"`
+
+	// Name of the resource.
+	Name string `json:"name"`
+
+	// Namespace of the resource. Empty for cluster-scoped resources.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
 // TeamPhase represents the current phase of a Team.
 // +kubebuilder:validation:Enum=Pending;Ready;Terminating;Failed
 type TeamPhase string
@@ -286,6 +450,27 @@ type TeamStatus struct {
 	// QuotaMessage provides details about quota status.
 	// +optional
 	QuotaMessage string `json:"quotaMessage,omitempty"`
+
+	// BlockingResources lists resources preventing deletion of the Team.
+	// Set while the Team is Terminating.
+	// +optional
+	BlockingResources []TeamResourceReference `json:"blockingResources,omitempty"`
+
+	// DeletionScheduledAt is when CascadeDelete will start deleting resources.
+	// +optional
+	DeletionScheduledAt *metav1.Time `json:"deletionScheduledAt,omitempty"`
+
+	// Bootstrap reports the state of each item in spec.bootstrap.
+	// +optional
+	Bootstrap []TeamBootstrapItemStatus `json:"bootstrap,omitempty"`
+
+	// Summary holds flat counts for printer columns.
+	// +optional
+	Summary *StatusSummary `json:"summary,omitempty"`
+
+	// Reconcile reports controller reconcile diagnostics.
+	// +optional
+	Reconcile *ReconcileStatus `json:"reconcile,omitempty"`
 }
 
 // Team condition types.
@@ -301,15 +486,24 @@ const (
 
 	// TeamConditionQuotaExceeded indicates the Team has exceeded quota.
 	TeamConditionQuotaExceeded = "QuotaExceeded"
+
+	// TeamConditionDeletionBlocked indicates Team deletion is waiting on
+	// resources listed in status.blockingResources.
+	TeamConditionDeletionBlocked = "DeletionBlocked"
+
+	// TeamConditionBootstrapped indicates every spec.bootstrap item is Ready.
+	TeamConditionBootstrapped = "Bootstrapped"
 )
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:scope=Cluster,shortName=tm
+// +kubebuilder:resource:scope=Cluster,shortName=tm,categories=butler
+// +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Display Name",type="string",JSONPath=".spec.displayName",description="Human-readable name"
 // +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Current phase"
 // +kubebuilder:printcolumn:name="Namespace",type="string",JSONPath=".status.namespace",description="Team namespace"
 // +kubebuilder:printcolumn:name="Clusters",type="integer",JSONPath=".status.clusterCount",description="Number of clusters"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.summary.clustersReady",description="Ready/total clusters"
 // +kubebuilder:printcolumn:name="Quota",type="string",JSONPath=".status.quotaStatus",description="Quota status"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
@@ -336,3 +530,31 @@ type TeamList struct {
 func init() {
 	SchemeBuilder.Register(&Team{}, &TeamList{})
 }
+
+// Helper methods
+
+// IsBootstrapped returns true if every recorded bootstrap item is Ready.
+func (t *Team) IsBootstrapped() bool {
+	for _, item := range t.Status.Bootstrap {
+		if item.Phase != TeamBootstrapItemReady {
+			return false
+		}
+	}
+	return true
+}
+
+// GetDeletionPolicy returns the deletion policy, defaulting to Forbid.
+func (t *Team) GetDeletionPolicy() TeamDeletionPolicy {
+	if t.Spec.DeletionPolicy == "" {
+		return TeamDeletionPolicyForbid
+	}
+	return t.Spec.DeletionPolicy
+}
+
+// GetDeletionGracePeriod returns the CascadeDelete grace period, defaulting to 24h.
+func (t *Team) GetDeletionGracePeriod() time.Duration {
+	if t.Spec.DeletionGracePeriod == nil {
+		return 24 * time.Hour
+	}
+	return t.Spec.DeletionGracePeriod.Duration
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TemplatePublicationPhase represents the current phase of a TemplatePublication.
+// +kubebuilder:validation:Enum=Pending;Approved;Rejected;Published;Failed
+type TemplatePublicationPhase string
+
+const (
+	// TemplatePublicationPhasePending indicates the request is awaiting review.
+	TemplatePublicationPhasePending TemplatePublicationPhase = "Pending"
+
+	// TemplatePublicationPhaseApproved indicates a platform admin approved the
+	// request and the template is being published.
+	TemplatePublicationPhaseApproved TemplatePublicationPhase = "Approved"
+
+	// TemplatePublicationPhaseRejected indicates a platform admin rejected the request.
+	TemplatePublicationPhaseRejected TemplatePublicationPhase = "Rejected"
+
+	// TemplatePublicationPhasePublished indicates the cluster-scoped template was created.
+	TemplatePublicationPhasePublished TemplatePublicationPhase = "Published"
+
+	// TemplatePublicationPhaseFailed indicates the approved template could not be published.
+	TemplatePublicationPhaseFailed TemplatePublicationPhase = "Failed"
+)
+
+// TemplatePublication condition types.
+const (
+	// TemplatePublicationConditionReviewed indicates a review was recorded.
+	TemplatePublicationConditionReviewed = "Reviewed"
+
+	// TemplatePublicationConditionPublished indicates the cluster-scoped
+	// template exists and matches the approved source generation.
+	TemplatePublicationConditionPublished = "Published"
+)
+
+// TemplatePublicationSpec defines the desired state of TemplatePublication.
+// +kubebuilder:validation:XValidation:rule="self.templateRef == oldSelf.templateRef",message="templateRef is immutable"
+// +kubebuilder:validation:XValidation:rule="(has(self.publishedName) == has(oldSelf.publishedName)) && (!has(self.publishedName) || self.publishedName == oldSelf.publishedName)",message="publishedName is immutable"
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.review) || (has(self.review) && self.review == oldSelf.review)",message="review is immutable once recorded"
+type TemplatePublicationSpec struct {
+	// TemplateRef references the team-scoped WorkspaceTemplate to publish.
+	// The template must be in the same namespace as the publication.
+	// +kubebuilder:validation:Required
+	TemplateRef LocalObjectReference `json:"templateRef"`
+
+	// PublishedName is the name of the cluster-scoped template in
+	// butler-system. Defaults to the source template name.
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +optional
+	PublishedName string `json:"publishedName,omitempty"`
+
+	// Reason explains why the template should be available platform-wide.
+	// Shown to reviewers.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Reason string `json:"reason"`
+
+	// Requester is the user who requested publication.
+	// Set by the admission webhook from the authenticated user and immutable.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="requester is immutable"
+	Requester string `json:"requester,omitempty"`
+
+	// Review records the platform admin's decision. The reviewer and time
+	// are set by the admission webhook; requesters cannot review their own
+	// publication.
+	// +optional
+	Review *ChangeReview `json:"review,omitempty"`
+}
+
+// TemplatePublicationStatus defines the observed state of TemplatePublication.
+type TemplatePublicationStatus struct {
+	// Conditions represent the latest available observations.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Phase represents the current phase of the publication.
+	// +optional
+	Phase TemplatePublicationPhase `json:"phase,omitempty"`
+
+	// SourceGeneration is the generation of the source template when it was
+	// reviewed. Later changes to the source are not published without a new
+	// TemplatePublication.
+	// +optional
+	SourceGeneration int64 `json:"sourceGeneration,omitempty"`
+
+	// PublishedTemplateRef references the cluster-scoped template.
+	// +optional
+	PublishedTemplateRef *NamespacedObjectReference `json:"publishedTemplateRef,omitempty"`
+
+	// PublishedAt is when the cluster-scoped template was created.
+	// +optional
+	PublishedAt *metav1.Time `json:"publishedAt,omitempty"`
+
+	// Message provides human-readable status information.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// ObservedGeneration is the last observed generation.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=wstp,categories=butler
+// +kubebuilder:printcolumn:name="Template",type="string",JSONPath=".spec.templateRef.name",description="Source template"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Publication phase"
+// +kubebuilder:printcolumn:name="Requester",type="string",JSONPath=".spec.requester",description="Requester",priority=1
+// +kubebuilder:printcolumn:name="Reviewer",type="string",JSONPath=".spec.review.reviewer",description="Reviewer",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// TemplatePublication requests promotion of a team-scoped WorkspaceTemplate
+// to a cluster-scoped template visible to all teams. Team admins create it
+// in the team namespace, and a platform admin approves or rejects it. On
+// approval, the controller writes the effective template, with its
+// baseTemplateRef chain resolved, to butler-system and labels it with
+// the source namespace and name. The publication is kept as the audit
+// record of who requested and who approved the promotion.
+type TemplatePublication struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TemplatePublicationSpec   `json:"spec,omitempty"`
+	Status TemplatePublicationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TemplatePublicationList contains a list of TemplatePublication.
+type TemplatePublicationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TemplatePublication `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TemplatePublication{}, &TemplatePublicationList{})
+}
+
+// Helper methods
+
+// GetPublishedName returns the name of the cluster-scoped template.
+func (p *TemplatePublication) GetPublishedName() string {
+	if p.Spec.PublishedName != "" {
+		return p.Spec.PublishedName
+	}
+	return p.Spec.TemplateRef.Name
+}
+
+// IsApproved returns true if a reviewer other than the requester approved
+// the publication.
+func (p *TemplatePublication) IsApproved() bool {
+	r := p.Spec.Review
+	return r != nil && r.Decision == ReviewDecisionApprove && r.Reviewer != p.Spec.Requester
+}
+
+// IsRejected returns true if the reviewer rejected the publication.
+func (p *TemplatePublication) IsRejected() bool {
+	return p.Spec.Review != nil && p.Spec.Review.Decision == ReviewDecisionReject
+}
+
+// IsPublished returns true if the cluster-scoped template was created.
+func (p *TemplatePublication) IsPublished() bool {
+	return p.Status.Phase == TemplatePublicationPhasePublished
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import "testing"
+
+func TestTemplatePublicationReview(t *testing.T) {
+	tests := []struct {
+		name         string
+		review       *ChangeReview
+		wantApproved bool
+		wantRejected bool
+	}{
+		{"pending", nil, false, false},
+		{"approved", &ChangeReview{Reviewer: "admin@example.com", Decision: ReviewDecisionApprove}, true, false},
+		{"self approval", &ChangeReview{Reviewer: "dev@example.com", Decision: ReviewDecisionApprove}, false, false},
+		{"rejected", &ChangeReview{Reviewer: "admin@example.com", Decision: ReviewDecisionReject}, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &TemplatePublication{Spec: TemplatePublicationSpec{Requester: "dev@example.com", Review: tt.review}}
+			if got := p.IsApproved(); got != tt.wantApproved {
+				t.Errorf("IsApproved() = %v, want %v", got, tt.wantApproved)
+			}
+			if got := p.IsRejected(); got != tt.wantRejected {
+				t.Errorf("IsRejected() = %v, want %v", got, tt.wantRejected)
+			}
+		})
+	}
+}
+
+func TestTemplatePublicationGetPublishedName(t *testing.T) {
+	p := &TemplatePublication{Spec: TemplatePublicationSpec{TemplateRef: LocalObjectReference{Name: "go-backend"}}}
+	if got := p.GetPublishedName(); got != "go-backend" {
+		t.Errorf("GetPublishedName() = %q, want go-backend", got)
+	}
+	p.Spec.PublishedName = "platform-go"
+	if got := p.GetPublishedName(); got != "platform-go" {
+		t.Errorf("GetPublishedName() = %q, want platform-go", got)
+	}
+}
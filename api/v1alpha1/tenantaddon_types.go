@@ -43,6 +43,8 @@ type TenantAddonSpec struct {
 	Helm *HelmChartSpec `json:"helm,omitempty"`
 
 	// Values are Helm values for customization.
+	// String values may reference cluster variables as ${butler.<name>}
+	// (e.g., ${butler.clusterName}); see ValuesContext for the full list.
 	// +optional
 	// +kubebuilder:pruning:PreserveUnknownFields
 	Values *ExtensionValues `json:"values,omitempty"`
@@ -136,6 +138,10 @@ type TenantAddonStatus struct {
 	// Message provides human-readable status information.
 	// +optional
 	Message string `json:"message,omitempty"`
+
+	// Reconcile reports controller reconcile diagnostics.
+	// +optional
+	Reconcile *ReconcileStatus `json:"reconcile,omitempty"`
 }
 
 // HelmReleaseStatus contains information about the Helm release
@@ -181,7 +187,7 @@ const (
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:shortName=ta
+// +kubebuilder:resource:shortName=ta,categories=butler
 // +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".spec.clusterRef.name",description="Target cluster"
 // +kubebuilder:printcolumn:name="Addon",type="string",JSONPath=".spec.addon",description="Addon name"
 // +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".spec.version",description="Desired version"
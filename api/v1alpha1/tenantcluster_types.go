@@ -18,9 +18,20 @@ package v1alpha1
 
 import (
 	"encoding/json"
-
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"net/netip"
+	"slices"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 // ManagementMode defines how Butler manages addons after initial installation.
@@ -62,7 +73,42 @@ const (
 	OSTypeBottlerocket OSType = "bottlerocket"
 )
 
+// BootstrapProviderType is the CAPI bootstrap provider for worker nodes.
+// +kubebuilder:validation:Enum=kubeadm;talos;k3s
+type BootstrapProviderType string
+
+const (
+	// BootstrapProviderKubeadm joins workers with kubeadm (CABPK).
+	BootstrapProviderKubeadm BootstrapProviderType = "kubeadm"
+
+	// BootstrapProviderTalos joins workers with Talos machine config (CABPT).
+	// Requires the talos OS type.
+	BootstrapProviderTalos BootstrapProviderType = "talos"
+
+	// BootstrapProviderK3s joins workers as k3s agents (CABP3).
+	BootstrapProviderK3s BootstrapProviderType = "k3s"
+)
+
+// TenancyMode selects how a tenant cluster is provisioned.
+// +kubebuilder:validation:Enum=dedicated;virtual
+type TenancyMode string
+
+const (
+	// TenancyModeDedicated provisions a Steward-hosted control plane and
+	// dedicated worker machines.
+	TenancyModeDedicated TenancyMode = "dedicated"
+
+	// TenancyModeVirtual provisions a vcluster inside a shared host
+	// cluster. Workloads run on the host cluster's nodes.
+	TenancyModeVirtual TenancyMode = "virtual"
+)
+
 // TenantClusterSpec defines the desired state of TenantCluster.
+// +kubebuilder:validation:XValidation:rule="!has(self.bootstrapProvider) || self.bootstrapProvider != 'talos' || (has(self.workers.machineTemplate) && has(self.workers.machineTemplate.os) && has(self.workers.machineTemplate.os.type) && self.workers.machineTemplate.os.type == 'talos')",message="bootstrapProvider talos requires workers.machineTemplate.os.type talos"
+// +kubebuilder:validation:XValidation:rule="!has(self.bootstrapProvider) || self.bootstrapProvider != 'talos' || !has(self.workerPools) || self.workerPools.all(p, has(p.machineTemplate.os) && has(p.machineTemplate.os.type) && p.machineTemplate.os.type == 'talos')",message="bootstrapProvider talos requires workerPools machineTemplate.os.type talos"
+// +kubebuilder:validation:XValidation:rule="has(self.virtual) == (has(self.tenancyMode) && self.tenancyMode == 'virtual')",message="virtual is required for, and only allowed with, tenancyMode virtual"
+// +kubebuilder:validation:XValidation:rule="(has(self.tenancyMode) && self.tenancyMode == 'virtual') || (has(self.workers) && self.workers.replicas >= 1)",message="workers.replicas must be at least 1 for dedicated clusters"
+// +kubebuilder:validation:XValidation:rule="!has(self.tenancyMode) || self.tenancyMode != 'virtual' || ((!has(self.workers) || self.workers.replicas == 0) && !has(self.workerPools))",message="virtual clusters have no workers; workers.replicas must be 0 and workerPools unset"
 type TenantClusterSpec struct {
 	// KubernetesVersion is the target Kubernetes version.
 	// +kubebuilder:validation:Required
@@ -80,14 +126,54 @@ type TenantClusterSpec struct {
 	// +optional
 	ProviderConfigRef *ProviderReference `json:"providerConfigRef,omitempty"`
 
+	// BootstrapProvider selects the CAPI bootstrap provider for worker nodes.
+	// If not specified, talos is used for Talos workers and kubeadm otherwise.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="bootstrapProvider is immutable"
+	// +optional
+	BootstrapProvider BootstrapProviderType `json:"bootstrapProvider,omitempty"`
+
+	// TenancyMode selects a dedicated cluster or a virtual cluster in a
+	// shared host cluster. Addons, access grants, and the kubeconfig Secret
+	// work the same in both modes.
+	// +kubebuilder:default=dedicated
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="tenancyMode is immutable"
+	// +optional
+	TenancyMode TenancyMode `json:"tenancyMode,omitempty"`
+
+	// Virtual configures the vcluster. Required when tenancyMode is virtual.
+	// +optional
+	Virtual *VirtualClusterSpec `json:"virtual,omitempty"`
+
 	// ControlPlane configures the Steward-hosted control plane.
 	// +optional
 	ControlPlane ControlPlaneSpec `json:"controlPlane,omitempty"`
 
 	// Workers configures the worker nodes.
-	// +kubebuilder:validation:Required
+	// This is the default worker pool; see WorkerPools for additional pools.
+	// Required for dedicated clusters; virtual clusters have no workers.
+	// +optional
 	Workers WorkersSpec `json:"workers"`
 
+	// WorkerPools adds worker pools alongside the default pool in Workers,
+	// for example GPU or memory-optimized nodes. Each pool is a separate
+	// MachineDeployment with its own machine template, labels, and taints.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:rule="self.all(p, p.name != 'default')",message="worker pool name default is reserved for spec.workers"
+	WorkerPools []WorkerPoolSpec `json:"workerPools,omitempty"`
+
+	// RebootPolicy enables rolling reboots of workers, for example to
+	// apply kernel updates on Rocky or Flatcar nodes.
+	// +optional
+	RebootPolicy *RebootPolicy `json:"rebootPolicy,omitempty"`
+
+	// Hibernation scales workers down off-hours on a schedule, for
+	// example to stop development clusters overnight.
+	// +optional
+	Hibernation *HibernationSpec `json:"hibernation,omitempty"`
+
 	// Networking configures cluster networking.
 	// +optional
 	Networking NetworkingSpec `json:"networking,omitempty"`
@@ -119,6 +205,267 @@ type TenantClusterSpec struct {
 	// with SSH access in the tenant cluster's "workspaces" namespace.
 	// +optional
 	Workspaces *WorkspacesConfig `json:"workspaces,omitempty"`
+
+	// AccessGrants give users temporary elevated access to this cluster,
+	// for example just-in-time production access.
+	// +optional
+	// +listType=map
+	// +listMapKey=user
+	// +kubebuilder:validation:MaxItems=32
+	AccessGrants []AccessGrant `json:"accessGrants,omitempty"`
+
+	// SLO declares the availability objective promised for this cluster.
+	// Requires an observability pipeline in ButlerConfig to be measured.
+	// +optional
+	SLO *SLOSpec `json:"slo,omitempty"`
+
+	// ClusterMetadata is propagated to objects Butler generates for this
+	// cluster so that policy and cost tooling can select them.
+	// +optional
+	ClusterMetadata *ClusterMetadata `json:"clusterMetadata,omitempty"`
+
+	// PolicyExceptions lists temporary exceptions from admission policies
+	// in this cluster. Each exception is removed at its expiry.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=64
+	PolicyExceptions []PolicyException `json:"policyExceptions,omitempty"`
+
+	// Ownership records who owns the cluster and how to reach them during
+	// an incident.
+	// +optional
+	Ownership *OwnershipSpec `json:"ownership,omitempty"`
+
+	// DeletionProtection denies deletion of the TenantCluster until it is
+	// set back to false.
+	// +optional
+	DeletionProtection bool `json:"deletionProtection,omitempty"`
+}
+
+// EnvironmentTier is the deployment tier of a cluster.
+// +kubebuilder:validation:Enum=dev;staging;prod
+type EnvironmentTier string
+
+const (
+	// EnvironmentTierDev is a development cluster.
+	EnvironmentTierDev EnvironmentTier = "dev"
+
+	// EnvironmentTierStaging is a pre-production cluster.
+	EnvironmentTierStaging EnvironmentTier = "staging"
+
+	// EnvironmentTierProd is a production cluster.
+	EnvironmentTierProd EnvironmentTier = "prod"
+)
+
+// OwnershipSpec identifies the owners of a cluster for incident response.
+type OwnershipSpec struct {
+	// OwnerEmail is the email of the accountable owner. Unlike the
+	// butler.butlerlabs.dev/owner annotation, which records the creator,
+	// this is maintained by the team.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Format=email
+	// +kubebuilder:validation:MaxLength=254
+	OwnerEmail string `json:"ownerEmail"`
+
+	// EscalationContact is who to page when the owner does not respond,
+	// such as an on-call rotation email or pager handle.
+	// +kubebuilder:validation:MaxLength=254
+	// +optional
+	EscalationContact string `json:"escalationContact,omitempty"`
+
+	// SlackChannel is the channel for incidents (e.g., "#payments-oncall").
+	// +kubebuilder:validation:Pattern=`^#?[a-z0-9][a-z0-9._-]{0,79}$`
+	// +optional
+	SlackChannel string `json:"slackChannel,omitempty"`
+
+	// BusinessUnit is the organizational unit the cluster is billed to.
+	// Propagated as the butler.butlerlabs.dev/business-unit label.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`
+	// +optional
+	BusinessUnit string `json:"businessUnit,omitempty"`
+
+	// Environment is the deployment tier. This is independent of the
+	// butler.butlerlabs.dev/environment label, which names a Team
+	// environment. Propagated as the butler.butlerlabs.dev/environment-tier
+	// label.
+	// +kubebuilder:validation:Required
+	Environment EnvironmentTier `json:"environment"`
+}
+
+// ClusterMetadata holds labels and annotations propagated from a
+// TenantCluster to its tenant namespace, its CAPI Cluster, and its nodes.
+// Butler always adds the team, tenant, and environment labels; see
+// TenantCluster.ClusterLabels.
+type ClusterMetadata struct {
+	// Labels are applied to the tenant namespace, the CAPI Cluster, and
+	// worker nodes. Nodes receive them through the kubelet --node-labels
+	// argument, so keys in the kubernetes.io and k8s.io namespaces other
+	// than kubelet.kubernetes.io and node.kubernetes.io are not applied
+	// to nodes.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self.all(k, !k.startsWith('butler.butlerlabs.dev/'))",message="labels with the butler.butlerlabs.dev/ prefix are reserved"
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations are applied to the tenant namespace and the CAPI Cluster.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Validate checks that label keys and values and annotation keys are valid.
+func (m *ClusterMetadata) Validate() error {
+	if m == nil {
+		return nil
+	}
+	var errs []error
+	for _, k := range slices.Sorted(maps.Keys(m.Labels)) {
+		for _, msg := range validation.IsQualifiedName(k) {
+			errs = append(errs, fmt.Errorf("label key %q: %s", k, msg))
+		}
+		for _, msg := range validation.IsValidLabelValue(m.Labels[k]) {
+			errs = append(errs, fmt.Errorf("label %q value: %s", k, msg))
+		}
+	}
+	for _, k := range slices.Sorted(maps.Keys(m.Annotations)) {
+		for _, msg := range validation.IsQualifiedName(strings.ToLower(k)) {
+			errs = append(errs, fmt.Errorf("annotation key %q: %s", k, msg))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// IsKubeletLabel returns true if the kubelet may set the label key on its
+// own Node. The NodeRestriction admission plugin rejects kubelet-set labels
+// in the kubernetes.io and k8s.io namespaces except under
+// kubelet.kubernetes.io and node.kubernetes.io.
+func IsKubeletLabel(key string) bool {
+	prefix, _, ok := strings.Cut(key, "/")
+	if !ok {
+		return true
+	}
+	for _, allowed := range []string{"kubelet.kubernetes.io", "node.kubernetes.io"} {
+		if prefix == allowed || strings.HasSuffix(prefix, "."+allowed) {
+			return true
+		}
+	}
+	for _, restricted := range []string{"kubernetes.io", "k8s.io"} {
+		if prefix == restricted || strings.HasSuffix(prefix, "."+restricted) {
+			return false
+		}
+	}
+	return true
+}
+
+// GetBootstrapProvider returns the bootstrap provider for worker nodes.
+// Defaults to talos for Talos workers and kubeadm otherwise.
+func (s *TenantClusterSpec) GetBootstrapProvider() BootstrapProviderType {
+	if s.BootstrapProvider != "" {
+		return s.BootstrapProvider
+	}
+	if s.Workers.MachineTemplate.OS.Type == OSTypeTalos {
+		return BootstrapProviderTalos
+	}
+	return BootstrapProviderKubeadm
+}
+
+// IsVirtual returns true if the cluster is a vcluster in a host cluster.
+func (s *TenantClusterSpec) IsVirtual() bool {
+	return s.TenancyMode == TenancyModeVirtual
+}
+
+// GetFromHost returns the host resources visible in the virtual cluster,
+// defaulting to StorageClasses and IngressClasses.
+func (s *VirtualClusterSync) GetFromHost() []VirtualSyncFromHostResource {
+	if s == nil || s.FromHost == nil {
+		return []VirtualSyncFromHostResource{VirtualSyncFromHostStorageClasses, VirtualSyncFromHostIngressClasses}
+	}
+	return s.FromHost
+}
+
+// AllWorkerPools returns every worker pool, starting with spec.workers as
+// the pool named DefaultWorkerPoolName. Unset update strategy, drain, and
+// infrastructure overrides are filled in from spec.workers and the spec.
+func (s *TenantClusterSpec) AllWorkerPools() []WorkerPoolSpec {
+	pools := make([]WorkerPoolSpec, 0, len(s.WorkerPools)+1)
+	pools = append(pools, WorkerPoolSpec{
+		Name:                   DefaultWorkerPoolName,
+		Replicas:               s.Workers.Replicas,
+		MachineTemplate:        s.Workers.MachineTemplate,
+		InfrastructureOverride: s.InfrastructureOverride,
+		UpdateStrategy:         s.Workers.UpdateStrategy,
+		Drain:                  s.Workers.Drain,
+		Distribution:           s.Workers.Distribution,
+	})
+	for _, p := range s.WorkerPools {
+		if p.UpdateStrategy == nil {
+			p.UpdateStrategy = s.Workers.UpdateStrategy
+		}
+		if p.Drain == nil {
+			p.Drain = s.Workers.Drain
+		}
+		if p.InfrastructureOverride == nil {
+			p.InfrastructureOverride = s.InfrastructureOverride
+		}
+		pools = append(pools, p)
+	}
+	for i := range pools {
+		pools[i] = *pools[i].DeepCopy()
+	}
+	return pools
+}
+
+// GetWorkerPool returns the named worker pool as returned by AllWorkerPools,
+// or nil if there is no such pool.
+func (s *TenantClusterSpec) GetWorkerPool(name string) *WorkerPoolSpec {
+	for _, p := range s.AllWorkerPools() {
+		if p.Name == name {
+			return &p
+		}
+	}
+	return nil
+}
+
+// TotalWorkerReplicas returns the desired number of workers across all pools.
+func (s *TenantClusterSpec) TotalWorkerReplicas() int32 {
+	total := s.Workers.Replicas
+	for _, p := range s.WorkerPools {
+		total += p.Replicas
+	}
+	return total
+}
+
+// NodeLabels returns the pool labels plus LabelWorkerPool.
+func (p *WorkerPoolSpec) NodeLabels() map[string]string {
+	labels := maps.Clone(p.Labels)
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[LabelWorkerPool] = p.Name
+	return labels
+}
+
+// EffectiveTaints returns the machine template taints merged with the pool
+// taints. A pool taint replaces a template taint with the same key and effect.
+func (p *WorkerPoolSpec) EffectiveTaints() []NodeTaint {
+	taints := make([]NodeTaint, 0, len(p.MachineTemplate.Taints)+len(p.Taints))
+	for _, t := range p.MachineTemplate.Taints {
+		if !slices.ContainsFunc(p.Taints, func(pt NodeTaint) bool { return pt.Key == t.Key && pt.Effect == t.Effect }) {
+			taints = append(taints, t)
+		}
+	}
+	return append(taints, p.Taints...)
+}
+
+// TaintsArg returns the effective pool taints formatted for the kubelet
+// --register-with-taints argument.
+func (p *WorkerPoolSpec) TaintsArg() string {
+	effective := p.EffectiveTaints()
+	taints := make([]string, len(effective))
+	for i, t := range effective {
+		taints[i] = t.String()
+	}
+	return strings.Join(taints, ",")
 }
 
 // WorkspacesConfig configures the workspace feature for a tenant cluster.
@@ -167,6 +514,8 @@ type WorkspaceResourceQuota struct {
 }
 
 // ControlPlaneSpec configures the Steward-hosted control plane.
+// +kubebuilder:validation:XValidation:rule="!has(self.vipPoolRef) || !has(self.serviceType) || self.serviceType == 'LoadBalancer'",message="vipPoolRef requires serviceType LoadBalancer"
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.encryption) || has(self.encryption)",message="encryption cannot be removed once enabled"
 type ControlPlaneSpec struct {
 	// Replicas is the number of API server replicas.
 	// Steward manages high availability automatically.
@@ -188,6 +537,13 @@ type ControlPlaneSpec struct {
 	// +optional
 	ServiceType string `json:"serviceType,omitempty"`
 
+	// VIPPoolRef allocates the API server LoadBalancer IP from this
+	// NetworkPool with a controlplane IPAllocation, so the endpoint is
+	// stable and known before the cluster is created.
+	// Requires the LoadBalancer service type.
+	// +optional
+	VIPPoolRef *LocalObjectReference `json:"vipPoolRef,omitempty"`
+
 	// CertSANs are additional Subject Alternative Names for the API server certificate.
 	// Use this to add custom DNS names or IPs for API server access.
 	// +optional
@@ -204,41 +560,807 @@ type ControlPlaneSpec struct {
 	// for that component. Components not set here inherit from ButlerConfig.
 	// +optional
 	Resources *ControlPlaneResourcesSpec `json:"resources,omitempty"`
+
+	// AccessControl restricts which client networks may reach the API server.
+	// It is translated into LoadBalancer source ranges or Ingress/Gateway
+	// policies depending on how the control plane is exposed.
+	// +optional
+	AccessControl *APIServerAccessControl `json:"accessControl,omitempty"`
+
+	// Encryption enables encryption at rest for API resources stored in
+	// the control plane's datastore. Once enabled it cannot be removed.
+	// +optional
+	Encryption *EncryptionConfig `json:"encryption,omitempty"`
+}
+
+// EncryptionProviderType is the encryption-at-rest provider.
+// +kubebuilder:validation:Enum=aescbc;kms
+type EncryptionProviderType string
+
+const (
+	// EncryptionProviderAESCBC encrypts with AES-CBC using a key held in a
+	// Secret on the management cluster.
+	EncryptionProviderAESCBC EncryptionProviderType = "aescbc"
+
+	// EncryptionProviderKMS encrypts with envelope encryption through a
+	// KMS v2 plugin, so the key encryption key never leaves the KMS.
+	EncryptionProviderKMS EncryptionProviderType = "kms"
+)
+
+// EncryptionRotationPhase is the stage of an encryption key rotation.
+// +kubebuilder:validation:Enum=Idle;Staging;Promoting;Rewriting;Pruning
+type EncryptionRotationPhase string
+
+const (
+	// EncryptionRotationIdle indicates no rotation is in progress.
+	EncryptionRotationIdle EncryptionRotationPhase = "Idle"
+
+	// EncryptionRotationStaging indicates the new key was added as a
+	// decryption-only key and API servers are restarting.
+	EncryptionRotationStaging EncryptionRotationPhase = "Staging"
+
+	// EncryptionRotationPromoting indicates the new key is becoming the
+	// write key and API servers are restarting.
+	EncryptionRotationPromoting EncryptionRotationPhase = "Promoting"
+
+	// EncryptionRotationRewriting indicates encrypted resources are being
+	// rewritten with the new key.
+	EncryptionRotationRewriting EncryptionRotationPhase = "Rewriting"
+
+	// EncryptionRotationPruning indicates the old key is being removed.
+	EncryptionRotationPruning EncryptionRotationPhase = "Pruning"
+)
+
+// DefaultEncryptedResources are encrypted when EncryptionConfig.Resources is empty.
+var DefaultEncryptedResources = []string{"secrets"}
+
+// EncryptionConfig configures encryption at rest for a tenant control plane.
+// +kubebuilder:validation:XValidation:rule="self.provider != 'aescbc' || has(self.aescbc)",message="aescbc is required for the aescbc provider"
+// +kubebuilder:validation:XValidation:rule="self.provider != 'kms' || has(self.kms)",message="kms is required for the kms provider"
+// +kubebuilder:validation:XValidation:rule="!has(self.rotationInterval) || self.provider == 'aescbc'",message="rotationInterval is only supported for the aescbc provider; rotate kms keys in the KMS"
+type EncryptionConfig struct {
+	// Provider is the encryption provider.
+	// +kubebuilder:validation:Required
+	Provider EncryptionProviderType `json:"provider"`
+
+	// AESCBC configures the aescbc provider.
+	// +optional
+	AESCBC *AESCBCEncryptionConfig `json:"aescbc,omitempty"`
+
+	// KMS configures the kms provider.
+	// +optional
+	KMS *KMSEncryptionConfig `json:"kms,omitempty"`
+
+	// Resources are the API resources to encrypt, as "resource.group"
+	// (e.g., "secrets", "configmaps", "deployments.apps", or "*.apps").
+	// Defaults to secrets.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:items:Pattern=`^(\*|[a-z0-9]([-a-z0-9]*[a-z0-9])?)(\.(\*|[a-z0-9]([-a-z0-9.]*[a-z0-9])?))?$`
+	Resources []string `json:"resources,omitempty"`
+
+	// RotationInterval rotates the aescbc key when the active key is
+	// older than this. If not set, keys are only rotated when the key
+	// Secret changes.
+	// +optional
+	RotationInterval *metav1.Duration `json:"rotationInterval,omitempty"`
+}
+
+// AESCBCEncryptionConfig configures the aescbc provider.
+type AESCBCEncryptionConfig struct {
+	// KeySecretRef references the Secret holding the base64-encoded 32-byte
+	// key. If the Secret does not exist, the controller generates a key.
+	// Key defaults to "key". Changing the key data starts a rotation.
+	// +kubebuilder:validation:Required
+	KeySecretRef SecretReference `json:"keySecretRef"`
+}
+
+// KMSEncryptionConfig configures a KMS v2 plugin.
+type KMSEncryptionConfig struct {
+	// Name identifies the KMS configuration. Changing it starts a
+	// migration to the new configuration.
+	// +kubebuilder:default="butler-kms"
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Endpoint is the gRPC endpoint of the KMS plugin, reachable from the
+	// API server pods (e.g., "unix:///var/run/kms/socket.sock").
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Endpoint string `json:"endpoint"`
+
+	// KeyID identifies the key encryption key in the KMS
+	// (e.g., a Vault transit key name or a cloud KMS key ARN).
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	KeyID string `json:"keyID"`
+
+	// CredentialsSecretRef references a Secret with credentials for the
+	// KMS plugin, mounted into the plugin sidecar.
+	// +optional
+	CredentialsSecretRef *SecretReference `json:"credentialsSecretRef,omitempty"`
+
+	// Timeout for calls to the KMS plugin.
+	// +kubebuilder:default="3s"
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// EncryptionStatus reports encryption at rest and key rotation progress.
+type EncryptionStatus struct {
+	// Provider is the encryption provider in use.
+	// +optional
+	Provider EncryptionProviderType `json:"provider,omitempty"`
+
+	// ActiveKeyID identifies the key used for writes: a hash of the aescbc
+	// key, or the key ID reported by the KMS plugin.
+	// +optional
+	ActiveKeyID string `json:"activeKeyID,omitempty"`
+
+	// KeyCreatedAt is when the active key started being used for writes.
+	// +optional
+	KeyCreatedAt *metav1.Time `json:"keyCreatedAt,omitempty"`
+
+	// RotationPhase is the stage of the current key rotation.
+	// +optional
+	RotationPhase EncryptionRotationPhase `json:"rotationPhase,omitempty"`
+
+	// LastRotationTime is when the last key rotation completed.
+	// +optional
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
+
+	// EncryptedResources are the resources confirmed to be encrypted with
+	// the active key.
+	// +optional
+	EncryptedResources []string `json:"encryptedResources,omitempty"`
+
+	// Message provides human-readable status information.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// APIServerAccessControl is an allow-list of client networks for the API server.
+type APIServerAccessControl struct {
+	// AllowedCIDRs are the client networks allowed to reach the API server
+	// (e.g., office or VPN egress ranges). The management cluster's own
+	// addresses are always allowed so Butler can keep reconciling.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	// +kubebuilder:validation:items:XValidation:rule="isCIDR(self)",message="allowedCIDRs must be in CIDR notation"
+	AllowedCIDRs []string `json:"allowedCIDRs"`
+
+	// DenyByDefault rejects clients outside AllowedCIDRs. When false, the
+	// rules are computed and reported in status but not enforced, which
+	// allows auditing an allow-list before turning it on.
+	// +kubebuilder:default=true
+	// +optional
+	DenyByDefault *bool `json:"denyByDefault,omitempty"`
+}
+
+// APIServerAccessMechanism identifies how access control is enforced.
+// +kubebuilder:validation:Enum=LoadBalancerSourceRanges;IngressAllowList;GatewayPolicy;None
+type APIServerAccessMechanism string
+
+const (
+	// APIServerAccessMechanismLoadBalancerSourceRanges sets
+	// loadBalancerSourceRanges on the API server Service.
+	APIServerAccessMechanismLoadBalancerSourceRanges APIServerAccessMechanism = "LoadBalancerSourceRanges"
+
+	// APIServerAccessMechanismIngressAllowList sets a source allow-list on
+	// the API server Ingress.
+	APIServerAccessMechanismIngressAllowList APIServerAccessMechanism = "IngressAllowList"
+
+	// APIServerAccessMechanismGatewayPolicy attaches a source IP policy to
+	// the API server TLSRoute.
+	APIServerAccessMechanismGatewayPolicy APIServerAccessMechanism = "GatewayPolicy"
+
+	// APIServerAccessMechanismNone indicates the rules are not enforced,
+	// either because denyByDefault is false or the exposure mode has no
+	// way to filter by source address.
+	APIServerAccessMechanismNone APIServerAccessMechanism = "None"
+)
+
+// APIServerAccessStatus reports the access control rules in effect.
+type APIServerAccessStatus struct {
+	// Enforced indicates clients outside EffectiveCIDRs are rejected.
+	// +optional
+	Enforced bool `json:"enforced,omitempty"`
+
+	// Mechanism is how the rules are enforced.
+	// +optional
+	Mechanism APIServerAccessMechanism `json:"mechanism,omitempty"`
+
+	// EffectiveCIDRs are the allowed client networks, including the
+	// management cluster's addresses, normalized and sorted.
+	// +optional
+	EffectiveCIDRs []string `json:"effectiveCIDRs,omitempty"`
+
+	// Message provides details, such as why the rules are not enforced.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // WorkersSpec configures worker nodes.
+// +kubebuilder:validation:XValidation:rule="!has(self.machineTemplate) || !has(self.machineTemplate.networks) || self.machineTemplate.networks.all(n, !has(n.addresses) || size(n.addresses) >= self.replicas)",message="static network addresses must cover every worker replica"
 type WorkersSpec struct {
 	// Replicas is the desired number of worker nodes.
+	// At least 1 for dedicated clusters and 0 for virtual clusters.
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Minimum=0
 	Replicas int32 `json:"replicas"`
 
 	// MachineTemplate defines the VM specification for workers.
 	// +optional
 	MachineTemplate MachineTemplateSpec `json:"machineTemplate,omitempty"`
+
+	// UpdateStrategy controls how workers are replaced when the machine
+	// template changes.
+	// +optional
+	UpdateStrategy *MachineUpdateStrategy `json:"updateStrategy,omitempty"`
+
+	// Drain controls how workers are drained before removal during
+	// scale-down and replacement.
+	// +optional
+	Drain *DrainPolicy `json:"drain,omitempty"`
+
+	// Distribution assigns workers to the provider's failure domains.
+	// Defaults to spreading across all domains.
+	// +optional
+	Distribution *FailureDomainDistribution `json:"distribution,omitempty"`
 }
 
-// MachineTemplateSpec defines VM specifications.
-type MachineTemplateSpec struct {
-	// CPU is the number of CPU cores.
-	// +kubebuilder:default=4
-	// +kubebuilder:validation:Minimum=1
+// VirtualClusterSpec configures a vcluster tenant cluster.
+type VirtualClusterSpec struct {
+	// HostClusterRef references the TenantCluster hosting the vcluster.
+	// The host must be a dedicated cluster.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="hostClusterRef is immutable"
+	HostClusterRef NamespacedObjectReference `json:"hostClusterRef"`
+
+	// Sync selects which resources are synced between the virtual and
+	// host clusters, beyond the pods, services, endpoints, ConfigMaps,
+	// Secrets, and PersistentVolumeClaims vcluster always syncs.
+	// +optional
+	Sync *VirtualClusterSync `json:"sync,omitempty"`
+
+	// ResourceQuota limits what the vcluster may consume on the host.
+	// Enforced with a ResourceQuota in the host namespace.
+	// +optional
+	ResourceQuota *VirtualClusterQuota `json:"resourceQuota,omitempty"`
+}
+
+// VirtualSyncToHostResource is a virtual cluster resource that can be
+// synced to the host cluster.
+// +kubebuilder:validation:Enum=Ingresses;NetworkPolicies;ServiceAccounts;PodDisruptionBudgets;PriorityClasses;VolumeSnapshots
+type VirtualSyncToHostResource string
+
+const (
+	// VirtualSyncToHostIngresses syncs Ingresses to the host ingress controller.
+	VirtualSyncToHostIngresses VirtualSyncToHostResource = "Ingresses"
+
+	// VirtualSyncToHostNetworkPolicies syncs NetworkPolicies.
+	VirtualSyncToHostNetworkPolicies VirtualSyncToHostResource = "NetworkPolicies"
+
+	// VirtualSyncToHostServiceAccounts syncs ServiceAccounts, for workload
+	// identity on the host.
+	VirtualSyncToHostServiceAccounts VirtualSyncToHostResource = "ServiceAccounts"
+
+	// VirtualSyncToHostPodDisruptionBudgets syncs PodDisruptionBudgets.
+	VirtualSyncToHostPodDisruptionBudgets VirtualSyncToHostResource = "PodDisruptionBudgets"
+
+	// VirtualSyncToHostPriorityClasses syncs PriorityClasses.
+	VirtualSyncToHostPriorityClasses VirtualSyncToHostResource = "PriorityClasses"
+
+	// VirtualSyncToHostVolumeSnapshots syncs VolumeSnapshots.
+	VirtualSyncToHostVolumeSnapshots VirtualSyncToHostResource = "VolumeSnapshots"
+)
+
+// VirtualSyncFromHostResource is a host cluster resource that can be made
+// visible in the virtual cluster.
+// +kubebuilder:validation:Enum=Nodes;StorageClasses;IngressClasses;PriorityClasses;CSIDrivers
+type VirtualSyncFromHostResource string
+
+const (
+	// VirtualSyncFromHostNodes shows the host nodes running vcluster pods
+	// instead of a single pseudo node.
+	VirtualSyncFromHostNodes VirtualSyncFromHostResource = "Nodes"
+
+	// VirtualSyncFromHostStorageClasses shows host StorageClasses.
+	VirtualSyncFromHostStorageClasses VirtualSyncFromHostResource = "StorageClasses"
+
+	// VirtualSyncFromHostIngressClasses shows host IngressClasses.
+	VirtualSyncFromHostIngressClasses VirtualSyncFromHostResource = "IngressClasses"
+
+	// VirtualSyncFromHostPriorityClasses shows host PriorityClasses.
+	VirtualSyncFromHostPriorityClasses VirtualSyncFromHostResource = "PriorityClasses"
+
+	// VirtualSyncFromHostCSIDrivers shows host CSIDrivers.
+	VirtualSyncFromHostCSIDrivers VirtualSyncFromHostResource = "CSIDrivers"
+)
+
+// VirtualClusterSync configures vcluster resource syncing.
+type VirtualClusterSync struct {
+	// ToHost lists virtual cluster resources synced to the host.
+	// +optional
+	// +listType=set
+	ToHost []VirtualSyncToHostResource `json:"toHost,omitempty"`
+
+	// FromHost lists host resources visible in the virtual cluster.
+	// Defaults to StorageClasses and IngressClasses.
+	// +optional
+	// +listType=set
+	FromHost []VirtualSyncFromHostResource `json:"fromHost,omitempty"`
+}
+
+// VirtualClusterQuota limits a vcluster's consumption on the host cluster.
+// Nil fields are not limited.
+type VirtualClusterQuota struct {
+	// CPU limits total CPU requests.
+	// +optional
+	CPU *resource.Quantity `json:"cpu,omitempty"`
+
+	// Memory limits total memory requests.
+	// +optional
+	Memory *resource.Quantity `json:"memory,omitempty"`
+
+	// Storage limits total PersistentVolumeClaim requests.
+	// +optional
+	Storage *resource.Quantity `json:"storage,omitempty"`
+
+	// Pods limits the number of pods.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Pods *int32 `json:"pods,omitempty"`
+
+	// LoadBalancers limits the number of LoadBalancer Services.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	LoadBalancers *int32 `json:"loadBalancers,omitempty"`
+}
+
+// WorkerPoolFailureDomains reports a worker pool's machines per failure domain.
+type WorkerPoolFailureDomains struct {
+	// Pool is the worker pool name, "default" for spec.workers.
+	Pool string `json:"pool"`
+
+	// Domains reports machines per failure domain.
+	// +optional
+	Domains []FailureDomainReplicas `json:"domains,omitempty"`
+}
+
+// DefaultWorkerPoolName is the pool name of spec.workers.
+const DefaultWorkerPoolName = "default"
+
+// WorkerPoolSpec configures an additional pool of worker nodes.
+// +kubebuilder:validation:XValidation:rule="!has(self.machineTemplate.networks) || self.machineTemplate.networks.all(n, !has(n.addresses) || size(n.addresses) >= self.replicas)",message="static network addresses must cover every worker replica"
+type WorkerPoolSpec struct {
+	// Name identifies the pool. Nodes are labeled with LabelWorkerPool.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=32
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// Replicas is the desired number of nodes in the pool.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=0
+	Replicas int32 `json:"replicas"`
+
+	// MachineTemplate defines the VM specification for the pool.
+	// +kubebuilder:validation:Required
+	MachineTemplate MachineTemplateSpec `json:"machineTemplate"`
+
+	// Labels are applied to nodes in the pool.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Taints are applied to nodes in the pool when they register.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Taints []NodeTaint `json:"taints,omitempty"`
+
+	// InfrastructureOverride overrides provider settings for the pool.
+	// Takes precedence over spec.infrastructureOverride.
+	// +optional
+	InfrastructureOverride *InfrastructureOverride `json:"infrastructureOverride,omitempty"`
+
+	// UpdateStrategy controls how nodes in the pool are replaced.
+	// Defaults to spec.workers.updateStrategy.
+	// +optional
+	UpdateStrategy *MachineUpdateStrategy `json:"updateStrategy,omitempty"`
+
+	// Drain controls how nodes in the pool are drained.
+	// Defaults to spec.workers.drain.
+	// +optional
+	Drain *DrainPolicy `json:"drain,omitempty"`
+
+	// Distribution assigns the pool's nodes to the provider's failure
+	// domains. Defaults to spreading across all domains.
+	// +optional
+	Distribution *FailureDomainDistribution `json:"distribution,omitempty"`
+}
+
+// RebootPolicy configures rolling worker reboots. Nodes are rebooted one
+// batch at a time, each with a Reboot NodeOperation that cordons, drains,
+// reboots, and uncordons the node.
+type RebootPolicy struct {
+	// MaintenanceWindow limits when reboots may start. A reboot in progress
+	// when the window closes is finished. If not set, reboots start as soon
+	// as a node requires one.
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
+	// MaxUnavailable is the number of workers, or percentage of workers,
+	// rebooted at once.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:XIntOrString
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// Drain controls how nodes are drained before rebooting.
+	// Defaults to spec.workers.drain.
+	// +optional
+	Drain *DrainPolicy `json:"drain,omitempty"`
+
+	// DetectRebootRequired reboots nodes that report a pending reboot, such
+	// as /var/run/reboot-required on Rocky or a staged update on Flatcar.
+	// Set to false to only reboot nodes queued through the console or API.
+	// +kubebuilder:default=true
+	// +optional
+	DetectRebootRequired *bool `json:"detectRebootRequired,omitempty"`
+
+	// WorkerPools limits reboots to these worker pools, including
+	// "default" for spec.workers. If empty, every pool is rebooted.
+	// +optional
+	// +listType=set
+	WorkerPools []string `json:"workerPools,omitempty"`
+
+	// Paused stops starting new reboots. Reboots in progress finish.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// HibernationSpec schedules worker scale-down and scale-up. At scaleDownAt
+// every worker pool, including NodePools, is scaled to
+// minReplicasDuringHibernate, and at scaleUpAt each is restored to the
+// size it had before. The control plane keeps running.
+// +kubebuilder:validation:XValidation:rule="self.scaleDownAt != self.scaleUpAt",message="scaleDownAt and scaleUpAt must differ"
+type HibernationSpec struct {
+	// ScaleDownAt is when workers are scaled down, in cron format
+	// (e.g., "0 20 * * 1-5").
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^(@(yearly|annually|monthly|weekly|daily|midnight|hourly)|(\S+\s+){4}\S+)$`
+	ScaleDownAt string `json:"scaleDownAt"`
+
+	// ScaleUpAt is when workers are restored, in cron format
+	// (e.g., "0 7 * * 1-5").
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^(@(yearly|annually|monthly|weekly|daily|midnight|hourly)|(\S+\s+){4}\S+)$`
+	ScaleUpAt string `json:"scaleUpAt"`
+
+	// TimeZone is the IANA time zone of the schedules (e.g., "Europe/Berlin").
+	// +kubebuilder:default="UTC"
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// MinReplicasDuringHibernate is the number of workers each pool keeps
+	// while hibernated. Pools already smaller keep their size.
+	// +kubebuilder:default=0
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinReplicasDuringHibernate int32 `json:"minReplicasDuringHibernate,omitempty"`
+
+	// Suspend skips scheduled transitions and leaves workers at their
+	// current size. Clear it on a hibernated cluster to wake it at the
+	// next scaleUpAt.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+}
+
+// TaintEffect is the effect of a node taint.
+// +kubebuilder:validation:Enum=NoSchedule;PreferNoSchedule;NoExecute
+type TaintEffect string
+
+const (
+	// TaintEffectNoSchedule prevents new pods without a toleration from scheduling.
+	TaintEffectNoSchedule TaintEffect = "NoSchedule"
+
+	// TaintEffectPreferNoSchedule avoids scheduling pods without a toleration.
+	TaintEffectPreferNoSchedule TaintEffect = "PreferNoSchedule"
+
+	// TaintEffectNoExecute also evicts running pods without a toleration.
+	TaintEffectNoExecute TaintEffect = "NoExecute"
+)
+
+// NodeTaint is a taint applied to nodes.
+type NodeTaint struct {
+	// Key is the taint key.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=316
+	Key string `json:"key"`
+
+	// Value is the taint value.
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	Value string `json:"value,omitempty"`
+
+	// Effect is the taint effect.
+	// +kubebuilder:validation:Required
+	Effect TaintEffect `json:"effect"`
+}
+
+// String returns the taint in kubelet --register-with-taints format.
+func (t NodeTaint) String() string {
+	if t.Value == "" {
+		return t.Key + ":" + string(t.Effect)
+	}
+	return t.Key + "=" + t.Value + ":" + string(t.Effect)
+}
+
+// MachineUpdateStrategyType is the strategy for replacing machines.
+// +kubebuilder:validation:Enum=RollingUpdate;OnDelete
+type MachineUpdateStrategyType string
+
+const (
+	// MachineUpdateStrategyRollingUpdate replaces machines progressively,
+	// bounded by maxSurge and maxUnavailable.
+	MachineUpdateStrategyRollingUpdate MachineUpdateStrategyType = "RollingUpdate"
+
+	// MachineUpdateStrategyOnDelete replaces a machine only after it is deleted.
+	// Use this to control the pace of replacement manually.
+	MachineUpdateStrategyOnDelete MachineUpdateStrategyType = "OnDelete"
+)
+
+// MachineUpdateStrategy controls machine replacement.
+// +kubebuilder:validation:XValidation:rule="!has(self.rollingUpdate) || !has(self.type) || self.type == 'RollingUpdate'",message="rollingUpdate may only be set when type is RollingUpdate"
+type MachineUpdateStrategy struct {
+	// Type of update strategy.
+	// +kubebuilder:default=RollingUpdate
+	// +optional
+	Type MachineUpdateStrategyType `json:"type,omitempty"`
+
+	// RollingUpdate configures the RollingUpdate strategy.
+	// +optional
+	RollingUpdate *MachineRollingUpdate `json:"rollingUpdate,omitempty"`
+}
+
+// MachineRollingUpdate bounds a rolling replacement.
+// Percentages are of the desired replica count; maxSurge rounds up and
+// maxUnavailable rounds down. Both must not resolve to zero.
+type MachineRollingUpdate struct {
+	// MaxSurge is the number of machines that can be created above the
+	// desired count during the update.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:XIntOrString
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+
+	// MaxUnavailable is the number of machines that can be unavailable
+	// during the update.
+	// +kubebuilder:default=0
+	// +kubebuilder:validation:XIntOrString
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// GetType returns the strategy type, defaulting to RollingUpdate.
+func (s *MachineUpdateStrategy) GetType() MachineUpdateStrategyType {
+	if s == nil || s.Type == "" {
+		return MachineUpdateStrategyRollingUpdate
+	}
+	return s.Type
+}
+
+// ResolveRollingUpdate returns maxSurge and maxUnavailable as machine counts
+// for the given replica count. Defaults to a surge of 1 and no unavailability.
+func (s *MachineUpdateStrategy) ResolveRollingUpdate(replicas int32) (maxSurge, maxUnavailable int32, err error) {
+	surge := intstr.FromInt32(1)
+	unavailable := intstr.FromInt32(0)
+	if s != nil && s.RollingUpdate != nil {
+		if s.RollingUpdate.MaxSurge != nil {
+			surge = *s.RollingUpdate.MaxSurge
+		}
+		if s.RollingUpdate.MaxUnavailable != nil {
+			unavailable = *s.RollingUpdate.MaxUnavailable
+		}
+	}
+	su, err := intstr.GetScaledValueFromIntOrPercent(&surge, int(replicas), true)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid maxSurge: %w", err)
+	}
+	un, err := intstr.GetScaledValueFromIntOrPercent(&unavailable, int(replicas), false)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid maxUnavailable: %w", err)
+	}
+	if su < 0 || un < 0 {
+		return 0, 0, fmt.Errorf("maxSurge and maxUnavailable must not be negative")
+	}
+	if su == 0 && un == 0 {
+		return 0, 0, fmt.Errorf("maxSurge and maxUnavailable must not both be zero")
+	}
+	return int32(su), int32(un), nil
+}
+
+// MachineTemplateSpec defines VM specifications.
+type MachineTemplateSpec struct {
+	// CPU is the number of CPU cores.
+	// +kubebuilder:default=4
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	CPU int32 `json:"cpu,omitempty"`
+
+	// Memory is the amount of RAM.
+	// +kubebuilder:default="16Gi"
+	// +optional
+	Memory resource.Quantity `json:"memory,omitempty"`
+
+	// DiskSize is the root disk size.
+	// +kubebuilder:default="100Gi"
+	// +optional
+	DiskSize resource.Quantity `json:"diskSize,omitempty"`
+
+	// OS configures the operating system.
+	// +optional
+	OS OSSpec `json:"os,omitempty"`
+
+	// Networks attaches secondary NICs to each worker, for example a
+	// storage or DMZ VLAN. Nodes are labeled with LabelNetworkPrefix plus
+	// the network name so workloads can be scheduled onto them.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=8
+	Networks []WorkerNetwork `json:"networks,omitempty"`
+
+	// Taints are applied to nodes when they register. In a worker pool,
+	// the pool's taints take precedence for the same key and effect.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Taints []NodeTaint `json:"taints,omitempty"`
+
+	// KubeletExtraArgs are extra kubelet flags, without the leading "--"
+	// (e.g., "max-pods": "250"). Flags managed by Butler, such as
+	// node-labels and register-with-taints, may not be set.
+	// +optional
+	// +kubebuilder:validation:MaxProperties=32
+	KubeletExtraArgs map[string]string `json:"kubeletExtraArgs,omitempty"`
+
+	// NodeAnnotations are applied to nodes after they register. Changing
+	// them updates existing nodes without replacing them.
+	// +optional
+	NodeAnnotations map[string]string `json:"nodeAnnotations,omitempty"`
+
+	// Firmware configures boot firmware, secure boot, and the virtual TPM.
+	// Changing it replaces the machines.
+	// +optional
+	Firmware *FirmwareSpec `json:"firmware,omitempty"`
+
+	// Devices requests GPUs, PCI passthrough devices, and huge pages for
+	// each machine. Changing it replaces the machines.
+	// +optional
+	Devices *MachineDevices `json:"devices,omitempty"`
+}
+
+// ReservedKubeletArgs are kubelet flags set by Butler that
+// kubeletExtraArgs may not override.
+var ReservedKubeletArgs = []string{"node-labels", "register-with-taints", "node-ip", "bootstrap-kubeconfig", "kubeconfig"}
+
+// ValidateNodeSettings checks kubelet extra args and node annotations.
+func (m *MachineTemplateSpec) ValidateNodeSettings() error {
+	return validateNodeSettings(m.KubeletExtraArgs, m.NodeAnnotations)
+}
+
+// validateNodeSettings returns an error if args sets a reserved flag or a
+// flag with a leading "-", or if an annotation key is invalid.
+func validateNodeSettings(args, annotations map[string]string) error {
+	var errs []error
+	for _, k := range slices.Sorted(maps.Keys(args)) {
+		if strings.HasPrefix(k, "-") {
+			errs = append(errs, fmt.Errorf("kubelet flag %q must not start with '-'", k))
+		} else if slices.Contains(ReservedKubeletArgs, k) {
+			errs = append(errs, fmt.Errorf("kubelet flag %q is managed by Butler", k))
+		}
+	}
+	errs = append(errs, (&ClusterMetadata{Annotations: annotations}).Validate())
+	return errors.Join(errs...)
+}
+
+// RequiresReplacement returns true if changing the template from m to n
+// requires replacing machines. Node annotations are updated in place.
+func (m *MachineTemplateSpec) RequiresReplacement(n *MachineTemplateSpec) bool {
+	a, b := m.DeepCopy(), n.DeepCopy()
+	a.NodeAnnotations, b.NodeAnnotations = nil, nil
+	return !equality.Semantic.DeepEqual(a, b)
+}
+
+// WorkerNetworkAddressMode determines how a secondary NIC is addressed.
+// +kubebuilder:validation:Enum=DHCP;Static;IPAM
+type WorkerNetworkAddressMode string
+
+const (
+	// WorkerNetworkAddressDHCP leaves addressing to DHCP on the network.
+	WorkerNetworkAddressDHCP WorkerNetworkAddressMode = "DHCP"
+
+	// WorkerNetworkAddressStatic assigns addresses from a fixed list, one
+	// per worker.
+	WorkerNetworkAddressStatic WorkerNetworkAddressMode = "Static"
+
+	// WorkerNetworkAddressIPAM allocates addresses from a NetworkPool.
+	WorkerNetworkAddressIPAM WorkerNetworkAddressMode = "IPAM"
+)
+
+// WorkerNetwork is a secondary NIC attached to every worker.
+// +kubebuilder:validation:XValidation:rule="!has(self.addressMode) || self.addressMode != 'Static' || (has(self.addresses) && size(self.addresses) > 0)",message="addresses are required for Static address mode"
+// +kubebuilder:validation:XValidation:rule="!has(self.addressMode) || self.addressMode != 'IPAM' || has(self.poolRef)",message="poolRef is required for IPAM address mode"
+// +kubebuilder:validation:XValidation:rule="(has(self.addressMode) && self.addressMode == 'Static') || !has(self.addresses)",message="addresses may only be set for Static address mode"
+type WorkerNetwork struct {
+	// Name identifies the network (e.g., "storage", "dmz"). Used as the
+	// interface name, the node label suffix, and the name of the Multus
+	// NetworkAttachmentDefinition.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=15
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// ProviderNetwork is the provider network to attach. See
+	// MachineNetworkInterface.ProviderNetwork for the format.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ProviderNetwork string `json:"providerNetwork"`
+
+	// AddressMode determines how the interface is addressed.
+	// +kubebuilder:default="DHCP"
 	// +optional
-	CPU int32 `json:"cpu,omitempty"`
+	AddressMode WorkerNetworkAddressMode `json:"addressMode,omitempty"`
 
-	// Memory is the amount of RAM.
-	// +kubebuilder:default="16Gi"
+	// Addresses lists static addresses in CIDR notation, assigned to
+	// workers in order. Must have at least as many entries as workers.
 	// +optional
-	Memory resource.Quantity `json:"memory,omitempty"`
+	// +kubebuilder:validation:MaxItems=256
+	// +kubebuilder:validation:XValidation:rule="self.all(a, isCIDR(a))",message="addresses must be in CIDR notation"
+	Addresses []string `json:"addresses,omitempty"`
 
-	// DiskSize is the root disk size.
-	// +kubebuilder:default="100Gi"
+	// PoolRef references the NetworkPool to allocate addresses from.
 	// +optional
-	DiskSize resource.Quantity `json:"diskSize,omitempty"`
+	PoolRef *LocalObjectReference `json:"poolRef,omitempty"`
 
-	// OS configures the operating system.
+	// MTU of the interface. If not set, the provider network's MTU is used.
 	// +optional
-	OS OSSpec `json:"os,omitempty"`
+	// +kubebuilder:validation:Minimum=576
+	// +kubebuilder:validation:Maximum=9216
+	MTU *int32 `json:"mtu,omitempty"`
+}
+
+// GetAddressMode returns the address mode, defaulting to DHCP.
+func (n *WorkerNetwork) GetAddressMode() WorkerNetworkAddressMode {
+	if n.AddressMode == "" {
+		return WorkerNetworkAddressDHCP
+	}
+	return n.AddressMode
+}
+
+// ToMachineNetworkInterface returns the interface for the worker at index.
+// For IPAM networks, address is the address allocated from the pool; it is
+// ignored for other modes.
+func (n *WorkerNetwork) ToMachineNetworkInterface(index int, address string) (MachineNetworkInterface, error) {
+	iface := MachineNetworkInterface{Name: n.Name, ProviderNetwork: n.ProviderNetwork, MTU: n.MTU}
+	switch n.GetAddressMode() {
+	case WorkerNetworkAddressStatic:
+		if index < 0 || index >= len(n.Addresses) {
+			return iface, fmt.Errorf("network %s: no static address for worker %d (%d addresses)", n.Name, index, len(n.Addresses))
+		}
+		iface.Address = n.Addresses[index]
+	case WorkerNetworkAddressIPAM:
+		if address == "" {
+			return iface, fmt.Errorf("network %s: no address allocated for worker %d", n.Name, index)
+		}
+		iface.Address = address
+	}
+	return iface, nil
 }
 
 // OSSpec configures the operating system.
@@ -308,6 +1430,14 @@ type InfrastructureOverride struct {
 	// +optional
 	Proxmox *ProxmoxOverride `json:"proxmox,omitempty"`
 
+	// VSphere contains vSphere-specific overrides.
+	// +optional
+	VSphere *VSphereOverride `json:"vsphere,omitempty"`
+
+	// OpenStack contains OpenStack-specific overrides.
+	// +optional
+	OpenStack *OpenStackOverride `json:"openstack,omitempty"`
+
 	// GCP contains GCP-specific overrides.
 	// +optional
 	GCP *GCPOverride `json:"gcp,omitempty"`
@@ -362,6 +1492,52 @@ type ProxmoxOverride struct {
 	TemplateID int `json:"templateID,omitempty"`
 }
 
+// VSphereOverride contains vSphere-specific settings.
+type VSphereOverride struct {
+	// Datastore is the datastore for VM disks.
+	// +optional
+	Datastore string `json:"datastore,omitempty"`
+
+	// ResourcePool is the resource pool for VMs.
+	// +optional
+	ResourcePool string `json:"resourcePool,omitempty"`
+
+	// Folder is the VM folder for VMs.
+	// +optional
+	Folder string `json:"folder,omitempty"`
+
+	// Network is the port group for the primary NIC.
+	// +optional
+	Network string `json:"network,omitempty"`
+
+	// Template is the VM template to clone.
+	// +optional
+	Template string `json:"template,omitempty"`
+}
+
+// OpenStackOverride contains OpenStack-specific settings.
+type OpenStackOverride struct {
+	// AvailabilityZone is the Nova availability zone for VMs.
+	// +optional
+	AvailabilityZone string `json:"availabilityZone,omitempty"`
+
+	// Flavor is the Nova flavor for worker nodes.
+	// +optional
+	Flavor string `json:"flavor,omitempty"`
+
+	// NetworkID is the Neutron network ID for the primary NIC.
+	// +optional
+	NetworkID string `json:"networkID,omitempty"`
+
+	// SecurityGroupIDs replace the provider's security groups.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIDs,omitempty"`
+
+	// Image is the Glance image name or ID.
+	// +optional
+	Image string `json:"image,omitempty"`
+}
+
 // GCPOverride contains GCP-specific settings that can be overridden per-cluster.
 type GCPOverride struct {
 	// Zone overrides the default GCP compute zone.
@@ -386,17 +1562,52 @@ type GCPOverride struct {
 }
 
 // NetworkingSpec configures cluster networking.
+// For dual-stack clusters set PodCIDRs and ServiceCIDRs to one IPv4 and one
+// IPv6 CIDR each; the first entry selects the primary IP family.
+// +kubebuilder:validation:XValidation:rule="!has(self.dnsServiceIP) || !has(self.serviceCIDR) || !isCIDR(self.serviceCIDR) || !isIP(self.dnsServiceIP) || cidr(self.serviceCIDR).containsIP(self.dnsServiceIP) || (has(self.serviceCIDRs) && self.serviceCIDRs.exists(c, isCIDR(c) && cidr(c).containsIP(self.dnsServiceIP)))",message="dnsServiceIP must be within serviceCIDR"
+// +kubebuilder:validation:XValidation:rule="!has(self.podCIDRs) || !has(self.podCIDR) || self.podCIDR in self.podCIDRs",message="podCIDRs must include podCIDR"
+// +kubebuilder:validation:XValidation:rule="!has(self.serviceCIDRs) || !has(self.serviceCIDR) || self.serviceCIDR in self.serviceCIDRs",message="serviceCIDRs must include serviceCIDR"
 type NetworkingSpec struct {
 	// PodCIDR is the CIDR for pod IPs.
 	// +kubebuilder:default="10.244.0.0/16"
+	// +kubebuilder:validation:MaxLength=43
+	// +kubebuilder:validation:XValidation:rule="isCIDR(self)",message="must be a valid CIDR"
 	// +optional
 	PodCIDR string `json:"podCIDR,omitempty"`
 
 	// ServiceCIDR is the CIDR for service IPs.
 	// +kubebuilder:default="10.96.0.0/12"
+	// +kubebuilder:validation:MaxLength=43
+	// +kubebuilder:validation:XValidation:rule="isCIDR(self)",message="must be a valid CIDR"
 	// +optional
 	ServiceCIDR string `json:"serviceCIDR,omitempty"`
 
+	// PodCIDRs lists the pod CIDRs of a dual-stack cluster, primary family
+	// first. Must include PodCIDR.
+	// +optional
+	// +kubebuilder:validation:MaxItems=2
+	// +kubebuilder:validation:items:MaxLength=43
+	// +kubebuilder:validation:XValidation:rule="self.all(c, isCIDR(c))",message="must be valid CIDRs"
+	// +kubebuilder:validation:XValidation:rule="size(self) < 2 || !isCIDR(self[0]) || !isCIDR(self[1]) || cidr(self[0]).ip().family() != cidr(self[1]).ip().family()",message="dual-stack CIDRs must be one IPv4 and one IPv6 CIDR"
+	PodCIDRs []string `json:"podCIDRs,omitempty"`
+
+	// ServiceCIDRs lists the service CIDRs of a dual-stack cluster, primary
+	// family first. Must include ServiceCIDR.
+	// +optional
+	// +kubebuilder:validation:MaxItems=2
+	// +kubebuilder:validation:items:MaxLength=43
+	// +kubebuilder:validation:XValidation:rule="self.all(c, isCIDR(c))",message="must be valid CIDRs"
+	// +kubebuilder:validation:XValidation:rule="size(self) < 2 || !isCIDR(self[0]) || !isCIDR(self[1]) || cidr(self[0]).ip().family() != cidr(self[1]).ip().family()",message="dual-stack CIDRs must be one IPv4 and one IPv6 CIDR"
+	ServiceCIDRs []string `json:"serviceCIDRs,omitempty"`
+
+	// DNSServiceIP is the cluster IP of the cluster DNS service.
+	// Must fall within a service CIDR. Defaults to the tenth address of the
+	// primary service CIDR.
+	// +kubebuilder:validation:MaxLength=39
+	// +kubebuilder:validation:XValidation:rule="isIP(self)",message="must be a valid IP address"
+	// +optional
+	DNSServiceIP string `json:"dnsServiceIP,omitempty"`
+
 	// LoadBalancerPool defines the IP pool for LoadBalancer services.
 	// When IPAM is active, this is populated automatically from IPAllocation.
 	// +optional
@@ -407,19 +1618,312 @@ type NetworkingSpec struct {
 	// +optional
 	// +kubebuilder:validation:Minimum=1
 	LBPoolSize *int32 `json:"lbPoolSize,omitempty"`
+
+	// StaticLoadBalancerIPs pins LoadBalancer IPs to well-known services so
+	// DNS records can be created ahead of time. Addresses must fall within
+	// LoadBalancerPool; with IPAM the controller pins the cluster's
+	// load balancer IPAllocation so the addresses survive rebuilds.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:rule="self.all(a, !has(a.address) || self.exists_one(b, has(b.address) && b.address == a.address))",message="addresses must be unique"
+	StaticLoadBalancerIPs []StaticLoadBalancerIP `json:"staticLoadBalancerIPs,omitempty"`
+
+	// ClusterMesh connects this cluster to other tenant clusters with
+	// Cilium ClusterMesh, so Services can span clusters.
+	// +optional
+	ClusterMesh *ClusterMeshSpec `json:"clusterMesh,omitempty"`
+}
+
+// StaticLoadBalancerIPClusterMesh is the StaticLoadBalancerIP name that
+// pins the clustermesh-apiserver Service.
+const StaticLoadBalancerIPClusterMesh = "clustermesh"
+
+// ClusterMeshSpec configures Cilium ClusterMesh for a tenant cluster.
+// Peers must use the same MeshID and CASecretRef, have unique ClusterIDs,
+// and have non-overlapping pod CIDRs.
+// +kubebuilder:validation:XValidation:rule="!self.enabled || (has(self.meshID) && has(self.clusterID))",message="meshID and clusterID are required when clusterMesh is enabled"
+type ClusterMeshSpec struct {
+	// Enabled installs the clustermesh-apiserver and connects to Peers.
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled"`
+
+	// MeshID names the mesh. Only clusters with the same MeshID are connected.
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	MeshID string `json:"meshID,omitempty"`
+
+	// ClusterID is the Cilium cluster ID, unique within the mesh.
+	// Immutable once set, since Cilium encodes it in identities.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=255
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="clusterID is immutable"
+	ClusterID int32 `json:"clusterID,omitempty"`
+
+	// Peers are the TenantClusters to connect to. Connections are only
+	// established when both clusters list each other.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +listMapKey=namespace
+	// +kubebuilder:validation:MaxItems=32
+	Peers []NamespacedObjectReference `json:"peers,omitempty"`
+
+	// CASecretRef references the shared Cilium CA Secret in the management
+	// cluster. All clusters in a mesh must use the same CA. If not set, the
+	// controller creates one per MeshID in butler-system.
+	// +optional
+	CASecretRef *SecretReference `json:"caSecretRef,omitempty"`
+}
+
+// ClusterMeshStatus reports ClusterMesh connectivity.
+type ClusterMeshStatus struct {
+	// Endpoint is the clustermesh-apiserver address peers connect to.
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Peers reports connectivity to each peer.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +listMapKey=namespace
+	Peers []ClusterMeshPeerStatus `json:"peers,omitempty"`
+}
+
+// ClusterMeshPeerStatus reports connectivity to a ClusterMesh peer.
+type ClusterMeshPeerStatus struct {
+	// Name of the peer TenantCluster.
+	Name string `json:"name"`
+
+	// Namespace of the peer TenantCluster.
+	Namespace string `json:"namespace"`
+
+	// Connected indicates Cilium reports the peer as ready.
+	// +optional
+	Connected bool `json:"connected,omitempty"`
+
+	// LastConnectedTime is when the peer was last seen connected.
+	// +optional
+	LastConnectedTime *metav1.Time `json:"lastConnectedTime,omitempty"`
+
+	// Message describes why the peer is not connected (e.g., "peer does
+	// not list this cluster", "pod CIDR overlaps").
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// IsEnabled returns true if ClusterMesh is enabled.
+func (c *ClusterMeshSpec) IsEnabled() bool {
+	return c != nil && c.Enabled
+}
+
+// CheckClusterMeshPeer returns the reasons two clusters cannot be meshed,
+// or nil if they can. Mutual listing in Peers is not checked.
+func CheckClusterMeshPeer(a, b *TenantClusterSpec) []string {
+	ma, mb := a.Networking.ClusterMesh, b.Networking.ClusterMesh
+	if !ma.IsEnabled() || !mb.IsEnabled() {
+		return []string{"clusterMesh is not enabled on both clusters"}
+	}
+	var reasons []string
+	if ma.MeshID != mb.MeshID {
+		reasons = append(reasons, fmt.Sprintf("meshID %q does not match %q", ma.MeshID, mb.MeshID))
+	}
+	if ma.ClusterID == mb.ClusterID {
+		reasons = append(reasons, fmt.Sprintf("clusterID %d is not unique", ma.ClusterID))
+	}
+	if cidrsOverlap(a.Networking.PodCIDR, b.Networking.PodCIDR) {
+		reasons = append(reasons, fmt.Sprintf("podCIDR %s overlaps %s", a.Networking.PodCIDR, b.Networking.PodCIDR))
+	}
+	return reasons
+}
+
+// cidrsOverlap returns true if two CIDRs share any address. Unparseable
+// CIDRs are reported as not overlapping.
+func cidrsOverlap(a, b string) bool {
+	_, na, errA := net.ParseCIDR(a)
+	_, nb, errB := net.ParseCIDR(b)
+	if errA != nil || errB != nil {
+		return false
+	}
+	return na.Contains(nb.IP) || nb.Contains(na.IP)
+}
+
+// StaticLoadBalancerIPIngress is the StaticLoadBalancerIP name that pins
+// the ingress controller Service installed by the ingress addon.
+const StaticLoadBalancerIPIngress = "ingress"
+
+// StaticLoadBalancerIP pins a LoadBalancer IP to a Service in the tenant cluster.
+// +kubebuilder:validation:XValidation:rule="self.name == 'ingress' || has(self.serviceRef)",message="serviceRef is required unless name is ingress"
+type StaticLoadBalancerIP struct {
+	// Name identifies the assignment (e.g., "ingress", "api-gateway").
+	// The name "ingress" targets the ingress addon's controller Service.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+
+	// ServiceRef is the LoadBalancer Service in the tenant cluster.
+	// Not needed for the ingress assignment.
+	// +optional
+	ServiceRef *NamespacedObjectReference `json:"serviceRef,omitempty"`
+
+	// Address is the IP to assign. If empty, the controller picks a free
+	// address from LoadBalancerPool on first reconcile and records it in
+	// status; set it from status to keep the address across rebuilds.
+	// +optional
+	// +kubebuilder:validation:MaxLength=39
+	// +kubebuilder:validation:XValidation:rule="isIP(self)",message="must be a valid IP address"
+	Address string `json:"address,omitempty"`
+}
+
+// StaticLoadBalancerIPStatus reports a pinned LoadBalancer IP.
+type StaticLoadBalancerIPStatus struct {
+	// Name of the StaticLoadBalancerIP.
+	Name string `json:"name"`
+
+	// Address assigned to the Service.
+	// +optional
+	Address string `json:"address,omitempty"`
+
+	// Bound indicates the Service exists and has been assigned Address.
+	// +optional
+	Bound bool `json:"bound,omitempty"`
+
+	// Message describes why the address is not bound.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // IPPool defines a range of IP addresses.
+// +kubebuilder:validation:XValidation:rule="!isIP(self.start) || !isIP(self.end) || ip(self.start).family() == ip(self.end).family()",message="start and end must be the same IP family"
 type IPPool struct {
 	// Start is the first IP in the pool.
 	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=39
+	// +kubebuilder:validation:XValidation:rule="isIP(self)",message="must be a valid IP address"
 	Start string `json:"start"`
 
 	// End is the last IP in the pool.
 	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=39
+	// +kubebuilder:validation:XValidation:rule="isIP(self)",message="must be a valid IP address"
 	End string `json:"end"`
 }
 
+// GetStaticLoadBalancerIP returns the named static LoadBalancer IP, or nil.
+func (n *NetworkingSpec) GetStaticLoadBalancerIP(name string) *StaticLoadBalancerIP {
+	for i := range n.StaticLoadBalancerIPs {
+		if n.StaticLoadBalancerIPs[i].Name == name {
+			return &n.StaticLoadBalancerIPs[i]
+		}
+	}
+	return nil
+}
+
+// ValidateStaticLoadBalancerIPs checks that every pinned address falls
+// within LoadBalancerPool. Addresses cannot be checked until the pool is
+// known, so nil is returned when LoadBalancerPool is unset.
+func (n *NetworkingSpec) ValidateStaticLoadBalancerIPs() error {
+	if n.LoadBalancerPool == nil {
+		return nil
+	}
+	pool := &LoadBalancerPoolSpec{Start: n.LoadBalancerPool.Start, End: n.LoadBalancerPool.End}
+	for _, s := range n.StaticLoadBalancerIPs {
+		if s.Address != "" && !pool.ContainsIP(s.Address) {
+			return fmt.Errorf("static load balancer IP %s address %s is outside loadBalancerPool %s", s.Name, s.Address, pool.ToAddressRange())
+		}
+	}
+	return nil
+}
+
+// GetDNSServiceIP returns the cluster DNS service IP.
+// Returns DNSServiceIP when set, otherwise the tenth address of the primary
+// service CIDR (10.96.0.10 for the default 10.96.0.0/12). Returns "" if the
+// service CIDR is invalid.
+func (n *NetworkingSpec) GetDNSServiceIP() string {
+	if n.DNSServiceIP != "" {
+		return n.DNSServiceIP
+	}
+	serviceCIDR := "10.96.0.0/12"
+	if cidrs := n.GetServiceCIDRs(); len(cidrs) > 0 {
+		serviceCIDR = cidrs[0]
+	}
+	prefix, err := netip.ParsePrefix(serviceCIDR)
+	if err != nil {
+		return ""
+	}
+	ip := nthAddr(prefix, 10)
+	if !ip.IsValid() {
+		return ""
+	}
+	return ip.String()
+}
+
+// GetPodCIDRs returns the pod CIDRs, primary family first.
+func (n *NetworkingSpec) GetPodCIDRs() []string {
+	return dualStackCIDRs(n.PodCIDR, n.PodCIDRs)
+}
+
+// GetServiceCIDRs returns the service CIDRs, primary family first.
+func (n *NetworkingSpec) GetServiceCIDRs() []string {
+	return dualStackCIDRs(n.ServiceCIDR, n.ServiceCIDRs)
+}
+
+// IPFamilies returns the cluster IP families, primary first.
+func (n *NetworkingSpec) IPFamilies() []IPFamily {
+	return cidrFamilies(n.GetServiceCIDRs())
+}
+
+// IsDualStack returns true if the cluster has both IPv4 and IPv6 networks.
+func (n *NetworkingSpec) IsDualStack() bool {
+	return len(n.IPFamilies()) == 2
+}
+
+// dualStackCIDRs returns cidrs if set, otherwise the single primary CIDR.
+func dualStackCIDRs(primary string, cidrs []string) []string {
+	if len(cidrs) > 0 {
+		return cidrs
+	}
+	if primary == "" {
+		return nil
+	}
+	return []string{primary}
+}
+
+// cidrFamilies returns the distinct families of cidrs in order.
+func cidrFamilies(cidrs []string) []IPFamily {
+	var families []IPFamily
+	for _, c := range cidrs {
+		if f := IPFamilyOf(c); f != "" && !slices.Contains(families, f) {
+			families = append(families, f)
+		}
+	}
+	return families
+}
+
+// ValidateDualStack checks that a CIDR list holds at most one CIDR per IP
+// family and includes the primary CIDR when both are set.
+func ValidateDualStack(primary string, cidrs []string) error {
+	if len(cidrs) == 0 {
+		return nil
+	}
+	for _, c := range cidrs {
+		if IPFamilyOf(c) == "" || !strings.Contains(c, "/") {
+			return fmt.Errorf("invalid CIDR %q", c)
+		}
+	}
+	if len(cidrs) > 2 || len(cidrFamilies(cidrs)) != len(cidrs) {
+		return fmt.Errorf("dual-stack CIDRs must be one IPv4 and one IPv6 CIDR, got %s", strings.Join(cidrs, ", "))
+	}
+	if primary != "" && !slices.Contains(cidrs, primary) {
+		return fmt.Errorf("%s is not in %s", primary, strings.Join(cidrs, ", "))
+	}
+	return nil
+}
+
 // ManagementPolicySpec defines how Butler manages the cluster.
 type ManagementPolicySpec struct {
 	// Mode determines how Butler manages addons.
@@ -453,6 +1957,51 @@ type AddonsSpec struct {
 	// GitOps configures GitOps (Flux or ArgoCD).
 	// +optional
 	GitOps *GitOpsSpec `json:"gitops,omitempty"`
+
+	// Backup configures cluster backups.
+	// +optional
+	Backup *BackupAddonSpec `json:"backup,omitempty"`
+
+	// Multus configures the Multus meta-CNI, which attaches pods to the
+	// secondary networks in workers.machineTemplate.networks.
+	// +optional
+	Multus *MultusSpec `json:"multus,omitempty"`
+}
+
+// MultusSpec configures the Multus addon.
+type MultusSpec struct {
+	// Enabled controls whether Multus is installed.
+	// +kubebuilder:default=true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Version is the addon version. Defaults to the controller's built-in version when omitted.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// CreateNetworkAttachments creates a NetworkAttachmentDefinition in the
+	// default namespace for each worker network, using a macvlan on the
+	// matching interface. Disable to manage attachments yourself.
+	// +kubebuilder:default=true
+	// +optional
+	CreateNetworkAttachments *bool `json:"createNetworkAttachments,omitempty"`
+
+	// Values are Helm values for customization.
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Values *ExtensionValues `json:"values,omitempty"`
+}
+
+// IsEnabled returns whether Multus should be installed.
+// Returns false when the spec is nil.
+func (s *MultusSpec) IsEnabled() bool {
+	return s != nil && (s.Enabled == nil || *s.Enabled)
+}
+
+// ShouldCreateNetworkAttachments returns whether NetworkAttachmentDefinitions
+// are generated for worker networks.
+func (s *MultusSpec) ShouldCreateNetworkAttachments() bool {
+	return s.IsEnabled() && (s.CreateNetworkAttachments == nil || *s.CreateNetworkAttachments)
 }
 
 // CNISpec configures the CNI addon.
@@ -519,6 +2068,16 @@ type StorageSpec struct {
 	// +kubebuilder:validation:Required
 	Version string `json:"version"`
 
+	// Policies are the StorageClasses to create, each with its own replica
+	// count, data locality, and snapshot schedule. If empty, a single
+	// default "longhorn" policy is created.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:rule="self.filter(p, has(p.default) && p.default).size() <= 1",message="at most one storage policy may be the default"
+	Policies []StoragePolicy `json:"policies,omitempty"`
+
 	// Values are Helm values for customization.
 	// +optional
 	// +kubebuilder:pruning:PreserveUnknownFields
@@ -548,16 +2107,175 @@ type IngressSpec struct {
 	Values *ExtensionValues `json:"values,omitempty"`
 }
 
-// IsIngressEnabled returns whether the ingress controller should be installed.
-// Returns true when the spec is nil or when Enabled is nil (default behavior).
-func (s *IngressSpec) IsIngressEnabled() bool {
+// Default sets fields that are otherwise resolved by getters, so the
+// persisted spec records the values the controller acts on: the cluster
+// DNS service IP and storage policies. bootstrapProvider is immutable, so
+// it is only defaulted before the cluster is created. Default is
+// idempotent and is called by the defaulting webhook.
+func (tc *TenantCluster) Default() {
+	if tc.CreationTimestamp.IsZero() && tc.Spec.BootstrapProvider == "" {
+		tc.Spec.BootstrapProvider = tc.Spec.GetBootstrapProvider()
+	}
+	if n := &tc.Spec.Networking; n.DNSServiceIP == "" {
+		n.DNSServiceIP = n.GetDNSServiceIP()
+	}
+	if s := tc.Spec.Addons.Storage; s != nil {
+		// Replica counts are recorded uncapped; GetStoragePolicies caps
+		// them at the current worker count.
+		s.Policies = resolveStoragePolicies(s.Policies, DefaultStorageReplicaCount, 0)
+	}
+}
+
+// GetStoragePolicies returns the storage policies with replica counts
+// resolved. Replica counts default to DefaultStorageReplicaCount and are
+// capped at the number of workers. Returns nil if no storage addon is set.
+func (tc *TenantCluster) GetStoragePolicies() []StoragePolicy {
+	s := tc.Spec.Addons.Storage
+	if s == nil {
+		return nil
+	}
+	return resolveStoragePolicies(s.Policies, DefaultStorageReplicaCount, max(tc.Spec.TotalWorkerReplicas(), 1))
+}
+
+// IsIngressEnabled returns whether the ingress controller should be installed.
+// Returns true when the spec is nil or when Enabled is nil (default behavior).
+func (s *IngressSpec) IsIngressEnabled() bool {
+	if s == nil || s.Enabled == nil {
+		return true
+	}
+	return *s.Enabled
+}
+
+// BackupAddonSpec configures a backup addon.
+// Shared by TenantCluster and ClusterBootstrap.
+// +kubebuilder:validation:XValidation:rule="!has(self.enabled) || !self.enabled || has(self.storageLocation)",message="storageLocation is required when backup is enabled"
+type BackupAddonSpec struct {
+	// Enabled controls whether the backup addon is installed.
+	// +kubebuilder:default=false
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Provider is the backup tool.
+	// +kubebuilder:validation:Enum=velero
+	// +kubebuilder:default="velero"
+	// +optional
+	Provider string `json:"provider,omitempty"`
+
+	// Version is the addon version.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// StorageLocation is where backups are stored.
+	// +optional
+	StorageLocation *BackupStorageLocation `json:"storageLocation,omitempty"`
+
+	// Schedule is the default backup schedule.
+	// If not set, no scheduled backups are created.
+	// +optional
+	Schedule *BackupSchedule `json:"schedule,omitempty"`
+
+	// SnapshotVolumes takes volume snapshots in addition to resource backups.
+	// +kubebuilder:default=true
+	// +optional
+	SnapshotVolumes *bool `json:"snapshotVolumes,omitempty"`
+
+	// Values are Helm values for customization.
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Values *ExtensionValues `json:"values,omitempty"`
+}
+
+// BackupStorageLocation is an object storage location for backups.
+type BackupStorageLocation struct {
+	// Provider is the object storage provider. Use "aws" for S3-compatible stores.
+	// +kubebuilder:validation:Enum=aws;gcp;azure
+	// +kubebuilder:default="aws"
+	// +optional
+	Provider string `json:"provider,omitempty"`
+
+	// Bucket is the bucket name.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Bucket string `json:"bucket"`
+
+	// Prefix is the path within the bucket. Defaults to the cluster name.
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// Region is the bucket region.
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// Endpoint is the URL of an S3-compatible store (e.g., MinIO).
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// CredentialsRef references a Secret with object storage credentials.
+	// +kubebuilder:validation:Required
+	CredentialsRef SecretReference `json:"credentialsRef"`
+
+	// InsecureSkipTLSVerify disables TLS verification for Endpoint.
+	// +optional
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+}
+
+// BackupSchedule defines a recurring backup.
+type BackupSchedule struct {
+	// Cron is the schedule in cron format.
+	// +kubebuilder:default="0 2 * * *"
+	// +optional
+	Cron string `json:"cron,omitempty"`
+
+	// TTL is how long backups are kept.
+	// +kubebuilder:default="720h"
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+
+	// IncludedNamespaces limits backups to these namespaces. Empty means all.
+	// +optional
+	IncludedNamespaces []string `json:"includedNamespaces,omitempty"`
+
+	// ExcludedNamespaces are skipped.
+	// +optional
+	ExcludedNamespaces []string `json:"excludedNamespaces,omitempty"`
+}
+
+// IsBackupEnabled returns whether the backup addon should be installed.
+func (s *BackupAddonSpec) IsBackupEnabled() bool {
 	if s == nil || s.Enabled == nil {
-		return true
+		return false
 	}
 	return *s.Enabled
 }
 
+// GitOpsProvider identifies a GitOps tool.
+type GitOpsProvider string
+
+const (
+	// GitOpsProviderFluxCD is Flux.
+	GitOpsProviderFluxCD GitOpsProvider = "fluxcd"
+
+	// GitOpsProviderArgoCD is Argo CD.
+	GitOpsProviderArgoCD GitOpsProvider = "argocd"
+)
+
+// NormalizeGitOpsProvider maps provider names used across the API to a
+// GitOpsProvider. ClusterBootstrap uses "flux" where TenantCluster uses
+// "fluxcd". Returns "" for "none" and unknown values.
+func NormalizeGitOpsProvider(name string) GitOpsProvider {
+	switch name {
+	case "flux", "fluxcd":
+		return GitOpsProviderFluxCD
+	case "argocd":
+		return GitOpsProviderArgoCD
+	default:
+		return ""
+	}
+}
+
 // GitOpsSpec configures GitOps tooling.
+// This is the canonical GitOps shape; ClusterBootstrap's GitOpsAddonSpec
+// converts to it with ToGitOpsSpec.
 type GitOpsSpec struct {
 	// Provider is the GitOps provider.
 	// +kubebuilder:validation:Enum=fluxcd;argocd
@@ -571,6 +2289,32 @@ type GitOpsSpec struct {
 	// Repository configures the Git repository for GitOps.
 	// +optional
 	Repository *GitRepositorySpec `json:"repository,omitempty"`
+
+	// ArgoCD configures Argo CD. Only used when provider is argocd.
+	// +optional
+	ArgoCD *ArgoCDOptions `json:"argocd,omitempty"`
+}
+
+// ArgoCDOptions configures an Argo CD installation.
+type ArgoCDOptions struct {
+	// HA installs Argo CD in high-availability mode
+	// (multiple replicas and Redis HA).
+	// +optional
+	HA bool `json:"ha,omitempty"`
+
+	// IngressHost exposes the Argo CD UI on this hostname.
+	// If empty, no Ingress is created.
+	// +optional
+	IngressHost string `json:"ingressHost,omitempty"`
+
+	// IngressClassName is the ingress class for the Argo CD UI.
+	// +optional
+	IngressClassName string `json:"ingressClassName,omitempty"`
+
+	// AdminSecretRef references a Secret with a bcrypt-hashed "admin.password" key.
+	// If not set, Argo CD generates the initial admin password.
+	// +optional
+	AdminSecretRef *SecretReference `json:"adminSecretRef,omitempty"`
 }
 
 // GitRepositorySpec configures a Git repository for GitOps.
@@ -673,10 +2417,25 @@ type TenantClusterStatus struct {
 	// +optional
 	TenantNamespace string `json:"tenantNamespace,omitempty"`
 
+	// HostNamespace is the namespace in the host cluster running the
+	// vcluster. Only set for virtual clusters.
+	// +optional
+	HostNamespace string `json:"hostNamespace,omitempty"`
+
 	// ControlPlaneEndpoint is the API server endpoint.
 	// +optional
 	ControlPlaneEndpoint string `json:"controlPlaneEndpoint,omitempty"`
 
+	// Encryption reports encryption at rest and key rotation progress.
+	// Only set when spec.controlPlane.encryption is configured.
+	// +optional
+	Encryption *EncryptionStatus `json:"encryption,omitempty"`
+
+	// APIServerAccess reports the API server access control rules in effect.
+	// Only set when spec.controlPlane.accessControl is configured.
+	// +optional
+	APIServerAccess *APIServerAccessStatus `json:"apiServerAccess,omitempty"`
+
 	// KubeconfigSecretRef references the Secret containing the kubeconfig.
 	// +optional
 	KubeconfigSecretRef *LocalObjectReference `json:"kubeconfigSecretRef,omitempty"`
@@ -707,6 +2466,10 @@ type TenantClusterStatus struct {
 	// +optional
 	IPAllocationRef *LocalObjectReference `json:"ipAllocationRef,omitempty"`
 
+	// VIPAllocationRef references the control plane VIP allocation from IPAM.
+	// +optional
+	VIPAllocationRef *LocalObjectReference `json:"vipAllocationRef,omitempty"`
+
 	// LBAllocationRef references the load balancer IP allocation from IPAM.
 	// +optional
 	LBAllocationRef *LocalObjectReference `json:"lbAllocationRef,omitempty"`
@@ -714,6 +2477,262 @@ type TenantClusterStatus struct {
 	// ImageSyncRef references the ImageSync resource for this cluster's OS image.
 	// +optional
 	ImageSyncRef *LocalObjectReference `json:"imageSyncRef,omitempty"`
+
+	// CAPIRefs names the Cluster API objects generated for this cluster.
+	// All objects live in TenantNamespace.
+	// +optional
+	CAPIRefs *CAPIObjectRefs `json:"capiRefs,omitempty"`
+
+	// WorkerRollout reports progress of the current worker replacement.
+	// +optional
+	WorkerRollout *RolloutStatus `json:"workerRollout,omitempty"`
+
+	// Preflight reports the most recent upgrade pre-flight checks.
+	// +optional
+	Preflight *PreflightStatus `json:"preflight,omitempty"`
+
+	// Hibernation reports the hibernation state when spec.hibernation is set.
+	// +optional
+	Hibernation *HibernationStatus `json:"hibernation,omitempty"`
+
+	// Reboot reports rolling reboot progress when spec.rebootPolicy is set.
+	// +optional
+	Reboot *RebootStatus `json:"reboot,omitempty"`
+
+	// WorkerFailureDomains reports workers per failure domain for
+	// spec.workers and spec.workerPools. NodePools report their own.
+	// +optional
+	// +listType=map
+	// +listMapKey=pool
+	WorkerFailureDomains []WorkerPoolFailureDomains `json:"workerFailureDomains,omitempty"`
+
+	// AccessGrants records current and past access grants.
+	// +optional
+	AccessGrants []AccessGrantStatus `json:"accessGrants,omitempty"`
+
+	// PolicyExceptions records current and past policy exceptions.
+	// +optional
+	PolicyExceptions []PolicyExceptionStatus `json:"policyExceptions,omitempty"`
+
+	// ResourceRefs lists every object Butler created for this cluster,
+	// across the management cluster and provider integrations.
+	// Used for troubleshooting and to audit garbage collection on deletion.
+	// +optional
+	ResourceRefs []GeneratedResourceRef `json:"resourceRefs,omitempty"`
+
+	// StaticLoadBalancerIPs reports the addresses pinned by
+	// spec.networking.staticLoadBalancerIPs.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	StaticLoadBalancerIPs []StaticLoadBalancerIPStatus `json:"staticLoadBalancerIPs,omitempty"`
+
+	// ClusterMesh reports ClusterMesh connectivity.
+	// +optional
+	ClusterMesh *ClusterMeshStatus `json:"clusterMesh,omitempty"`
+
+	// Summary holds flat counts for printer columns. See ComputeSummary.
+	// +optional
+	Summary *StatusSummary `json:"summary,omitempty"`
+
+	// SLO reports observed availability against spec.slo.
+	// +optional
+	SLO *SLOStatus `json:"slo,omitempty"`
+
+	// Reconcile reports controller reconcile diagnostics.
+	// +optional
+	Reconcile *ReconcileStatus `json:"reconcile,omitempty"`
+}
+
+// CAPIObjectRefs names the Cluster API objects backing a TenantCluster.
+type CAPIObjectRefs struct {
+	// Cluster is the CAPI Cluster.
+	// +optional
+	Cluster *CAPIObjectReference `json:"cluster,omitempty"`
+
+	// ControlPlane is the control plane object (e.g., KamajiControlPlane).
+	// +optional
+	ControlPlane *CAPIObjectReference `json:"controlPlane,omitempty"`
+
+	// InfrastructureCluster is the provider infrastructure cluster object.
+	// +optional
+	InfrastructureCluster *CAPIObjectReference `json:"infrastructureCluster,omitempty"`
+
+	// MachineDeployment is the worker MachineDeployment.
+	// +optional
+	MachineDeployment *CAPIObjectReference `json:"machineDeployment,omitempty"`
+
+	// BootstrapConfigTemplate is the worker bootstrap config template
+	// (e.g., KubeadmConfigTemplate, TalosConfigTemplate).
+	// +optional
+	BootstrapConfigTemplate *CAPIObjectReference `json:"bootstrapConfigTemplate,omitempty"`
+
+	// InfrastructureMachineTemplate is the worker machine template.
+	// +optional
+	InfrastructureMachineTemplate *CAPIObjectReference `json:"infrastructureMachineTemplate,omitempty"`
+}
+
+// CAPIObjectReference identifies a Cluster API object by kind and name.
+type CAPIObjectReference struct {
+	// APIVersion of the object (e.g., "cluster.x-k8s.io/v1beta1").
+	// +optional
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// Kind of the object.
+	Kind string `json:"kind"`
+
+	// Name of the object.
+	Name string `json:"name"`
+}
+
+// RolloutStatus reports progress of a machine replacement.
+type RolloutStatus struct {
+	// Replicas is the desired number of machines.
+	Replicas int32 `json:"replicas"`
+
+	// UpdatedReplicas is the number of machines running the current template.
+	UpdatedReplicas int32 `json:"updatedReplicas"`
+
+	// ReadyReplicas is the number of ready machines, updated or not.
+	ReadyReplicas int32 `json:"readyReplicas"`
+
+	// StartedAt is when the current rollout started.
+	// +optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+
+	// CompletedAt is when the current rollout completed.
+	// +optional
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+}
+
+// IsComplete returns true when every machine runs the current template and is ready.
+func (r *RolloutStatus) IsComplete() bool {
+	return r.UpdatedReplicas >= r.Replicas && r.ReadyReplicas >= r.Replicas
+}
+
+// HibernationState is the hibernation state of a TenantCluster.
+// +kubebuilder:validation:Enum=Awake;Hibernating;Hibernated;Waking
+type HibernationState string
+
+const (
+	// HibernationStateAwake indicates workers run at their configured size.
+	HibernationStateAwake HibernationState = "Awake"
+
+	// HibernationStateHibernating indicates workers are being scaled down.
+	HibernationStateHibernating HibernationState = "Hibernating"
+
+	// HibernationStateHibernated indicates workers are scaled down.
+	HibernationStateHibernated HibernationState = "Hibernated"
+
+	// HibernationStateWaking indicates workers are being restored.
+	HibernationStateWaking HibernationState = "Waking"
+)
+
+// HibernationStatus tracks scheduled hibernation.
+type HibernationStatus struct {
+	// State is the current hibernation state.
+	// +optional
+	State HibernationState `json:"state,omitempty"`
+
+	// SavedReplicas records each pool's size before hibernation, so it
+	// can be restored on wake.
+	// +optional
+	// +listType=map
+	// +listMapKey=pool
+	SavedReplicas []PoolReplicas `json:"savedReplicas,omitempty"`
+
+	// LastScaleDownTime is when the cluster last started hibernating.
+	// +optional
+	LastScaleDownTime *metav1.Time `json:"lastScaleDownTime,omitempty"`
+
+	// LastScaleUpTime is when the cluster last started waking.
+	// +optional
+	LastScaleUpTime *metav1.Time `json:"lastScaleUpTime,omitempty"`
+
+	// NextTransitionTime is when the next scheduled scale-down or scale-up runs.
+	// +optional
+	NextTransitionTime *metav1.Time `json:"nextTransitionTime,omitempty"`
+
+	// Message provides human-readable status information.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// PoolReplicas is the size of a worker pool.
+type PoolReplicas struct {
+	// Pool is the worker pool or NodePool name, "default" for spec.workers.
+	Pool string `json:"pool"`
+
+	// Replicas is the number of workers.
+	Replicas int32 `json:"replicas"`
+}
+
+// MaxRebootHistory is the number of completed reboots kept in status.
+const MaxRebootHistory = 50
+
+// RebootStatus tracks rolling worker reboots.
+type RebootStatus struct {
+	// PendingNodes lists nodes waiting to be rebooted.
+	// +optional
+	// +listType=set
+	PendingNodes []string `json:"pendingNodes,omitempty"`
+
+	// RebootingNodes lists nodes being rebooted.
+	// +optional
+	// +listType=set
+	RebootingNodes []string `json:"rebootingNodes,omitempty"`
+
+	// CompletedNodes lists the most recent completed reboots, newest first.
+	// +optional
+	// +kubebuilder:validation:MaxItems=50
+	CompletedNodes []NodeRebootRecord `json:"completedNodes,omitempty"`
+
+	// LastRebootTime is when a node last finished rebooting.
+	// +optional
+	LastRebootTime *metav1.Time `json:"lastRebootTime,omitempty"`
+
+	// Message provides human-readable status information, such as why
+	// pending reboots are waiting.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// NodeRebootRecord records a finished node reboot.
+type NodeRebootRecord struct {
+	// Node is the node name.
+	Node string `json:"node"`
+
+	// CompletedAt is when the node was uncordoned after rebooting.
+	CompletedAt metav1.Time `json:"completedAt"`
+
+	// Failed is true if the reboot failed and the node was left cordoned.
+	// +optional
+	Failed bool `json:"failed,omitempty"`
+}
+
+// GeneratedResourceRef identifies an object created by Butler for a cluster.
+type GeneratedResourceRef struct {
+	// APIVersion of the object (e.g., "cluster.x-k8s.io/v1beta1").
+	// +optional
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// Kind of the object.
+	Kind string `json:"kind"`
+
+	// Name of the object.
+	Name string `json:"name"`
+
+	// Namespace of the object. Empty for cluster-scoped objects.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Ready indicates the object reports ready.
+	// Objects without a readiness signal are reported ready once they exist.
+	Ready bool `json:"ready"`
+
+	// Message explains why the object is not ready.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // ObservedClusterState captures the current state of the cluster.
@@ -792,15 +2811,63 @@ const (
 	// and the per-member cap. Set False with reason ReasonQuotaExceeded,
 	// ReasonEnvQuotaExceeded, or ReasonPerMemberCapExceeded on denial.
 	TenantClusterConditionQuotaSatisfied = "QuotaSatisfied"
+
+	// TenantClusterConditionClusterMeshReady indicates all ClusterMesh peers are connected.
+	TenantClusterConditionClusterMeshReady = "ClusterMeshReady"
+
+	// TenantClusterConditionErrorBudgetExhausted is True when the SLO error
+	// budget for the current window is used up.
+	TenantClusterConditionErrorBudgetExhausted = "ErrorBudgetExhausted"
+
+	// TenantClusterConditionKubernetesVersionEndOfLife is True when the
+	// running Kubernetes version is past its end of life in the
+	// KubernetesVersionCatalog.
+	TenantClusterConditionKubernetesVersionEndOfLife = "KubernetesVersionEndOfLife"
+
+	// TenantClusterConditionPolicyExceptionsExpired is True when any entry
+	// in spec.policyExceptions has expired and should be removed.
+	TenantClusterConditionPolicyExceptionsExpired = "PolicyExceptionsExpired"
+
+	// TenantClusterConditionPolicyExceptionsExpiring is True when any
+	// policy exception expires within PolicyExceptionExpiryWarning.
+	TenantClusterConditionPolicyExceptionsExpiring = "PolicyExceptionsExpiring"
+
+	// TenantClusterConditionAPIServerAccessApplied indicates the API server
+	// access control rules are applied by the exposure mechanism.
+	TenantClusterConditionAPIServerAccessApplied = "APIServerAccessApplied"
+
+	// TenantClusterConditionEncryptionReady indicates every configured
+	// resource is encrypted with the active key.
+	TenantClusterConditionEncryptionReady = "EncryptionReady"
+
+	// TenantClusterConditionRebootPending is True when workers are waiting
+	// for or undergoing a reboot under spec.rebootPolicy.
+	TenantClusterConditionRebootPending = "RebootPending"
+
+	// TenantClusterConditionHibernated is True when workers are scaled down
+	// under spec.hibernation.
+	TenantClusterConditionHibernated = "Hibernated"
+
+	// TenantClusterConditionPreflightPassed indicates no check failed in
+	// the most recent upgrade pre-flight run.
+	TenantClusterConditionPreflightPassed = "PreflightPassed"
 )
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:shortName=tc
+// +kubebuilder:resource:shortName=tc,categories=butler
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Team",type="string",JSONPath=".spec.teamRef.name",description="Owning team"
 // +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Cluster phase"
 // +kubebuilder:printcolumn:name="K8s Version",type="string",JSONPath=".spec.kubernetesVersion",description="Kubernetes version"
-// +kubebuilder:printcolumn:name="Workers",type="string",JSONPath=".status.observedState.workers.ready",description="Ready workers"
+// +kubebuilder:printcolumn:name="Workers",type="string",JSONPath=".status.summary.workersReady",description="Ready/desired workers"
+// +kubebuilder:printcolumn:name="Addons",type="string",JSONPath=".status.summary.addonsHealthy",description="Healthy/total addons"
 // +kubebuilder:printcolumn:name="Endpoint",type="string",JSONPath=".status.controlPlaneEndpoint",description="API endpoint"
+// +kubebuilder:printcolumn:name="Tier",type="string",JSONPath=".spec.ownership.environment",description="Environment tier"
+// +kubebuilder:printcolumn:name="Owner",type="string",JSONPath=".spec.ownership.ownerEmail",description="Owner email",priority=1
+// +kubebuilder:printcolumn:name="Slack",type="string",JSONPath=".spec.ownership.slackChannel",description="Incident channel",priority=1
+// +kubebuilder:printcolumn:name="Availability",type="string",JSONPath=".status.slo.observedAvailability",description="Observed availability (%)",priority=1
+// +kubebuilder:printcolumn:name="Hibernation",type="string",JSONPath=".status.hibernation.state",description="Hibernation state",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // TenantCluster is the Schema for the tenantclusters API.
@@ -825,3 +2892,350 @@ type TenantClusterList struct {
 func init() {
 	SchemeBuilder.Register(&TenantCluster{}, &TenantClusterList{})
 }
+
+// Helper methods
+
+// EnvironmentTier returns the cluster's environment tier, or "" if the
+// cluster has no ownership metadata.
+func (tc *TenantCluster) EnvironmentTier() EnvironmentTier {
+	if tc.Spec.Ownership == nil {
+		return ""
+	}
+	return tc.Spec.Ownership.Environment
+}
+
+// ClusterLabels returns the labels propagated to the tenant namespace and the
+// CAPI Cluster: spec.clusterMetadata.labels plus the tenant label, and the
+// team and environment labels when known.
+func (tc *TenantCluster) ClusterLabels() map[string]string {
+	labels := map[string]string{}
+	if m := tc.Spec.ClusterMetadata; m != nil {
+		maps.Copy(labels, m.Labels)
+	}
+	labels[LabelTenant] = tc.Name
+	if tc.Spec.TeamRef != nil {
+		labels[LabelTeam] = tc.Spec.TeamRef.Name
+	}
+	if env := tc.Labels[LabelEnvironment]; env != "" {
+		labels[LabelEnvironment] = env
+	}
+	if o := tc.Spec.Ownership; o != nil {
+		labels[LabelEnvironmentTier] = string(o.Environment)
+		if o.BusinessUnit != "" {
+			labels[LabelBusinessUnit] = o.BusinessUnit
+		}
+	}
+	return labels
+}
+
+// ClusterAnnotations returns the annotations propagated to the tenant
+// namespace and the CAPI Cluster.
+func (tc *TenantCluster) ClusterAnnotations() map[string]string {
+	if tc.Spec.ClusterMetadata == nil || len(tc.Spec.ClusterMetadata.Annotations) == 0 {
+		return nil
+	}
+	return maps.Clone(tc.Spec.ClusterMetadata.Annotations)
+}
+
+// NodeLabels returns the cluster labels that the kubelet may set on worker
+// nodes. See IsKubeletLabel.
+func (tc *TenantCluster) NodeLabels() map[string]string {
+	labels := tc.ClusterLabels()
+	maps.DeleteFunc(labels, func(k, _ string) bool { return !IsKubeletLabel(k) })
+	return labels
+}
+
+// NodeLabelsArg returns NodeLabels formatted for the kubelet --node-labels
+// extra argument, sorted by key.
+func (tc *TenantCluster) NodeLabelsArg() string {
+	labels := tc.NodeLabels()
+	pairs := make([]string, 0, len(labels))
+	for _, k := range slices.Sorted(maps.Keys(labels)) {
+		pairs = append(pairs, k+"="+labels[k])
+	}
+	return strings.Join(pairs, ",")
+}
+
+// GetResourceRef returns the generated object with the given kind, namespace,
+// and name, or nil if it is not recorded.
+func (s *TenantClusterStatus) GetResourceRef(kind, namespace, name string) *GeneratedResourceRef {
+	for i := range s.ResourceRefs {
+		r := &s.ResourceRefs[i]
+		if r.Kind == kind && r.Namespace == namespace && r.Name == name {
+			return r
+		}
+	}
+	return nil
+}
+
+// NotReadyResourceRefs returns the generated objects that are not ready.
+func (s *TenantClusterStatus) NotReadyResourceRefs() []GeneratedResourceRef {
+	var out []GeneratedResourceRef
+	for _, r := range s.ResourceRefs {
+		if !r.Ready {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// ComputeSummary returns the printer column summary for the status.
+// Control plane readiness is not observed directly and is left empty.
+func (s *TenantClusterStatus) ComputeSummary() *StatusSummary {
+	summary := &StatusSummary{
+		WorkersReady: FormatReadyCount(s.WorkerNodesReady, s.WorkerNodesDesired),
+	}
+	if s.ObservedState != nil {
+		var healthy int32
+		for _, a := range s.ObservedState.Addons {
+			if a.Status == "Healthy" {
+				healthy++
+			}
+		}
+		summary.AddonsHealthy = FormatReadyCount(healthy, int32(len(s.ObservedState.Addons)))
+	}
+	return summary
+}
+
+// IsEnforced returns true if clients outside the allow-list are rejected.
+func (a *APIServerAccessControl) IsEnforced() bool {
+	return a != nil && (a.DenyByDefault == nil || *a.DenyByDefault)
+}
+
+// EffectiveCIDRs returns the allowed networks merged with the management
+// cluster's networks, masked to their prefix, deduplicated, and sorted.
+// Invalid entries are skipped; they are rejected at admission.
+func (a *APIServerAccessControl) EffectiveCIDRs(managementCIDRs []string) []string {
+	if a == nil {
+		return nil
+	}
+	seen := map[netip.Prefix]bool{}
+	var prefixes []netip.Prefix
+	for _, c := range slices.Concat(a.AllowedCIDRs, managementCIDRs) {
+		p, err := netip.ParsePrefix(c)
+		if err != nil {
+			continue
+		}
+		p = p.Masked()
+		if !seen[p] {
+			seen[p] = true
+			prefixes = append(prefixes, p)
+		}
+	}
+	slices.SortFunc(prefixes, func(x, y netip.Prefix) int {
+		if c := x.Addr().Compare(y.Addr()); c != 0 {
+			return c
+		}
+		return x.Bits() - y.Bits()
+	})
+	out := make([]string, len(prefixes))
+	for i, p := range prefixes {
+		out[i] = p.String()
+	}
+	return out
+}
+
+// Allows returns true if the client address is within AllowedCIDRs.
+// Returns true when no access control is configured.
+func (a *APIServerAccessControl) Allows(clientIP string) bool {
+	if a == nil {
+		return true
+	}
+	addr, err := parseAddr(clientIP)
+	if err != nil {
+		return false
+	}
+	for _, c := range a.AllowedCIDRs {
+		if p, err := netip.ParsePrefix(c); err == nil && p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// APIServerAccessMechanismFor returns how access control is enforced for
+// a control plane exposed with the given mode and service type.
+// serviceType takes precedence over the platform mode, as it does for
+// the API server Service itself.
+func APIServerAccessMechanismFor(mode ControlPlaneExposureMode, serviceType string) APIServerAccessMechanism {
+	switch serviceType {
+	case "LoadBalancer":
+		return APIServerAccessMechanismLoadBalancerSourceRanges
+	case "NodePort", "ClusterIP":
+		return APIServerAccessMechanismNone
+	}
+	switch mode {
+	case ControlPlaneExposureModeIngress:
+		return APIServerAccessMechanismIngressAllowList
+	case ControlPlaneExposureModeGateway:
+		return APIServerAccessMechanismGatewayPolicy
+	default:
+		return APIServerAccessMechanismLoadBalancerSourceRanges
+	}
+}
+
+// GetResources returns the resources to encrypt, defaulting to secrets.
+func (e *EncryptionConfig) GetResources() []string {
+	if len(e.Resources) == 0 {
+		return DefaultEncryptedResources
+	}
+	return e.Resources
+}
+
+// GetKeySecretKey returns the Secret key holding the aescbc key.
+func (a *AESCBCEncryptionConfig) GetKeySecretKey() string {
+	if a.KeySecretRef.Key == "" {
+		return "key"
+	}
+	return a.KeySecretRef.Key
+}
+
+// IsRotating returns true if a key rotation is in progress.
+func (s *EncryptionStatus) IsRotating() bool {
+	return s != nil && s.RotationPhase != "" && s.RotationPhase != EncryptionRotationIdle
+}
+
+// RotationDue returns true if the active key is older than the configured
+// rotation interval and no rotation is in progress.
+func (e *EncryptionConfig) RotationDue(status *EncryptionStatus, now time.Time) bool {
+	if e == nil || e.RotationInterval == nil || e.RotationInterval.Duration <= 0 {
+		return false
+	}
+	if status == nil || status.KeyCreatedAt == nil || status.IsRotating() {
+		return false
+	}
+	return !now.Before(status.KeyCreatedAt.Add(e.RotationInterval.Duration))
+}
+
+// ShouldDetectRebootRequired returns whether nodes reporting a pending
+// reboot are queued automatically, defaulting to true.
+func (p *RebootPolicy) ShouldDetectRebootRequired() bool {
+	return p.DetectRebootRequired == nil || *p.DetectRebootRequired
+}
+
+// IncludesPool returns true if the policy reboots nodes in the pool.
+func (p *RebootPolicy) IncludesPool(pool string) bool {
+	return len(p.WorkerPools) == 0 || slices.Contains(p.WorkerPools, pool)
+}
+
+// ResolveMaxUnavailable returns the number of workers that may reboot at
+// once out of workers, rounding percentages down but never below one.
+func (p *RebootPolicy) ResolveMaxUnavailable(workers int32) (int32, error) {
+	unavailable := intstr.FromInt32(1)
+	if p.MaxUnavailable != nil {
+		unavailable = *p.MaxUnavailable
+	}
+	n, err := intstr.GetScaledValueFromIntOrPercent(&unavailable, int(workers), false)
+	if err != nil {
+		return 0, fmt.Errorf("invalid maxUnavailable: %w", err)
+	}
+	if n < 0 {
+		return 0, fmt.Errorf("maxUnavailable must not be negative")
+	}
+	return int32(max(n, 1)), nil
+}
+
+// CanStart returns true if new reboots may start at now.
+func (p *RebootPolicy) CanStart(now time.Time) (bool, error) {
+	if p.Paused {
+		return false, nil
+	}
+	if p.MaintenanceWindow == nil {
+		return true, nil
+	}
+	return p.MaintenanceWindow.IsOpen(now)
+}
+
+// NextNodes returns the pending nodes to start rebooting so that at most
+// maxUnavailable nodes reboot at once, in queue order.
+func (s *RebootStatus) NextNodes(maxUnavailable int32) []string {
+	free := int(maxUnavailable) - len(s.RebootingNodes)
+	var next []string
+	for _, n := range s.PendingNodes {
+		if free <= 0 {
+			break
+		}
+		if slices.Contains(s.RebootingNodes, n) {
+			continue
+		}
+		next = append(next, n)
+		free--
+	}
+	return next
+}
+
+// Enqueue adds a node to the pending queue unless it is already pending
+// or rebooting.
+func (s *RebootStatus) Enqueue(node string) {
+	if slices.Contains(s.PendingNodes, node) || slices.Contains(s.RebootingNodes, node) {
+		return
+	}
+	s.PendingNodes = append(s.PendingNodes, node)
+}
+
+// Start moves a node from the pending queue to rebooting.
+func (s *RebootStatus) Start(node string) {
+	s.PendingNodes = slices.DeleteFunc(s.PendingNodes, func(n string) bool { return n == node })
+	if !slices.Contains(s.RebootingNodes, node) {
+		s.RebootingNodes = append(s.RebootingNodes, node)
+	}
+}
+
+// Complete records a finished reboot, keeping the newest MaxRebootHistory
+// records.
+func (s *RebootStatus) Complete(node string, failed bool, now time.Time) {
+	s.RebootingNodes = slices.DeleteFunc(s.RebootingNodes, func(n string) bool { return n == node })
+	t := metav1.NewTime(now)
+	s.CompletedNodes = slices.Insert(s.CompletedNodes, 0, NodeRebootRecord{Node: node, CompletedAt: t, Failed: failed})
+	if len(s.CompletedNodes) > MaxRebootHistory {
+		s.CompletedNodes = s.CompletedNodes[:MaxRebootHistory]
+	}
+	if !failed {
+		s.LastRebootTime = &t
+	}
+}
+
+// IsIdle returns true if no node is pending or rebooting.
+func (s *RebootStatus) IsIdle() bool {
+	return len(s.PendingNodes) == 0 && len(s.RebootingNodes) == 0
+}
+
+// Location returns the time zone of the hibernation schedules.
+func (h *HibernationSpec) Location() (*time.Location, error) {
+	tz := h.TimeZone
+	if tz == "" {
+		tz = "UTC"
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time zone %q: %w", tz, err)
+	}
+	return loc, nil
+}
+
+// HibernatedReplicas returns the size of a pool of replicas while hibernated.
+func (h *HibernationSpec) HibernatedReplicas(replicas int32) int32 {
+	return min(replicas, h.MinReplicasDuringHibernate)
+}
+
+// IsHibernated returns true if workers are scaled down or being scaled down.
+func (s *HibernationStatus) IsHibernated() bool {
+	return s != nil && (s.State == HibernationStateHibernating || s.State == HibernationStateHibernated)
+}
+
+// SaveReplicas records pool sizes before hibernating, replacing any
+// previously saved sizes.
+func (s *HibernationStatus) SaveReplicas(pools []PoolReplicas) {
+	s.SavedReplicas = slices.Clone(pools)
+}
+
+// RestoreReplicas returns the saved size of a pool. If none was saved,
+// fallback is returned, such as the pool's spec replicas.
+func (s *HibernationStatus) RestoreReplicas(pool string, fallback int32) int32 {
+	for _, r := range s.SavedReplicas {
+		if r.Pool == pool {
+			return r.Replicas
+		}
+	}
+	return fallback
+}
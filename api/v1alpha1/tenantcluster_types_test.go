@@ -0,0 +1,674 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestMachineUpdateStrategyResolveRollingUpdate(t *testing.T) {
+	intOrStr := func(v intstr.IntOrString) *intstr.IntOrString { return &v }
+
+	tests := []struct {
+		name            string
+		strategy        *MachineUpdateStrategy
+		replicas        int32
+		wantSurge       int32
+		wantUnavailable int32
+		wantErr         bool
+	}{
+		{
+			name:            "nil strategy defaults to surge one",
+			replicas:        5,
+			wantSurge:       1,
+			wantUnavailable: 0,
+		},
+		{
+			name: "percent surge rounds up and unavailable rounds down",
+			strategy: &MachineUpdateStrategy{RollingUpdate: &MachineRollingUpdate{
+				MaxSurge:       intOrStr(intstr.FromString("25%")),
+				MaxUnavailable: intOrStr(intstr.FromString("25%")),
+			}},
+			replicas:        5,
+			wantSurge:       2,
+			wantUnavailable: 1,
+		},
+		{
+			name: "absolute values",
+			strategy: &MachineUpdateStrategy{RollingUpdate: &MachineRollingUpdate{
+				MaxSurge:       intOrStr(intstr.FromInt32(0)),
+				MaxUnavailable: intOrStr(intstr.FromInt32(2)),
+			}},
+			replicas:        10,
+			wantSurge:       0,
+			wantUnavailable: 2,
+		},
+		{
+			name: "both zero is rejected",
+			strategy: &MachineUpdateStrategy{RollingUpdate: &MachineRollingUpdate{
+				MaxSurge:       intOrStr(intstr.FromInt32(0)),
+				MaxUnavailable: intOrStr(intstr.FromString("10%")),
+			}},
+			replicas: 3,
+			wantErr:  true,
+		},
+		{
+			name: "malformed percent is rejected",
+			strategy: &MachineUpdateStrategy{RollingUpdate: &MachineRollingUpdate{
+				MaxSurge: intOrStr(intstr.FromString("many")),
+			}},
+			replicas: 3,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			surge, unavailable, err := tt.strategy.ResolveRollingUpdate(tt.replicas)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ResolveRollingUpdate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if surge != tt.wantSurge || unavailable != tt.wantUnavailable {
+				t.Errorf("ResolveRollingUpdate() = (%d, %d), want (%d, %d)", surge, unavailable, tt.wantSurge, tt.wantUnavailable)
+			}
+		})
+	}
+}
+
+func TestTenantClusterStatusComputeSummary(t *testing.T) {
+	s := &TenantClusterStatus{
+		WorkerNodesReady:   3,
+		WorkerNodesDesired: 5,
+		ObservedState: &ObservedClusterState{
+			Addons: []AddonStatus{
+				{Name: "cilium", Status: "Healthy"},
+				{Name: "metallb", Status: "Healthy"},
+				{Name: "longhorn", Status: "Degraded"},
+			},
+		},
+	}
+	got := s.ComputeSummary()
+	if got.WorkersReady != "3/5" || got.AddonsHealthy != "2/3" {
+		t.Errorf("ComputeSummary() = %+v, want workersReady 3/5 and addonsHealthy 2/3", got)
+	}
+
+	empty := (&TenantClusterStatus{}).ComputeSummary()
+	if empty.WorkersReady != "0/0" || empty.AddonsHealthy != "" {
+		t.Errorf("ComputeSummary() of empty status = %+v", empty)
+	}
+}
+
+func TestValidateStaticLoadBalancerIPs(t *testing.T) {
+	pool := &IPPool{Start: "10.40.0.100", End: "10.40.0.120"}
+	tests := []struct {
+		name    string
+		spec    NetworkingSpec
+		wantErr bool
+	}{
+		{
+			name: "no pool yet",
+			spec: NetworkingSpec{StaticLoadBalancerIPs: []StaticLoadBalancerIP{{Name: "ingress", Address: "10.50.0.1"}}},
+		},
+		{
+			name: "within pool",
+			spec: NetworkingSpec{
+				LoadBalancerPool: pool,
+				StaticLoadBalancerIPs: []StaticLoadBalancerIP{
+					{Name: "ingress", Address: "10.40.0.100"},
+					{Name: "gateway", Address: "10.40.0.120"},
+					{Name: "unassigned"},
+				},
+			},
+		},
+		{
+			name: "outside pool",
+			spec: NetworkingSpec{
+				LoadBalancerPool:      pool,
+				StaticLoadBalancerIPs: []StaticLoadBalancerIP{{Name: "ingress", Address: "10.40.0.121"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.spec.ValidateStaticLoadBalancerIPs()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateStaticLoadBalancerIPs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckClusterMeshPeer(t *testing.T) {
+	cluster := func(meshID string, clusterID int32, podCIDR string) *TenantClusterSpec {
+		return &TenantClusterSpec{Networking: NetworkingSpec{
+			PodCIDR:     podCIDR,
+			ClusterMesh: &ClusterMeshSpec{Enabled: true, MeshID: meshID, ClusterID: clusterID},
+		}}
+	}
+	tests := []struct {
+		name string
+		a, b *TenantClusterSpec
+		want int
+	}{
+		{"compatible", cluster("prod", 1, "10.244.0.0/16"), cluster("prod", 2, "10.245.0.0/16"), 0},
+		{"overlapping pod CIDRs", cluster("prod", 1, "10.244.0.0/16"), cluster("prod", 2, "10.244.128.0/17"), 1},
+		{"mesh and cluster ID mismatch", cluster("prod", 1, "10.244.0.0/16"), cluster("dev", 1, "10.245.0.0/16"), 2},
+		{"disabled peer", cluster("prod", 1, "10.244.0.0/16"), &TenantClusterSpec{}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CheckClusterMeshPeer(tt.a, tt.b); len(got) != tt.want {
+				t.Errorf("CheckClusterMeshPeer() = %v, want %d reasons", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWorkerNetworkToMachineNetworkInterface(t *testing.T) {
+	tests := []struct {
+		name    string
+		network WorkerNetwork
+		index   int
+		address string
+		want    string
+		wantErr bool
+	}{
+		{name: "dhcp", network: WorkerNetwork{Name: "dmz", ProviderNetwork: "default/dmz"}, want: ""},
+		{name: "static", network: WorkerNetwork{Name: "storage", AddressMode: WorkerNetworkAddressStatic, Addresses: []string{"10.60.0.21/24", "10.60.0.22/24"}}, index: 1, want: "10.60.0.22/24"},
+		{name: "static out of range", network: WorkerNetwork{Name: "storage", AddressMode: WorkerNetworkAddressStatic, Addresses: []string{"10.60.0.21/24"}}, index: 1, wantErr: true},
+		{name: "ipam", network: WorkerNetwork{Name: "storage", AddressMode: WorkerNetworkAddressIPAM}, address: "10.60.0.50/24", want: "10.60.0.50/24"},
+		{name: "ipam without allocation", network: WorkerNetwork{Name: "storage", AddressMode: WorkerNetworkAddressIPAM}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.network.ToMachineNetworkInterface(tt.index, tt.address)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ToMachineNetworkInterface() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && (got.Address != tt.want || got.Name != tt.network.Name) {
+				t.Errorf("ToMachineNetworkInterface() = %+v, want address %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSLOSpecNewSLOStatus(t *testing.T) {
+	now := metav1.Now()
+	tests := []struct {
+		name          string
+		target        string
+		observed      float64
+		wantRemaining string
+		wantBurn      string
+		wantExhausted bool
+		wantErr       bool
+	}{
+		{name: "half budget used", target: "99.9", observed: 0.9995, wantRemaining: "50.0", wantBurn: "0.50"},
+		{name: "perfect", target: "99.5", observed: 1, wantRemaining: "100.0", wantBurn: "0.00"},
+		{name: "exhausted", target: "99.9", observed: 0.998, wantRemaining: "-100.0", wantBurn: "2.00", wantExhausted: true},
+		{name: "invalid target", target: "0", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := &SLOSpec{Target: tt.target}
+			st, err := spec.NewSLOStatus(tt.observed, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewSLOStatus() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if st.ErrorBudgetRemaining != tt.wantRemaining || st.BurnRate != tt.wantBurn {
+				t.Errorf("NewSLOStatus() = remaining %s burn %s, want %s and %s",
+					st.ErrorBudgetRemaining, st.BurnRate, tt.wantRemaining, tt.wantBurn)
+			}
+			if got := st.IsBudgetExhausted(); got != tt.wantExhausted {
+				t.Errorf("IsBudgetExhausted() = %v, want %v", got, tt.wantExhausted)
+			}
+		})
+	}
+}
+
+func TestIsKubeletLabel(t *testing.T) {
+	tests := []struct {
+		key  string
+		want bool
+	}{
+		{"cost-center", true},
+		{"example.com/owner", true},
+		{"node.kubernetes.io/instance-type", true},
+		{"kubelet.kubernetes.io/pool", true},
+		{"foo.node.kubernetes.io/x", true},
+		{"topology.kubernetes.io/zone", false},
+		{"node-role.kubernetes.io/worker", false},
+		{"kubernetes.io/hostname", false},
+		{"example.k8s.io/x", false},
+	}
+
+	for _, tt := range tests {
+		if got := IsKubeletLabel(tt.key); got != tt.want {
+			t.Errorf("IsKubeletLabel(%q) = %v, want %v", tt.key, got, tt.want)
+		}
+	}
+}
+
+func TestTenantClusterClusterLabels(t *testing.T) {
+	tc := &TenantCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "c1",
+			Labels: map[string]string{LabelEnvironment: "prod"},
+		},
+		Spec: TenantClusterSpec{
+			TeamRef: &LocalObjectReference{Name: "team-a"},
+			ClusterMetadata: &ClusterMetadata{
+				Labels: map[string]string{
+					"cost-center":                 "eng",
+					"topology.kubernetes.io/zone": "a",
+				},
+			},
+		},
+	}
+
+	labels := tc.ClusterLabels()
+	for k, want := range map[string]string{
+		LabelTenant:                   "c1",
+		LabelTeam:                     "team-a",
+		LabelEnvironment:              "prod",
+		"cost-center":                 "eng",
+		"topology.kubernetes.io/zone": "a",
+	} {
+		if labels[k] != want {
+			t.Errorf("ClusterLabels()[%q] = %q, want %q", k, labels[k], want)
+		}
+	}
+
+	want := "butler.butlerlabs.dev/environment=prod,butler.butlerlabs.dev/team=team-a,butler.butlerlabs.dev/tenant=c1,cost-center=eng"
+	if got := tc.NodeLabelsArg(); got != want {
+		t.Errorf("NodeLabelsArg() = %q, want %q", got, want)
+	}
+
+	tc.Spec.Ownership = &OwnershipSpec{
+		OwnerEmail:   "alice@example.com",
+		BusinessUnit: "payments",
+		Environment:  EnvironmentTierProd,
+	}
+	labels = tc.ClusterLabels()
+	if got := labels[LabelEnvironmentTier]; got != "prod" {
+		t.Errorf("ClusterLabels()[%q] = %q, want %q", LabelEnvironmentTier, got, "prod")
+	}
+	if got := labels[LabelBusinessUnit]; got != "payments" {
+		t.Errorf("ClusterLabels()[%q] = %q, want %q", LabelBusinessUnit, got, "payments")
+	}
+	if got := tc.ClusterAnnotations(); got != nil {
+		t.Errorf("ClusterAnnotations() = %v, want nil", got)
+	}
+}
+
+func TestWorkerPoolSpecEffectiveTaints(t *testing.T) {
+	pool := &WorkerPoolSpec{
+		MachineTemplate: MachineTemplateSpec{Taints: []NodeTaint{
+			{Key: "dedicated", Value: "template", Effect: TaintEffectNoSchedule},
+			{Key: "dedicated", Effect: TaintEffectNoExecute},
+		}},
+		Taints: []NodeTaint{{Key: "dedicated", Value: "ml", Effect: TaintEffectNoSchedule}},
+	}
+	if got, want := pool.TaintsArg(), "dedicated:NoExecute,dedicated=ml:NoSchedule"; got != want {
+		t.Errorf("TaintsArg() = %q, want %q", got, want)
+	}
+}
+
+func TestTenantClusterSpecAllWorkerPools(t *testing.T) {
+	onDelete := &MachineUpdateStrategy{Type: MachineUpdateStrategyOnDelete}
+	spec := &TenantClusterSpec{
+		Workers: WorkersSpec{
+			Replicas:        3,
+			MachineTemplate: MachineTemplateSpec{CPU: 4, Memory: resource.MustParse("16Gi")},
+			UpdateStrategy:  onDelete,
+		},
+		WorkerPools: []WorkerPoolSpec{
+			{
+				Name:            "gpu",
+				Replicas:        2,
+				MachineTemplate: MachineTemplateSpec{CPU: 16, Memory: resource.MustParse("64Gi")},
+				Taints:          []NodeTaint{{Key: "nvidia.com/gpu", Value: "true", Effect: TaintEffectNoSchedule}},
+			},
+		},
+	}
+
+	pools := spec.AllWorkerPools()
+	if len(pools) != 2 || pools[0].Name != DefaultWorkerPoolName || pools[1].Name != "gpu" {
+		t.Fatalf("AllWorkerPools() = %+v, want default and gpu", pools)
+	}
+	if pools[1].UpdateStrategy.GetType() != MachineUpdateStrategyOnDelete {
+		t.Errorf("gpu pool update strategy = %v, want inherited OnDelete", pools[1].UpdateStrategy)
+	}
+	if got := pools[1].TaintsArg(); got != "nvidia.com/gpu=true:NoSchedule" {
+		t.Errorf("TaintsArg() = %q", got)
+	}
+	if got := pools[1].NodeLabels()[LabelWorkerPool]; got != "gpu" {
+		t.Errorf("NodeLabels()[%s] = %q, want gpu", LabelWorkerPool, got)
+	}
+	if spec.WorkerPools[0].UpdateStrategy != nil {
+		t.Error("AllWorkerPools modified spec.workerPools")
+	}
+	if got := spec.GetWorkerPool("missing"); got != nil {
+		t.Errorf("GetWorkerPool(missing) = %+v, want nil", got)
+	}
+}
+
+func TestNetworkingSpecDualStack(t *testing.T) {
+	tests := []struct {
+		name       string
+		networking NetworkingSpec
+		wantDNS    string
+		wantDual   bool
+	}{
+		{
+			name:       "single stack default",
+			networking: NetworkingSpec{},
+			wantDNS:    "10.96.0.10",
+		},
+		{
+			name: "dual stack IPv4 primary",
+			networking: NetworkingSpec{
+				ServiceCIDR:  "10.96.0.0/12",
+				ServiceCIDRs: []string{"10.96.0.0/12", "fd00:96::/108"},
+			},
+			wantDNS:  "10.96.0.10",
+			wantDual: true,
+		},
+		{
+			name: "dual stack IPv6 primary",
+			networking: NetworkingSpec{
+				ServiceCIDR:  "10.96.0.0/12",
+				ServiceCIDRs: []string{"fd00:96::/108", "10.96.0.0/12"},
+			},
+			wantDNS:  "fd00:96::a",
+			wantDual: true,
+		},
+		{
+			name:       "single stack IPv6",
+			networking: NetworkingSpec{ServiceCIDR: "fd00:96::/108"},
+			wantDNS:    "fd00:96::a",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.networking.GetDNSServiceIP(); got != tt.wantDNS {
+				t.Errorf("GetDNSServiceIP() = %q, want %q", got, tt.wantDNS)
+			}
+			if got := tt.networking.IsDualStack(); got != tt.wantDual {
+				t.Errorf("IsDualStack() = %v, want %v", got, tt.wantDual)
+			}
+		})
+	}
+}
+
+func TestNetworkingSpecGetDNSServiceIP(t *testing.T) {
+	tests := []struct {
+		name       string
+		networking NetworkingSpec
+		want       string
+	}{
+		{name: "explicit", networking: NetworkingSpec{DNSServiceIP: "10.96.0.53"}, want: "10.96.0.53"},
+		{name: "default service CIDR", want: "10.96.0.10"},
+		{name: "custom service CIDR", networking: NetworkingSpec{ServiceCIDR: "172.20.0.0/16"}, want: "172.20.0.10"},
+		{name: "unmasked service CIDR", networking: NetworkingSpec{ServiceCIDR: "10.100.5.7/24"}, want: "10.100.5.10"},
+		{name: "IPv6 only", networking: NetworkingSpec{ServiceCIDRs: []string{"fd00:96::/108"}}, want: "fd00:96::a"},
+		{name: "service CIDR too small", networking: NetworkingSpec{ServiceCIDR: "10.96.0.0/29"}},
+		{name: "invalid service CIDR", networking: NetworkingSpec{ServiceCIDR: "10.96.0.0"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.networking.GetDNSServiceIP(); got != tt.want {
+				t.Errorf("GetDNSServiceIP() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateDualStack(t *testing.T) {
+	tests := []struct {
+		name    string
+		primary string
+		cidrs   []string
+		wantErr bool
+	}{
+		{"unset", "10.244.0.0/16", nil, false},
+		{"dual stack", "10.244.0.0/16", []string{"10.244.0.0/16", "fd00:244::/56"}, false},
+		{"same family twice", "10.244.0.0/16", []string{"10.244.0.0/16", "10.245.0.0/16"}, true},
+		{"missing primary", "10.244.0.0/16", []string{"10.250.0.0/16", "fd00:244::/56"}, true},
+		{"address instead of CIDR", "", []string{"10.244.0.1"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateDualStack(tt.primary, tt.cidrs); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateDualStack() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTenantClusterDefault(t *testing.T) {
+	tc := &TenantCluster{
+		Spec: TenantClusterSpec{
+			Networking: NetworkingSpec{ServiceCIDR: "10.100.0.0/16"},
+			Addons:     AddonsSpec{Storage: &StorageSpec{Version: "1.7.2"}},
+		},
+	}
+	tc.Default()
+
+	if tc.Spec.BootstrapProvider != BootstrapProviderKubeadm {
+		t.Errorf("bootstrapProvider = %q, want kubeadm", tc.Spec.BootstrapProvider)
+	}
+	if tc.Spec.Networking.DNSServiceIP != "10.100.0.10" {
+		t.Errorf("dnsServiceIP = %q, want 10.100.0.10", tc.Spec.Networking.DNSServiceIP)
+	}
+	policies := tc.Spec.Addons.Storage.Policies
+	if len(policies) != 1 || !policies[0].Default || *policies[0].ReplicaCount != DefaultStorageReplicaCount {
+		t.Errorf("storage policies = %+v, want one uncapped default policy", policies)
+	}
+
+	// bootstrapProvider is immutable and is not defaulted on existing clusters.
+	existing := &TenantCluster{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.Now()}}
+	existing.Default()
+	if existing.Spec.BootstrapProvider != "" {
+		t.Errorf("bootstrapProvider defaulted on existing cluster: %q", existing.Spec.BootstrapProvider)
+	}
+}
+
+func TestAPIServerAccessControl(t *testing.T) {
+	var none *APIServerAccessControl
+	if none.IsEnforced() || !none.Allows("203.0.113.7") {
+		t.Error("nil access control should not be enforced and should allow all clients")
+	}
+
+	ac := &APIServerAccessControl{AllowedCIDRs: []string{"198.51.100.0/24", "10.8.3.4/16", "10.8.0.0/16"}}
+	if !ac.IsEnforced() {
+		t.Error("IsEnforced() = false, want true by default")
+	}
+	if !ac.Allows("198.51.100.20") || ac.Allows("203.0.113.7") {
+		t.Error("Allows() did not match the allow-list")
+	}
+
+	got := ac.EffectiveCIDRs([]string{"172.16.0.0/12", "198.51.100.0/24"})
+	want := []string{"10.8.0.0/16", "172.16.0.0/12", "198.51.100.0/24"}
+	if !slices.Equal(got, want) {
+		t.Errorf("EffectiveCIDRs() = %v, want %v", got, want)
+	}
+
+	deny := false
+	ac.DenyByDefault = &deny
+	if ac.IsEnforced() {
+		t.Error("IsEnforced() = true with denyByDefault false")
+	}
+}
+
+func TestAPIServerAccessMechanismFor(t *testing.T) {
+	tests := []struct {
+		mode        ControlPlaneExposureMode
+		serviceType string
+		want        APIServerAccessMechanism
+	}{
+		{ControlPlaneExposureModeLoadBalancer, "", APIServerAccessMechanismLoadBalancerSourceRanges},
+		{ControlPlaneExposureModeIngress, "", APIServerAccessMechanismIngressAllowList},
+		{ControlPlaneExposureModeGateway, "", APIServerAccessMechanismGatewayPolicy},
+		{ControlPlaneExposureModeGateway, "LoadBalancer", APIServerAccessMechanismLoadBalancerSourceRanges},
+		{ControlPlaneExposureModeLoadBalancer, "NodePort", APIServerAccessMechanismNone},
+	}
+	for _, tt := range tests {
+		if got := APIServerAccessMechanismFor(tt.mode, tt.serviceType); got != tt.want {
+			t.Errorf("APIServerAccessMechanismFor(%q, %q) = %q, want %q", tt.mode, tt.serviceType, got, tt.want)
+		}
+	}
+}
+
+func TestEncryptionConfigRotationDue(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	created := metav1.NewTime(now.Add(-31 * 24 * time.Hour))
+	e := &EncryptionConfig{
+		Provider:         EncryptionProviderAESCBC,
+		RotationInterval: &metav1.Duration{Duration: 30 * 24 * time.Hour},
+	}
+	if got := e.GetResources(); len(got) != 1 || got[0] != "secrets" {
+		t.Errorf("GetResources() = %v, want [secrets]", got)
+	}
+
+	status := &EncryptionStatus{KeyCreatedAt: &created, RotationPhase: EncryptionRotationIdle}
+	if !e.RotationDue(status, now) {
+		t.Error("RotationDue() = false for a key older than the interval")
+	}
+	status.RotationPhase = EncryptionRotationRewriting
+	if e.RotationDue(status, now) {
+		t.Error("RotationDue() = true while a rotation is in progress")
+	}
+	fresh := metav1.NewTime(now.Add(-time.Hour))
+	if e.RotationDue(&EncryptionStatus{KeyCreatedAt: &fresh}, now) {
+		t.Error("RotationDue() = true for a fresh key")
+	}
+	e.RotationInterval = nil
+	if e.RotationDue(&EncryptionStatus{KeyCreatedAt: &created}, now) {
+		t.Error("RotationDue() = true without a rotation interval")
+	}
+}
+
+func TestRebootPolicy(t *testing.T) {
+	p := &RebootPolicy{}
+	if !p.ShouldDetectRebootRequired() || !p.IncludesPool("gpu") {
+		t.Error("defaults should detect reboots in every pool")
+	}
+	if n, err := p.ResolveMaxUnavailable(10); err != nil || n != 1 {
+		t.Errorf("ResolveMaxUnavailable() = %d, %v, want 1", n, err)
+	}
+	pct := intstr.FromString("25%")
+	p.MaxUnavailable = &pct
+	if n, _ := p.ResolveMaxUnavailable(10); n != 2 {
+		t.Errorf("ResolveMaxUnavailable(25%% of 10) = %d, want 2", n)
+	}
+	if n, _ := p.ResolveMaxUnavailable(3); n != 1 {
+		t.Errorf("ResolveMaxUnavailable(25%% of 3) = %d, want 1", n)
+	}
+
+	now := time.Date(2026, 5, 4, 3, 0, 0, 0, time.UTC) // Monday
+	p.MaintenanceWindow = &MaintenanceWindow{Start: "02:00", Duration: metav1.Duration{Duration: 2 * time.Hour}}
+	if ok, err := p.CanStart(now); err != nil || !ok {
+		t.Errorf("CanStart() inside window = %v, %v", ok, err)
+	}
+	if ok, _ := p.CanStart(now.Add(2 * time.Hour)); ok {
+		t.Error("CanStart() outside window should be false")
+	}
+	p.Paused = true
+	if ok, _ := p.CanStart(now); ok {
+		t.Error("CanStart() should be false when paused")
+	}
+}
+
+func TestRebootStatus(t *testing.T) {
+	now := time.Date(2026, 5, 4, 3, 0, 0, 0, time.UTC)
+	s := &RebootStatus{}
+	for _, n := range []string{"w1", "w2", "w3", "w1"} {
+		s.Enqueue(n)
+	}
+	if !slices.Equal(s.PendingNodes, []string{"w1", "w2", "w3"}) {
+		t.Fatalf("PendingNodes = %v", s.PendingNodes)
+	}
+	if got := s.NextNodes(2); !slices.Equal(got, []string{"w1", "w2"}) {
+		t.Errorf("NextNodes(2) = %v", got)
+	}
+	s.Start("w1")
+	if got := s.NextNodes(2); !slices.Equal(got, []string{"w2"}) {
+		t.Errorf("NextNodes(2) with one rebooting = %v", got)
+	}
+	s.Enqueue("w1")
+	if len(s.PendingNodes) != 2 {
+		t.Errorf("Enqueue() queued a rebooting node: %v", s.PendingNodes)
+	}
+
+	s.Complete("w1", false, now)
+	if len(s.RebootingNodes) != 0 || s.LastRebootTime == nil || s.CompletedNodes[0].Node != "w1" {
+		t.Errorf("Complete() = %+v", s)
+	}
+	for i := 0; i < MaxRebootHistory; i++ {
+		s.Complete("w9", true, now.Add(time.Minute))
+	}
+	if len(s.CompletedNodes) != MaxRebootHistory || !s.LastRebootTime.Equal(&metav1.Time{Time: now}) {
+		t.Errorf("history = %d, last reboot %v", len(s.CompletedNodes), s.LastRebootTime)
+	}
+	if s.IsIdle() {
+		t.Error("IsIdle() with pending nodes")
+	}
+}
+
+func TestHibernation(t *testing.T) {
+	h := &HibernationSpec{ScaleDownAt: "0 20 * * 1-5", ScaleUpAt: "0 7 * * 1-5", MinReplicasDuringHibernate: 1}
+	if loc, err := h.Location(); err != nil || loc != time.UTC {
+		t.Errorf("Location() = %v, %v", loc, err)
+	}
+	h.TimeZone = "Mars/Olympus"
+	if _, err := h.Location(); err == nil {
+		t.Error("Location() should reject an unknown time zone")
+	}
+	if got := h.HibernatedReplicas(5); got != 1 {
+		t.Errorf("HibernatedReplicas(5) = %d, want 1", got)
+	}
+	if got := h.HibernatedReplicas(0); got != 0 {
+		t.Errorf("HibernatedReplicas(0) = %d, want 0", got)
+	}
+
+	var s *HibernationStatus
+	if s.IsHibernated() {
+		t.Error("nil status should not be hibernated")
+	}
+	s = &HibernationStatus{State: HibernationStateHibernated}
+	s.SaveReplicas([]PoolReplicas{{Pool: DefaultWorkerPoolName, Replicas: 5}})
+	if !s.IsHibernated() || s.RestoreReplicas(DefaultWorkerPoolName, 3) != 5 || s.RestoreReplicas("gpu", 2) != 2 {
+		t.Errorf("status = %+v", s)
+	}
+}
@@ -0,0 +1,1011 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Offline validation.
+//
+// ValidateAll checks a bundle of decoded manifests without a cluster, for
+// use by CI pipelines and a `butler validate` command. It reports:
+//
+//   - objects whose apiVersion or kind is not part of this API
+//   - unknown or mistyped fields
+//   - duplicate objects in the bundle
+//   - cross-field rules that the CRD schemas enforce with CEL, and the
+//     additional checks the Butler admission webhook performs
+//   - references between objects in the bundle, such as WorkspaceTemplate
+//     base chains
+//
+// OpenAPI constraints that do not span fields (enums, patterns, minimums)
+// are enforced only by the API server; run a server-side dry run against
+// the generated CRDs to check those. Transition rules such as immutability
+// cannot be checked offline. Objects from other API groups are skipped.
+
+// ValidationSeverity is the severity of a ValidationFinding.
+type ValidationSeverity string
+
+const (
+	// ValidationSeverityError means the API server or admission webhook
+	// would reject the object.
+	ValidationSeverityError ValidationSeverity = "Error"
+
+	// ValidationSeverityWarning means the object is accepted but uses a
+	// deprecated or discouraged form.
+	ValidationSeverityWarning ValidationSeverity = "Warning"
+)
+
+// ValidationFinding is a single problem found in a manifest.
+// +kubebuilder:object:generate=false
+type ValidationFinding struct {
+	// Kind, Namespace, and Name identify the object. Index is its position
+	// in the bundle passed to ValidateAll.
+	Kind      string
+	Namespace string
+	Name      string
+	Index     int
+
+	// Field is the JSON path of the offending field (e.g., "spec.workers").
+	// Empty for findings about the whole object.
+	Field string
+
+	Severity ValidationSeverity
+	Message  string
+}
+
+// String formats the finding as "Kind namespace/name: field: message".
+func (f ValidationFinding) String() string {
+	id := f.Name
+	if f.Namespace != "" {
+		id = f.Namespace + "/" + f.Name
+	}
+	s := fmt.Sprintf("%s %s", f.Kind, id)
+	if f.Field != "" {
+		s += ": " + f.Field
+	}
+	return fmt.Sprintf("%s: %s", s, f.Message)
+}
+
+// HasValidationErrors returns true if any finding has Error severity.
+func HasValidationErrors(findings []ValidationFinding) bool {
+	for _, f := range findings {
+		if f.Severity == ValidationSeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateAll decodes each object into its Butler type and validates it,
+// including references to other objects in the bundle. Findings are
+// returned in bundle order.
+func ValidateAll(objs []*unstructured.Unstructured) []ValidationFinding {
+	scheme := newValidationScheme()
+
+	var findings []ValidationFinding
+	b := &validationBundle{objects: map[string]runtime.Object{}}
+	seen := map[string]int{}
+	decoded := make([]runtime.Object, len(objs))
+
+	for i, u := range objs {
+		gvk := u.GroupVersionKind()
+		if gvk.Group != GroupVersion.Group {
+			continue
+		}
+		report := func(field, msg string) {
+			findings = append(findings, ValidationFinding{
+				Kind: gvk.Kind, Namespace: u.GetNamespace(), Name: u.GetName(), Index: i,
+				Field: field, Severity: ValidationSeverityError, Message: msg,
+			})
+		}
+		if gvk.Version != GroupVersion.Version {
+			report("apiVersion", fmt.Sprintf("unsupported version %q", gvk.Version))
+			continue
+		}
+		obj, err := scheme.New(gvk)
+		if err != nil {
+			report("kind", fmt.Sprintf("unknown kind %q", gvk.Kind))
+			continue
+		}
+		if u.GetName() == "" && u.GetGenerateName() == "" {
+			report("metadata.name", "name is required")
+		}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructuredWithValidation(u.Object, obj, true); err != nil {
+			report("", err.Error())
+			continue
+		}
+
+		key := bundleKey(gvk.Kind, u.GetNamespace(), u.GetName())
+		if first, ok := seen[key]; ok && u.GetName() != "" {
+			report("metadata.name", fmt.Sprintf("duplicate of object %d in the bundle", first))
+			continue
+		}
+		seen[key] = i
+		b.objects[key] = obj
+		decoded[i] = obj
+	}
+
+	for i, obj := range decoded {
+		if obj == nil {
+			continue
+		}
+		for _, f := range b.validate(obj) {
+			f.Index = i
+			findings = append(findings, f)
+		}
+	}
+	sort.SliceStable(findings, func(i, j int) bool { return findings[i].Index < findings[j].Index })
+	return findings
+}
+
+// ValidateObject validates a single typed object. References to other
+// objects are not resolved.
+func ValidateObject(obj runtime.Object) []ValidationFinding {
+	return (&validationBundle{}).validate(obj)
+}
+
+// ValidateObjectWith validates a single typed object, resolving references
+// against related objects fetched by the caller, such as the ButlerConfig,
+// the KubernetesVersionCatalog, or referenced NetworkPools. Admission
+// webhooks use it to apply the same checks as ValidateAll to live objects.
+// Related objects that are not Butler types are ignored.
+func ValidateObjectWith(obj runtime.Object, related ...runtime.Object) []ValidationFinding {
+	scheme := newValidationScheme()
+	b := &validationBundle{objects: map[string]runtime.Object{}}
+	for _, o := range related {
+		gvks, _, err := scheme.ObjectKinds(o)
+		if err != nil {
+			continue
+		}
+		m, ok := o.(metav1.Object)
+		if !ok {
+			continue
+		}
+		b.objects[bundleKey(gvks[0].Kind, m.GetNamespace(), m.GetName())] = o
+	}
+	return b.validate(obj)
+}
+
+func newValidationScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	if err := AddToScheme(scheme); err != nil {
+		panic(fmt.Sprintf("registering %s types: %v", GroupVersion, err))
+	}
+	return scheme
+}
+
+// validationBundle holds the decoded objects of a bundle, keyed by
+// bundleKey, for resolving references between them.
+type validationBundle struct {
+	objects map[string]runtime.Object
+}
+
+func bundleKey(kind, namespace, name string) string {
+	return kind + "/" + namespace + "/" + name
+}
+
+// findingRecorder accumulates findings for a single object.
+type findingRecorder struct {
+	obj      metav1.Object
+	kind     string
+	findings []ValidationFinding
+}
+
+func (r *findingRecorder) add(severity ValidationSeverity, field, format string, args ...interface{}) {
+	r.findings = append(r.findings, ValidationFinding{
+		Kind: r.kind, Namespace: r.obj.GetNamespace(), Name: r.obj.GetName(),
+		Field: field, Severity: severity, Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (r *findingRecorder) errorf(field, format string, args ...interface{}) {
+	r.add(ValidationSeverityError, field, format, args...)
+}
+
+func (r *findingRecorder) warnf(field, format string, args ...interface{}) {
+	r.add(ValidationSeverityWarning, field, format, args...)
+}
+
+func (b *validationBundle) validate(obj runtime.Object) []ValidationFinding {
+	switch o := obj.(type) {
+	case *ClusterBootstrap:
+		r := &findingRecorder{obj: o, kind: "ClusterBootstrap"}
+		validateClusterBootstrap(r, o)
+		return r.findings
+	case *TenantCluster:
+		r := &findingRecorder{obj: o, kind: "TenantCluster"}
+		validateTenantClusterSpec(r, "spec", &o.Spec)
+		b.validateKubernetesVersion(r, "spec.kubernetesVersion", o.Spec.KubernetesVersion)
+		b.validateVirtualCluster(r, o)
+		b.validateEnvironmentPolicy(r, o)
+		b.validateTenantClusterMachines(r, o)
+		return r.findings
+	case *MachineRequest:
+		r := &findingRecorder{obj: o, kind: "MachineRequest"}
+		b.validateMachineRequest(r, o)
+		return r.findings
+	case *NodePool:
+		r := &findingRecorder{obj: o, kind: "NodePool"}
+		b.validateNodePool(r, o)
+		return r.findings
+	case *ClusterBackupPolicy:
+		r := &findingRecorder{obj: o, kind: "ClusterBackupPolicy"}
+		b.validateClusterBackupPolicy(r, o)
+		return r.findings
+	case *KubernetesUpgradePlan:
+		r := &findingRecorder{obj: o, kind: "KubernetesUpgradePlan"}
+		b.validateKubernetesUpgradePlan(r, o)
+		return r.findings
+	case *AddonDefinition:
+		r := &findingRecorder{obj: o, kind: "AddonDefinition"}
+		validateAddonDefinition(r, o)
+		return r.findings
+	case *TenantAddon:
+		r := &findingRecorder{obj: o, kind: "TenantAddon"}
+		b.validateTenantAddon(r, o)
+		return r.findings
+	case *ClusterRestore:
+		r := &findingRecorder{obj: o, kind: "ClusterRestore"}
+		b.validateClusterRestore(r, o)
+		return r.findings
+	case *NetworkPool:
+		r := &findingRecorder{obj: o, kind: "NetworkPool"}
+		validateNetworkPool(r, o)
+		return r.findings
+	case *IPAllocation:
+		r := &findingRecorder{obj: o, kind: "IPAllocation"}
+		validateIPAllocation(r, o)
+		return r.findings
+	case *ProviderConfig:
+		r := &findingRecorder{obj: o, kind: "ProviderConfig"}
+		validateProviderConfig(r, o)
+		b.validateProviderPools(r, o)
+		return r.findings
+	case *Workspace:
+		r := &findingRecorder{obj: o, kind: "Workspace"}
+		b.validateWorkspace(r, o)
+		return r.findings
+	case *WorkspaceTemplate:
+		r := &findingRecorder{obj: o, kind: "WorkspaceTemplate"}
+		b.validateWorkspaceTemplate(r, o)
+		return r.findings
+	case *ClusterTemplate:
+		r := &findingRecorder{obj: o, kind: "ClusterTemplate"}
+		validateClusterTemplate(r, o)
+		return r.findings
+	case *TemplatePublication:
+		r := &findingRecorder{obj: o, kind: "TemplatePublication"}
+		b.validateTemplatePublication(r, o)
+		return r.findings
+	case *SupportBundle:
+		r := &findingRecorder{obj: o, kind: "SupportBundle"}
+		validateSupportBundle(r, o)
+		return r.findings
+	}
+	return nil
+}
+
+func validateClusterBootstrap(r *findingRecorder, cb *ClusterBootstrap) {
+	if err := cb.Spec.Cluster.ControlPlane.ValidateNodeSettings(); err != nil {
+		r.errorf("spec.cluster.controlPlane", "%v", err)
+	}
+	if w := cb.Spec.Cluster.Workers; w != nil {
+		if err := w.ValidateNodeSettings(); err != nil {
+			r.errorf("spec.cluster.workers", "%v", err)
+		}
+	}
+	provider := ProviderType(cb.Spec.Provider)
+	if err := cb.Spec.Cluster.ControlPlane.Firmware.ValidateForProvider(provider); err != nil {
+		r.errorf("spec.cluster.controlPlane.firmware", "%v", err)
+	}
+	if w := cb.Spec.Cluster.Workers; w != nil {
+		if err := w.Firmware.ValidateForProvider(provider); err != nil {
+			r.errorf("spec.cluster.workers.firmware", "%v", err)
+		}
+	}
+	if err := cb.Spec.Network.Validate(); err != nil {
+		r.errorf("spec.network", "%v", err)
+	}
+	warnings, err := cb.DeepCopy().NormalizeLoadBalancerPool()
+	if err != nil {
+		r.errorf("spec.network.loadBalancerPool", "%v", err)
+	}
+	for _, w := range warnings {
+		r.warnf("spec.network", "%s", w)
+	}
+}
+
+func validateTenantClusterSpec(r *findingRecorder, path string, spec *TenantClusterSpec) {
+	talos := spec.GetBootstrapProvider() == BootstrapProviderTalos
+	if talos && spec.Workers.MachineTemplate.OS.Type != OSTypeTalos {
+		r.errorf(path+".bootstrapProvider", "bootstrapProvider talos requires workers.machineTemplate.os.type talos")
+	}
+	if !talos && spec.Workers.MachineTemplate.OS.Type == OSTypeTalos {
+		r.errorf(path+".workers.machineTemplate.os.type", "talos workers require bootstrapProvider talos")
+	}
+	if err := spec.Workers.MachineTemplate.ValidateNodeSettings(); err != nil {
+		r.errorf(path+".workers.machineTemplate", "%v", err)
+	}
+	if s := spec.Workers.UpdateStrategy; s != nil {
+		if s.RollingUpdate != nil && s.GetType() != MachineUpdateStrategyRollingUpdate {
+			r.errorf(path+".workers.updateStrategy.rollingUpdate", "rollingUpdate may only be set when type is RollingUpdate")
+		}
+		if _, _, err := s.ResolveRollingUpdate(spec.Workers.Replicas); err != nil {
+			r.errorf(path+".workers.updateStrategy", "%v", err)
+		}
+	}
+	if lb := spec.Networking.LoadBalancerPool; lb != nil {
+		pool := &LoadBalancerPoolSpec{Start: lb.Start, End: lb.End}
+		if err := pool.Validate(); err != nil {
+			r.errorf(path+".networking.loadBalancerPool", "%v", err)
+		}
+	}
+	if err := ValidateDualStack(spec.Networking.PodCIDR, spec.Networking.PodCIDRs); err != nil {
+		r.errorf(path+".networking.podCIDRs", "%v", err)
+	}
+	if err := ValidateDualStack(spec.Networking.ServiceCIDR, spec.Networking.ServiceCIDRs); err != nil {
+		r.errorf(path+".networking.serviceCIDRs", "%v", err)
+	}
+	if err := spec.Networking.ValidateStaticLoadBalancerIPs(); err != nil {
+		r.errorf(path+".networking.staticLoadBalancerIPs", "%v", err)
+	}
+	validateDistribution(r, path+".workers.distribution", spec.Workers.Distribution, spec.Workers.Replicas)
+	if h := spec.Hibernation; h != nil {
+		if _, err := h.Location(); err != nil {
+			r.errorf(path+".hibernation.timeZone", "%v", err)
+		}
+		if spec.IsVirtual() {
+			r.warnf(path+".hibernation", "virtual clusters have no workers to hibernate")
+		}
+	}
+	if rp := spec.RebootPolicy; rp != nil {
+		validateRebootPolicy(r, path+".rebootPolicy", spec, rp)
+	}
+	for i := range spec.WorkerPools {
+		validateWorkerPool(r, fmt.Sprintf("%s.workerPools[%d]", path, i), spec, &spec.WorkerPools[i])
+	}
+	validateAccessGrants(r, path+".accessGrants", spec.AccessGrants)
+	validatePolicyExceptions(r, path+".policyExceptions", spec.PolicyExceptions)
+	if s := spec.Addons.Storage; s != nil && !spec.IsVirtual() {
+		workers := spec.TotalWorkerReplicas()
+		for i, p := range s.Policies {
+			if p.ReplicaCount != nil && *p.ReplicaCount > workers {
+				r.warnf(fmt.Sprintf("%s.addons.storage.policies[%d].replicaCount", path, i),
+					"storage policy %q requests %d replicas but the cluster has %d workers; replicas will be capped",
+					p.Name, *p.ReplicaCount, workers)
+			}
+		}
+	}
+	if m := spec.ClusterMetadata; m != nil {
+		if err := m.Validate(); err != nil {
+			r.errorf(path+".clusterMetadata", "%v", err)
+		}
+		for _, k := range slices.Sorted(maps.Keys(m.Labels)) {
+			if !IsKubeletLabel(k) {
+				r.warnf(path+".clusterMetadata.labels", "label %q cannot be set by the kubelet and is not applied to nodes", k)
+			}
+		}
+	}
+}
+
+// validateVirtualCluster checks the host of a virtual TenantCluster when
+// the host is in the bundle.
+func (b *validationBundle) validateVirtualCluster(r *findingRecorder, tc *TenantCluster) {
+	if !tc.Spec.IsVirtual() {
+		return
+	}
+	v := tc.Spec.Virtual
+	if v == nil {
+		r.errorf("spec.virtual", "virtual is required for tenancyMode virtual")
+		return
+	}
+	if v.HostClusterRef.Name == tc.Name && v.HostClusterRef.Namespace == tc.Namespace {
+		r.errorf("spec.virtual.hostClusterRef", "a cluster cannot host itself")
+		return
+	}
+	host, ok := b.objects[bundleKey("TenantCluster", v.HostClusterRef.Namespace, v.HostClusterRef.Name)].(*TenantCluster)
+	if ok && host.Spec.IsVirtual() {
+		r.errorf("spec.virtual.hostClusterRef", "host TenantCluster %s/%s is virtual; vclusters must run on a dedicated cluster",
+			host.Namespace, host.Name)
+	}
+	if tc.Spec.BootstrapProvider != "" {
+		r.warnf("spec.bootstrapProvider", "bootstrapProvider is ignored for virtual clusters")
+	}
+}
+
+// validateClusterBackupPolicy checks that the policy's TenantCluster has
+// the backup addon enabled when the cluster is in the bundle.
+func (b *validationBundle) validateClusterBackupPolicy(r *findingRecorder, p *ClusterBackupPolicy) {
+	for _, ns := range p.Spec.IncludedNamespaces {
+		if slices.Contains(p.Spec.ExcludedNamespaces, ns) {
+			r.warnf("spec.excludedNamespaces", "namespace %q is both included and excluded and will not be backed up", ns)
+		}
+	}
+	tc, ok := b.objects[bundleKey("TenantCluster", p.Namespace, p.Spec.ClusterRef.Name)].(*TenantCluster)
+	if !ok {
+		return
+	}
+	if !tc.Spec.Addons.Backup.IsBackupEnabled() {
+		r.errorf("spec.clusterRef", "TenantCluster %q does not have the backup addon enabled", tc.Name)
+	}
+}
+
+// validateAddonDefinition checks that compatibility entries name a listed
+// chart version and have valid ranges.
+func validateAddonDefinition(r *findingRecorder, a *AddonDefinition) {
+	versions := a.Versions()
+	for i, c := range a.Spec.Chart.Compatibility {
+		path := fmt.Sprintf("spec.chart.compatibility[%d]", i)
+		if !slices.Contains(versions, c.Version) {
+			r.errorf(path+".version", "version %q is not defaultVersion or in availableVersions", c.Version)
+		}
+		if err := c.KubernetesVersions.Validate(); err != nil {
+			r.errorf(path+".kubernetesVersions", "%v", err)
+		}
+		if c.ButlerVersions != nil {
+			if err := c.ButlerVersions.Validate(); err != nil {
+				r.errorf(path+".butlerVersions", "%v", err)
+			}
+		}
+	}
+}
+
+// validateTenantAddon warns when the addon version has not been tested
+// with the cluster's Kubernetes version, when both the AddonDefinition
+// and the TenantCluster are in the bundle.
+func (b *validationBundle) validateTenantAddon(r *findingRecorder, ta *TenantAddon) {
+	if ta.Spec.Addon == "" {
+		return
+	}
+	def, ok := b.objects[bundleKey("AddonDefinition", "", ta.Spec.Addon)].(*AddonDefinition)
+	if !ok {
+		return
+	}
+	tc, ok := b.objects[bundleKey("TenantCluster", ta.Namespace, ta.Spec.ClusterRef.Name)].(*TenantCluster)
+	if !ok {
+		return
+	}
+	if err := def.CheckCompatibility(ta.Spec.Version, tc.Spec.KubernetesVersion, ""); err != nil {
+		r.warnf("spec.version", "%v", err)
+	}
+}
+
+// validateClusterRestore checks the restore's namespace filters and its
+// target cluster. An existing target must have the backup addon enabled
+// when it is in the bundle; a new target's spec is validated like a
+// TenantCluster.
+func (b *validationBundle) validateClusterRestore(r *findingRecorder, cr *ClusterRestore) {
+	for _, ns := range cr.Spec.IncludedNamespaces {
+		if slices.Contains(cr.Spec.ExcludedNamespaces, ns) {
+			r.warnf("spec.excludedNamespaces", "namespace %q is both included and excluded and will not be restored", ns)
+		}
+	}
+	if nc := cr.Spec.Target.NewCluster; nc != nil {
+		if _, ok := b.objects[bundleKey("TenantCluster", cr.Namespace, nc.Name)]; ok {
+			r.errorf("spec.target.newCluster.name", "TenantCluster %q already exists; use target.clusterRef to restore into it", nc.Name)
+		}
+		if nc.Spec != nil {
+			validateTenantClusterSpec(r, "spec.target.newCluster.spec", nc.Spec)
+		}
+		return
+	}
+	if ref := cr.Spec.Target.ClusterRef; ref != nil {
+		tc, ok := b.objects[bundleKey("TenantCluster", cr.Namespace, ref.Name)].(*TenantCluster)
+		if ok && !tc.Spec.Addons.Backup.IsBackupEnabled() {
+			r.errorf("spec.target.clusterRef", "TenantCluster %q does not have the backup addon enabled", tc.Name)
+		}
+	}
+}
+
+// validateWorkerPool checks a worker pool from spec.workerPools or a
+// NodePool. OS checks are skipped when the cluster spec is nil.
+func validateWorkerPool(r *findingRecorder, path string, spec *TenantClusterSpec, pool *WorkerPoolSpec) {
+	if err := pool.MachineTemplate.ValidateNodeSettings(); err != nil {
+		r.errorf(path+".machineTemplate", "%v", err)
+	}
+	if spec != nil {
+		talos := spec.GetBootstrapProvider() == BootstrapProviderTalos
+		if talos && pool.MachineTemplate.OS.Type != OSTypeTalos {
+			r.errorf(path+".machineTemplate.os.type", "bootstrapProvider talos requires machineTemplate.os.type talos")
+		}
+		if !talos && pool.MachineTemplate.OS.Type == OSTypeTalos {
+			r.errorf(path+".machineTemplate.os.type", "talos workers require bootstrapProvider talos")
+		}
+	}
+	if s := pool.UpdateStrategy; s != nil && pool.Replicas > 0 {
+		if _, _, err := s.ResolveRollingUpdate(pool.Replicas); err != nil {
+			r.errorf(path+".updateStrategy", "%v", err)
+		}
+	}
+	validateDistribution(r, path+".distribution", pool.Distribution, pool.Replicas)
+	meta := &ClusterMetadata{Labels: pool.Labels}
+	if err := meta.Validate(); err != nil {
+		r.errorf(path+".labels", "%v", err)
+	}
+	for _, k := range slices.Sorted(maps.Keys(pool.Labels)) {
+		if !IsKubeletLabel(k) {
+			r.errorf(path+".labels", "label %q cannot be set by the kubelet", k)
+		}
+	}
+}
+
+// validateRebootPolicy checks the maintenance window and pool names of a
+// reboot policy.
+func validateRebootPolicy(r *findingRecorder, path string, spec *TenantClusterSpec, rp *RebootPolicy) {
+	if w := rp.MaintenanceWindow; w != nil {
+		if _, err := w.NextStart(time.Now()); err != nil {
+			r.errorf(path+".maintenanceWindow", "%v", err)
+		}
+	}
+	if _, err := rp.ResolveMaxUnavailable(spec.TotalWorkerReplicas()); err != nil {
+		r.errorf(path+".maxUnavailable", "%v", err)
+	}
+	for _, name := range rp.WorkerPools {
+		if name != DefaultWorkerPoolName && spec.GetWorkerPool(name) == nil {
+			r.warnf(path+".workerPools", "worker pool %q is not in spec.workerPools; NodePools are matched by name", name)
+		}
+	}
+	if spec.GetBootstrapProvider() == BootstrapProviderTalos && rp.ShouldDetectRebootRequired() {
+		r.warnf(path+".detectRebootRequired", "talos nodes do not report pending reboots; only queued nodes are rebooted")
+	}
+}
+
+// validateDistribution checks that explicit failure domain counts add up
+// to the pool's replicas. Domain names are checked by the controller
+// against the provider.
+func validateDistribution(r *findingRecorder, path string, d *FailureDomainDistribution, replicas int32) {
+	if d.GetPolicy() != FailureDomainDistributionExplicit {
+		return
+	}
+	var total int32
+	for _, c := range d.Counts {
+		total += c.Replicas
+	}
+	if total != replicas {
+		r.errorf(path+".counts", "counts add up to %d but the pool has %d replicas", total, replicas)
+	}
+}
+
+// validateNodePool checks a NodePool against its TenantCluster when the
+// cluster is in the bundle.
+func (b *validationBundle) validateNodePool(r *findingRecorder, np *NodePool) {
+	if np.Name == DefaultWorkerPoolName {
+		r.errorf("metadata.name", "name %q is reserved for spec.workers", DefaultWorkerPoolName)
+	}
+	if a := np.Spec.Autoscaling; a != nil && np.Spec.Replicas != nil && np.ClampReplicas(*np.Spec.Replicas) != *np.Spec.Replicas {
+		r.warnf("spec.replicas", "replicas %d is outside autoscaling bounds [%d, %d] and will be clamped",
+			*np.Spec.Replicas, a.MinReplicas, a.MaxReplicas)
+	}
+	tc, _ := b.objects[bundleKey("TenantCluster", np.Namespace, np.Spec.ClusterRef.Name)].(*TenantCluster)
+	var spec *TenantClusterSpec
+	if tc != nil {
+		spec = &tc.Spec
+		if np.Name != DefaultWorkerPoolName && spec.GetWorkerPool(np.Name) != nil {
+			r.errorf("metadata.name", "TenantCluster %q already has a worker pool named %q", tc.Name, np.Name)
+		}
+	}
+	pool := np.WorkerPool(spec)
+	validateWorkerPool(r, "spec", spec, &pool)
+}
+
+func validatePolicyExceptions(r *findingRecorder, path string, exceptions []PolicyException) {
+	now := time.Now()
+	for i := range exceptions {
+		e := &exceptions[i]
+		field := fmt.Sprintf("%s[%d]", path, i)
+		if e.IsExpired(now) {
+			r.warnf(field+".expiresAt", "exception %q expired at %s and is no longer applied", e.Name, e.ExpiresAt.UTC().Format(time.RFC3339))
+		}
+		if e.Engine == PolicyEngineGatekeeper && !strings.Contains(e.Policy, "/") {
+			r.errorf(field+".policy", "gatekeeper policy must be a constraint as {kind}/{name}")
+		}
+	}
+}
+
+func validateAccessGrants(r *findingRecorder, path string, grants []AccessGrant) {
+	for i, g := range grants {
+		if g.Duration.Duration <= 0 || g.Duration.Duration > 168*time.Hour {
+			r.errorf(fmt.Sprintf("%s[%d].duration", path, i), "duration must be greater than 0 and at most 168h")
+		}
+	}
+}
+
+func validateProviderConfig(r *findingRecorder, pc *ProviderConfig) {
+	for i, w := range pc.Spec.FreezeWindows {
+		if !w.End.After(w.Start.Time) {
+			r.errorf(fmt.Sprintf("spec.freezeWindows[%d]", i), "end must be after start")
+		}
+	}
+	if b := pc.Spec.Bastion; b != nil {
+		if !isValidEndpoint(b.Host) {
+			r.errorf("spec.bastion.host", "host %q must be an IP address or DNS name", b.Host)
+		}
+		if b.HostKey == "" {
+			r.warnf("spec.bastion.hostKey", "hostKey is not set; the key presented on first connection will be trusted")
+		}
+	}
+	if bm := pc.Spec.BareMetal; bm != nil {
+		validateBareMetalHosts(r, bm)
+	}
+}
+
+// validateBareMetalHosts checks that hosts do not share boot MAC or IP
+// addresses and that IPMI hosts boot with iPXE.
+func validateBareMetalHosts(r *findingRecorder, bm *BareMetalProviderConfig) {
+	macs := map[string]string{}
+	ips := map[string]string{}
+	for i, h := range bm.Hosts {
+		field := fmt.Sprintf("spec.baremetal.hosts[%d]", i)
+		mac := strings.ToLower(h.BootMACAddress)
+		if other, ok := macs[mac]; ok {
+			r.errorf(field+".bootMACAddress", "bootMACAddress %s is also used by host %q", h.BootMACAddress, other)
+		} else {
+			macs[mac] = h.Name
+		}
+		if h.IPAddress != "" {
+			if other, ok := ips[h.IPAddress]; ok {
+				r.errorf(field+".ipAddress", "ipAddress %s is also used by host %q", h.IPAddress, other)
+			} else {
+				ips[h.IPAddress] = h.Name
+			}
+		}
+		if bm.GetProtocol(&h) == BMCProtocolIPMI && bm.Boot.GetMethod() == BareMetalBootMethodVirtualMedia {
+			r.errorf(field+".bmc", "host %q uses IPMI, which does not support VirtualMedia boot", h.Name)
+		}
+	}
+}
+
+// validateNetworkPool checks that static assignments do not overlap each
+// other or reserved ranges, and that the pool has addresses left to
+// allocate. Containment in the CIDR is enforced by the CRD schema.
+func validateNetworkPool(r *findingRecorder, np *NetworkPool) {
+	var reserved []IPBlock
+	for _, rr := range np.Spec.Reserved {
+		if b, err := ParseCIDRBlock(rr.CIDR); err == nil {
+			reserved = append(reserved, b)
+		}
+	}
+	static := map[string]IPBlock{}
+	for i, a := range np.Spec.StaticAssignments {
+		field := fmt.Sprintf("spec.staticAssignments[%d].range", i)
+		b, err := ParseIPBlock(a.Range.StartAddress, a.Range.EndAddress)
+		if err != nil {
+			r.errorf(field, "%v", err)
+			continue
+		}
+		for _, rb := range reserved {
+			if b.Overlaps(rb) {
+				r.errorf(field, "range %s overlaps reserved range %s", b, rb)
+			}
+		}
+		for _, name := range slices.Sorted(maps.Keys(static)) {
+			if b.Overlaps(static[name]) {
+				r.errorf(field, "range %s overlaps static assignment %q", b, name)
+			}
+		}
+		static[a.Name] = b
+	}
+	if ta := np.Spec.TenantAllocation; ta != nil {
+		if _, err := ParseIPBlock(ta.Start, ta.End); err != nil {
+			r.errorf("spec.tenantAllocation", "%v", err)
+			return
+		}
+	}
+	if free, err := np.FreeBlocks(nil); err == nil && len(free) == 0 {
+		r.warnf("spec", "every address in the pool is reserved or statically assigned")
+	}
+}
+
+// validateIPAllocation checks that a pinned range does not end before it
+// starts, which CEL cannot check for IPv6 addresses.
+func validateIPAllocation(r *findingRecorder, a *IPAllocation) {
+	if p := a.Spec.PinnedRange; p != nil {
+		if _, err := ParseIPBlock(p.StartAddress, p.EndAddress); err != nil {
+			r.errorf("spec.pinnedRange", "%v", err)
+		}
+	}
+}
+
+// validateProviderPools checks that NetworkPools in the bundle allow the
+// ProviderConfig that references them. Pools are resolved in the
+// ProviderConfig's namespace.
+func (b *validationBundle) validateProviderPools(r *findingRecorder, pc *ProviderConfig) {
+	if pc.Spec.Network == nil {
+		return
+	}
+	for i, ref := range pc.Spec.Network.PoolRefs {
+		obj, ok := b.objects[bundleKey("NetworkPool", pc.Namespace, ref.Name)]
+		if !ok {
+			continue
+		}
+		pool := obj.(*NetworkPool)
+		field := fmt.Sprintf("spec.network.poolRefs[%d]", i)
+		if !pool.AllowsProvider(pc.Name, pc.Namespace) {
+			r.errorf(field, "NetworkPool %q does not allow this ProviderConfig", ref.Name)
+		}
+		if scope := pc.Spec.Scope; scope != nil && scope.Type == ProviderConfigScopeTeam && scope.TeamRef != nil {
+			if !pool.AllowsTeam(scope.TeamRef.Name) {
+				r.errorf(field, "NetworkPool %q is not available to team %q", ref.Name, scope.TeamRef.Name)
+			}
+		}
+	}
+}
+
+// validateKubernetesVersion checks a Kubernetes version at field against
+// the default KubernetesVersionCatalog when the bundle contains it.
+func (b *validationBundle) validateKubernetesVersion(r *findingRecorder, field, v string) {
+	obj, ok := b.objects[bundleKey("KubernetesVersionCatalog", "", DefaultKubernetesVersionCatalogName)]
+	if !ok {
+		return
+	}
+	if err := obj.(*KubernetesVersionCatalog).CheckVersion(v, time.Now()); err != nil {
+		r.warnf(field, "%v", err)
+	}
+}
+
+// validateKubernetesUpgradePlan checks the maintenance window and, when
+// the cluster is in the bundle, the upgrade path from its current version.
+// Finished plans are not checked against the cluster, which may already
+// run the target version.
+func (b *validationBundle) validateKubernetesUpgradePlan(r *findingRecorder, p *KubernetesUpgradePlan) {
+	b.validateKubernetesVersion(r, "spec.targetVersion", p.Spec.TargetVersion)
+	if w := p.Spec.MaintenanceWindow; w != nil {
+		if _, err := w.NextStart(time.Now()); err != nil {
+			r.errorf("spec.maintenanceWindow", "%v", err)
+		}
+	}
+	if p.IsTerminal() {
+		return
+	}
+	tc, ok := b.objects[bundleKey("TenantCluster", p.Namespace, p.Spec.ClusterRef.Name)].(*TenantCluster)
+	if !ok {
+		return
+	}
+	if err := CheckUpgradePath(tc.Spec.KubernetesVersion, p.Spec.TargetVersion); err != nil {
+		r.errorf("spec.targetVersion", "%v", err)
+	}
+}
+
+// validateTenantClusterMachines checks worker devices, and checks worker
+// firmware and devices against the capabilities of the cluster's provider
+// when its ProviderConfig is in the bundle.
+func (b *validationBundle) validateTenantClusterMachines(r *findingRecorder, tc *TenantCluster) {
+	templates := map[string]*MachineTemplateSpec{"spec.workers.machineTemplate": &tc.Spec.Workers.MachineTemplate}
+	for i := range tc.Spec.WorkerPools {
+		templates[fmt.Sprintf("spec.workerPools[%d].machineTemplate", i)] = &tc.Spec.WorkerPools[i].MachineTemplate
+	}
+	for _, path := range slices.Sorted(maps.Keys(templates)) {
+		mt := templates[path]
+		if err := mt.Devices.Validate(int32(mt.Memory.Value() / (1024 * 1024))); err != nil {
+			r.errorf(path+".devices", "%v", err)
+		}
+	}
+
+	ref := tc.Spec.ProviderConfigRef
+	if ref == nil {
+		return
+	}
+	namespace := ref.Namespace
+	if namespace == "" {
+		namespace = "butler-system"
+	}
+	pc, ok := b.objects[bundleKey("ProviderConfig", namespace, ref.Name)].(*ProviderConfig)
+	if !ok {
+		return
+	}
+	for _, path := range slices.Sorted(maps.Keys(templates)) {
+		mt := templates[path]
+		if err := mt.Firmware.ValidateForProvider(pc.Spec.Provider); err != nil {
+			r.errorf(path+".firmware", "%v", err)
+		}
+		if err := mt.Devices.ValidateForProvider(pc.Spec.Provider); err != nil {
+			r.errorf(path+".devices", "%v", err)
+		}
+	}
+}
+
+// validateMachineRequest checks that user data matches its format, that
+// devices fit the machine, and that gateways are on their subnets. When
+// the ProviderConfig is in the bundle, it also checks firmware and devices
+// against the capabilities of the provider.
+func (b *validationBundle) validateMachineRequest(r *findingRecorder, mr *MachineRequest) {
+	if err := mr.Spec.ValidateUserData(); err != nil {
+		r.errorf("spec.userData", "%v", err)
+	}
+	if err := mr.Spec.Devices.Validate(mr.Spec.MemoryMB); err != nil {
+		r.errorf("spec.devices", "%v", err)
+	}
+	if err := mr.Spec.NetworkConfig.Validate(); err != nil {
+		r.errorf("spec.networkConfig", "%v", err)
+	}
+	for i := range mr.Spec.Networks {
+		n := &mr.Spec.Networks[i]
+		if n.Gateway == "" {
+			continue
+		}
+		if n.Address == "" {
+			r.errorf(fmt.Sprintf("spec.networks[%d].gateway", i), "gateway requires a static address")
+		} else if err := checkGateway(n.Address, n.Gateway); err != nil {
+			r.errorf(fmt.Sprintf("spec.networks[%d].gateway", i), "%v", err)
+		}
+	}
+	namespace := mr.Spec.ProviderRef.Namespace
+	if namespace == "" {
+		namespace = mr.Namespace
+	}
+	pc, ok := b.objects[bundleKey("ProviderConfig", namespace, mr.Spec.ProviderRef.Name)].(*ProviderConfig)
+	if !ok {
+		return
+	}
+	if err := mr.Spec.Firmware.ValidateForProvider(pc.Spec.Provider); err != nil {
+		r.errorf("spec.firmware", "%v", err)
+	}
+	if err := mr.Spec.Devices.ValidateForProvider(pc.Spec.Provider); err != nil {
+		r.errorf("spec.devices", "%v", err)
+	}
+	if mr.Spec.BareMetalHost == "" {
+		return
+	}
+	switch bm := pc.Spec.BareMetal; {
+	case pc.Spec.Provider != ProviderTypeBareMetal:
+		r.warnf("spec.bareMetalHost", "bareMetalHost is ignored by provider %q", pc.Spec.Provider)
+	case bm != nil && bm.InventoryRef == nil && bm.FindHost(mr.Spec.BareMetalHost) == nil:
+		r.errorf("spec.bareMetalHost", "host %q is not in the inventory of ProviderConfig %q", mr.Spec.BareMetalHost, pc.Name)
+	}
+}
+
+// validateEnvironmentPolicy checks the cluster against the environment
+// policy for its tier when the ButlerConfig is in the bundle.
+func (b *validationBundle) validateEnvironmentPolicy(r *findingRecorder, tc *TenantCluster) {
+	cfg, ok := b.objects[bundleKey("ButlerConfig", "", ButlerConfigName)].(*ButlerConfig)
+	if !ok {
+		return
+	}
+	for _, v := range cfg.EnvironmentPolicyViolations(tc) {
+		r.errorf("spec", "%s", v)
+	}
+}
+
+func (b *validationBundle) validateWorkspace(r *findingRecorder, ws *Workspace) {
+	if ws.Spec.ClassName != "" && ws.Spec.Resources != nil {
+		r.errorf("spec", "className and resources are mutually exclusive")
+	}
+	if ws.Spec.ClassName != "" && b.objects != nil && b.hasKind("WorkspaceClass") {
+		if _, ok := b.objects[bundleKey("WorkspaceClass", "", ws.Spec.ClassName)]; !ok {
+			r.warnf("spec.className", "WorkspaceClass %q is not in the bundle", ws.Spec.ClassName)
+		}
+	}
+	validateAccessGrants(r, "spec.accessGrants", ws.Spec.AccessGrants)
+}
+
+// validateClusterTemplate checks that the template references only
+// declared variables and renders with its defaults when every required
+// variable has a default.
+func validateClusterTemplate(r *findingRecorder, ct *ClusterTemplate) {
+	for i := range ct.Spec.Variables {
+		v := &ct.Spec.Variables[i]
+		if v.Default == nil {
+			continue
+		}
+		if _, err := v.parse(*v.Default); err != nil {
+			r.errorf(fmt.Sprintf("spec.variables[%d].default", i), "%v", err)
+		}
+	}
+	refs, err := ct.ReferencedVariables()
+	if err != nil {
+		r.errorf("spec.template", "%v", err)
+		return
+	}
+	undeclared := false
+	for _, name := range refs {
+		if ct.GetVariable(name) == nil {
+			r.errorf("spec.template", "references undeclared variable var.%s", name)
+			undeclared = true
+		}
+	}
+	for i := range ct.Spec.Variables {
+		if !slices.Contains(refs, ct.Spec.Variables[i].Name) {
+			r.warnf(fmt.Sprintf("spec.variables[%d]", i), "variable %q is not referenced by the template", ct.Spec.Variables[i].Name)
+		}
+	}
+	if undeclared {
+		return
+	}
+	for _, v := range ct.Spec.Variables {
+		if v.Required && v.Default == nil {
+			return
+		}
+	}
+	if _, err := ct.Render(nil); err != nil {
+		r.errorf("spec.template", "%v", err)
+	}
+}
+
+func (b *validationBundle) validateWorkspaceTemplate(r *findingRecorder, wt *WorkspaceTemplate) {
+	body := &wt.Spec.Template
+	if wt.Spec.BaseTemplateRef == nil && body.Image == "" {
+		r.errorf("spec.template.image", "template.image is required unless baseTemplateRef is set")
+	}
+	if body.ClassName != "" && body.Resources != nil {
+		r.errorf("spec.template", "className and resources are mutually exclusive")
+	}
+	if wt.Spec.BaseTemplateRef == nil || b.objects == nil {
+		return
+	}
+
+	// Resolve the base chain against the bundle. Bases that are not in the
+	// bundle may already exist in the cluster, so they are not reported.
+	missing := false
+	get := func(namespace, name string) (*WorkspaceTemplate, error) {
+		if base, ok := b.objects[bundleKey("WorkspaceTemplate", namespace, name)].(*WorkspaceTemplate); ok {
+			return base, nil
+		}
+		missing = true
+		return nil, fmt.Errorf("not in bundle")
+	}
+	if _, err := ResolveEffectiveTemplate(wt, get); err != nil && !missing {
+		r.errorf("spec.baseTemplateRef", "%v", err)
+	}
+}
+
+func (b *validationBundle) validateTemplatePublication(r *findingRecorder, p *TemplatePublication) {
+	if rv := p.Spec.Review; rv != nil && p.Spec.Requester != "" && rv.Reviewer == p.Spec.Requester {
+		r.errorf("spec.review.reviewer", "requesters cannot review their own publication")
+	}
+	wt, ok := b.objects[bundleKey("WorkspaceTemplate", p.Namespace, p.Spec.TemplateRef.Name)].(*WorkspaceTemplate)
+	if !ok {
+		return
+	}
+	if wt.Spec.Scope == WorkspaceTemplateScopeCluster {
+		r.errorf("spec.templateRef", "WorkspaceTemplate %q is already cluster-scoped", wt.Name)
+	}
+}
+
+func validateSupportBundle(r *findingRecorder, sb *SupportBundle) {
+	if sb.Spec.Scope == SupportBundleScopeCluster && sb.Spec.ClusterRef == nil {
+		r.errorf("spec.clusterRef", "clusterRef is required for Cluster scope")
+	}
+	if sb.Spec.Scope == SupportBundleScopeTeam && sb.Spec.TeamRef == nil {
+		r.errorf("spec.teamRef", "teamRef is required for Team scope")
+	}
+	if sb.Spec.Redaction != nil {
+		if _, err := sb.Spec.Redaction.CompilePatterns(); err != nil {
+			r.errorf("spec.redaction.patterns", "%v", err)
+		}
+	}
+}
+
+// hasKind returns true if the bundle contains any object of kind.
+func (b *validationBundle) hasKind(kind string) bool {
+	for key := range b.objects {
+		if strings.HasPrefix(key, kind+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,578 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestValidateAll(t *testing.T) {
+	obj := func(kind, namespace, name string, spec map[string]interface{}) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": GroupVersion.String(),
+			"kind":       kind,
+			"metadata":   map[string]interface{}{"name": name},
+			"spec":       spec,
+		}}
+		if namespace != "" {
+			u.SetNamespace(namespace)
+		}
+		return u
+	}
+
+	tests := []struct {
+		name string
+		objs []*unstructured.Unstructured
+		want []string
+	}{
+		{
+			name: "valid workspace template chain",
+			objs: []*unstructured.Unstructured{
+				obj("WorkspaceTemplate", "team-a", "base", map[string]interface{}{
+					"displayName": "Base",
+					"template":    map[string]interface{}{"image": "ghcr.io/butlerdotdev/workspace-base:latest"},
+				}),
+				obj("WorkspaceTemplate", "team-a", "go", map[string]interface{}{
+					"displayName":     "Go",
+					"baseTemplateRef": map[string]interface{}{"name": "base"},
+					"template":        map[string]interface{}{},
+				}),
+			},
+		},
+		{
+			name: "other API groups are skipped",
+			objs: []*unstructured.Unstructured{{Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata":   map[string]interface{}{"name": "cm"},
+			}}},
+		},
+		{
+			name: "unknown kind and version",
+			objs: []*unstructured.Unstructured{
+				obj("Widget", "", "w", nil),
+				{Object: map[string]interface{}{
+					"apiVersion": GroupVersion.Group + "/v9",
+					"kind":       "Team",
+					"metadata":   map[string]interface{}{"name": "t"},
+				}},
+			},
+			want: []string{`unknown kind "Widget"`, `unsupported version "v9"`},
+		},
+		{
+			name: "unknown field",
+			objs: []*unstructured.Unstructured{
+				obj("Workspace", "team-a", "ws", map[string]interface{}{"imagee": "ubuntu"}),
+			},
+			want: []string{"imagee"},
+		},
+		{
+			name: "duplicate objects",
+			objs: []*unstructured.Unstructured{
+				obj("Workspace", "team-a", "ws", map[string]interface{}{"image": "ubuntu"}),
+				obj("Workspace", "team-a", "ws", map[string]interface{}{"image": "ubuntu"}),
+			},
+			want: []string{"duplicate of object 0"},
+		},
+		{
+			name: "cross-field rules",
+			objs: []*unstructured.Unstructured{
+				obj("Workspace", "team-a", "ws", map[string]interface{}{
+					"image":     "ubuntu",
+					"className": "large",
+					"resources": map[string]interface{}{"cpu": "2"},
+					"accessGrants": []interface{}{
+						map[string]interface{}{"user": "a@example.com", "duration": "720h", "reason": "debug"},
+					},
+				}),
+				obj("TenantCluster", "team-a", "tc", map[string]interface{}{
+					"bootstrapProvider": "talos",
+					"workers":           map[string]interface{}{"replicas": int64(3)},
+				}),
+			},
+			want: []string{"mutually exclusive", "at most 168h", "requires workers.machineTemplate.os.type talos"},
+		},
+		{
+			name: "reversed IPv6 ranges",
+			objs: []*unstructured.Unstructured{
+				obj("TenantCluster", "team-a", "tc", map[string]interface{}{
+					"workers": map[string]interface{}{"replicas": int64(3)},
+					"networking": map[string]interface{}{
+						"loadBalancerPool": map[string]interface{}{"start": "fd00::20", "end": "fd00::10"},
+					},
+				}),
+				obj("IPAllocation", "butler-system", "pinned", map[string]interface{}{
+					"poolRef":          map[string]interface{}{"name": "lab"},
+					"tenantClusterRef": map[string]interface{}{"name": "tc", "namespace": "team-a"},
+					"type":             "loadbalancer",
+					"pinnedRange":      map[string]interface{}{"startAddress": "fd00::9", "endAddress": "fd00::1"},
+				}),
+			},
+			want: []string{"start IP fd00::20 must be <= end IP fd00::10", "start fd00::9 is after end fd00::1"},
+		},
+		{
+			name: "cluster metadata",
+			objs: []*unstructured.Unstructured{
+				obj("TenantCluster", "team-a", "tc", map[string]interface{}{
+					"workers": map[string]interface{}{"replicas": int64(3)},
+					"clusterMetadata": map[string]interface{}{
+						"labels": map[string]interface{}{
+							"cost-center":                   "eng",
+							"topology.kubernetes.io/region": "east",
+							"bad key":                       "x",
+						},
+					},
+				}),
+			},
+			want: []string{`label key "bad key"`, "not applied to nodes"},
+		},
+		{
+			name: "worker pools",
+			objs: []*unstructured.Unstructured{
+				obj("TenantCluster", "team-a", "tc", map[string]interface{}{
+					"workers": map[string]interface{}{"replicas": int64(3)},
+					"workerPools": []interface{}{
+						map[string]interface{}{
+							"name":            "gpu",
+							"replicas":        int64(1),
+							"machineTemplate": map[string]interface{}{"os": map[string]interface{}{"type": "talos"}},
+							"labels":          map[string]interface{}{"node-role.kubernetes.io/gpu": ""},
+						},
+					},
+				}),
+			},
+			want: []string{"require bootstrapProvider talos", "cannot be set by the kubelet"},
+		},
+		{
+			name: "talos workers with kubeadm",
+			objs: []*unstructured.Unstructured{
+				obj("TenantCluster", "team-a", "tc", map[string]interface{}{
+					"bootstrapProvider": "kubeadm",
+					"workers": map[string]interface{}{
+						"replicas":        int64(3),
+						"machineTemplate": map[string]interface{}{"os": map[string]interface{}{"type": "talos"}},
+					},
+				}),
+			},
+			want: []string{"workers.machineTemplate.os.type: talos workers require bootstrapProvider talos"},
+		},
+		{
+			name: "node pool",
+			objs: []*unstructured.Unstructured{
+				obj("TenantCluster", "team-a", "tc", map[string]interface{}{
+					"workers":     map[string]interface{}{"replicas": int64(3)},
+					"workerPools": []interface{}{map[string]interface{}{"name": "gpu", "replicas": int64(1), "machineTemplate": map[string]interface{}{}}},
+				}),
+				obj("NodePool", "team-a", "gpu", map[string]interface{}{
+					"clusterRef":      map[string]interface{}{"name": "tc"},
+					"replicas":        int64(10),
+					"autoscaling":     map[string]interface{}{"minReplicas": int64(1), "maxReplicas": int64(4)},
+					"machineTemplate": map[string]interface{}{"os": map[string]interface{}{"type": "talos"}},
+				}),
+			},
+			want: []string{"will be clamped", "already has a worker pool", "require bootstrapProvider talos"},
+		},
+		{
+			name: "virtual cluster on a virtual host",
+			objs: []*unstructured.Unstructured{
+				obj("TenantCluster", "platform", "host", map[string]interface{}{
+					"tenancyMode": "virtual",
+					"workers":     map[string]interface{}{"replicas": int64(0)},
+					"virtual":     map[string]interface{}{"hostClusterRef": map[string]interface{}{"name": "host", "namespace": "platform"}},
+				}),
+				obj("TenantCluster", "team-a", "tc", map[string]interface{}{
+					"tenancyMode":       "virtual",
+					"bootstrapProvider": "k3s",
+					"workers":           map[string]interface{}{"replicas": int64(0)},
+					"virtual":           map[string]interface{}{"hostClusterRef": map[string]interface{}{"name": "host", "namespace": "platform"}},
+				}),
+			},
+			want: []string{"cannot host itself", "is virtual", "ignored for virtual clusters"},
+		},
+		{
+			name: "policy exceptions",
+			objs: []*unstructured.Unstructured{
+				obj("TenantCluster", "team-a", "tc", map[string]interface{}{
+					"workers": map[string]interface{}{"replicas": int64(3)},
+					"policyExceptions": []interface{}{
+						map[string]interface{}{
+							"name": "old", "engine": "kyverno", "policy": "disallow-host-path",
+							"justification": "CSI node plugin", "approver": "sec@example.com", "expiresAt": "2020-01-01T00:00:00Z",
+						},
+						map[string]interface{}{
+							"name": "labels", "engine": "gatekeeper", "policy": "require-labels",
+							"justification": "migration", "approver": "sec@example.com", "expiresAt": "2999-01-01T00:00:00Z",
+						},
+					},
+				}),
+			},
+			want: []string{"expired at 2020-01-01T00:00:00Z", "{kind}/{name}"},
+		},
+		{
+			name: "backup policy without backup addon",
+			objs: []*unstructured.Unstructured{
+				obj("TenantCluster", "team-a", "tc", map[string]interface{}{
+					"workers": map[string]interface{}{"replicas": int64(3)},
+				}),
+				obj("ClusterBackupPolicy", "team-a", "nightly", map[string]interface{}{
+					"clusterRef":         map[string]interface{}{"name": "tc"},
+					"schedule":           "0 2 * * *",
+					"includedNamespaces": []interface{}{"app", "db"},
+					"excludedNamespaces": []interface{}{"db"},
+				}),
+			},
+			want: []string{"both included and excluded", "backup addon enabled"},
+		},
+		{
+			name: "restore into cluster without backup addon",
+			objs: []*unstructured.Unstructured{
+				obj("TenantCluster", "team-a", "tc", map[string]interface{}{
+					"workers": map[string]interface{}{"replicas": int64(3)},
+				}),
+				obj("ClusterRestore", "team-a", "r1", map[string]interface{}{
+					"backupName": "butler-nightly-20260501020000",
+					"target":     map[string]interface{}{"clusterRef": map[string]interface{}{"name": "tc"}},
+				}),
+				obj("ClusterRestore", "team-a", "r2", map[string]interface{}{
+					"backupName": "butler-nightly-20260501020000",
+					"target":     map[string]interface{}{"newCluster": map[string]interface{}{"name": "tc"}},
+				}),
+			},
+			want: []string{"backup addon enabled", "already exists"},
+		},
+		{
+			name: "prod cluster violates environment policy",
+			objs: []*unstructured.Unstructured{
+				obj("ButlerConfig", "", "butler", map[string]interface{}{
+					"environmentPolicies": []interface{}{
+						map[string]interface{}{
+							"tier":                      "prod",
+							"minControlPlaneReplicas":   int64(3),
+							"requireDeletionProtection": true,
+						},
+					},
+				}),
+				obj("TenantCluster", "team-a", "tc", map[string]interface{}{
+					"workers":   map[string]interface{}{"replicas": int64(3)},
+					"ownership": map[string]interface{}{"ownerEmail": "a@example.com", "environment": "prod"},
+				}),
+			},
+			want: []string{"at least 3 control plane replicas", "require deletionProtection"},
+		},
+		{
+			name: "storage policy replicas exceed workers",
+			objs: []*unstructured.Unstructured{
+				obj("TenantCluster", "team-a", "tc", map[string]interface{}{
+					"workers": map[string]interface{}{"replicas": int64(2)},
+					"addons": map[string]interface{}{
+						"storage": map[string]interface{}{
+							"version":  "1.7.2",
+							"policies": []interface{}{map[string]interface{}{"name": "replicated", "replicaCount": int64(3)}},
+						},
+					},
+				}),
+			},
+			want: []string{`"replicated" requests 3 replicas`},
+		},
+		{
+			name: "upgrade plan skips a minor version",
+			objs: []*unstructured.Unstructured{
+				obj("TenantCluster", "team-a", "tc", map[string]interface{}{
+					"kubernetesVersion": "v1.31.4",
+					"workers":           map[string]interface{}{"replicas": int64(3)},
+				}),
+				obj("KubernetesUpgradePlan", "team-a", "to-1-33", map[string]interface{}{
+					"clusterRef":    map[string]interface{}{"name": "tc"},
+					"targetVersion": "v1.33.1",
+					"maintenanceWindow": map[string]interface{}{
+						"start":    "02:00",
+						"duration": "4h",
+						"timeZone": "Mars/Olympus_Mons",
+					},
+				}),
+			},
+			want: []string{"invalid time zone", "skips a minor version"},
+		},
+		{
+			name: "addon version untested with cluster kubernetes version",
+			objs: []*unstructured.Unstructured{
+				obj("AddonDefinition", "", "cilium", map[string]interface{}{
+					"displayName": "Cilium",
+					"description": "eBPF networking",
+					"category":    "cni",
+					"chart": map[string]interface{}{
+						"repository":        "https://helm.cilium.io",
+						"name":              "cilium",
+						"defaultVersion":    "1.16.5",
+						"availableVersions": []interface{}{"1.15.9"},
+						"compatibility": []interface{}{
+							map[string]interface{}{"version": "1.16.5", "kubernetesVersions": map[string]interface{}{"min": "v1.29", "max": "v1.32"}},
+							map[string]interface{}{"version": "1.15.9", "kubernetesVersions": map[string]interface{}{"min": "v1.30", "max": "v1.28"}},
+							map[string]interface{}{"version": "1.14.0", "kubernetesVersions": map[string]interface{}{"max": "v1.29"}},
+						},
+					},
+				}),
+				obj("TenantCluster", "team-a", "tc", map[string]interface{}{
+					"kubernetesVersion": "v1.33.1",
+					"workers":           map[string]interface{}{"replicas": int64(3)},
+				}),
+				obj("TenantAddon", "team-a", "cilium", map[string]interface{}{
+					"clusterRef": map[string]interface{}{"name": "tc"},
+					"addon":      "cilium",
+					"version":    "1.16.5",
+				}),
+			},
+			want: []string{"above maximum", `"1.14.0" is not defaultVersion`, "not tested with Kubernetes v1.33.1"},
+		},
+		{
+			name: "reserved kubelet args and invalid node annotations",
+			objs: []*unstructured.Unstructured{
+				obj("TenantCluster", "team-a", "tc", map[string]interface{}{
+					"workers": map[string]interface{}{
+						"replicas": int64(3),
+						"machineTemplate": map[string]interface{}{
+							"kubeletExtraArgs": map[string]interface{}{"node-labels": "a=b", "max-pods": "250"},
+						},
+					},
+					"workerPools": []interface{}{
+						map[string]interface{}{
+							"name":     "gpu",
+							"replicas": int64(1),
+							"machineTemplate": map[string]interface{}{
+								"nodeAnnotations": map[string]interface{}{"bad key!": "x"},
+							},
+						},
+					},
+				}),
+			},
+			want: []string{`"node-labels" is managed by Butler`, `annotation key "bad key!"`},
+		},
+		{
+			name: "workspace template cycle in bundle",
+			objs: []*unstructured.Unstructured{
+				obj("WorkspaceTemplate", "team-a", "a", map[string]interface{}{
+					"displayName":     "A",
+					"baseTemplateRef": map[string]interface{}{"name": "b"},
+					"template":        map[string]interface{}{"image": "a"},
+				}),
+				obj("WorkspaceTemplate", "team-a", "b", map[string]interface{}{
+					"displayName":     "B",
+					"baseTemplateRef": map[string]interface{}{"name": "a"},
+					"template":        map[string]interface{}{"image": "b"},
+				}),
+			},
+			want: []string{"cycle", "cycle"},
+		},
+		{
+			name: "base outside the bundle is not reported",
+			objs: []*unstructured.Unstructured{
+				obj("WorkspaceTemplate", "team-a", "go", map[string]interface{}{
+					"displayName":     "Go",
+					"baseTemplateRef": map[string]interface{}{"name": "base", "namespace": "butler-system"},
+					"template":        map[string]interface{}{},
+				}),
+			},
+		},
+		{
+			name: "network pool does not allow provider",
+			objs: []*unstructured.Unstructured{
+				obj("NetworkPool", "butler-system", "lab", map[string]interface{}{
+					"cidr":         "10.40.0.0/24",
+					"scope":        map[string]interface{}{"type": "team", "teamRef": map[string]interface{}{"name": "team-a"}},
+					"providerRefs": []interface{}{map[string]interface{}{"name": "harvester-a"}},
+				}),
+				obj("ProviderConfig", "butler-system", "harvester-b", map[string]interface{}{
+					"provider":       "harvester",
+					"credentialsRef": map[string]interface{}{"name": "creds"},
+					"scope":          map[string]interface{}{"type": "team", "teamRef": map[string]interface{}{"name": "team-b"}},
+					"network": map[string]interface{}{
+						"mode":     "ipam",
+						"poolRefs": []interface{}{map[string]interface{}{"name": "lab"}},
+					},
+				}),
+			},
+			want: []string{"does not allow this ProviderConfig", `not available to team "team-b"`},
+		},
+		{
+			name: "network pool static assignment overlaps",
+			objs: []*unstructured.Unstructured{
+				obj("NetworkPool", "butler-system", "lab", map[string]interface{}{
+					"cidr":     "10.40.0.0/24",
+					"reserved": []interface{}{map[string]interface{}{"cidr": "10.40.0.0/28"}},
+					"staticAssignments": []interface{}{
+						map[string]interface{}{
+							"name": "dns", "owner": "netops",
+							"range": map[string]interface{}{"startAddress": "10.40.0.10", "endAddress": "10.40.0.20"},
+						},
+						map[string]interface{}{
+							"name": "ntp", "owner": "netops",
+							"range": map[string]interface{}{"startAddress": "10.40.0.20", "endAddress": "10.40.0.21"},
+						},
+					},
+				}),
+			},
+			want: []string{"overlaps reserved range 10.40.0.0-10.40.0.15", `overlaps static assignment "dns"`},
+		},
+		{
+			name: "machine request firmware not supported by provider",
+			objs: []*unstructured.Unstructured{
+				obj("ProviderConfig", "butler-system", "gcp", map[string]interface{}{
+					"provider":       "gcp",
+					"credentialsRef": map[string]interface{}{"name": "creds"},
+				}),
+				obj("MachineRequest", "butler-system", "cp-0", map[string]interface{}{
+					"providerRef": map[string]interface{}{"name": "gcp"},
+					"machineName": "cp-0", "role": "control-plane",
+					"cpu": int64(4), "memoryMB": int64(8192), "diskGB": int64(50),
+					"firmware": map[string]interface{}{"type": "BIOS"},
+				}),
+			},
+			want: []string{"provider gcp does not support BIOS firmware"},
+		},
+		{
+			name: "bare-metal inventory",
+			objs: []*unstructured.Unstructured{
+				obj("ProviderConfig", "butler-system", "metal", map[string]interface{}{
+					"provider":       "baremetal",
+					"credentialsRef": map[string]interface{}{"name": "bmc"},
+					"baremetal": map[string]interface{}{
+						"boot": map[string]interface{}{"method": "VirtualMedia", "isoURL": "https://boot.example.com/talos.iso"},
+						"hosts": []interface{}{
+							map[string]interface{}{
+								"name": "node-a", "bootMACAddress": "aa:bb:cc:00:00:01", "ipAddress": "10.50.0.11",
+								"bmc": map[string]interface{}{"address": "10.50.1.11"},
+							},
+							map[string]interface{}{
+								"name": "node-b", "bootMACAddress": "AA:BB:CC:00:00:01", "ipAddress": "10.50.0.12",
+								"bmc": map[string]interface{}{"address": "10.50.1.12", "protocol": "IPMI"},
+							},
+						},
+					},
+				}),
+				obj("MachineRequest", "butler-system", "worker-0", map[string]interface{}{
+					"providerRef": map[string]interface{}{"name": "metal"},
+					"machineName": "worker-0", "role": "worker",
+					"cpu": int64(32), "memoryMB": int64(131072), "diskGB": int64(500),
+					"bareMetalHost": "node-c",
+				}),
+			},
+			want: []string{`is also used by host "node-a"`, "does not support VirtualMedia boot", `host "node-c" is not in the inventory`},
+		},
+		{
+			name: "template publication",
+			objs: []*unstructured.Unstructured{
+				obj("WorkspaceTemplate", "team-a", "go-backend", map[string]interface{}{
+					"displayName": "Go backend",
+					"scope":       "cluster",
+					"template":    map[string]interface{}{"image": "golang:1.24"},
+				}),
+				obj("TemplatePublication", "team-a", "go-backend", map[string]interface{}{
+					"templateRef": map[string]interface{}{"name": "go-backend"},
+					"reason":      "used by every backend team",
+					"requester":   "dev@example.com",
+					"review":      map[string]interface{}{"reviewer": "dev@example.com", "decision": "Approve"},
+				}),
+			},
+			want: []string{"cannot review their own publication", "already cluster-scoped"},
+		},
+		{
+			name: "machine network gateway",
+			objs: []*unstructured.Unstructured{
+				obj("MachineRequest", "butler-system", "cp-0", map[string]interface{}{
+					"providerRef": map[string]interface{}{"name": "harvester"},
+					"machineName": "cp-0", "role": "control-plane",
+					"cpu": int64(4), "memoryMB": int64(8192), "diskGB": int64(50),
+					"networkConfig": map[string]interface{}{
+						"address": "10.40.0.21/24",
+						"gateway": "10.41.0.1",
+					},
+					"networks": []interface{}{
+						map[string]interface{}{"name": "storage", "providerNetwork": "default/storage", "gateway": "10.60.0.1"},
+					},
+				}),
+			},
+			want: []string{"gateway 10.41.0.1 is not on subnet 10.40.0.0/24", "gateway requires a static address"},
+		},
+		{
+			name: "cluster template",
+			objs: []*unstructured.Unstructured{
+				obj("ClusterTemplate", "butler-system", "standard", map[string]interface{}{
+					"displayName": "Standard",
+					"variables": []interface{}{
+						map[string]interface{}{"name": "workers", "type": "integer", "default": "three"},
+						map[string]interface{}{"name": "unused"},
+					},
+					"template": map[string]interface{}{
+						"kubernetesVersion": "${var.version}",
+						"workers":           map[string]interface{}{"replicas": "${var.workers}"},
+					},
+				}),
+			},
+			want: []string{`"three" is not an integer`, "undeclared variable var.version", `variable "unused" is not referenced`},
+		},
+		{
+			name: "explicit failure domain counts",
+			objs: []*unstructured.Unstructured{
+				obj("NodePool", "team-a", "zonal", map[string]interface{}{
+					"clusterRef": map[string]interface{}{"name": "tc"},
+					"replicas":   int64(4),
+					"distribution": map[string]interface{}{
+						"policy": "Explicit",
+						"counts": []interface{}{
+							map[string]interface{}{"domain": "az-a", "replicas": int64(2)},
+							map[string]interface{}{"domain": "az-b", "replicas": int64(1)},
+						},
+					},
+					"machineTemplate": map[string]interface{}{"os": map[string]interface{}{"type": "rocky"}},
+				}),
+			},
+			want: []string{"counts add up to 3 but the pool has 4 replicas"},
+		},
+		{
+			name: "bastion host",
+			objs: []*unstructured.Unstructured{
+				obj("ProviderConfig", "butler-system", "proxmox", map[string]interface{}{
+					"provider":       "proxmox",
+					"credentialsRef": map[string]interface{}{"name": "creds"},
+					"bastion": map[string]interface{}{
+						"host":                "jump host",
+						"user":                "butler",
+						"privateKeySecretRef": map[string]interface{}{"name": "bastion-key"},
+					},
+				}),
+			},
+			want: []string{"must be an IP address or DNS name", "hostKey is not set"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ValidateAll(tt.objs)
+			if len(got) != len(tt.want) {
+				t.Fatalf("ValidateAll() = %v, want %d findings", got, len(tt.want))
+			}
+			for i, f := range got {
+				if !strings.Contains(f.String(), tt.want[i]) {
+					t.Errorf("finding %d = %q, want it to contain %q", i, f.String(), tt.want[i])
+				}
+			}
+		})
+	}
+}
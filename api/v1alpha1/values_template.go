@@ -0,0 +1,247 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Addon values templating.
+//
+// String values in addon Helm values may reference cluster variables with
+// ${butler.<name>}, for example:
+//
+//	ingress:
+//	  hostname: grafana.${butler.clusterName}.${butler.domain}
+//
+// Only the ${butler.} prefix is interpreted, so shell-style ${VAR} strings
+// and Helm tpl strings ({{ ... }}) pass through unchanged. Write
+// $${butler.<name>} to produce a literal ${butler.<name>}. Map keys are never
+// rendered. Referencing an unknown variable, or a variable that is empty for
+// the cluster being rendered, is an error.
+//
+// Variables:
+//
+//	clusterName           name of the cluster
+//	clusterNamespace      namespace of the TenantCluster (tenant addons only)
+//	team                  owning Team (tenant addons only)
+//	environment           Team environment label (tenant addons only)
+//	environmentTier       ownership environment tier (tenant addons only)
+//	provider              infrastructure provider type
+//	kubernetesVersion     Kubernetes version (tenant addons only)
+//	controlPlaneEndpoint  API server endpoint as reported in status
+//	vip                   API server host without scheme or port
+//	lbPoolStart           first LoadBalancer pool address
+//	lbPoolEnd             last LoadBalancer pool address
+//	lbPool                LoadBalancer pool as "start-end"
+//	podCIDR               pod network CIDR
+//	serviceCIDR           service network CIDR
+//	dnsServiceIP          cluster DNS service IP (tenant addons only)
+//	domain                platform base domain, supplied by the caller
+
+// valuesVariablePattern matches ${butler.name} and the escaped $${butler.name}.
+var valuesVariablePattern = regexp.MustCompile(`\$?\$\{butler\.([A-Za-z][A-Za-z0-9]*)\}`)
+
+// ValuesContext holds the variables available to addon values templates.
+// +kubebuilder:object:generate=false
+type ValuesContext struct {
+	ClusterName          string
+	ClusterNamespace     string
+	Team                 string
+	Environment          string
+	EnvironmentTier      string
+	Provider             string
+	KubernetesVersion    string
+	ControlPlaneEndpoint string
+	VIP                  string
+	LBPoolStart          string
+	LBPoolEnd            string
+	PodCIDR              string
+	ServiceCIDR          string
+	DNSServiceIP         string
+	Domain               string
+}
+
+// NewTenantClusterValuesContext builds the values context for addons
+// installed into a TenantCluster. Provider and Domain are not recorded on
+// the TenantCluster and must be set by the caller.
+func NewTenantClusterValuesContext(tc *TenantCluster) *ValuesContext {
+	ctx := &ValuesContext{
+		ClusterName:          tc.Name,
+		ClusterNamespace:     tc.Namespace,
+		Environment:          tc.Labels[LabelEnvironment],
+		EnvironmentTier:      string(tc.EnvironmentTier()),
+		KubernetesVersion:    tc.Spec.KubernetesVersion,
+		ControlPlaneEndpoint: tc.Status.ControlPlaneEndpoint,
+		VIP:                  endpointHost(tc.Status.ControlPlaneEndpoint),
+		PodCIDR:              primaryCIDR(tc.Spec.Networking.GetPodCIDRs()),
+		ServiceCIDR:          primaryCIDR(tc.Spec.Networking.GetServiceCIDRs()),
+		DNSServiceIP:         tc.Spec.Networking.GetDNSServiceIP(),
+	}
+	if tc.Spec.TeamRef != nil {
+		ctx.Team = tc.Spec.TeamRef.Name
+	}
+	if pool := tc.Spec.Networking.LoadBalancerPool; pool != nil {
+		ctx.LBPoolStart = pool.Start
+		ctx.LBPoolEnd = pool.End
+	}
+	return ctx
+}
+
+// NewClusterBootstrapValuesContext builds the values context for management
+// addons installed by a ClusterBootstrap. Domain must be set by the caller.
+func NewClusterBootstrapValuesContext(cb *ClusterBootstrap) *ValuesContext {
+	ctx := &ValuesContext{
+		ClusterName:          cb.Spec.Cluster.Name,
+		Provider:             cb.Spec.Provider,
+		ControlPlaneEndpoint: cb.Status.ControlPlaneEndpoint,
+		VIP:                  cb.GetVIP(),
+		PodCIDR:              primaryCIDR(cb.Spec.Network.GetPodCIDRs()),
+		ServiceCIDR:          primaryCIDR(cb.Spec.Network.GetServiceCIDRs()),
+	}
+	if ctx.VIP == "" {
+		ctx.VIP = endpointHost(cb.Status.ControlPlaneEndpoint)
+	}
+	if pool := cb.Spec.Network.LoadBalancerPool; pool != nil {
+		ctx.LBPoolStart = pool.Start
+		ctx.LBPoolEnd = pool.End
+	}
+	return ctx
+}
+
+// primaryCIDR returns the primary-family entry of cidrs, as used for the
+// DNS service IP, or "" if cidrs is empty.
+func primaryCIDR(cidrs []string) string {
+	if len(cidrs) == 0 {
+		return ""
+	}
+	return cidrs[0]
+}
+
+// Variables returns the template variables keyed by name.
+func (c *ValuesContext) Variables() map[string]string {
+	vars := map[string]string{
+		"clusterName":          c.ClusterName,
+		"clusterNamespace":     c.ClusterNamespace,
+		"team":                 c.Team,
+		"environment":          c.Environment,
+		"environmentTier":      c.EnvironmentTier,
+		"provider":             c.Provider,
+		"kubernetesVersion":    c.KubernetesVersion,
+		"controlPlaneEndpoint": c.ControlPlaneEndpoint,
+		"vip":                  c.VIP,
+		"lbPoolStart":          c.LBPoolStart,
+		"lbPoolEnd":            c.LBPoolEnd,
+		"lbPool":               "",
+		"podCIDR":              c.PodCIDR,
+		"serviceCIDR":          c.ServiceCIDR,
+		"dnsServiceIP":         c.DNSServiceIP,
+		"domain":               c.Domain,
+	}
+	if c.LBPoolStart != "" && c.LBPoolEnd != "" {
+		vars["lbPool"] = c.LBPoolStart + "-" + c.LBPoolEnd
+	}
+	return vars
+}
+
+// Render converts the values to a map and renders ${butler.<name>}
+// references against ctx. Returns nil if the values are empty.
+func (v *ExtensionValues) Render(ctx *ValuesContext) (map[string]interface{}, error) {
+	m, err := v.ToMap()
+	if err != nil || m == nil {
+		return m, err
+	}
+	return RenderValues(m, ctx)
+}
+
+// RenderValues returns a copy of values with ${butler.<name>} references in
+// string values rendered against ctx. All invalid references are reported
+// in a single error.
+func RenderValues(values map[string]interface{}, ctx *ValuesContext) (map[string]interface{}, error) {
+	r := &valuesRenderer{vars: ctx.Variables()}
+	out, _ := r.render("", values).(map[string]interface{})
+	if len(r.errs) > 0 {
+		sort.Strings(r.errs)
+		return nil, fmt.Errorf("rendering addon values: %s", strings.Join(r.errs, "; "))
+	}
+	return out, nil
+}
+
+type valuesRenderer struct {
+	vars map[string]string
+	errs []string
+}
+
+func (r *valuesRenderer) render(path string, v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(t))
+		for k, val := range t {
+			out[k] = r.render(path+"."+k, val)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(t))
+		for i, val := range t {
+			out[i] = r.render(fmt.Sprintf("%s[%d]", path, i), val)
+		}
+		return out
+	case string:
+		return r.renderString(path, t)
+	default:
+		return v
+	}
+}
+
+func (r *valuesRenderer) renderString(path, s string) string {
+	return valuesVariablePattern.ReplaceAllStringFunc(s, func(match string) string {
+		if strings.HasPrefix(match, "$$") {
+			return match[1:]
+		}
+		name := valuesVariablePattern.FindStringSubmatch(match)[1]
+		val, ok := r.vars[name]
+		switch {
+		case !ok:
+			r.errs = append(r.errs, fmt.Sprintf("%s: unknown variable butler.%s", path, name))
+		case val == "":
+			r.errs = append(r.errs, fmt.Sprintf("%s: variable butler.%s is not set for this cluster", path, name))
+		}
+		return val
+	})
+}
+
+// endpointHost returns the host of an endpoint such as "https://10.0.0.5:6443",
+// "10.0.0.5:6443", or "api.example.com".
+func endpointHost(endpoint string) string {
+	if endpoint == "" {
+		return ""
+	}
+	if strings.Contains(endpoint, "://") {
+		if u, err := url.Parse(endpoint); err == nil {
+			return u.Hostname()
+		}
+	}
+	if host, _, err := net.SplitHostPort(endpoint); err == nil {
+		return host
+	}
+	return endpoint
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRenderValues(t *testing.T) {
+	ctx := &ValuesContext{
+		ClusterName: "prod-east",
+		Domain:      "butler.example.com",
+		LBPoolStart: "10.40.0.100",
+		LBPoolEnd:   "10.40.0.110",
+	}
+
+	tests := []struct {
+		name    string
+		values  map[string]interface{}
+		want    map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name: "nested strings and lists",
+			values: map[string]interface{}{
+				"ingress": map[string]interface{}{
+					"hosts": []interface{}{"grafana.${butler.clusterName}.${butler.domain}"},
+				},
+				"pool":     "${butler.lbPool}",
+				"replicas": float64(2),
+			},
+			want: map[string]interface{}{
+				"ingress": map[string]interface{}{
+					"hosts": []interface{}{"grafana.prod-east.butler.example.com"},
+				},
+				"pool":     "10.40.0.100-10.40.0.110",
+				"replicas": float64(2),
+			},
+		},
+		{
+			name: "non-butler references pass through",
+			values: map[string]interface{}{
+				"cmd": "echo ${HOME}",
+				"tpl": "{{ .Release.Name }}",
+			},
+			want: map[string]interface{}{
+				"cmd": "echo ${HOME}",
+				"tpl": "{{ .Release.Name }}",
+			},
+		},
+		{
+			name:   "escaped reference",
+			values: map[string]interface{}{"literal": "$${butler.clusterName}"},
+			want:   map[string]interface{}{"literal": "${butler.clusterName}"},
+		},
+		{
+			name:    "unknown variable",
+			values:  map[string]interface{}{"x": "${butler.clustername}"},
+			wantErr: true,
+		},
+		{
+			name:    "unset variable",
+			values:  map[string]interface{}{"x": "${butler.vip}"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RenderValues(tt.values, ctx)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RenderValues() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RenderValues() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEndpointHost(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		want     string
+	}{
+		{"https://10.0.0.5:6443", "10.0.0.5"},
+		{"10.0.0.5:6443", "10.0.0.5"},
+		{"api.example.com", "api.example.com"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := endpointHost(tt.endpoint); got != tt.want {
+			t.Errorf("endpointHost(%q) = %q, want %q", tt.endpoint, got, tt.want)
+		}
+	}
+}
+
+func TestNewTenantClusterValuesContextCIDRs(t *testing.T) {
+	tests := []struct {
+		name            string
+		networking      NetworkingSpec
+		wantPodCIDR     string
+		wantServiceCIDR string
+		wantDNSIP       string
+	}{
+		{
+			name:            "single stack",
+			networking:      NetworkingSpec{PodCIDR: "10.244.0.0/16", ServiceCIDR: "10.96.0.0/12"},
+			wantPodCIDR:     "10.244.0.0/16",
+			wantServiceCIDR: "10.96.0.0/12",
+			wantDNSIP:       "10.96.0.10",
+		},
+		{
+			name: "dual stack lists only",
+			networking: NetworkingSpec{
+				PodCIDRs:     []string{"fd00:10:244::/56", "10.244.0.0/16"},
+				ServiceCIDRs: []string{"fd00:10:96::/112", "10.96.0.0/12"},
+			},
+			wantPodCIDR:     "fd00:10:244::/56",
+			wantServiceCIDR: "fd00:10:96::/112",
+			wantDNSIP:       "fd00:10:96::a",
+		},
+		{
+			name: "IPv6-first lists with defaulted IPv4 scalars",
+			networking: NetworkingSpec{
+				PodCIDR:      "10.244.0.0/16",
+				ServiceCIDR:  "10.96.0.0/12",
+				PodCIDRs:     []string{"fd00:10:244::/56", "10.244.0.0/16"},
+				ServiceCIDRs: []string{"fd00:10:96::/112", "10.96.0.0/12"},
+			},
+			wantPodCIDR:     "fd00:10:244::/56",
+			wantServiceCIDR: "fd00:10:96::/112",
+			wantDNSIP:       "fd00:10:96::a",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := NewTenantClusterValuesContext(&TenantCluster{Spec: TenantClusterSpec{Networking: tt.networking}})
+			if ctx.PodCIDR != tt.wantPodCIDR || ctx.ServiceCIDR != tt.wantServiceCIDR {
+				t.Errorf("PodCIDR, ServiceCIDR = %q, %q, want %q, %q", ctx.PodCIDR, ctx.ServiceCIDR, tt.wantPodCIDR, tt.wantServiceCIDR)
+			}
+			if ctx.DNSServiceIP != tt.wantDNSIP {
+				t.Errorf("DNSServiceIP = %q, want %q in the same family as serviceCIDR", ctx.DNSServiceIP, tt.wantDNSIP)
+			}
+		})
+	}
+}
+
+func TestNewTenantClusterValuesContextEnvironment(t *testing.T) {
+	tc := &TenantCluster{}
+	tc.Labels = map[string]string{LabelEnvironment: "staging"}
+	tc.Spec.Ownership = &OwnershipSpec{Environment: EnvironmentTierProd}
+
+	out, err := RenderValues(map[string]interface{}{
+		"env":  "${butler.environment}",
+		"tier": "${butler.environmentTier}",
+	}, NewTenantClusterValuesContext(tc))
+	if err != nil {
+		t.Fatalf("RenderValues() error = %v", err)
+	}
+	if out["env"] != "staging" || out["tier"] != string(EnvironmentTierProd) {
+		t.Errorf("RenderValues() = %v, want env from the label and tier from spec.ownership", out)
+	}
+
+	tc.Spec.Ownership = nil
+	if _, err := RenderValues(map[string]interface{}{"tier": "${butler.environmentTier}"}, NewTenantClusterValuesContext(tc)); err == nil {
+		t.Errorf("RenderValues() rendered environmentTier for a cluster without spec.ownership")
+	}
+}
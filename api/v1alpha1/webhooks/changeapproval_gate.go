@@ -0,0 +1,242 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	butlerv1alpha1 "github.com/butlerdotdev/butler-api/api/v1alpha1"
+)
+
+// ChangeApprovalGatePath is the path the change approval gate is served on.
+const ChangeApprovalGatePath = "/validate-butler-butlerlabs-dev-v1alpha1-change-approval"
+
+// SetupChangeApprovalGateWithManager registers the change approval gate.
+func SetupChangeApprovalGateWithManager(mgr ctrl.Manager) error {
+	mgr.GetWebhookServer().Register(ChangeApprovalGatePath, &webhook.Admission{
+		Handler: &ChangeApprovalGate{Reader: mgr.GetClient()},
+	})
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-butler-butlerlabs-dev-v1alpha1-change-approval,mutating=false,failurePolicy=fail,sideEffects=None,groups=butler.butlerlabs.dev,resources=*,verbs=create;update;delete,versions=v1alpha1,name=vchangeapproval-v1alpha1.butlerlabs.dev,admissionReviewVersions=v1
+
+// ChangeApprovalGate denies operations gated by the ButlerConfig approval
+// policy unless an approved, unexpired ChangeRequest authorizes them.
+//
+// ChangeRequests live in the namespace of their target, or in
+// butler-system for cluster-scoped targets. Creates and updates name the
+// ChangeRequest in the AnnotationChangeRequest annotation of the new
+// object. Deletes use the annotation of the stored object when set, and
+// otherwise any ChangeRequest in the namespace that authorizes the delete.
+// An update is only admitted if its spec equals the spec of the stored
+// object with the ChangeRequest's patch applied.
+//
+// Subresource requests, ChangeRequests themselves, updates that leave the
+// spec unchanged, and updates of objects that are being deleted are not
+// gated, so controllers can always manage metadata such as finalizers.
+type ChangeApprovalGate struct {
+	// Reader resolves the ButlerConfig and ChangeRequests.
+	Reader client.Reader
+
+	// Now returns the current time. Defaults to time.Now.
+	Now func() time.Time
+}
+
+var _ admission.Handler = &ChangeApprovalGate{}
+
+// gateScheme decodes Butler objects so that patched specs can be defaulted
+// before they are compared.
+var gateScheme = func() *runtime.Scheme {
+	s := runtime.NewScheme()
+	utilruntime.Must(butlerv1alpha1.AddToScheme(s))
+	return s
+}()
+
+// Handle implements admission.Handler.
+func (g *ChangeApprovalGate) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.SubResource != "" || req.Kind.Kind == "ChangeRequest" {
+		return admission.Allowed("")
+	}
+	op := butlerv1alpha1.ChangeOperation(req.Operation)
+	switch op {
+	case butlerv1alpha1.ChangeOperationCreate, butlerv1alpha1.ChangeOperationUpdate, butlerv1alpha1.ChangeOperationDelete:
+	default:
+		return admission.Allowed("")
+	}
+
+	var obj, old metav1.PartialObjectMetadata
+	if len(req.Object.Raw) > 0 {
+		if err := json.Unmarshal(req.Object.Raw, &obj); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+	}
+	if err := decodeOld(req, &old); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	if op == butlerv1alpha1.ChangeOperationUpdate && old.DeletionTimestamp != nil {
+		return admission.Allowed("")
+	}
+
+	target := butlerv1alpha1.ChangeTargetReference{
+		APIGroup:  req.Kind.Group,
+		Kind:      req.Kind.Kind,
+		Name:      req.Name,
+		Namespace: req.Namespace,
+	}
+	rule, err := approvalRule(ctx, g.Reader, target, op)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	if rule == nil {
+		return admission.Allowed("")
+	}
+	if op == butlerv1alpha1.ChangeOperationUpdate {
+		oldSpec, err := specOf(req.Kind.Kind, req.OldObject.Raw)
+		if err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		newSpec, err := specOf(req.Kind.Kind, req.Object.Raw)
+		if err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		if equality.Semantic.DeepEqual(oldSpec, newSpec) {
+			return admission.Allowed("")
+		}
+	}
+
+	namespace := req.Namespace
+	if namespace == "" {
+		namespace = "butler-system"
+	}
+	annotated := obj.Annotations[butlerv1alpha1.AnnotationChangeRequest]
+	if op == butlerv1alpha1.ChangeOperationDelete {
+		annotated = old.Annotations[butlerv1alpha1.AnnotationChangeRequest]
+	}
+
+	var candidates []butlerv1alpha1.ChangeRequest
+	switch {
+	case annotated != "":
+		cr := &butlerv1alpha1.ChangeRequest{}
+		if err := g.Reader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: annotated}, cr); err != nil {
+			if apierrors.IsNotFound(err) {
+				return admission.Denied(fmt.Sprintf("ChangeRequest %s/%s not found", namespace, annotated))
+			}
+			return admission.Errored(http.StatusInternalServerError, err)
+		}
+		candidates = append(candidates, *cr)
+	case op == butlerv1alpha1.ChangeOperationDelete:
+		list := &butlerv1alpha1.ChangeRequestList{}
+		if err := g.Reader.List(ctx, list, client.InNamespace(namespace)); err != nil {
+			return admission.Errored(http.StatusInternalServerError, err)
+		}
+		candidates = list.Items
+	}
+
+	var reasons []string
+	for i := range candidates {
+		cr := &candidates[i]
+		err := cr.Authorizes(rule, target, op, now(g.Now))
+		if err == nil && op == butlerv1alpha1.ChangeOperationUpdate {
+			err = patchMatches(cr, req.Kind.Kind, req.OldObject.Raw, req.Object.Raw)
+		}
+		if err == nil {
+			return admission.Allowed(fmt.Sprintf("authorized by ChangeRequest %s", cr.Name))
+		}
+		if annotated != "" {
+			reasons = append(reasons, err.Error())
+		}
+	}
+	reasons = append(reasons, fmt.Sprintf("%s of %s %s requires an approved ChangeRequest in namespace %s named by the %s annotation",
+		op, target.Kind, target.Name, namespace, butlerv1alpha1.AnnotationChangeRequest))
+	return admission.Denied(strings.Join(reasons, "; "))
+}
+
+// patchMatches returns nil if the spec of newRaw equals the spec of oldRaw
+// with the patch of cr applied. Known Butler kinds are defaulted on both
+// sides, so fields materialized by the defaulting webhook do not need to be
+// part of the patch.
+func patchMatches(cr *butlerv1alpha1.ChangeRequest, kind string, oldRaw, newRaw []byte) error {
+	var patched []byte
+	var err error
+	switch cr.Spec.PatchType {
+	case butlerv1alpha1.ChangePatchTypeJSON:
+		var p jsonpatch.Patch
+		if p, err = jsonpatch.DecodePatch([]byte(cr.Spec.Patch)); err == nil {
+			patched, err = p.Apply(oldRaw)
+		}
+	default:
+		patched, err = jsonpatch.MergePatch(oldRaw, []byte(cr.Spec.Patch))
+	}
+	if err != nil {
+		return fmt.Errorf("applying the patch of ChangeRequest %s: %w", cr.Name, err)
+	}
+
+	want, err := specOf(kind, patched)
+	if err != nil {
+		return err
+	}
+	got, err := specOf(kind, newRaw)
+	if err != nil {
+		return err
+	}
+	if !equality.Semantic.DeepEqual(want, got) {
+		return fmt.Errorf("the update does not match the patch of ChangeRequest %s", cr.Name)
+	}
+	return nil
+}
+
+// specOf returns the spec of raw as generic JSON. Known Butler kinds are
+// round-tripped through their Go type and defaulted, so that both sides of
+// a comparison are normalized the same way.
+func specOf(kind string, raw []byte) (interface{}, error) {
+	if obj, err := gateScheme.New(butlerv1alpha1.GroupVersion.WithKind(kind)); err == nil {
+		if err := json.Unmarshal(raw, obj); err != nil {
+			return nil, fmt.Errorf("decoding %s: %w", kind, err)
+		}
+		if d, ok := obj.(interface{ Default() }); ok {
+			d.Default()
+		}
+		if raw, err = json.Marshal(obj); err != nil {
+			return nil, err
+		}
+	}
+	var doc struct {
+		Spec interface{} `json:"spec"`
+	}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, fmt.Errorf("decoding %s: %w", kind, err)
+	}
+	return doc.Spec, nil
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	butlerv1alpha1 "github.com/butlerdotdev/butler-api/api/v1alpha1"
+)
+
+// SetupChangeRequestWebhookWithManager registers the ChangeRequest webhook.
+func SetupChangeRequestWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&butlerv1alpha1.ChangeRequest{}).
+		WithDefaulter(&ChangeRequestCustomDefaulter{Reader: mgr.GetClient()}).
+		WithValidator(&ChangeRequestCustomValidator{Reader: mgr.GetClient()}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-butler-butlerlabs-dev-v1alpha1-changerequest,mutating=true,failurePolicy=fail,sideEffects=None,groups=butler.butlerlabs.dev,resources=changerequests,verbs=create;update,versions=v1alpha1,name=mchangerequest-v1alpha1.butlerlabs.dev,admissionReviewVersions=v1
+
+// ChangeRequestCustomDefaulter records identities on ChangeRequests. On
+// create it sets spec.requester from the authenticated user and caps
+// spec.expiresAt at the approval rule's TTL. New entries in spec.reviews
+// get Reviewer, Groups, and Time from the authenticated user, overwriting
+// any values sent by the client.
+type ChangeRequestCustomDefaulter struct {
+	// Reader resolves the approval policy. Optional; without it the
+	// default TTL applies.
+	Reader client.Reader
+}
+
+var _ admission.CustomDefaulter = &ChangeRequestCustomDefaulter{}
+
+// Default implements admission.CustomDefaulter.
+func (d *ChangeRequestCustomDefaulter) Default(ctx context.Context, obj runtime.Object) error {
+	cr, err := expectType[*butlerv1alpha1.ChangeRequest](obj)
+	if err != nil {
+		return err
+	}
+	if cr.DeletionTimestamp != nil {
+		return nil
+	}
+	req, err := requestFrom(ctx)
+	if err != nil {
+		return err
+	}
+	old := &butlerv1alpha1.ChangeRequest{}
+	if err := decodeOld(req, old); err != nil {
+		return err
+	}
+
+	stamp := metav1.Now()
+	if req.Operation == admissionv1.Create {
+		cr.Spec.Requester = req.UserInfo.Username
+		rule, err := approvalRule(ctx, d.Reader, cr.Spec.TargetRef, cr.Spec.Operation)
+		if err != nil {
+			return err
+		}
+		expires := metav1.NewTime(stamp.Add(rule.GetTTL()))
+		if cr.Spec.ExpiresAt == nil || expires.Before(cr.Spec.ExpiresAt) {
+			cr.Spec.ExpiresAt = &expires
+		}
+	}
+	for i := range cr.Spec.Reviews {
+		r := &cr.Spec.Reviews[i]
+		if hasReview(old.Spec.Reviews, *r) {
+			continue
+		}
+		r.Reviewer = req.UserInfo.Username
+		r.Groups = req.UserInfo.Groups
+		r.Time = &stamp
+	}
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-butler-butlerlabs-dev-v1alpha1-changerequest,mutating=false,failurePolicy=fail,sideEffects=None,groups=butler.butlerlabs.dev,resources=changerequests,verbs=create;update,versions=v1alpha1,name=vchangerequest-v1alpha1.butlerlabs.dev,admissionReviewVersions=v1
+
+// ChangeRequestCustomValidator validates reviews: requesters cannot review
+// their own request, each user reviews at most once, and recorded reviews
+// cannot be changed or removed. With a Reader it also rejects reviews by
+// users the matching approval rule does not list.
+type ChangeRequestCustomValidator struct {
+	// Reader resolves the approval policy. Optional.
+	Reader client.Reader
+}
+
+var _ admission.CustomValidator = &ChangeRequestCustomValidator{}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *ChangeRequestCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	cr, err := expectType[*butlerv1alpha1.ChangeRequest](obj)
+	if err != nil {
+		return nil, err
+	}
+	findings, err := v.validateReviews(ctx, nil, cr)
+	if err != nil {
+		return nil, err
+	}
+	return toAdmission("ChangeRequest", cr.Name, findings)
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (v *ChangeRequestCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldCR, err := expectType[*butlerv1alpha1.ChangeRequest](oldObj)
+	if err != nil {
+		return nil, err
+	}
+	cr, err := expectType[*butlerv1alpha1.ChangeRequest](newObj)
+	if err != nil {
+		return nil, err
+	}
+	if cr.DeletionTimestamp != nil {
+		return nil, nil
+	}
+	findings, err := v.validateReviews(ctx, oldCR.Spec.Reviews, cr)
+	if err != nil {
+		return nil, err
+	}
+	return toAdmission("ChangeRequest", cr.Name, findings)
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (v *ChangeRequestCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *ChangeRequestCustomValidator) validateReviews(ctx context.Context, old []butlerv1alpha1.ChangeReview, cr *butlerv1alpha1.ChangeRequest) ([]butlerv1alpha1.ValidationFinding, error) {
+	var findings []butlerv1alpha1.ValidationFinding
+	deny := func(format string, args ...interface{}) {
+		findings = append(findings, butlerv1alpha1.ValidationFinding{
+			Field: "spec.reviews", Severity: butlerv1alpha1.ValidationSeverityError, Message: fmt.Sprintf(format, args...),
+		})
+	}
+	for _, r := range old {
+		if !hasReview(cr.Spec.Reviews, r) {
+			deny("review by %q cannot be changed or removed", r.Reviewer)
+		}
+	}
+
+	rule, err := approvalRule(ctx, v.Reader, cr.Spec.TargetRef, cr.Spec.Operation)
+	if err != nil {
+		return nil, err
+	}
+	reviewed := map[string]bool{}
+	for _, r := range old {
+		reviewed[r.Reviewer] = true
+	}
+	for _, r := range cr.Spec.Reviews {
+		if hasReview(old, r) {
+			continue
+		}
+		switch {
+		case r.Reviewer == cr.Spec.Requester:
+			deny("requesters cannot review their own request")
+		case reviewed[r.Reviewer]:
+			deny("user %q has already reviewed the request", r.Reviewer)
+		case !cr.CanReview(rule, r.Reviewer, r.Groups):
+			deny("user %q is not an approver for %s of %s", r.Reviewer, cr.Spec.Operation, cr.Spec.TargetRef.Kind)
+		}
+		reviewed[r.Reviewer] = true
+	}
+	return findings, nil
+}
+
+// hasReview returns true if reviews contains r unchanged.
+func hasReview(reviews []butlerv1alpha1.ChangeReview, r butlerv1alpha1.ChangeReview) bool {
+	for _, o := range reviews {
+		if equality.Semantic.DeepEqual(o, r) {
+			return true
+		}
+	}
+	return false
+}
+
+// approvalRule returns the ButlerConfig approval rule gating op on target,
+// or nil if there is none or r is nil.
+func approvalRule(ctx context.Context, r client.Reader, target butlerv1alpha1.ChangeTargetReference, op butlerv1alpha1.ChangeOperation) (*butlerv1alpha1.ApprovalRule, error) {
+	cfg, err := getButlerConfig(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	group := target.APIGroup
+	if group == "" {
+		group = butlerv1alpha1.GroupVersion.Group
+	}
+	return cfg.GetApprovalRule(group, target.Kind, op), nil
+}
+
+// now returns the current time, or the result of clock when set.
+func now(clock func() time.Time) time.Time {
+	if clock != nil {
+		return clock()
+	}
+	return time.Now()
+}
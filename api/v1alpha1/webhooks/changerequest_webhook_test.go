@@ -0,0 +1,273 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	butlerv1alpha1 "github.com/butlerdotdev/butler-api/api/v1alpha1"
+)
+
+// approvalConfig returns a ButlerConfig requiring approval from the sre
+// group to update or delete TenantClusters.
+func approvalConfig() *butlerv1alpha1.ButlerConfig {
+	return &butlerv1alpha1.ButlerConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: butlerv1alpha1.ButlerConfigName},
+		Spec: butlerv1alpha1.ButlerConfigSpec{
+			ApprovalPolicy: &butlerv1alpha1.ApprovalPolicy{Rules: []butlerv1alpha1.ApprovalRule{{
+				Kind:       "TenantCluster",
+				Operations: []butlerv1alpha1.ChangeOperation{butlerv1alpha1.ChangeOperationUpdate, butlerv1alpha1.ChangeOperationDelete},
+				Approvers:  []string{"sre"},
+			}}},
+		},
+	}
+}
+
+func changeRequest(op butlerv1alpha1.ChangeOperation, reviews ...butlerv1alpha1.ChangeReview) *butlerv1alpha1.ChangeRequest {
+	return &butlerv1alpha1.ChangeRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "scale-payments", Namespace: "team-a"},
+		Spec: butlerv1alpha1.ChangeRequestSpec{
+			TargetRef: butlerv1alpha1.ChangeTargetReference{Kind: "TenantCluster", Name: "payments", Namespace: "team-a"},
+			Operation: op,
+			Patch:     `{"spec":{"workers":{"replicas":5}}}`,
+			Reason:    "holiday traffic",
+			Requester: "alice@example.com",
+			Reviews:   reviews,
+		},
+	}
+}
+
+func TestChangeRequestIdentities(t *testing.T) {
+	r := newReader(t, approvalConfig())
+	d := &ChangeRequestCustomDefaulter{Reader: r}
+	v := &ChangeRequestCustomValidator{Reader: r}
+
+	t.Run("requester and expiry are set on create", func(t *testing.T) {
+		cr := changeRequest(butlerv1alpha1.ChangeOperationUpdate)
+		cr.Spec.Requester = "bob@example.com"
+		far := metav1.NewTime(time.Now().Add(30 * 24 * time.Hour))
+		cr.Spec.ExpiresAt = &far
+		if err := d.Default(requestContext(t, "alice@example.com", nil, nil), cr); err != nil {
+			t.Fatal(err)
+		}
+		if cr.Spec.Requester != "alice@example.com" {
+			t.Errorf("requester = %q, want alice@example.com", cr.Spec.Requester)
+		}
+		if cr.Spec.ExpiresAt.After(time.Now().Add(72 * time.Hour)) {
+			t.Errorf("expiresAt = %v, want it capped at the 72h TTL", cr.Spec.ExpiresAt)
+		}
+	})
+
+	// review records a review as user and returns the resulting object
+	// and the validation error.
+	review := func(old *butlerv1alpha1.ChangeRequest, user string, groups []string, claimedBy string) (*butlerv1alpha1.ChangeRequest, error) {
+		cr := old.DeepCopy()
+		cr.Spec.Reviews = append(cr.Spec.Reviews, butlerv1alpha1.ChangeReview{Reviewer: claimedBy, Decision: butlerv1alpha1.ReviewDecisionApprove})
+		ctx := requestContext(t, user, groups, old)
+		if err := d.Default(ctx, cr); err != nil {
+			t.Fatal(err)
+		}
+		_, err := v.ValidateUpdate(ctx, old, cr)
+		return cr, err
+	}
+
+	t.Run("reviewer is taken from the authenticated user", func(t *testing.T) {
+		cr, err := review(changeRequest(butlerv1alpha1.ChangeOperationUpdate), "bob@example.com", []string{"sre"}, "carol@example.com")
+		wantErr(t, err, "")
+		got := cr.Spec.Reviews[0]
+		if got.Reviewer != "bob@example.com" || got.Time == nil || len(got.Groups) != 1 {
+			t.Errorf("review = %+v, want bob@example.com in sre with a time", got)
+		}
+	})
+
+	t.Run("requester cannot approve their own request", func(t *testing.T) {
+		_, err := review(changeRequest(butlerv1alpha1.ChangeOperationUpdate), "alice@example.com", []string{"sre"}, "bob@example.com")
+		wantErr(t, err, "requesters cannot review their own request")
+	})
+
+	t.Run("unlisted reviewer is rejected", func(t *testing.T) {
+		_, err := review(changeRequest(butlerv1alpha1.ChangeOperationUpdate), "mallory@example.com", []string{"dev"}, "bob@example.com")
+		wantErr(t, err, `user "mallory@example.com" is not an approver`)
+	})
+
+	t.Run("recorded reviews are immutable", func(t *testing.T) {
+		old := changeRequest(butlerv1alpha1.ChangeOperationUpdate, butlerv1alpha1.ChangeReview{
+			Reviewer: "bob@example.com", Groups: []string{"sre"}, Decision: butlerv1alpha1.ReviewDecisionReject,
+		})
+		edited := old.DeepCopy()
+		edited.Spec.Reviews[0].Decision = butlerv1alpha1.ReviewDecisionApprove
+		ctx := requestContext(t, "bob@example.com", []string{"sre"}, old)
+		if err := d.Default(ctx, edited); err != nil {
+			t.Fatal(err)
+		}
+		_, err := v.ValidateUpdate(ctx, old, edited)
+		wantErr(t, err, `review by "bob@example.com" cannot be changed or removed`)
+
+		_, err = review(old, "bob@example.com", []string{"sre"}, "")
+		wantErr(t, err, `user "bob@example.com" has already reviewed the request`)
+	})
+}
+
+// gateRequest returns an admission request for op on the TenantCluster
+// payments in team-a.
+func gateRequest(t *testing.T, op admissionv1.Operation, old, obj runtime.Object) admission.Request {
+	t.Helper()
+	encode := func(o runtime.Object) runtime.RawExtension {
+		if o == nil {
+			return runtime.RawExtension{}
+		}
+		raw, err := json.Marshal(o)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return runtime.RawExtension{Raw: raw}
+	}
+	return admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+		Kind:      metav1.GroupVersionKind{Group: butlerv1alpha1.GroupVersion.Group, Version: "v1alpha1", Kind: "TenantCluster"},
+		Name:      "payments",
+		Namespace: "team-a",
+		Operation: op,
+		Object:    encode(obj),
+		OldObject: encode(old),
+	}}
+}
+
+func TestChangeApprovalGate(t *testing.T) {
+	approved := changeRequest(butlerv1alpha1.ChangeOperationUpdate, butlerv1alpha1.ChangeReview{
+		Reviewer: "bob@example.com", Groups: []string{"sre"}, Decision: butlerv1alpha1.ReviewDecisionApprove,
+	})
+	unlisted := changeRequest(butlerv1alpha1.ChangeOperationUpdate, butlerv1alpha1.ChangeReview{
+		Reviewer: "mallory@example.com", Groups: []string{"dev"}, Decision: butlerv1alpha1.ReviewDecisionApprove,
+	})
+	unlisted.Name = "unlisted"
+	deletion := changeRequest(butlerv1alpha1.ChangeOperationDelete, approved.Spec.Reviews...)
+	deletion.Name = "retire-payments"
+
+	old := prodCluster()
+	old.Spec.Workers.Replicas = 3
+	annotated := func(name string, replicas int32) *butlerv1alpha1.TenantCluster {
+		tc := old.DeepCopy()
+		tc.Annotations = map[string]string{butlerv1alpha1.AnnotationChangeRequest: name}
+		tc.Spec.Workers.Replicas = replicas
+		return tc
+	}
+
+	withFinalizer := func(tc *butlerv1alpha1.TenantCluster, finalizer string) *butlerv1alpha1.TenantCluster {
+		tc = tc.DeepCopy()
+		tc.Finalizers = append(tc.Finalizers, finalizer)
+		return tc
+	}
+
+	tests := []struct {
+		name    string
+		objs    []client.Object
+		req     admission.Request
+		allowed bool
+		reason  string
+	}{
+		{
+			name:    "no approval policy",
+			req:     gateRequest(t, admissionv1.Update, old, annotated("", 5)),
+			allowed: true,
+		},
+		{
+			name:   "update without a ChangeRequest",
+			objs:   []client.Object{approvalConfig()},
+			req:    gateRequest(t, admissionv1.Update, old, annotated("", 5)),
+			reason: "requires an approved ChangeRequest",
+		},
+		{
+			name:    "approved update matching the patch",
+			objs:    []client.Object{approvalConfig(), approved},
+			req:     gateRequest(t, admissionv1.Update, old, annotated(approved.Name, 5)),
+			allowed: true,
+		},
+		{
+			name:   "approved update not matching the patch",
+			objs:   []client.Object{approvalConfig(), approved},
+			req:    gateRequest(t, admissionv1.Update, old, annotated(approved.Name, 9)),
+			reason: "does not match the patch",
+		},
+		{
+			name:   "approval by an unlisted reviewer",
+			objs:   []client.Object{approvalConfig(), unlisted},
+			req:    gateRequest(t, admissionv1.Update, old, annotated(unlisted.Name, 5)),
+			reason: "has 0 of 1 required approvals",
+		},
+		{
+			name:   "ChangeRequest for another operation",
+			objs:   []client.Object{approvalConfig(), deletion},
+			req:    gateRequest(t, admissionv1.Update, old, annotated(deletion.Name, 5)),
+			reason: "is for DELETE of TenantCluster payments",
+		},
+		{
+			name:    "metadata-only update without a ChangeRequest",
+			objs:    []client.Object{approvalConfig()},
+			req:     gateRequest(t, admissionv1.Update, old, withFinalizer(old, "butler.butlerlabs.dev/cleanup")),
+			allowed: true,
+		},
+		{
+			name:    "delete authorized by any ChangeRequest",
+			objs:    []client.Object{approvalConfig(), approved, deletion},
+			req:     gateRequest(t, admissionv1.Delete, old, nil),
+			allowed: true,
+		},
+		{
+			name:    "ungated operation",
+			objs:    []client.Object{approvalConfig()},
+			req:     gateRequest(t, admissionv1.Create, nil, old),
+			allowed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &ChangeApprovalGate{Reader: newReader(t, tt.objs...)}
+			resp := g.Handle(context.Background(), tt.req)
+			if resp.Allowed != tt.allowed {
+				t.Fatalf("Allowed = %v, want %v (%s)", resp.Allowed, tt.allowed, resp.Result.Message)
+			}
+			if tt.reason != "" && !strings.Contains(resp.Result.Message, tt.reason) {
+				t.Errorf("message %q does not contain %q", resp.Result.Message, tt.reason)
+			}
+		})
+	}
+
+	t.Run("expired ChangeRequest", func(t *testing.T) {
+		expired := approved.DeepCopy()
+		expires := metav1.NewTime(time.Now().Add(time.Hour))
+		expired.Spec.ExpiresAt = &expires
+		g := &ChangeApprovalGate{
+			Reader: newReader(t, approvalConfig(), expired),
+			Now:    func() time.Time { return expires.Add(time.Minute) },
+		}
+		resp := g.Handle(context.Background(), gateRequest(t, admissionv1.Update, old, annotated(approved.Name, 5)))
+		if resp.Allowed || !strings.Contains(resp.Result.Message, "has expired") {
+			t.Errorf("response = %v %q, want denied as expired", resp.Allowed, resp.Result.Message)
+		}
+	})
+}
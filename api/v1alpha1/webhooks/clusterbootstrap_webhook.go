@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	butlerv1alpha1 "github.com/butlerdotdev/butler-api/api/v1alpha1"
+)
+
+// SetupClusterBootstrapWebhookWithManager registers the ClusterBootstrap webhook.
+func SetupClusterBootstrapWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&butlerv1alpha1.ClusterBootstrap{}).
+		WithDefaulter(&ClusterBootstrapCustomDefaulter{}).
+		WithValidator(&ClusterBootstrapCustomValidator{Reader: mgr.GetClient()}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-butler-butlerlabs-dev-v1alpha1-clusterbootstrap,mutating=true,failurePolicy=fail,sideEffects=None,groups=butler.butlerlabs.dev,resources=clusterbootstraps,verbs=create;update,versions=v1alpha1,name=mclusterbootstrap-v1alpha1.butlerlabs.dev,admissionReviewVersions=v1
+
+// ClusterBootstrapCustomDefaulter sets defaults on ClusterBootstraps with
+// ClusterBootstrap.Default and reconciles the deprecated
+// addons.loadBalancer.addressPool with network.loadBalancerPool. A legacy
+// address pool that is invalid or conflicts with network.loadBalancerPool
+// is rejected.
+type ClusterBootstrapCustomDefaulter struct{}
+
+var _ admission.CustomDefaulter = &ClusterBootstrapCustomDefaulter{}
+
+// Default implements admission.CustomDefaulter.
+func (d *ClusterBootstrapCustomDefaulter) Default(_ context.Context, obj runtime.Object) error {
+	cb, err := expectType[*butlerv1alpha1.ClusterBootstrap](obj)
+	if err != nil {
+		return err
+	}
+	if cb.DeletionTimestamp != nil {
+		return nil
+	}
+	cb.Default()
+	_, err = cb.NormalizeLoadBalancerPool()
+	return err
+}
+
+// +kubebuilder:webhook:path=/validate-butler-butlerlabs-dev-v1alpha1-clusterbootstrap,mutating=false,failurePolicy=fail,sideEffects=None,groups=butler.butlerlabs.dev,resources=clusterbootstraps,verbs=create;update,versions=v1alpha1,name=vclusterbootstrap-v1alpha1.butlerlabs.dev,admissionReviewVersions=v1
+
+// ClusterBootstrapCustomValidator validates ClusterBootstraps: node
+// settings, the network configuration, and the load balancer pool. With a
+// Reader it also rejects new bootstraps whose cluster name is already in
+// use.
+type ClusterBootstrapCustomValidator struct {
+	// Reader resolves related objects. Optional.
+	Reader client.Reader
+}
+
+var _ admission.CustomValidator = &ClusterBootstrapCustomValidator{}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *ClusterBootstrapCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	cb, err := expectType[*butlerv1alpha1.ClusterBootstrap](obj)
+	if err != nil {
+		return nil, err
+	}
+	findings := butlerv1alpha1.ValidateObject(cb)
+	collision, err := clusterNameCollision(ctx, v.Reader, cb.Spec.Cluster.Name, "spec.cluster.name", client.ObjectKeyFromObject(cb))
+	if err != nil {
+		return nil, err
+	}
+	if collision != nil {
+		findings = append(findings, *collision)
+	}
+	return toAdmission("ClusterBootstrap", cb.Name, findings)
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (v *ClusterBootstrapCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldCB, err := expectType[*butlerv1alpha1.ClusterBootstrap](oldObj)
+	if err != nil {
+		return nil, err
+	}
+	cb, err := expectType[*butlerv1alpha1.ClusterBootstrap](newObj)
+	if err != nil {
+		return nil, err
+	}
+	if cb.DeletionTimestamp != nil {
+		return nil, nil
+	}
+	findings := butlerv1alpha1.ValidateObject(cb)
+	findings = append(findings, planFindings(butlerv1alpha1.PlanClusterBootstrapChange(&oldCB.Spec, &cb.Spec))...)
+	return toAdmission("ClusterBootstrap", cb.Name, findings)
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (v *ClusterBootstrapCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
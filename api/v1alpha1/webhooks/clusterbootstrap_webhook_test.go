@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	butlerv1alpha1 "github.com/butlerdotdev/butler-api/api/v1alpha1"
+)
+
+func TestClusterBootstrapCustomValidator(t *testing.T) {
+	ctx := context.Background()
+	bootstrap := func(vip string) *butlerv1alpha1.ClusterBootstrap {
+		return &butlerv1alpha1.ClusterBootstrap{
+			ObjectMeta: metav1.ObjectMeta{Name: "mgmt", Namespace: "butler-system"},
+			Spec: butlerv1alpha1.ClusterBootstrapSpec{
+				Provider: "proxmox",
+				Cluster:  butlerv1alpha1.ClusterBootstrapClusterSpec{Name: "mgmt"},
+				Network: butlerv1alpha1.ClusterBootstrapNetworkSpec{
+					VIP:              vip,
+					LoadBalancerPool: &butlerv1alpha1.LoadBalancerPoolSpec{Start: "10.0.0.100", End: "10.0.0.120"},
+				},
+			},
+		}
+	}
+	v := &ClusterBootstrapCustomValidator{}
+
+	_, err := v.ValidateCreate(ctx, bootstrap("10.0.0.10"))
+	wantErr(t, err, "")
+
+	_, err = v.ValidateCreate(ctx, bootstrap("10.0.0.110"))
+	wantErr(t, err, "must not be within loadBalancerPool")
+
+	oldCB := bootstrap("10.0.0.10")
+	cb := bootstrap("10.0.0.10")
+	cb.Spec.Provider = "harvester"
+	_, err = v.ValidateUpdate(ctx, oldCB, cb)
+	wantErr(t, err, "provider is immutable")
+
+	cb = bootstrap("10.0.0.10")
+	cb.Spec.Cluster.Name = "mgmt-2"
+	_, err = v.ValidateUpdate(ctx, oldCB, cb)
+	wantErr(t, err, "cluster.name is immutable")
+
+	other := bootstrap("10.0.0.10")
+	other.Namespace = "butler-dr"
+	tc := &butlerv1alpha1.TenantCluster{ObjectMeta: metav1.ObjectMeta{Name: "mgmt", Namespace: "team-a"}}
+	for _, existing := range []client.Object{other, tc} {
+		v := &ClusterBootstrapCustomValidator{Reader: newReader(t, existing)}
+		_, err = v.ValidateCreate(ctx, bootstrap("10.0.0.10"))
+		wantErr(t, err, `cluster name "mgmt" is already used by`)
+	}
+}
+
+func TestClusterBootstrapCustomDefaulter(t *testing.T) {
+	cb := &butlerv1alpha1.ClusterBootstrap{ObjectMeta: metav1.ObjectMeta{Name: "mgmt", Namespace: "butler-system"}}
+	if err := (&ClusterBootstrapCustomDefaulter{}).Default(context.Background(), cb); err != nil {
+		t.Fatal(err)
+	}
+	if cb.Spec.ControlPlaneExposure == nil || cb.Spec.ControlPlaneExposure.Mode != butlerv1alpha1.ControlPlaneExposureModeLoadBalancer {
+		t.Errorf("controlPlaneExposure = %+v, want LoadBalancer mode", cb.Spec.ControlPlaneExposure)
+	}
+}
+
+func TestClusterBootstrapCustomDefaulterLegacyAddressPool(t *testing.T) {
+	d := &ClusterBootstrapCustomDefaulter{}
+	bootstrap := func(legacy string, pool *butlerv1alpha1.LoadBalancerPoolSpec) *butlerv1alpha1.ClusterBootstrap {
+		cb := &butlerv1alpha1.ClusterBootstrap{ObjectMeta: metav1.ObjectMeta{Name: "mgmt", Namespace: "butler-system"}}
+		cb.Spec.Addons.LoadBalancer = &butlerv1alpha1.LoadBalancerAddonSpec{AddressPool: legacy}
+		cb.Spec.Network.LoadBalancerPool = pool
+		return cb
+	}
+
+	cb := bootstrap("10.40.0.0/28", nil)
+	wantErr(t, d.Default(context.Background(), cb), "")
+	if got := cb.Spec.Network.LoadBalancerPool.ToAddressRange(); got != "10.40.0.0-10.40.0.15" {
+		t.Errorf("network.loadBalancerPool = %q, want 10.40.0.0-10.40.0.15", got)
+	}
+	if got := cb.Spec.Addons.LoadBalancer.AddressPool; got != "10.40.0.0-10.40.0.15" {
+		t.Errorf("addons.loadBalancer.addressPool = %q, want the canonical range", got)
+	}
+
+	cb = bootstrap("10.40.0.0/28", &butlerv1alpha1.LoadBalancerPoolSpec{Start: "10.40.0.200", End: "10.40.0.250"})
+	wantErr(t, d.Default(context.Background(), cb), "conflicts with network.loadBalancerPool")
+}
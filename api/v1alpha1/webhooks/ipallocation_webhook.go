@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	butlerv1alpha1 "github.com/butlerdotdev/butler-api/api/v1alpha1"
+)
+
+// SetupIPAllocationWebhookWithManager registers the IPAllocation webhook.
+func SetupIPAllocationWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&butlerv1alpha1.IPAllocation{}).
+		WithValidator(&IPAllocationCustomValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-butler-butlerlabs-dev-v1alpha1-ipallocation,mutating=false,failurePolicy=fail,sideEffects=None,groups=butler.butlerlabs.dev,resources=ipallocations,verbs=create;update,versions=v1alpha1,name=vipallocation-v1alpha1.butlerlabs.dev,admissionReviewVersions=v1
+
+// IPAllocationCustomValidator validates IPAllocations: a pinned range must
+// not end before it starts.
+type IPAllocationCustomValidator struct{}
+
+var _ admission.CustomValidator = &IPAllocationCustomValidator{}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *IPAllocationCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	a, err := expectType[*butlerv1alpha1.IPAllocation](obj)
+	if err != nil {
+		return nil, err
+	}
+	return toAdmission("IPAllocation", a.Name, butlerv1alpha1.ValidateObject(a))
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (v *IPAllocationCustomValidator) ValidateUpdate(ctx context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	a, err := expectType[*butlerv1alpha1.IPAllocation](newObj)
+	if err != nil {
+		return nil, err
+	}
+	if a.DeletionTimestamp != nil {
+		return nil, nil
+	}
+	return v.ValidateCreate(ctx, a)
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (v *IPAllocationCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	butlerv1alpha1 "github.com/butlerdotdev/butler-api/api/v1alpha1"
+)
+
+func TestIPAllocationCustomValidator(t *testing.T) {
+	ctx := context.Background()
+	pinned := func(start, end string) *butlerv1alpha1.IPAllocation {
+		return &butlerv1alpha1.IPAllocation{
+			ObjectMeta: metav1.ObjectMeta{Name: "dns", Namespace: "butler-system"},
+			Spec: butlerv1alpha1.IPAllocationSpec{
+				PoolRef:     butlerv1alpha1.LocalObjectReference{Name: "lab"},
+				Type:        butlerv1alpha1.IPAllocationTypeLoadBalancer,
+				PinnedRange: &butlerv1alpha1.PinnedIPRange{StartAddress: start, EndAddress: end},
+			},
+		}
+	}
+	v := &IPAllocationCustomValidator{}
+
+	_, err := v.ValidateCreate(ctx, pinned("fd00::1", "fd00::9"))
+	wantErr(t, err, "")
+
+	_, err = v.ValidateCreate(ctx, pinned("fd00::9", "fd00::1"))
+	wantErr(t, err, "start fd00::9 is after end fd00::1")
+
+	_, err = v.ValidateUpdate(ctx, pinned("10.0.0.1", "10.0.0.9"), pinned("10.0.0.9", "10.0.0.1"))
+	wantErr(t, err, "start 10.0.0.9 is after end 10.0.0.1")
+}
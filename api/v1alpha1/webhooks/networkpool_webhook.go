@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	butlerv1alpha1 "github.com/butlerdotdev/butler-api/api/v1alpha1"
+)
+
+// SetupNetworkPoolWebhookWithManager registers the NetworkPool webhook.
+func SetupNetworkPoolWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&butlerv1alpha1.NetworkPool{}).
+		WithValidator(&NetworkPoolCustomValidator{Reader: mgr.GetClient()}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-butler-butlerlabs-dev-v1alpha1-networkpool,mutating=false,failurePolicy=fail,sideEffects=None,groups=butler.butlerlabs.dev,resources=networkpools,verbs=create;update,versions=v1alpha1,name=vnetworkpool-v1alpha1.butlerlabs.dev,admissionReviewVersions=v1
+
+// NetworkPoolCustomValidator validates NetworkPools: static assignments
+// must not overlap each other or reserved ranges. With a Reader it also
+// rejects changes to spec.cidr while IPAllocations from the pool exist;
+// the IPAllocations are listed rather than read from status.allocationCount,
+// which may lag behind.
+type NetworkPoolCustomValidator struct {
+	// Reader resolves related objects. Optional.
+	Reader client.Reader
+}
+
+var _ admission.CustomValidator = &NetworkPoolCustomValidator{}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *NetworkPoolCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	np, err := expectType[*butlerv1alpha1.NetworkPool](obj)
+	if err != nil {
+		return nil, err
+	}
+	return toAdmission("NetworkPool", np.Name, butlerv1alpha1.ValidateObject(np))
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (v *NetworkPoolCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldNP, err := expectType[*butlerv1alpha1.NetworkPool](oldObj)
+	if err != nil {
+		return nil, err
+	}
+	np, err := expectType[*butlerv1alpha1.NetworkPool](newObj)
+	if err != nil {
+		return nil, err
+	}
+	if np.DeletionTimestamp != nil {
+		return nil, nil
+	}
+	findings := butlerv1alpha1.ValidateObject(np)
+	if oldNP.Spec.CIDR != np.Spec.CIDR && v.Reader != nil {
+		n, err := v.activeAllocations(ctx, np)
+		if err != nil {
+			return nil, err
+		}
+		if n > 0 {
+			findings = append(findings, butlerv1alpha1.ValidationFinding{
+				Field:    "spec.cidr",
+				Severity: butlerv1alpha1.ValidationSeverityError,
+				Message:  fmt.Sprintf("spec.cidr cannot be changed while %d IPAllocations from the pool exist", n),
+			})
+		}
+	}
+	return toAdmission("NetworkPool", np.Name, findings)
+}
+
+// activeAllocations returns the number of IPAllocations from np that have
+// not been released.
+func (v *NetworkPoolCustomValidator) activeAllocations(ctx context.Context, np *butlerv1alpha1.NetworkPool) (int, error) {
+	var allocations butlerv1alpha1.IPAllocationList
+	if err := v.Reader.List(ctx, &allocations, client.InNamespace(np.Namespace)); err != nil {
+		return 0, fmt.Errorf("listing IPAllocations: %w", err)
+	}
+	n := 0
+	for _, a := range allocations.Items {
+		if a.Spec.PoolRef.Name == np.Name && a.Status.Phase != butlerv1alpha1.IPAllocationPhaseReleased {
+			n++
+		}
+	}
+	return n, nil
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (v *NetworkPoolCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
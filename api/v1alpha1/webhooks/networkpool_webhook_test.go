@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	butlerv1alpha1 "github.com/butlerdotdev/butler-api/api/v1alpha1"
+)
+
+func TestNetworkPoolCustomValidator(t *testing.T) {
+	ctx := context.Background()
+	pool := func(static ...butlerv1alpha1.StaticIPAssignment) *butlerv1alpha1.NetworkPool {
+		return &butlerv1alpha1.NetworkPool{
+			ObjectMeta: metav1.ObjectMeta{Name: "lab", Namespace: "butler-system"},
+			Spec: butlerv1alpha1.NetworkPoolSpec{
+				CIDR:              "10.40.0.0/24",
+				Reserved:          []butlerv1alpha1.ReservedRange{{CIDR: "10.40.0.0/28"}},
+				StaticAssignments: static,
+			},
+		}
+	}
+	assignment := func(name, start, end string) butlerv1alpha1.StaticIPAssignment {
+		return butlerv1alpha1.StaticIPAssignment{
+			Name: name, Owner: "netops",
+			Range: butlerv1alpha1.PinnedIPRange{StartAddress: start, EndAddress: end},
+		}
+	}
+	v := &NetworkPoolCustomValidator{}
+
+	_, err := v.ValidateCreate(ctx, pool(assignment("dns", "10.40.0.20", "10.40.0.21")))
+	wantErr(t, err, "")
+
+	_, err = v.ValidateCreate(ctx, pool(assignment("dns", "10.40.0.10", "10.40.0.21")))
+	wantErr(t, err, "overlaps reserved range")
+
+	np := pool(assignment("dns", "10.40.0.20", "10.40.0.21"), assignment("ntp", "10.40.0.21", "10.40.0.22"))
+	_, err = v.ValidateUpdate(ctx, pool(), np)
+	wantErr(t, err, `overlaps static assignment "dns"`)
+
+	allocation := func(name string, phase butlerv1alpha1.IPAllocationPhase) *butlerv1alpha1.IPAllocation {
+		return &butlerv1alpha1.IPAllocation{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "butler-system"},
+			Spec:       butlerv1alpha1.IPAllocationSpec{PoolRef: butlerv1alpha1.LocalObjectReference{Name: "lab"}},
+			Status:     butlerv1alpha1.IPAllocationStatus{Phase: phase},
+		}
+	}
+	resized := pool()
+	resized.Spec.CIDR = "10.40.0.0/23"
+
+	v = &NetworkPoolCustomValidator{Reader: newReader(t, allocation("payments-nodes", butlerv1alpha1.IPAllocationPhaseAllocated))}
+	_, err = v.ValidateUpdate(ctx, pool(), resized)
+	wantErr(t, err, "spec.cidr cannot be changed while 1 IPAllocations from the pool exist")
+
+	v = &NetworkPoolCustomValidator{Reader: newReader(t, allocation("payments-nodes", butlerv1alpha1.IPAllocationPhaseReleased))}
+	_, err = v.ValidateUpdate(ctx, pool(), resized)
+	wantErr(t, err, "")
+}
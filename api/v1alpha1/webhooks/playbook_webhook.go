@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	butlerv1alpha1 "github.com/butlerdotdev/butler-api/api/v1alpha1"
+)
+
+// SetupPlaybookWebhookWithManager registers the Playbook webhook.
+func SetupPlaybookWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&butlerv1alpha1.Playbook{}).
+		WithDefaulter(&PlaybookCustomDefaulter{}).
+		WithValidator(&PlaybookCustomValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-butler-butlerlabs-dev-v1alpha1-playbook,mutating=true,failurePolicy=fail,sideEffects=None,groups=butler.butlerlabs.dev,resources=playbooks/status,verbs=update,versions=v1alpha1,name=mplaybook-v1alpha1.butlerlabs.dev,admissionReviewVersions=v1
+
+// PlaybookCustomDefaulter records who approved each Approval step. Entries
+// added to status.approvals get ApprovedBy, Groups, and ApprovedAt from the
+// authenticated user, overwriting any values sent by the client.
+type PlaybookCustomDefaulter struct{}
+
+var _ admission.CustomDefaulter = &PlaybookCustomDefaulter{}
+
+// Default implements admission.CustomDefaulter.
+func (d *PlaybookCustomDefaulter) Default(ctx context.Context, obj runtime.Object) error {
+	pb, err := expectType[*butlerv1alpha1.Playbook](obj)
+	if err != nil {
+		return err
+	}
+	req, err := requestFrom(ctx)
+	if err != nil {
+		return err
+	}
+	old := &butlerv1alpha1.Playbook{}
+	if err := decodeOld(req, old); err != nil {
+		return err
+	}
+	now := metav1.Now()
+	for i := range pb.Status.Approvals {
+		a := &pb.Status.Approvals[i]
+		if old.GetApproval(a.Step) != nil {
+			continue
+		}
+		a.ApprovedBy = req.UserInfo.Username
+		a.Groups = req.UserInfo.Groups
+		a.ApprovedAt = &now
+	}
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-butler-butlerlabs-dev-v1alpha1-playbook,mutating=false,failurePolicy=fail,sideEffects=None,groups=butler.butlerlabs.dev,resources=playbooks/status,verbs=update,versions=v1alpha1,name=vplaybook-v1alpha1.butlerlabs.dev,admissionReviewVersions=v1
+
+// PlaybookCustomValidator validates approvals recorded in status.approvals:
+// new approvals must be for an Approval step by a user the step allows,
+// and recorded approvals cannot be changed or removed.
+type PlaybookCustomValidator struct{}
+
+var _ admission.CustomValidator = &PlaybookCustomValidator{}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *PlaybookCustomValidator) ValidateCreate(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (v *PlaybookCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldPB, err := expectType[*butlerv1alpha1.Playbook](oldObj)
+	if err != nil {
+		return nil, err
+	}
+	pb, err := expectType[*butlerv1alpha1.Playbook](newObj)
+	if err != nil {
+		return nil, err
+	}
+	var findings []butlerv1alpha1.ValidationFinding
+	deny := func(format string, args ...interface{}) {
+		findings = append(findings, butlerv1alpha1.ValidationFinding{
+			Field: "status.approvals", Severity: butlerv1alpha1.ValidationSeverityError, Message: fmt.Sprintf(format, args...),
+		})
+	}
+	for _, old := range oldPB.Status.Approvals {
+		if a := pb.GetApproval(old.Step); a == nil || !equality.Semantic.DeepEqual(*a, old) {
+			deny("approval of step %q cannot be changed or removed", old.Step)
+		}
+	}
+	for _, a := range pb.Status.Approvals {
+		if oldPB.GetApproval(a.Step) != nil {
+			continue
+		}
+		if s := pb.GetStep(a.Step); s == nil || s.Type != butlerv1alpha1.PlaybookStepApproval {
+			deny("step %q is not an Approval step", a.Step)
+		} else if !pb.CanApprove(a.Step, a.ApprovedBy, a.Groups) {
+			deny("user %q may not approve step %q", a.ApprovedBy, a.Step)
+		}
+	}
+	return toAdmission("Playbook", pb.Name, findings)
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (v *PlaybookCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	butlerv1alpha1 "github.com/butlerdotdev/butler-api/api/v1alpha1"
+)
+
+// requestContext returns a context carrying an admission request from user
+// with old as the old object.
+func requestContext(t *testing.T, user string, groups []string, old runtime.Object) context.Context {
+	t.Helper()
+	req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+		UserInfo: authenticationv1.UserInfo{Username: user, Groups: groups},
+	}}
+	if old != nil {
+		raw, err := json.Marshal(old)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Operation = admissionv1.Update
+		req.OldObject = runtime.RawExtension{Raw: raw}
+	} else {
+		req.Operation = admissionv1.Create
+	}
+	return admission.NewContextWithRequest(context.Background(), req)
+}
+
+func TestPlaybookApprovals(t *testing.T) {
+	playbook := func(approvals ...butlerv1alpha1.PlaybookApproval) *butlerv1alpha1.Playbook {
+		return &butlerv1alpha1.Playbook{
+			ObjectMeta: metav1.ObjectMeta{Name: "rotate", Namespace: "team-a"},
+			Spec: butlerv1alpha1.PlaybookSpec{
+				Steps: []butlerv1alpha1.PlaybookStep{
+					{Name: "sign-off", Type: butlerv1alpha1.PlaybookStepApproval,
+						Approval: &butlerv1alpha1.PlaybookApprovalStep{Approvers: []string{"sre"}}},
+					{Name: "rotate", Type: butlerv1alpha1.PlaybookStepRotateKubeconfig},
+				},
+			},
+			Status: butlerv1alpha1.PlaybookStatus{Approvals: approvals},
+		}
+	}
+	d := &PlaybookCustomDefaulter{}
+	v := &PlaybookCustomValidator{}
+
+	// approve records an approval of step as user and returns the
+	// resulting object and the validation error.
+	approve := func(old *butlerv1alpha1.Playbook, user string, groups []string, step, claimedBy string) (*butlerv1alpha1.Playbook, error) {
+		pb := old.DeepCopy()
+		pb.Status.Approvals = append(pb.Status.Approvals, butlerv1alpha1.PlaybookApproval{Step: step, ApprovedBy: claimedBy})
+		ctx := requestContext(t, user, groups, old)
+		if err := d.Default(ctx, pb); err != nil {
+			t.Fatal(err)
+		}
+		_, err := v.ValidateUpdate(ctx, old, pb)
+		return pb, err
+	}
+
+	t.Run("approver is taken from the authenticated user", func(t *testing.T) {
+		pb, err := approve(playbook(), "bob@example.com", []string{"sre"}, "sign-off", "alice@example.com")
+		wantErr(t, err, "")
+		a := pb.GetApproval("sign-off")
+		if a.ApprovedBy != "bob@example.com" || a.ApprovedAt == nil {
+			t.Errorf("approval = %+v, want approvedBy bob@example.com with a time", a)
+		}
+		if !pb.IsStepApproved("sign-off") {
+			t.Error("IsStepApproved(sign-off) = false")
+		}
+	})
+
+	t.Run("unlisted user cannot approve by naming a listed one", func(t *testing.T) {
+		_, err := approve(playbook(), "mallory@example.com", []string{"dev"}, "sign-off", "alice@example.com")
+		wantErr(t, err, `user "mallory@example.com" may not approve step "sign-off"`)
+	})
+
+	t.Run("only Approval steps can be approved", func(t *testing.T) {
+		_, err := approve(playbook(), "bob@example.com", []string{"sre"}, "rotate", "")
+		wantErr(t, err, `step "rotate" is not an Approval step`)
+	})
+
+	t.Run("recorded approvals are immutable", func(t *testing.T) {
+		recorded := butlerv1alpha1.PlaybookApproval{Step: "sign-off", ApprovedBy: "bob@example.com", Groups: []string{"sre"}}
+		old := playbook(recorded)
+
+		edited := old.DeepCopy()
+		edited.Status.Approvals[0].ApprovedBy = "alice@example.com"
+		ctx := requestContext(t, "alice@example.com", []string{"sre"}, old)
+		if err := d.Default(ctx, edited); err != nil {
+			t.Fatal(err)
+		}
+		_, err := v.ValidateUpdate(ctx, old, edited)
+		wantErr(t, err, `approval of step "sign-off" cannot be changed or removed`)
+
+		_, err = v.ValidateUpdate(ctx, old, playbook())
+		wantErr(t, err, `approval of step "sign-off" cannot be changed or removed`)
+
+		// Status updates that leave approvals alone, such as the
+		// controller recording progress, are admitted.
+		progressed := old.DeepCopy()
+		progressed.Status.CurrentStep = 1
+		_, err = v.ValidateUpdate(ctx, old, progressed)
+		wantErr(t, err, "")
+	})
+
+	t.Run("missing admission request", func(t *testing.T) {
+		wantErr(t, d.Default(context.Background(), playbook()), "reading admission request")
+	})
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	butlerv1alpha1 "github.com/butlerdotdev/butler-api/api/v1alpha1"
+)
+
+// SetupProviderConfigWebhookWithManager registers the ProviderConfig webhook.
+func SetupProviderConfigWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&butlerv1alpha1.ProviderConfig{}).
+		WithValidator(&ProviderConfigCustomValidator{Reader: mgr.GetClient()}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-butler-butlerlabs-dev-v1alpha1-providerconfig,mutating=false,failurePolicy=fail,sideEffects=None,groups=butler.butlerlabs.dev,resources=providerconfigs,verbs=create;update,versions=v1alpha1,name=vproviderconfig-v1alpha1.butlerlabs.dev,admissionReviewVersions=v1
+
+// ProviderConfigCustomValidator validates ProviderConfigs. With a Reader it
+// also checks that referenced NetworkPools allow the ProviderConfig and
+// its team.
+type ProviderConfigCustomValidator struct {
+	// Reader resolves related objects. Optional.
+	Reader client.Reader
+}
+
+var _ admission.CustomValidator = &ProviderConfigCustomValidator{}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *ProviderConfigCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	pc, err := expectType[*butlerv1alpha1.ProviderConfig](obj)
+	if err != nil {
+		return nil, err
+	}
+	var related []runtime.Object
+	if pc.Spec.Network != nil {
+		for _, ref := range pc.Spec.Network.PoolRefs {
+			related, err = getRelated(ctx, v.Reader, types.NamespacedName{Namespace: pc.Namespace, Name: ref.Name},
+				&butlerv1alpha1.NetworkPool{}, related)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return toAdmission("ProviderConfig", pc.Name, butlerv1alpha1.ValidateObjectWith(pc, related...))
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (v *ProviderConfigCustomValidator) ValidateUpdate(ctx context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	pc, err := expectType[*butlerv1alpha1.ProviderConfig](newObj)
+	if err != nil {
+		return nil, err
+	}
+	if pc.DeletionTimestamp != nil {
+		return nil, nil
+	}
+	return v.ValidateCreate(ctx, pc)
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (v *ProviderConfigCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
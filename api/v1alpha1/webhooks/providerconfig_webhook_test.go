@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	butlerv1alpha1 "github.com/butlerdotdev/butler-api/api/v1alpha1"
+)
+
+func TestProviderConfigCustomValidator(t *testing.T) {
+	ctx := context.Background()
+	pool := &butlerv1alpha1.NetworkPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "lab", Namespace: "butler-system"},
+		Spec: butlerv1alpha1.NetworkPoolSpec{
+			CIDR:         "10.40.0.0/24",
+			ProviderRefs: []butlerv1alpha1.ProviderReference{{Name: "harvester-a"}},
+		},
+	}
+	provider := func(name string) *butlerv1alpha1.ProviderConfig {
+		return &butlerv1alpha1.ProviderConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "butler-system"},
+			Spec: butlerv1alpha1.ProviderConfigSpec{
+				Provider: butlerv1alpha1.ProviderTypeHarvester,
+				Network: &butlerv1alpha1.ProviderNetworkConfig{
+					Mode:     "ipam",
+					PoolRefs: []butlerv1alpha1.PoolReference{{Name: "lab"}},
+				},
+			},
+		}
+	}
+	v := &ProviderConfigCustomValidator{Reader: newReader(t, pool)}
+
+	_, err := v.ValidateCreate(ctx, provider("harvester-a"))
+	wantErr(t, err, "")
+
+	_, err = v.ValidateCreate(ctx, provider("harvester-b"))
+	wantErr(t, err, "does not allow this ProviderConfig")
+
+	// Without a reader, pool references are not resolved.
+	_, err = (&ProviderConfigCustomValidator{}).ValidateCreate(ctx, provider("harvester-b"))
+	wantErr(t, err, "")
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	butlerv1alpha1 "github.com/butlerdotdev/butler-api/api/v1alpha1"
+)
+
+// SetupTeamWebhookWithManager registers the Team webhook.
+func SetupTeamWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&butlerv1alpha1.Team{}).
+		WithValidator(&TeamCustomValidator{Reader: mgr.GetClient()}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-butler-butlerlabs-dev-v1alpha1-team,mutating=false,failurePolicy=fail,sideEffects=None,groups=butler.butlerlabs.dev,resources=teams,verbs=delete,versions=v1alpha1,name=vteam-v1alpha1.butlerlabs.dev,admissionReviewVersions=v1
+
+// TeamCustomValidator enforces the Forbid deletion policy: a Team is not
+// deleted while it has TenantClusters or Workspaces. Other policies are
+// carried out by the Team finalizer.
+type TeamCustomValidator struct {
+	// Reader lists the Team's resources. Without it deletes are admitted
+	// and the finalizer alone blocks them.
+	Reader client.Reader
+}
+
+var _ admission.CustomValidator = &TeamCustomValidator{}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *TeamCustomValidator) ValidateCreate(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (v *TeamCustomValidator) ValidateUpdate(_ context.Context, _, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (v *TeamCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	team, err := expectType[*butlerv1alpha1.Team](obj)
+	if err != nil {
+		return nil, err
+	}
+	if v.Reader == nil || team.GetDeletionPolicy() != butlerv1alpha1.TeamDeletionPolicyForbid {
+		return nil, nil
+	}
+	blocking, err := v.teamResources(ctx, team)
+	if err != nil {
+		return nil, err
+	}
+	if len(blocking) == 0 {
+		return nil, nil
+	}
+	names := make([]string, 0, len(blocking))
+	for _, r := range blocking {
+		names = append(names, fmt.Sprintf("%s %s/%s", r.Kind, r.Namespace, r.Name))
+	}
+	gr := butlerv1alpha1.GroupVersion.WithResource("teams").GroupResource()
+	return nil, apierrors.NewForbidden(gr, team.Name, fmt.Errorf(
+		"deletionPolicy is Forbid and the team still has %d resources (%s); delete them or change deletionPolicy first",
+		len(blocking), strings.Join(names, ", ")))
+}
+
+// teamResources returns the TenantClusters that reference the team or live
+// in the team namespace, and the Workspaces in the team namespace. Outside
+// Enforced multi-tenancy teamRef is optional, so the namespace is checked
+// as well.
+func (v *TeamCustomValidator) teamResources(ctx context.Context, team *butlerv1alpha1.Team) ([]butlerv1alpha1.TeamResourceReference, error) {
+	var refs []butlerv1alpha1.TeamResourceReference
+	clusters := &butlerv1alpha1.TenantClusterList{}
+	if err := v.Reader.List(ctx, clusters); err != nil {
+		return nil, fmt.Errorf("listing TenantClusters: %w", err)
+	}
+	for _, tc := range clusters.Items {
+		inNamespace := team.Status.Namespace != "" && tc.Namespace == team.Status.Namespace
+		if inNamespace || (tc.Spec.TeamRef != nil && tc.Spec.TeamRef.Name == team.Name) {
+			refs = append(refs, butlerv1alpha1.TeamResourceReference{Kind: "TenantCluster", Name: tc.Name, Namespace: tc.Namespace})
+		}
+	}
+	if team.Status.Namespace == "" {
+		return refs, nil
+	}
+	workspaces := &butlerv1alpha1.WorkspaceList{}
+	if err := v.Reader.List(ctx, workspaces, client.InNamespace(team.Status.Namespace)); err != nil {
+		return nil, fmt.Errorf("listing Workspaces: %w", err)
+	}
+	for _, ws := range workspaces.Items {
+		refs = append(refs, butlerv1alpha1.TeamResourceReference{Kind: "Workspace", Name: ws.Name, Namespace: ws.Namespace})
+	}
+	return refs, nil
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	butlerv1alpha1 "github.com/butlerdotdev/butler-api/api/v1alpha1"
+)
+
+func TestTeamValidateDelete(t *testing.T) {
+	team := func(policy butlerv1alpha1.TeamDeletionPolicy) *butlerv1alpha1.Team {
+		return &butlerv1alpha1.Team{
+			ObjectMeta: metav1.ObjectMeta{Name: "team-a"},
+			Spec:       butlerv1alpha1.TeamSpec{DeletionPolicy: policy},
+			Status:     butlerv1alpha1.TeamStatus{Namespace: "team-a"},
+		}
+	}
+	cluster := prodCluster()
+	cluster.Spec.TeamRef = &butlerv1alpha1.LocalObjectReference{Name: "team-a"}
+	other := prodCluster()
+	other.Name, other.Namespace = "billing", "team-b"
+	other.Spec.TeamRef = &butlerv1alpha1.LocalObjectReference{Name: "team-b"}
+	unreferenced := prodCluster()
+	unreferenced.Name = "ledger"
+	workspace := &butlerv1alpha1.Workspace{ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: "team-a"}}
+
+	tests := []struct {
+		name    string
+		team    *butlerv1alpha1.Team
+		objs    []client.Object
+		wantErr string
+	}{
+		{
+			name: "no resources",
+			team: team(""),
+			objs: []client.Object{other},
+		},
+		{
+			name:    "forbid with a cluster",
+			team:    team(""),
+			objs:    []client.Object{cluster, other},
+			wantErr: "still has 1 resources (TenantCluster team-a/payments)",
+		},
+		{
+			name:    "forbid with a cluster in the team namespace without teamRef",
+			team:    team(butlerv1alpha1.TeamDeletionPolicyForbid),
+			objs:    []client.Object{unreferenced, other},
+			wantErr: "still has 1 resources (TenantCluster team-a/ledger)",
+		},
+		{
+			name:    "forbid with a workspace",
+			team:    team(butlerv1alpha1.TeamDeletionPolicyForbid),
+			objs:    []client.Object{workspace},
+			wantErr: "Workspace team-a/dev",
+		},
+		{
+			name: "cascade delete",
+			team: team(butlerv1alpha1.TeamDeletionPolicyCascadeDelete),
+			objs: []client.Object{cluster, workspace},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &TeamCustomValidator{Reader: newReader(t, tt.objs...)}
+			_, err := v.ValidateDelete(context.Background(), tt.team)
+			wantErr(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	butlerv1alpha1 "github.com/butlerdotdev/butler-api/api/v1alpha1"
+)
+
+// SetupTenantClusterWebhookWithManager registers the TenantCluster webhook.
+func SetupTenantClusterWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&butlerv1alpha1.TenantCluster{}).
+		WithDefaulter(&TenantClusterCustomDefaulter{}).
+		WithValidator(&TenantClusterCustomValidator{Reader: mgr.GetClient()}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-butler-butlerlabs-dev-v1alpha1-tenantcluster,mutating=true,failurePolicy=fail,sideEffects=None,groups=butler.butlerlabs.dev,resources=tenantclusters,verbs=create;update,versions=v1alpha1,name=mtenantcluster-v1alpha1.butlerlabs.dev,admissionReviewVersions=v1
+
+// TenantClusterCustomDefaulter sets defaults on TenantClusters with
+// TenantCluster.Default.
+type TenantClusterCustomDefaulter struct{}
+
+var _ admission.CustomDefaulter = &TenantClusterCustomDefaulter{}
+
+// Default implements admission.CustomDefaulter.
+func (d *TenantClusterCustomDefaulter) Default(_ context.Context, obj runtime.Object) error {
+	tc, err := expectType[*butlerv1alpha1.TenantCluster](obj)
+	if err != nil {
+		return err
+	}
+	if tc.DeletionTimestamp == nil {
+		tc.Default()
+	}
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-butler-butlerlabs-dev-v1alpha1-tenantcluster,mutating=false,failurePolicy=fail,sideEffects=None,groups=butler.butlerlabs.dev,resources=tenantclusters,verbs=create;update;delete,versions=v1alpha1,name=vtenantcluster-v1alpha1.butlerlabs.dev,admissionReviewVersions=v1
+
+// TenantClusterCustomValidator validates TenantClusters. With a Reader it
+// also enforces the environment policies in the ButlerConfig, checks the
+// Kubernetes version against the default KubernetesVersionCatalog, checks
+// worker firmware against the provider, checks the host of virtual
+// clusters, and rejects new clusters whose name is already in use.
+// Updates that lower the environment tier of a cluster are denied unless
+// an approval rule gates TenantCluster updates.
+type TenantClusterCustomValidator struct {
+	// Reader resolves related objects. Optional.
+	Reader client.Reader
+}
+
+var _ admission.CustomValidator = &TenantClusterCustomValidator{}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *TenantClusterCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	tc, err := expectType[*butlerv1alpha1.TenantCluster](obj)
+	if err != nil {
+		return nil, err
+	}
+	findings, err := v.validate(ctx, tc)
+	if err != nil {
+		return nil, err
+	}
+	collision, err := clusterNameCollision(ctx, v.Reader, tc.Name, "metadata.name", client.ObjectKeyFromObject(tc))
+	if err != nil {
+		return nil, err
+	}
+	if collision != nil {
+		findings = append(findings, *collision)
+	}
+	return toAdmission("TenantCluster", tc.Name, findings)
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (v *TenantClusterCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldTC, err := expectType[*butlerv1alpha1.TenantCluster](oldObj)
+	if err != nil {
+		return nil, err
+	}
+	tc, err := expectType[*butlerv1alpha1.TenantCluster](newObj)
+	if err != nil {
+		return nil, err
+	}
+	if tc.DeletionTimestamp != nil {
+		return nil, nil
+	}
+	findings, err := v.validate(ctx, tc)
+	if err != nil {
+		return nil, err
+	}
+	findings = append(findings, planFindings(butlerv1alpha1.PlanTenantClusterChange(&oldTC.Spec, &tc.Spec))...)
+	cfg, err := getButlerConfig(ctx, v.Reader)
+	if err != nil {
+		return nil, err
+	}
+	if msg := cfg.EnvironmentTierChangeViolation(oldTC, tc); msg != "" {
+		findings = append(findings, butlerv1alpha1.ValidationFinding{
+			Field: "spec.ownership.environment", Severity: butlerv1alpha1.ValidationSeverityError, Message: msg,
+		})
+	}
+	return toAdmission("TenantCluster", tc.Name, findings)
+}
+
+// ValidateDelete implements admission.CustomValidator. Deletion is denied
+// while spec.deletionProtection is set.
+func (v *TenantClusterCustomValidator) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	tc, err := expectType[*butlerv1alpha1.TenantCluster](obj)
+	if err != nil {
+		return nil, err
+	}
+	if tc.Spec.DeletionProtection {
+		return nil, fmt.Errorf("TenantCluster %s/%s has deletionProtection enabled; set spec.deletionProtection to false before deleting it",
+			tc.Namespace, tc.Name)
+	}
+	return nil, nil
+}
+
+func (v *TenantClusterCustomValidator) validate(ctx context.Context, tc *butlerv1alpha1.TenantCluster) ([]butlerv1alpha1.ValidationFinding, error) {
+	var related []runtime.Object
+	var err error
+	related, err = getRelated(ctx, v.Reader, types.NamespacedName{Name: butlerv1alpha1.ButlerConfigName},
+		&butlerv1alpha1.ButlerConfig{}, related)
+	if err != nil {
+		return nil, err
+	}
+	related, err = getRelated(ctx, v.Reader, types.NamespacedName{Name: butlerv1alpha1.DefaultKubernetesVersionCatalogName},
+		&butlerv1alpha1.KubernetesVersionCatalog{}, related)
+	if err != nil {
+		return nil, err
+	}
+	if ref := tc.Spec.ProviderConfigRef; ref != nil {
+		namespace := ref.Namespace
+		if namespace == "" {
+			namespace = "butler-system"
+		}
+		related, err = getRelated(ctx, v.Reader, types.NamespacedName{Namespace: namespace, Name: ref.Name},
+			&butlerv1alpha1.ProviderConfig{}, related)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if tc.Spec.IsVirtual() && tc.Spec.Virtual != nil {
+		host := tc.Spec.Virtual.HostClusterRef
+		related, err = getRelated(ctx, v.Reader, types.NamespacedName{Namespace: host.Namespace, Name: host.Name},
+			&butlerv1alpha1.TenantCluster{}, related)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return butlerv1alpha1.ValidateObjectWith(tc, related...), nil
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	butlerv1alpha1 "github.com/butlerdotdev/butler-api/api/v1alpha1"
+)
+
+func newReader(t *testing.T, objs ...client.Object) client.Reader {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := butlerv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+// wantErr checks that err is nil when want is empty, or contains want.
+func wantErr(t *testing.T, err error, want string) {
+	t.Helper()
+	switch {
+	case want == "" && err != nil:
+		t.Fatalf("unexpected error: %v", err)
+	case want != "" && err == nil:
+		t.Fatalf("expected error containing %q", want)
+	case want != "" && !strings.Contains(err.Error(), want):
+		t.Fatalf("error %q does not contain %q", err, want)
+	}
+}
+
+func prodCluster() *butlerv1alpha1.TenantCluster {
+	return &butlerv1alpha1.TenantCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "payments", Namespace: "team-a"},
+		Spec: butlerv1alpha1.TenantClusterSpec{
+			KubernetesVersion: "v1.33.4",
+			Ownership: &butlerv1alpha1.OwnershipSpec{
+				OwnerEmail:  "payments@example.com",
+				Environment: butlerv1alpha1.EnvironmentTierProd,
+			},
+		},
+	}
+}
+
+func TestTenantClusterCustomValidator(t *testing.T) {
+	ctx := context.Background()
+	cfg := &butlerv1alpha1.ButlerConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: butlerv1alpha1.ButlerConfigName},
+		Spec: butlerv1alpha1.ButlerConfigSpec{
+			EnvironmentPolicies: []butlerv1alpha1.EnvironmentPolicy{
+				{Tier: butlerv1alpha1.EnvironmentTierProd, RequireDeletionProtection: true},
+			},
+		},
+	}
+
+	t.Run("create without reader skips environment policies", func(t *testing.T) {
+		_, err := (&TenantClusterCustomValidator{}).ValidateCreate(ctx, prodCluster())
+		wantErr(t, err, "")
+	})
+
+	t.Run("create enforces environment policy", func(t *testing.T) {
+		v := &TenantClusterCustomValidator{Reader: newReader(t, cfg)}
+		_, err := v.ValidateCreate(ctx, prodCluster())
+		wantErr(t, err, "deletionProtection")
+
+		tc := prodCluster()
+		tc.Spec.DeletionProtection = true
+		_, err = v.ValidateCreate(ctx, tc)
+		wantErr(t, err, "")
+	})
+
+	t.Run("update rejects forbidden changes", func(t *testing.T) {
+		oldTC := prodCluster()
+		tc := prodCluster()
+		tc.Spec.BootstrapProvider = butlerv1alpha1.BootstrapProviderKubeadm
+		_, err := (&TenantClusterCustomValidator{}).ValidateUpdate(ctx, oldTC, tc)
+		wantErr(t, err, "bootstrapProvider is immutable")
+	})
+
+	t.Run("update rejects identity and network changes", func(t *testing.T) {
+		withRefs := func() *butlerv1alpha1.TenantCluster {
+			tc := prodCluster()
+			tc.Spec.TeamRef = &butlerv1alpha1.LocalObjectReference{Name: "team-a"}
+			tc.Spec.ProviderConfigRef = &butlerv1alpha1.ProviderReference{Name: "harvester"}
+			tc.Spec.Networking = butlerv1alpha1.NetworkingSpec{PodCIDR: "10.244.0.0/16", ServiceCIDR: "10.96.0.0/12"}
+			return tc
+		}
+		tests := []struct {
+			name   string
+			mutate func(*butlerv1alpha1.TenantCluster)
+			want   string
+		}{
+			{"provider", func(tc *butlerv1alpha1.TenantCluster) { tc.Spec.ProviderConfigRef.Name = "nutanix" }, "providerConfigRef cannot be changed"},
+			{"team", func(tc *butlerv1alpha1.TenantCluster) { tc.Spec.TeamRef = nil }, "teamRef cannot be changed"},
+			{"pod CIDR", func(tc *butlerv1alpha1.TenantCluster) { tc.Spec.Networking.PodCIDR = "10.200.0.0/16" }, "podCIDR is immutable"},
+			{"service CIDR", func(tc *butlerv1alpha1.TenantCluster) { tc.Spec.Networking.ServiceCIDR = "10.100.0.0/16" }, "serviceCIDR is immutable"},
+			{"DNS service IP", func(tc *butlerv1alpha1.TenantCluster) { tc.Spec.Networking.DNSServiceIP = "10.96.0.53" }, "dnsServiceIP is immutable"},
+		}
+		for _, tt := range tests {
+			tc := withRefs()
+			tt.mutate(tc)
+			_, err := (&TenantClusterCustomValidator{}).ValidateUpdate(ctx, withRefs(), tc)
+			wantErr(t, err, tt.want)
+		}
+	})
+
+	t.Run("update denies lowering the environment tier", func(t *testing.T) {
+		oldTC := prodCluster()
+		oldTC.Spec.DeletionProtection = true
+		tc := oldTC.DeepCopy()
+		tc.Spec.Ownership.Environment = butlerv1alpha1.EnvironmentTierDev
+		tc.Spec.DeletionProtection = false
+
+		v := &TenantClusterCustomValidator{Reader: newReader(t, cfg)}
+		_, err := v.ValidateUpdate(ctx, oldTC, tc)
+		wantErr(t, err, "environment tier cannot be lowered from prod to dev")
+
+		gated := approvalConfig()
+		gated.Spec.EnvironmentPolicies = cfg.Spec.EnvironmentPolicies
+		v = &TenantClusterCustomValidator{Reader: newReader(t, gated)}
+		_, err = v.ValidateUpdate(ctx, oldTC, tc)
+		wantErr(t, err, "")
+	})
+
+	t.Run("update applies the default tier to unclassified clusters", func(t *testing.T) {
+		withDefault := cfg.DeepCopy()
+		withDefault.Spec.DefaultEnvironmentTier = butlerv1alpha1.EnvironmentTierProd
+		oldTC := prodCluster()
+		oldTC.Spec.DeletionProtection = true
+		tc := oldTC.DeepCopy()
+		tc.Spec.Ownership = nil
+		tc.Spec.DeletionProtection = false
+
+		v := &TenantClusterCustomValidator{Reader: newReader(t, withDefault)}
+		_, err := v.ValidateUpdate(ctx, oldTC, tc)
+		wantErr(t, err, "prod clusters require deletionProtection")
+
+		_, err = (&TenantClusterCustomValidator{Reader: newReader(t, cfg)}).ValidateUpdate(ctx, oldTC, tc)
+		wantErr(t, err, "lowered from prod to unclassified")
+	})
+
+	t.Run("create rejects cluster name in use", func(t *testing.T) {
+		other := prodCluster()
+		other.Namespace = "team-b"
+		v := &TenantClusterCustomValidator{Reader: newReader(t, other)}
+		_, err := v.ValidateCreate(ctx, prodCluster())
+		wantErr(t, err, `cluster name "payments" is already used by TenantCluster team-b/payments`)
+
+		bootstrap := &butlerv1alpha1.ClusterBootstrap{
+			ObjectMeta: metav1.ObjectMeta{Name: "mgmt", Namespace: "butler-system"},
+			Spec:       butlerv1alpha1.ClusterBootstrapSpec{Cluster: butlerv1alpha1.ClusterBootstrapClusterSpec{Name: "payments"}},
+		}
+		v = &TenantClusterCustomValidator{Reader: newReader(t, bootstrap)}
+		_, err = v.ValidateCreate(ctx, prodCluster())
+		wantErr(t, err, "already used by ClusterBootstrap butler-system/mgmt")
+
+		v = &TenantClusterCustomValidator{Reader: newReader(t, prodCluster())}
+		_, err = v.ValidateCreate(ctx, prodCluster())
+		wantErr(t, err, "")
+	})
+
+	t.Run("update of deleting cluster is admitted", func(t *testing.T) {
+		v := &TenantClusterCustomValidator{Reader: newReader(t, cfg)}
+		tc := prodCluster()
+		tc.DeletionTimestamp = &metav1.Time{}
+		_, err := v.ValidateUpdate(ctx, prodCluster(), tc)
+		wantErr(t, err, "")
+	})
+
+	t.Run("delete honors deletion protection", func(t *testing.T) {
+		tc := prodCluster()
+		tc.Spec.DeletionProtection = true
+		_, err := (&TenantClusterCustomValidator{}).ValidateDelete(ctx, tc)
+		wantErr(t, err, "deletionProtection enabled")
+
+		_, err = (&TenantClusterCustomValidator{}).ValidateDelete(ctx, prodCluster())
+		wantErr(t, err, "")
+	})
+
+	t.Run("wrong type", func(t *testing.T) {
+		_, err := (&TenantClusterCustomValidator{}).ValidateCreate(ctx, &butlerv1alpha1.NetworkPool{})
+		wantErr(t, err, "expected a *v1alpha1.TenantCluster")
+	})
+}
+
+func TestTenantClusterCustomDefaulter(t *testing.T) {
+	d := &TenantClusterCustomDefaulter{}
+	tc := prodCluster()
+	if err := d.Default(context.Background(), tc); err != nil {
+		t.Fatal(err)
+	}
+	if tc.Spec.Networking.DNSServiceIP != "10.96.0.10" {
+		t.Errorf("dnsServiceIP = %q, want 10.96.0.10", tc.Spec.Networking.DNSServiceIP)
+	}
+
+	deleting := prodCluster()
+	deleting.DeletionTimestamp = &metav1.Time{}
+	if err := d.Default(context.Background(), deleting); err != nil {
+		t.Fatal(err)
+	}
+	if deleting.Spec.Networking.DNSServiceIP != "" {
+		t.Errorf("deleting cluster was defaulted")
+	}
+}
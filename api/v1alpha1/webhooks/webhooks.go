@@ -0,0 +1,231 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhooks implements the Butler admission webhooks.
+//
+// Defaulting webhooks call the Default method of the object, which
+// materializes defaults that would otherwise be resolved by getters in each
+// controller, so the persisted spec is what the controllers act on and
+// GitOps diffs are deterministic.
+//
+// Each validator applies the checks of v1alpha1.ValidateObjectWith to the
+// incoming object: error findings deny the request and warning findings are
+// returned as admission warnings. When a validator has a Reader, it also
+// resolves the objects the checks depend on, such as the ButlerConfig or
+// referenced NetworkPools; without one, only checks that need no other
+// objects are applied. Updates are additionally checked against the
+// change plan for the kind, and objects that are being deleted are always
+// admitted so that finalizers can be removed.
+//
+// Approvals are recorded with the identity of the user who made them: the
+// Playbook defaulter sets the approver of new entries in status.approvals
+// from the authenticated user, and the validator rejects changes to
+// approvals already recorded. The ChangeRequest webhooks do the same for
+// spec.requester and spec.reviews, and the change approval gate denies
+// operations matched by the ButlerConfig approval policy unless an
+// approved, unexpired ChangeRequest authorizes them.
+//
+// TenantCluster names and ClusterBootstrap cluster names share one
+// namespace, because provider resources such as VM names and anti-affinity
+// groups are derived from them. Validators with a Reader reject a new
+// cluster whose name is already in use by another cluster of either kind.
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	butlerv1alpha1 "github.com/butlerdotdev/butler-api/api/v1alpha1"
+)
+
+// SetupWebhooksWithManager registers every Butler admission webhook with
+// the manager. Validators read related objects through the manager's
+// cached client.
+func SetupWebhooksWithManager(mgr ctrl.Manager) error {
+	for _, setup := range []func(ctrl.Manager) error{
+		SetupClusterBootstrapWebhookWithManager,
+		SetupTenantClusterWebhookWithManager,
+		SetupNetworkPoolWebhookWithManager,
+		SetupIPAllocationWebhookWithManager,
+		SetupProviderConfigWebhookWithManager,
+		SetupWorkspaceWebhookWithManager,
+		SetupTeamWebhookWithManager,
+		SetupPlaybookWebhookWithManager,
+		SetupChangeRequestWebhookWithManager,
+		SetupChangeApprovalGateWithManager,
+	} {
+		if err := setup(mgr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// toAdmission converts validation findings for an object into an admission
+// response. Error findings are combined into a single Invalid error.
+func toAdmission(kind, name string, findings []butlerv1alpha1.ValidationFinding) (admission.Warnings, error) {
+	var warnings admission.Warnings
+	var errs field.ErrorList
+	for _, f := range findings {
+		switch f.Severity {
+		case butlerv1alpha1.ValidationSeverityError:
+			errs = append(errs, &field.Error{
+				Type: field.ErrorTypeInvalid, Field: f.Field, BadValue: field.OmitValueType{}, Detail: f.Message,
+			})
+		default:
+			if f.Field != "" {
+				warnings = append(warnings, fmt.Sprintf("%s: %s", f.Field, f.Message))
+			} else {
+				warnings = append(warnings, f.Message)
+			}
+		}
+	}
+	if len(errs) == 0 {
+		return warnings, nil
+	}
+	gk := butlerv1alpha1.GroupVersion.WithKind(kind).GroupKind()
+	return warnings, apierrors.NewInvalid(gk, name, errs)
+}
+
+// planFindings converts a change plan into findings. Forbidden changes are
+// errors and disruptive changes are warnings.
+func planFindings(plan *butlerv1alpha1.ChangePlan) []butlerv1alpha1.ValidationFinding {
+	var findings []butlerv1alpha1.ValidationFinding
+	for _, c := range plan.Changes {
+		switch c.Impact {
+		case butlerv1alpha1.ChangeImpactForbidden:
+			findings = append(findings, butlerv1alpha1.ValidationFinding{
+				Field: c.Field, Severity: butlerv1alpha1.ValidationSeverityError, Message: c.Description,
+			})
+		case butlerv1alpha1.ChangeImpactDisruptive:
+			findings = append(findings, butlerv1alpha1.ValidationFinding{
+				Field: c.Field, Severity: butlerv1alpha1.ValidationSeverityWarning, Message: c.Description,
+			})
+		}
+	}
+	return findings
+}
+
+// getButlerConfig returns the ButlerConfig, or nil if it does not exist or
+// r is nil.
+func getButlerConfig(ctx context.Context, r client.Reader) (*butlerv1alpha1.ButlerConfig, error) {
+	if r == nil {
+		return nil, nil
+	}
+	cfg := &butlerv1alpha1.ButlerConfig{}
+	if err := r.Get(ctx, types.NamespacedName{Name: butlerv1alpha1.ButlerConfigName}, cfg); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("getting ButlerConfig: %w", err)
+	}
+	return cfg, nil
+}
+
+// getRelated fetches the object at key into obj and appends it to related.
+// Missing objects and a nil reader are not errors.
+func getRelated(ctx context.Context, r client.Reader, key client.ObjectKey, obj client.Object, related []runtime.Object) ([]runtime.Object, error) {
+	if r == nil {
+		return related, nil
+	}
+	if err := r.Get(ctx, key, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return related, nil
+		}
+		return related, fmt.Errorf("getting %T %s: %w", obj, key, err)
+	}
+	return append(related, obj), nil
+}
+
+// clusterNameCollision returns a finding when name is used by a
+// TenantCluster or ClusterBootstrap other than the object at self, or nil.
+// fieldPath is the field of the incoming object that holds the name.
+func clusterNameCollision(ctx context.Context, r client.Reader, name, fieldPath string, self client.ObjectKey) (*butlerv1alpha1.ValidationFinding, error) {
+	if r == nil {
+		return nil, nil
+	}
+	collision := func(kind string, key client.ObjectKey) *butlerv1alpha1.ValidationFinding {
+		return &butlerv1alpha1.ValidationFinding{
+			Field:    fieldPath,
+			Severity: butlerv1alpha1.ValidationSeverityError,
+			Message: fmt.Sprintf("cluster name %q is already used by %s %s; cluster names must be unique across namespaces",
+				name, kind, key),
+		}
+	}
+
+	var clusters butlerv1alpha1.TenantClusterList
+	if err := r.List(ctx, &clusters); err != nil {
+		return nil, fmt.Errorf("listing TenantClusters: %w", err)
+	}
+	for i := range clusters.Items {
+		key := client.ObjectKeyFromObject(&clusters.Items[i])
+		if clusters.Items[i].Name == name && key != self {
+			return collision("TenantCluster", key), nil
+		}
+	}
+	var bootstraps butlerv1alpha1.ClusterBootstrapList
+	if err := r.List(ctx, &bootstraps); err != nil {
+		return nil, fmt.Errorf("listing ClusterBootstraps: %w", err)
+	}
+	for i := range bootstraps.Items {
+		key := client.ObjectKeyFromObject(&bootstraps.Items[i])
+		if bootstraps.Items[i].Spec.Cluster.Name == name && key != self {
+			return collision("ClusterBootstrap", key), nil
+		}
+	}
+	return nil, nil
+}
+
+// requestFrom returns the admission request being handled, which carries
+// the authenticated user and, for updates, the old object.
+func requestFrom(ctx context.Context) (admission.Request, error) {
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return admission.Request{}, fmt.Errorf("reading admission request: %w", err)
+	}
+	return req, nil
+}
+
+// decodeOld decodes the old object of req into obj. obj is left unchanged
+// when req has no old object, as for creates.
+func decodeOld(req admission.Request, obj runtime.Object) error {
+	if len(req.OldObject.Raw) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(req.OldObject.Raw, obj); err != nil {
+		return fmt.Errorf("decoding old object: %w", err)
+	}
+	return nil
+}
+
+// expectType returns obj as a T or an error naming the expected kind.
+func expectType[T runtime.Object](obj runtime.Object) (T, error) {
+	t, ok := obj.(T)
+	if !ok {
+		var zero T
+		return zero, fmt.Errorf("expected a %T but got a %T", zero, obj)
+	}
+	return t, nil
+}
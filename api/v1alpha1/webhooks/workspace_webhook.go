@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	butlerv1alpha1 "github.com/butlerdotdev/butler-api/api/v1alpha1"
+)
+
+// TenantServiceLookup looks up Services inside tenant clusters. It is
+// implemented by the Butler server, which holds tenant cluster clients.
+type TenantServiceLookup interface {
+	// ServiceExists reports whether the Service namespace/name exists in the
+	// tenant cluster identified by the TenantCluster key.
+	ServiceExists(ctx context.Context, cluster types.NamespacedName, namespace, name string) (bool, error)
+}
+
+// SetupWorkspaceWebhookWithManager registers the Workspace webhook. The
+// registered validator has no TenantServiceLookup, so colocation targets
+// are only checked by validators that the Butler server registers itself.
+func SetupWorkspaceWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&butlerv1alpha1.Workspace{}).
+		WithValidator(&WorkspaceCustomValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-butler-butlerlabs-dev-v1alpha1-workspace,mutating=false,failurePolicy=fail,sideEffects=None,groups=butler.butlerlabs.dev,resources=workspaces,verbs=create;update,versions=v1alpha1,name=vworkspace-v1alpha1.butlerlabs.dev,admissionReviewVersions=v1
+
+// WorkspaceCustomValidator validates Workspaces. With Services it also
+// checks that the colocation target exists in the tenant cluster when a
+// workspace is created or its colocation target changes.
+type WorkspaceCustomValidator struct {
+	// Services looks up colocation targets. Optional.
+	Services TenantServiceLookup
+}
+
+var _ admission.CustomValidator = &WorkspaceCustomValidator{}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *WorkspaceCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	ws, err := expectType[*butlerv1alpha1.Workspace](obj)
+	if err != nil {
+		return nil, err
+	}
+	if err := v.validateColocation(ctx, ws); err != nil {
+		return nil, err
+	}
+	return toAdmission("Workspace", ws.Name, butlerv1alpha1.ValidateObjectWith(ws))
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (v *WorkspaceCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	ws, err := expectType[*butlerv1alpha1.Workspace](newObj)
+	if err != nil {
+		return nil, err
+	}
+	old, err := expectType[*butlerv1alpha1.Workspace](oldObj)
+	if err != nil {
+		return nil, err
+	}
+	if ws.DeletionTimestamp != nil {
+		return nil, nil
+	}
+	if !colocationEqual(old.Spec.Colocate, ws.Spec.Colocate) || old.Spec.ClusterRef != ws.Spec.ClusterRef {
+		if err := v.validateColocation(ctx, ws); err != nil {
+			return nil, err
+		}
+	}
+	return toAdmission("Workspace", ws.Name, butlerv1alpha1.ValidateObjectWith(ws))
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (v *WorkspaceCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateColocation denies the workspace when its colocation target does
+// not exist in the tenant cluster.
+func (v *WorkspaceCustomValidator) validateColocation(ctx context.Context, ws *butlerv1alpha1.Workspace) error {
+	c := ws.Spec.Colocate
+	if c == nil || v.Services == nil {
+		return nil
+	}
+	cluster := types.NamespacedName{Namespace: ws.Namespace, Name: ws.Spec.ClusterRef.Name}
+	ok, err := v.Services.ServiceExists(ctx, cluster, c.GetNamespace(), c.Service)
+	if err != nil {
+		return fmt.Errorf("looking up colocation target in cluster %s: %w", cluster, err)
+	}
+	if ok {
+		return nil
+	}
+	gk := butlerv1alpha1.GroupVersion.WithKind("Workspace").GroupKind()
+	return apierrors.NewInvalid(gk, ws.Name, field.ErrorList{
+		field.NotFound(field.NewPath("spec", "colocate", "service"), c.GetNamespace()+"/"+c.Service),
+	})
+}
+
+// colocationEqual compares colocation targets after defaulting.
+func colocationEqual(a, b *butlerv1alpha1.WorkspaceColocation) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Service == b.Service && a.GetNamespace() == b.GetNamespace()
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	butlerv1alpha1 "github.com/butlerdotdev/butler-api/api/v1alpha1"
+)
+
+// fakeServices reports the listed "cluster/namespace/name" Services as existing.
+type fakeServices map[string]bool
+
+func (f fakeServices) ServiceExists(_ context.Context, cluster types.NamespacedName, namespace, name string) (bool, error) {
+	return f[cluster.Name+"/"+namespace+"/"+name], nil
+}
+
+func TestWorkspaceCustomValidator(t *testing.T) {
+	ctx := context.Background()
+	workspace := func(colocate *butlerv1alpha1.WorkspaceColocation) *butlerv1alpha1.Workspace {
+		return &butlerv1alpha1.Workspace{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: "team-a"},
+			Spec: butlerv1alpha1.WorkspaceSpec{
+				ClusterRef: butlerv1alpha1.LocalObjectReference{Name: "dev-cluster"},
+				Owner:      "dev@example.com",
+				Image:      "ghcr.io/butlerdotdev/workspace:latest",
+				Colocate:   colocate,
+			},
+		}
+	}
+	v := &WorkspaceCustomValidator{Services: fakeServices{"dev-cluster/data/postgres": true}}
+
+	_, err := v.ValidateCreate(ctx, workspace(nil))
+	wantErr(t, err, "")
+
+	_, err = v.ValidateCreate(ctx, workspace(&butlerv1alpha1.WorkspaceColocation{Service: "postgres", Namespace: "data"}))
+	wantErr(t, err, "")
+
+	_, err = v.ValidateCreate(ctx, workspace(&butlerv1alpha1.WorkspaceColocation{Service: "postgres"}))
+	wantErr(t, err, "spec.colocate.service: Not found")
+
+	// Only a changed target is looked up again on update.
+	old := workspace(&butlerv1alpha1.WorkspaceColocation{Service: "redis", Namespace: "data"})
+	updated := workspace(&butlerv1alpha1.WorkspaceColocation{Service: "redis", Namespace: "data", Mode: butlerv1alpha1.ColocationModeRequired})
+	_, err = v.ValidateUpdate(ctx, old, updated)
+	wantErr(t, err, "")
+
+	updated = workspace(&butlerv1alpha1.WorkspaceColocation{Service: "cache", Namespace: "data"})
+	_, err = v.ValidateUpdate(ctx, old, updated)
+	wantErr(t, err, "Not found")
+
+	// Without a lookup, colocation targets are not checked.
+	_, err = (&WorkspaceCustomValidator{}).ValidateCreate(ctx, workspace(&butlerv1alpha1.WorkspaceColocation{Service: "postgres"}))
+	wantErr(t, err, "")
+}
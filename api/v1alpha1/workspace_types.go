@@ -17,6 +17,9 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"strings"
+	"time"
+
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -45,6 +48,23 @@ const (
 	WorkspacePhaseFailed WorkspacePhase = "Failed"
 )
 
+// ColocationMode controls how strictly a workspace is scheduled near its
+// colocation target.
+// +kubebuilder:validation:Enum=Preferred;Required
+type ColocationMode string
+
+const (
+	// ColocationModePreferred schedules near the target when possible.
+	ColocationModePreferred ColocationMode = "Preferred"
+
+	// ColocationModeRequired only schedules the workspace where the target's
+	// pods run. The workspace stays Pending if no such node has capacity.
+	ColocationModeRequired ColocationMode = "Required"
+)
+
+// DefaultColocationTopologyKey is the topology key used when none is set.
+const DefaultColocationTopologyKey = "kubernetes.io/hostname"
+
 // Workspace condition types.
 const (
 	// WorkspaceConditionPVCReady indicates the PVC is created and bound.
@@ -62,11 +82,20 @@ const (
 	// WorkspaceConditionSSHReady indicates the SSH server is accessible.
 	WorkspaceConditionSSHReady = "SSHReady"
 
+	// WorkspaceConditionSecretsSynced indicates injected Secrets were copied
+	// to the tenant cluster and are allowed by team policy.
+	WorkspaceConditionSecretsSynced = "SecretsSynced"
+
+	// WorkspaceConditionColocated indicates the colocation target was resolved
+	// and pod affinity was applied to the workspace pod.
+	WorkspaceConditionColocated = "Colocated"
+
 	// WorkspaceConditionReady indicates the workspace is fully operational.
 	WorkspaceConditionReady = "Ready"
 )
 
 // WorkspaceSpec defines the desired state of a Workspace.
+// +kubebuilder:validation:XValidation:rule="!has(self.className) || !has(self.resources)",message="className and resources are mutually exclusive"
 type WorkspaceSpec struct {
 	// ClusterRef references the TenantCluster this workspace runs in.
 	// The workspace pod is created in the tenant cluster's "workspaces" namespace.
@@ -100,7 +129,14 @@ type WorkspaceSpec struct {
 	// +optional
 	EnvFrom *WorkspaceEnvSource `json:"envFrom,omitempty"`
 
+	// ClassName is the WorkspaceClass that sizes the workspace pod.
+	// Mutually exclusive with Resources. If neither is set, the default
+	// WorkspaceClass is used, falling back to the Resources defaults.
+	// +optional
+	ClassName string `json:"className,omitempty"`
+
 	// Resources for the workspace pod.
+	// Prefer ClassName; free-form resources may be restricted by team policy.
 	// +optional
 	Resources *WorkspaceResources `json:"resources,omitempty"`
 
@@ -129,12 +165,156 @@ type WorkspaceSpec struct {
 
 	// SSHPublicKeys for authorized access. If empty, keys are resolved
 	// from the owner's User profile (spec.sshKeys).
+	// When an SSH certificate authority is configured, certificates signed
+	// by it are accepted in addition to these keys.
 	// +optional
 	SSHPublicKeys []string `json:"sshPublicKeys,omitempty"`
 
 	// EditorConfig holds per-editor configuration (e.g. Neovim config repo).
 	// +optional
 	EditorConfig *EditorConfig `json:"editorConfig,omitempty"`
+
+	// Secrets injects Secrets from the team namespace into the workspace.
+	// Each Secret must be allowed by the team's workspacePolicy. The controller
+	// copies it to the tenant cluster's workspaces namespace and keeps the
+	// copy in sync.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Secrets []WorkspaceSecret `json:"secrets,omitempty"`
+
+	// Sidecars are auxiliary containers run alongside the workspace container
+	// in the same pod (e.g., Postgres or Redis for local development).
+	// Sidecars share the pod network, so they are reachable on localhost.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=8
+	Sidecars []WorkspaceSidecar `json:"sidecars,omitempty"`
+
+	// DockerInDocker runs a privileged Docker daemon sidecar and points
+	// DOCKER_HOST at it. Requires the team's workspacePolicy to set
+	// allowDockerInDocker.
+	// +optional
+	DockerInDocker bool `json:"dockerInDocker,omitempty"`
+
+	// Colocate schedules the workspace pod near the pods of a Service in the
+	// tenant cluster, such as a database, for low-latency development
+	// against in-cluster data stores. The Service must exist when the
+	// workspace is created. Changing it recreates the workspace pod.
+	// +optional
+	Colocate *WorkspaceColocation `json:"colocate,omitempty"`
+
+	// AccessGrants give other users temporary access to this workspace.
+	// +optional
+	// +listType=map
+	// +listMapKey=user
+	// +kubebuilder:validation:MaxItems=16
+	AccessGrants []AccessGrant `json:"accessGrants,omitempty"`
+}
+
+// WorkspaceSidecar defines an auxiliary container in the workspace pod.
+type WorkspaceSidecar struct {
+	// Name of the container. Must be unique within the workspace.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// Image is the container image.
+	// +kubebuilder:validation:Required
+	Image string `json:"image"`
+
+	// Command overrides the image entrypoint.
+	// +optional
+	Command []string `json:"command,omitempty"`
+
+	// Args overrides the image command.
+	// +optional
+	Args []string `json:"args,omitempty"`
+
+	// Env sets environment variables in the container.
+	// +optional
+	Env []EnvVar `json:"env,omitempty"`
+
+	// Ports exposed by the container.
+	// +optional
+	Ports []WorkspaceSidecarPort `json:"ports,omitempty"`
+
+	// Resources for the container.
+	// +optional
+	Resources *ComponentResources `json:"resources,omitempty"`
+}
+
+// WorkspaceSidecarPort is a port exposed by a sidecar.
+type WorkspaceSidecarPort struct {
+	// Name of the port.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// ContainerPort is the port number.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	ContainerPort int32 `json:"containerPort"`
+
+	// Protocol for the port.
+	// +kubebuilder:validation:Enum=TCP;UDP
+	// +kubebuilder:default="TCP"
+	// +optional
+	Protocol string `json:"protocol,omitempty"`
+}
+
+// WorkspaceSecret injects a management-cluster Secret into the workspace
+// as environment variables, a file mount, or both.
+// +kubebuilder:validation:XValidation:rule="(has(self.env) && size(self.env) > 0) || has(self.mountPath)",message="at least one of env or mountPath must be set"
+type WorkspaceSecret struct {
+	// Name of the Secret in the team namespace on the management cluster.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Env maps Secret keys to environment variables.
+	// +optional
+	Env []WorkspaceSecretEnv `json:"env,omitempty"`
+
+	// MountPath mounts every key of the Secret as a file in this directory.
+	// +kubebuilder:validation:Pattern=`^/`
+	// +optional
+	MountPath string `json:"mountPath,omitempty"`
+}
+
+// WorkspaceSecretEnv maps a Secret key to an environment variable.
+type WorkspaceSecretEnv struct {
+	// Key in the Secret.
+	// +kubebuilder:validation:Required
+	Key string `json:"key"`
+
+	// Name of the environment variable. Defaults to Key.
+	// +kubebuilder:validation:Pattern=`^[A-Za-z_][A-Za-z0-9_]*$`
+	// +optional
+	Name string `json:"name,omitempty"`
+}
+
+// WorkspaceSecretStatus tracks a Secret copied into the tenant cluster.
+type WorkspaceSecretStatus struct {
+	// Name of the source Secret in the team namespace.
+	Name string `json:"name"`
+
+	// CopyName is the name of the managed copy in the workspaces namespace.
+	// Copies carry the butler.butlerlabs.dev/source-namespace and
+	// butler.butlerlabs.dev/source-name labels.
+	// +optional
+	CopyName string `json:"copyName,omitempty"`
+
+	// SourceResourceVersion is the resourceVersion of the source Secret
+	// at the last sync.
+	// +optional
+	SourceResourceVersion string `json:"sourceResourceVersion,omitempty"`
+
+	// LastSyncTime is when the copy was last updated.
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
 }
 
 // EditorConfig configures editor-specific settings for the workspace.
@@ -176,8 +356,117 @@ type WorkspaceRepository struct {
 	// SecretRef for Git credentials (SSH key or token).
 	// References a Secret in the team namespace on the management cluster.
 	// The controller copies it to the tenant cluster's workspaces namespace.
+	// The keys read from the Secret depend on AuthType.
 	// +optional
 	SecretRef *LocalObjectReference `json:"secretRef,omitempty"`
+
+	// AuthType selects how credentials in SecretRef are used.
+	// If empty, it is inferred from the URL: "ssh-key" for SSH URLs
+	// (git@host:org/repo or ssh://), "token" otherwise.
+	// +optional
+	AuthType GitAuthType `json:"authType,omitempty"`
+
+	// KnownHosts configures SSH host key verification for SSH URLs.
+	// If unset, the host keys of well-known Git hosts are trusted.
+	// +optional
+	KnownHosts *SSHKnownHosts `json:"knownHosts,omitempty"`
+
+	// Submodules clones submodules recursively.
+	// +optional
+	Submodules bool `json:"submodules,omitempty"`
+
+	// SparseCheckout limits the working tree to these paths (cone mode).
+	// If empty, the full tree is checked out.
+	// +optional
+	SparseCheckout []string `json:"sparseCheckout,omitempty"`
+
+	// Depth creates a shallow clone with this many commits of history.
+	// 0 clones the full history.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Depth int32 `json:"depth,omitempty"`
+}
+
+// GitAuthType defines how Git credentials are presented.
+// +kubebuilder:validation:Enum=ssh-key;token;basic
+type GitAuthType string
+
+const (
+	// GitAuthTypeSSHKey authenticates with an SSH private key.
+	// The Secret must contain the "ssh-privatekey" key.
+	GitAuthTypeSSHKey GitAuthType = "ssh-key"
+
+	// GitAuthTypeToken authenticates over HTTPS with a bearer/personal access token.
+	// The Secret must contain the "token" key.
+	GitAuthTypeToken GitAuthType = "token"
+
+	// GitAuthTypeBasic authenticates over HTTPS with a username and password.
+	// The Secret must contain the "username" and "password" keys.
+	GitAuthTypeBasic GitAuthType = "basic"
+)
+
+// Git credential Secret keys.
+const (
+	// GitSecretKeySSHPrivateKey is the Secret key holding an SSH private key.
+	// Matches the kubernetes.io/ssh-auth Secret type.
+	GitSecretKeySSHPrivateKey = "ssh-privatekey"
+
+	// GitSecretKeyToken is the Secret key holding an access token.
+	GitSecretKeyToken = "token"
+
+	// GitSecretKeyUsername is the Secret key holding a username.
+	// Matches the kubernetes.io/basic-auth Secret type.
+	GitSecretKeyUsername = "username"
+
+	// GitSecretKeyPassword is the Secret key holding a password.
+	// Matches the kubernetes.io/basic-auth Secret type.
+	GitSecretKeyPassword = "password"
+
+	// GitSecretKeyKnownHosts is the Secret key holding known_hosts content.
+	GitSecretKeyKnownHosts = "known_hosts"
+)
+
+// SSHKnownHosts configures SSH host key verification.
+type SSHKnownHosts struct {
+	// Entries are known_hosts lines (e.g., "github.com ssh-ed25519 AAAA...").
+	// +optional
+	Entries []string `json:"entries,omitempty"`
+
+	// SecretRef references a Secret in the team namespace whose "known_hosts"
+	// key is appended to Entries.
+	// +optional
+	SecretRef *LocalObjectReference `json:"secretRef,omitempty"`
+
+	// InsecureIgnoreHostKey disables host key verification.
+	// Intended for lab environments only.
+	// +optional
+	InsecureIgnoreHostKey bool `json:"insecureIgnoreHostKey,omitempty"`
+}
+
+// GetAuthType returns the effective auth type for the repository.
+// Returns AuthType when set, otherwise "ssh-key" for SSH URLs and "token" for others.
+func (r *WorkspaceRepository) GetAuthType() GitAuthType {
+	if r.AuthType != "" {
+		return r.AuthType
+	}
+	if r.IsSSH() {
+		return GitAuthTypeSSHKey
+	}
+	return GitAuthTypeToken
+}
+
+// IsSSH returns true if the repository URL uses the SSH transport,
+// either "ssh://" or the scp-like "user@host:path" form.
+func (r *WorkspaceRepository) IsSSH() bool {
+	if strings.HasPrefix(r.URL, "ssh://") {
+		return true
+	}
+	if strings.Contains(r.URL, "://") {
+		return false
+	}
+	at := strings.Index(r.URL, "@")
+	colon := strings.Index(r.URL, ":")
+	return at > 0 && colon > at
 }
 
 // WorkspaceEnvSource configures environment variable copying from an existing workload.
@@ -201,6 +490,124 @@ type WorkspaceEnvSource struct {
 	Container string `json:"container,omitempty"`
 }
 
+// WorkspaceColocation identifies a Service in the tenant cluster whose pods
+// the workspace is scheduled near. The controller resolves the Service's
+// selector and adds a pod affinity term for the matching pods.
+type WorkspaceColocation struct {
+	// Service is the name of the target Service.
+	// Services without a selector cannot be used as a target.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Service string `json:"service"`
+
+	// Namespace of the Service in the tenant cluster. Defaults to "default".
+	// +kubebuilder:default="default"
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Mode controls whether the affinity is preferred or required.
+	// +kubebuilder:default="Preferred"
+	// +optional
+	Mode ColocationMode `json:"mode,omitempty"`
+
+	// TopologyKey is the node label that defines "near".
+	// Defaults to "kubernetes.io/hostname" (same node). Use
+	// "topology.kubernetes.io/zone" to colocate within a zone.
+	// +kubebuilder:default="kubernetes.io/hostname"
+	// +optional
+	TopologyKey string `json:"topologyKey,omitempty"`
+}
+
+// GetNamespace returns the Service namespace, defaulting to "default".
+func (c *WorkspaceColocation) GetNamespace() string {
+	if c.Namespace != "" {
+		return c.Namespace
+	}
+	return "default"
+}
+
+// GetMode returns the colocation mode, defaulting to Preferred.
+func (c *WorkspaceColocation) GetMode() ColocationMode {
+	if c.Mode != "" {
+		return c.Mode
+	}
+	return ColocationModePreferred
+}
+
+// GetTopologyKey returns the topology key, defaulting to the node hostname.
+func (c *WorkspaceColocation) GetTopologyKey() string {
+	if c.TopologyKey != "" {
+		return c.TopologyKey
+	}
+	return DefaultColocationTopologyKey
+}
+
+// SSHCertificateAuthority configures short-lived SSH certificates for
+// workspace access. Butler signs the user's public key on connect, and the
+// workspace SSH server trusts the CA instead of a static authorized_keys list.
+type SSHCertificateAuthority struct {
+	// CAKeySecretRef references the Secret containing the CA private key.
+	// Key defaults to "ssh-privatekey", matching kubernetes.io/ssh-auth Secrets.
+	// +kubebuilder:validation:Required
+	CAKeySecretRef SecretReference `json:"caKeySecretRef"`
+
+	// MaxTTL is the maximum validity of an issued certificate.
+	// Connect requests for a longer TTL are capped to this value.
+	// +kubebuilder:default="8h"
+	// +optional
+	MaxTTL *metav1.Duration `json:"maxTTL,omitempty"`
+
+	// DisableStaticKeys stops workspaces from accepting raw public keys
+	// (spec.sshPublicKeys and User profile keys). Only certificates signed
+	// by the CA are accepted.
+	// +optional
+	DisableStaticKeys bool `json:"disableStaticKeys,omitempty"`
+}
+
+// DefaultSSHCertificateMaxTTL is the certificate lifetime used when MaxTTL is unset.
+const DefaultSSHCertificateMaxTTL = 8 * time.Hour
+
+// GetCAKeyKey returns the Secret key holding the CA private key.
+func (c *SSHCertificateAuthority) GetCAKeyKey() string {
+	if c.CAKeySecretRef.Key == "" {
+		return "ssh-privatekey"
+	}
+	return c.CAKeySecretRef.Key
+}
+
+// GetMaxTTL returns the maximum certificate validity.
+func (c *SSHCertificateAuthority) GetMaxTTL() time.Duration {
+	if c.MaxTTL == nil || c.MaxTTL.Duration <= 0 {
+		return DefaultSSHCertificateMaxTTL
+	}
+	return c.MaxTTL.Duration
+}
+
+// CertificateTTL returns the validity for a certificate requested with the
+// given TTL. Zero requests the maximum.
+func (c *SSHCertificateAuthority) CertificateTTL(requested time.Duration) time.Duration {
+	maxTTL := c.GetMaxTTL()
+	if requested <= 0 || requested > maxTTL {
+		return maxTTL
+	}
+	return requested
+}
+
+// ResolveSSHCertificateAuthority returns the SSH CA for a team's workspaces.
+// A team-level CA in resourceLimits.workspacePolicy overrides the platform CA.
+// Returns nil when neither is configured.
+func ResolveSSHCertificateAuthority(config *ButlerConfig, team *Team) *SSHCertificateAuthority {
+	if team != nil && team.Spec.ResourceLimits != nil {
+		if p := team.Spec.ResourceLimits.WorkspacePolicy; p != nil && p.SSHCertificateAuthority != nil {
+			return p.SSHCertificateAuthority
+		}
+	}
+	if config != nil {
+		return config.Spec.SSHCertificateAuthority
+	}
+	return nil
+}
+
 // WorkspaceResources configures compute resources for the workspace pod.
 type WorkspaceResources struct {
 	// CPU request and limit for the workspace.
@@ -254,6 +661,17 @@ type WorkspaceStatus struct {
 	// +optional
 	SSHEndpoint string `json:"sshEndpoint,omitempty"`
 
+	// SSHCAPublicKey is the public key of the certificate authority the
+	// workspace SSH server trusts, in authorized_keys format. Empty when
+	// certificate access is not configured.
+	// +optional
+	SSHCAPublicKey string `json:"sshCAPublicKey,omitempty"`
+
+	// SSHCertificateMaxTTL is the maximum validity of certificates accepted
+	// by the workspace, as resolved from the CA configuration.
+	// +optional
+	SSHCertificateMaxTTL *metav1.Duration `json:"sshCertificateMaxTTL,omitempty"`
+
 	// Connected indicates whether the SSH service is currently active.
 	// +optional
 	Connected bool `json:"connected,omitempty"`
@@ -268,14 +686,26 @@ type WorkspaceStatus struct {
 	// +optional
 	LastDisconnectTime *metav1.Time `json:"lastDisconnectTime,omitempty"`
 
+	// Secrets tracks Secrets copied into the tenant cluster.
+	// +optional
+	Secrets []WorkspaceSecretStatus `json:"secrets,omitempty"`
+
+	// AccessGrants records current and past access grants.
+	// +optional
+	AccessGrants []AccessGrantStatus `json:"accessGrants,omitempty"`
+
 	// ObservedGeneration is the last observed generation of the workspace spec.
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Reconcile reports controller reconcile diagnostics.
+	// +optional
+	Reconcile *ReconcileStatus `json:"reconcile,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:shortName=ws
+// +kubebuilder:resource:shortName=ws,categories=butler
 // +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Current lifecycle phase"
 // +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".spec.clusterRef.name",description="Target tenant cluster"
 // +kubebuilder:printcolumn:name="Owner",type="string",JSONPath=".spec.owner",description="Workspace owner email"
@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestWorkspaceRepositoryGetAuthType(t *testing.T) {
+	tests := []struct {
+		name     string
+		url      string
+		authType GitAuthType
+		want     GitAuthType
+	}{
+		{
+			name: "https defaults to token",
+			url:  "https://github.com/butlerdotdev/butler-api",
+			want: GitAuthTypeToken,
+		},
+		{
+			name: "scp-like ssh",
+			url:  "git@github.com:butlerdotdev/butler-api.git",
+			want: GitAuthTypeSSHKey,
+		},
+		{
+			name: "ssh scheme",
+			url:  "ssh://git@gitlab.example.com:2222/team/repo.git",
+			want: GitAuthTypeSSHKey,
+		},
+		{
+			name: "https with userinfo is not ssh",
+			url:  "https://user@github.com/butlerdotdev/butler-api",
+			want: GitAuthTypeToken,
+		},
+		{
+			name:     "explicit basic",
+			url:      "https://git.example.com/repo.git",
+			authType: GitAuthTypeBasic,
+			want:     GitAuthTypeBasic,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &WorkspaceRepository{URL: tt.url, AuthType: tt.authType}
+			if got := r.GetAuthType(); got != tt.want {
+				t.Errorf("GetAuthType() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWorkspaceColocationDefaults(t *testing.T) {
+	c := &WorkspaceColocation{Service: "postgres"}
+	if got := c.GetNamespace(); got != "default" {
+		t.Errorf("GetNamespace() = %q, want default", got)
+	}
+	if got := c.GetMode(); got != ColocationModePreferred {
+		t.Errorf("GetMode() = %q, want %q", got, ColocationModePreferred)
+	}
+	if got := c.GetTopologyKey(); got != DefaultColocationTopologyKey {
+		t.Errorf("GetTopologyKey() = %q, want %q", got, DefaultColocationTopologyKey)
+	}
+
+	c = &WorkspaceColocation{Service: "postgres", Namespace: "data", Mode: ColocationModeRequired, TopologyKey: "topology.kubernetes.io/zone"}
+	if c.GetNamespace() != "data" || c.GetMode() != ColocationModeRequired || c.GetTopologyKey() != "topology.kubernetes.io/zone" {
+		t.Errorf("explicit values not returned: %+v", c)
+	}
+}
+
+func TestSSHCertificateAuthorityTTL(t *testing.T) {
+	ca := &SSHCertificateAuthority{CAKeySecretRef: SecretReference{Name: "workspace-ca"}}
+	if got := ca.GetCAKeyKey(); got != "ssh-privatekey" {
+		t.Errorf("GetCAKeyKey() = %q, want ssh-privatekey", got)
+	}
+	if got := ca.CertificateTTL(0); got != DefaultSSHCertificateMaxTTL {
+		t.Errorf("CertificateTTL(0) = %v, want %v", got, DefaultSSHCertificateMaxTTL)
+	}
+
+	ca.MaxTTL = &metav1.Duration{Duration: time.Hour}
+	if got := ca.CertificateTTL(30 * time.Minute); got != 30*time.Minute {
+		t.Errorf("CertificateTTL(30m) = %v, want 30m", got)
+	}
+	if got := ca.CertificateTTL(24 * time.Hour); got != time.Hour {
+		t.Errorf("CertificateTTL(24h) = %v, want capped to 1h", got)
+	}
+}
+
+func TestResolveSSHCertificateAuthority(t *testing.T) {
+	platform := &SSHCertificateAuthority{CAKeySecretRef: SecretReference{Name: "platform-ca"}}
+	teamCA := &SSHCertificateAuthority{CAKeySecretRef: SecretReference{Name: "team-ca"}}
+	config := &ButlerConfig{Spec: ButlerConfigSpec{SSHCertificateAuthority: platform}}
+
+	if got := ResolveSSHCertificateAuthority(nil, nil); got != nil {
+		t.Errorf("ResolveSSHCertificateAuthority(nil, nil) = %+v, want nil", got)
+	}
+	if got := ResolveSSHCertificateAuthority(config, &Team{}); got != platform {
+		t.Errorf("team without override: got %+v, want platform CA", got)
+	}
+	team := &Team{Spec: TeamSpec{ResourceLimits: &TeamResourceLimits{
+		WorkspacePolicy: &WorkspacePolicy{SSHCertificateAuthority: teamCA},
+	}}}
+	if got := ResolveSSHCertificateAuthority(config, team); got != teamCA {
+		t.Errorf("team override: got %+v, want team CA", got)
+	}
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// WorkspaceClassSpec defines the resources of a WorkspaceClass.
+type WorkspaceClassSpec struct {
+	// DisplayName shown in the class picker (e.g., "Large (8 CPU, 16 GiB)").
+	// +optional
+	DisplayName string `json:"displayName,omitempty"`
+
+	// Description of the class and its intended use.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// CPU request and limit for workspaces of this class.
+	// +kubebuilder:validation:Required
+	CPU resource.Quantity `json:"cpu"`
+
+	// Memory request and limit for workspaces of this class.
+	// +kubebuilder:validation:Required
+	Memory resource.Quantity `json:"memory"`
+
+	// GPU requests accelerators for workspaces of this class.
+	// +optional
+	GPU *WorkspaceClassGPU `json:"gpu,omitempty"`
+
+	// NodeSelector constrains workspace pods to tenant nodes with matching labels.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations allow workspace pods to schedule onto tainted tenant nodes,
+	// such as dedicated GPU nodes.
+	// +optional
+	Tolerations []Toleration `json:"tolerations,omitempty"`
+
+	// Default marks this class as the one used when a workspace sets neither
+	// className nor resources. At most one class should be the default; if
+	// several are, the first by name is used.
+	// +optional
+	Default bool `json:"default,omitempty"`
+}
+
+// WorkspaceClassGPU requests accelerators for a workspace.
+type WorkspaceClassGPU struct {
+	// Count is the number of GPUs.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=1
+	// +optional
+	Count int32 `json:"count,omitempty"`
+
+	// ResourceName is the extended resource name of the GPU.
+	// +kubebuilder:default="nvidia.com/gpu"
+	// +optional
+	ResourceName string `json:"resourceName,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=wsc,categories=butler
+// +kubebuilder:printcolumn:name="CPU",type="string",JSONPath=".spec.cpu",description="CPU per workspace"
+// +kubebuilder:printcolumn:name="Memory",type="string",JSONPath=".spec.memory",description="Memory per workspace"
+// +kubebuilder:printcolumn:name="GPU",type="integer",JSONPath=".spec.gpu.count",description="GPUs per workspace"
+// +kubebuilder:printcolumn:name="Default",type="boolean",JSONPath=".spec.default",description="Default class"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// WorkspaceClass is a platform-defined size for Workspaces, such as small,
+// medium, large, or gpu. Workspaces and WorkspaceTemplates reference a class
+// by name instead of setting free-form resources, which keeps sizes
+// consistent for cost control and lets the UI offer a fixed set of choices.
+type WorkspaceClass struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec WorkspaceClassSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WorkspaceClassList contains a list of WorkspaceClass.
+type WorkspaceClassList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WorkspaceClass `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&WorkspaceClass{}, &WorkspaceClassList{})
+}
+
+// Helper methods
+
+// GetGPUCount returns the number of GPUs requested by the class.
+func (c *WorkspaceClass) GetGPUCount() int32 {
+	if c.Spec.GPU == nil {
+		return 0
+	}
+	if c.Spec.GPU.Count == 0 {
+		return 1
+	}
+	return c.Spec.GPU.Count
+}
+
+// GetGPUResourceName returns the extended resource name of the class GPU.
+func (c *WorkspaceClass) GetGPUResourceName() string {
+	if c.Spec.GPU == nil || c.Spec.GPU.ResourceName == "" {
+		return "nvidia.com/gpu"
+	}
+	return c.Spec.GPU.ResourceName
+}
+
+// Resources returns the class CPU and memory as WorkspaceResources.
+func (c *WorkspaceClass) Resources() *WorkspaceResources {
+	return &WorkspaceResources{
+		CPU:    c.Spec.CPU.String(),
+		Memory: c.Spec.Memory.String(),
+	}
+}
+
+// DefaultWorkspaceClass returns the default class from classes, choosing the
+// first by name if more than one is marked default. Returns nil if none is.
+func DefaultWorkspaceClass(classes []WorkspaceClass) *WorkspaceClass {
+	var def *WorkspaceClass
+	for i := range classes {
+		if classes[i].Spec.Default && (def == nil || classes[i].Name < def.Name) {
+			def = &classes[i]
+		}
+	}
+	return def
+}
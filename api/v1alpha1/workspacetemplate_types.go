@@ -17,6 +17,8 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"fmt"
+
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -43,10 +45,12 @@ const (
 	WorkspaceTemplateScopeCluster WorkspaceTemplateScope = "cluster"
 
 	// WorkspaceTemplateScopeTeam makes the template visible only to the owning team.
+	// Team templates are promoted to cluster scope with a TemplatePublication.
 	WorkspaceTemplateScopeTeam WorkspaceTemplateScope = "team"
 )
 
 // WorkspaceTemplateSpec defines the desired state of a WorkspaceTemplate.
+// +kubebuilder:validation:XValidation:rule="has(self.baseTemplateRef) || (has(self.template.image) && size(self.template.image) > 0)",message="template.image is required unless baseTemplateRef is set"
 type WorkspaceTemplateSpec struct {
 	// DisplayName shown in the template picker.
 	// +kubebuilder:validation:Required
@@ -73,17 +77,49 @@ type WorkspaceTemplateSpec struct {
 	// +optional
 	Scope WorkspaceTemplateScope `json:"scope,omitempty"`
 
+	// BaseTemplateRef extends another WorkspaceTemplate. The effective
+	// template is the base with this template's fields layered on top:
+	//   - image, repository, envFrom, dotfiles, and storageSize replace the
+	//     base value when set
+	//   - className replaces the base className and resources
+	//   - resources.cpu and resources.memory replace the base values
+	//     individually and clear the base className
+	//   - repositories are appended; an entry with the same url replaces the
+	//     base entry in place
+	//   - secrets and sidecars are merged by name; an entry with the same
+	//     name replaces the base entry
+	//   - dockerInDocker is enabled if either template enables it
+	// Bases may themselves have bases, up to 5 levels. Changes to a base
+	// apply to workspaces created afterwards; existing workspaces are not updated.
+	// +optional
+	BaseTemplateRef *WorkspaceTemplateReference `json:"baseTemplateRef,omitempty"`
+
 	// Template is the workspace spec that gets applied when using this template.
 	// Owner and ClusterRef are set at creation time by the server.
 	// +kubebuilder:validation:Required
 	Template WorkspaceTemplateBody `json:"template"`
 }
 
+// WorkspaceTemplateReference references a WorkspaceTemplate.
+type WorkspaceTemplateReference struct {
+	// Name of the WorkspaceTemplate.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Namespace of the WorkspaceTemplate. Defaults to the referencing
+	// template's namespace. Use butler-system to extend a platform template.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
 // WorkspaceTemplateBody defines the workspace configuration within a template.
+// +kubebuilder:validation:XValidation:rule="!has(self.className) || !has(self.resources)",message="className and resources are mutually exclusive"
 type WorkspaceTemplateBody struct {
 	// Image for the workspace container.
-	// +kubebuilder:validation:Required
-	Image string `json:"image"`
+	// Required unless the template has a baseTemplateRef.
+	// +optional
+	Image string `json:"image,omitempty"`
 
 	// Repository to clone into the workspace.
 	// Deprecated: Use Repositories for multi-repo support.
@@ -107,13 +143,37 @@ type WorkspaceTemplateBody struct {
 	// +optional
 	Resources *WorkspaceResources `json:"resources,omitempty"`
 
+	// ClassName is the WorkspaceClass for workspaces created from this
+	// template. Mutually exclusive with Resources.
+	// +optional
+	ClassName string `json:"className,omitempty"`
+
 	// StorageSize for the workspace PVC.
 	// +optional
 	StorageSize *resource.Quantity `json:"storageSize,omitempty"`
+
+	// Secrets to inject into workspaces created from this template.
+	// Subject to the creating team's workspacePolicy.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Secrets []WorkspaceSecret `json:"secrets,omitempty"`
+
+	// Sidecars are auxiliary containers for workspaces created from this template.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=8
+	Sidecars []WorkspaceSidecar `json:"sidecars,omitempty"`
+
+	// DockerInDocker enables a Docker daemon sidecar.
+	// Subject to the creating team's workspacePolicy.
+	// +optional
+	DockerInDocker bool `json:"dockerInDocker,omitempty"`
 }
 
 // +kubebuilder:object:root=true
-// +kubebuilder:resource:shortName=wst
+// +kubebuilder:resource:shortName=wst,categories=butler
 // +kubebuilder:printcolumn:name="Display Name",type="string",JSONPath=".spec.displayName",description="Template display name"
 // +kubebuilder:printcolumn:name="Image",type="string",JSONPath=".spec.template.image",description="Default workspace image"
 // +kubebuilder:printcolumn:name="Category",type="string",JSONPath=".spec.category",description="Template category"
@@ -143,3 +203,129 @@ type WorkspaceTemplateList struct {
 func init() {
 	SchemeBuilder.Register(&WorkspaceTemplate{}, &WorkspaceTemplateList{})
 }
+
+// MaxWorkspaceTemplateDepth is the maximum length of a baseTemplateRef chain,
+// including the template itself.
+const MaxWorkspaceTemplateDepth = 6
+
+// WorkspaceTemplateGetter fetches a WorkspaceTemplate by namespace and name.
+// +kubebuilder:object:generate=false
+type WorkspaceTemplateGetter func(namespace, name string) (*WorkspaceTemplate, error)
+
+// Helper methods
+
+// ResolveEffectiveTemplate returns the template body with every base in its
+// baseTemplateRef chain layered underneath, following the merge rules
+// documented on WorkspaceTemplateSpec.BaseTemplateRef. The result is a new
+// object; t and its bases are not modified.
+func ResolveEffectiveTemplate(t *WorkspaceTemplate, get WorkspaceTemplateGetter) (*WorkspaceTemplateBody, error) {
+	chain := []*WorkspaceTemplate{t}
+	seen := map[string]bool{t.Namespace + "/" + t.Name: true}
+	for cur := t; cur.Spec.BaseTemplateRef != nil; {
+		if len(chain) >= MaxWorkspaceTemplateDepth {
+			return nil, fmt.Errorf("workspace template %s/%s: inheritance deeper than %d levels", t.Namespace, t.Name, MaxWorkspaceTemplateDepth-1)
+		}
+		ref := cur.Spec.BaseTemplateRef
+		ns := ref.Namespace
+		if ns == "" {
+			ns = cur.Namespace
+		}
+		key := ns + "/" + ref.Name
+		if seen[key] {
+			return nil, fmt.Errorf("workspace template %s/%s: baseTemplateRef cycle at %s", t.Namespace, t.Name, key)
+		}
+		seen[key] = true
+		base, err := get(ns, ref.Name)
+		if err != nil {
+			return nil, fmt.Errorf("workspace template %s/%s: getting base %s: %w", t.Namespace, t.Name, key, err)
+		}
+		chain = append(chain, base)
+		cur = base
+	}
+
+	effective := chain[len(chain)-1].Spec.Template.DeepCopy()
+	for i := len(chain) - 2; i >= 0; i-- {
+		effective.overlay(&chain[i].Spec.Template)
+	}
+	if effective.Image == "" {
+		return nil, fmt.Errorf("workspace template %s/%s: no image set in template or its bases", t.Namespace, t.Name)
+	}
+	return effective, nil
+}
+
+// overlay layers o on top of b.
+func (b *WorkspaceTemplateBody) overlay(o *WorkspaceTemplateBody) {
+	o = o.DeepCopy()
+	if o.Image != "" {
+		b.Image = o.Image
+	}
+	if o.Repository != nil {
+		b.Repository = o.Repository
+	}
+	if o.EnvFrom != nil {
+		b.EnvFrom = o.EnvFrom
+	}
+	if o.Dotfiles != nil {
+		b.Dotfiles = o.Dotfiles
+	}
+	if o.StorageSize != nil {
+		b.StorageSize = o.StorageSize
+	}
+	if o.ClassName != "" {
+		b.ClassName = o.ClassName
+		b.Resources = nil
+	}
+	if o.Resources != nil {
+		b.ClassName = ""
+		if b.Resources == nil {
+			b.Resources = &WorkspaceResources{}
+		}
+		if o.Resources.CPU != "" {
+			b.Resources.CPU = o.Resources.CPU
+		}
+		if o.Resources.Memory != "" {
+			b.Resources.Memory = o.Resources.Memory
+		}
+	}
+	b.DockerInDocker = b.DockerInDocker || o.DockerInDocker
+
+	for _, repo := range o.Repositories {
+		replaced := false
+		for i := range b.Repositories {
+			if b.Repositories[i].URL == repo.URL {
+				b.Repositories[i] = repo
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			b.Repositories = append(b.Repositories, repo)
+		}
+	}
+	for _, secret := range o.Secrets {
+		replaced := false
+		for i := range b.Secrets {
+			if b.Secrets[i].Name == secret.Name {
+				b.Secrets[i] = secret
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			b.Secrets = append(b.Secrets, secret)
+		}
+	}
+	for _, sidecar := range o.Sidecars {
+		replaced := false
+		for i := range b.Sidecars {
+			if b.Sidecars[i].Name == sidecar.Name {
+				b.Sidecars[i] = sidecar
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			b.Sidecars = append(b.Sidecars, sidecar)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestResolveEffectiveTemplate(t *testing.T) {
+	template := func(ns, name string, base *WorkspaceTemplateReference, body WorkspaceTemplateBody) *WorkspaceTemplate {
+		return &WorkspaceTemplate{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: name},
+			Spec:       WorkspaceTemplateSpec{BaseTemplateRef: base, Template: body},
+		}
+	}
+
+	platform := template("butler-system", "go-base", nil, WorkspaceTemplateBody{
+		Image:        "ghcr.io/butlerdotdev/workspace-go:1.24",
+		Repositories: []WorkspaceRepository{{URL: "https://github.com/acme/tools", Branch: "main"}},
+		Resources:    &WorkspaceResources{CPU: "2", Memory: "4Gi"},
+		Sidecars:     []WorkspaceSidecar{{Name: "postgres", Image: "postgres:15"}},
+	})
+	team := template("team-a", "go-service", &WorkspaceTemplateReference{Name: "go-base", Namespace: "butler-system"}, WorkspaceTemplateBody{
+		Repositories: []WorkspaceRepository{
+			{URL: "https://github.com/acme/tools", Branch: "release"},
+			{URL: "https://github.com/acme/service"},
+		},
+		Resources:      &WorkspaceResources{Memory: "8Gi"},
+		Sidecars:       []WorkspaceSidecar{{Name: "postgres", Image: "postgres:16"}, {Name: "redis", Image: "redis:7"}},
+		DockerInDocker: true,
+	})
+	cycleA := template("team-a", "a", &WorkspaceTemplateReference{Name: "b"}, WorkspaceTemplateBody{Image: "a"})
+	cycleB := template("team-a", "b", &WorkspaceTemplateReference{Name: "a"}, WorkspaceTemplateBody{})
+	noImage := template("team-a", "no-image", &WorkspaceTemplateReference{Name: "empty"}, WorkspaceTemplateBody{})
+	empty := template("team-a", "empty", nil, WorkspaceTemplateBody{})
+
+	store := map[string]*WorkspaceTemplate{}
+	for _, wt := range []*WorkspaceTemplate{platform, team, cycleA, cycleB, noImage, empty} {
+		store[wt.Namespace+"/"+wt.Name] = wt
+	}
+	get := func(namespace, name string) (*WorkspaceTemplate, error) {
+		if wt, ok := store[namespace+"/"+name]; ok {
+			return wt, nil
+		}
+		return nil, fmt.Errorf("not found")
+	}
+
+	t.Run("layers team template over platform base", func(t *testing.T) {
+		got, err := ResolveEffectiveTemplate(team, get)
+		if err != nil {
+			t.Fatalf("ResolveEffectiveTemplate() error = %v", err)
+		}
+		if got.Image != "ghcr.io/butlerdotdev/workspace-go:1.24" {
+			t.Errorf("image = %q, want base image", got.Image)
+		}
+		if len(got.Repositories) != 2 || got.Repositories[0].Branch != "release" {
+			t.Errorf("repositories = %+v, want base repo overridden in place plus service repo", got.Repositories)
+		}
+		if got.Resources.CPU != "2" || got.Resources.Memory != "8Gi" {
+			t.Errorf("resources = %+v, want cpu 2 and memory 8Gi", got.Resources)
+		}
+		if len(got.Sidecars) != 2 || got.Sidecars[0].Image != "postgres:16" {
+			t.Errorf("sidecars = %+v, want postgres:16 and redis", got.Sidecars)
+		}
+		if !got.DockerInDocker {
+			t.Error("dockerInDocker = false, want true")
+		}
+		if platform.Spec.Template.Resources.Memory != "4Gi" || len(platform.Spec.Template.Repositories) != 1 {
+			t.Error("base template was modified")
+		}
+	})
+
+	t.Run("template without base", func(t *testing.T) {
+		got, err := ResolveEffectiveTemplate(platform, get)
+		if err != nil || got.Image != platform.Spec.Template.Image {
+			t.Errorf("ResolveEffectiveTemplate() = %v, %v", got, err)
+		}
+	})
+
+	for name, wt := range map[string]*WorkspaceTemplate{
+		"cycle":        cycleA,
+		"missing base": template("team-a", "orphan", &WorkspaceTemplateReference{Name: "missing"}, WorkspaceTemplateBody{Image: "x"}),
+		"no image":     noImage,
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ResolveEffectiveTemplate(wt, get); err == nil {
+				t.Error("ResolveEffectiveTemplate() error = nil, want error")
+			}
+		})
+	}
+
+	t.Run("depth limit", func(t *testing.T) {
+		deep := map[string]*WorkspaceTemplate{}
+		for i := 0; i < MaxWorkspaceTemplateDepth+1; i++ {
+			var base *WorkspaceTemplateReference
+			if i < MaxWorkspaceTemplateDepth {
+				base = &WorkspaceTemplateReference{Name: fmt.Sprintf("t%d", i+1)}
+			}
+			deep[fmt.Sprintf("t%d", i)] = template("team-a", fmt.Sprintf("t%d", i), base, WorkspaceTemplateBody{Image: "x"})
+		}
+		getDeep := func(_, name string) (*WorkspaceTemplate, error) { return deep[name], nil }
+		if _, err := ResolveEffectiveTemplate(deep["t0"], getDeep); err == nil {
+			t.Error("ResolveEffectiveTemplate() error = nil, want depth error")
+		}
+		if _, err := ResolveEffectiveTemplate(deep["t1"], getDeep); err != nil {
+			t.Errorf("ResolveEffectiveTemplate() at max depth error = %v", err)
+		}
+	})
+}
@@ -23,8 +23,70 @@ package v1alpha1
 import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AESCBCEncryptionConfig) DeepCopyInto(out *AESCBCEncryptionConfig) {
+	*out = *in
+	out.KeySecretRef = in.KeySecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AESCBCEncryptionConfig.
+func (in *AESCBCEncryptionConfig) DeepCopy() *AESCBCEncryptionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AESCBCEncryptionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerAccessControl) DeepCopyInto(out *APIServerAccessControl) {
+	*out = *in
+	if in.AllowedCIDRs != nil {
+		in, out := &in.AllowedCIDRs, &out.AllowedCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DenyByDefault != nil {
+		in, out := &in.DenyByDefault, &out.DenyByDefault
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerAccessControl.
+func (in *APIServerAccessControl) DeepCopy() *APIServerAccessControl {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerAccessControl)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerAccessStatus) DeepCopyInto(out *APIServerAccessStatus) {
+	*out = *in
+	if in.EffectiveCIDRs != nil {
+		in, out := &in.EffectiveCIDRs, &out.EffectiveCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerAccessStatus.
+func (in *APIServerAccessStatus) DeepCopy() *APIServerAccessStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerAccessStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSProviderConfig) DeepCopyInto(out *AWSProviderConfig) {
 	*out = *in
@@ -50,6 +112,43 @@ func (in *AWSProviderConfig) DeepCopy() *AWSProviderConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessGrant) DeepCopyInto(out *AccessGrant) {
+	*out = *in
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessGrant.
+func (in *AccessGrant) DeepCopy() *AccessGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessGrantStatus) DeepCopyInto(out *AccessGrantStatus) {
+	*out = *in
+	in.GrantedAt.DeepCopyInto(&out.GrantedAt)
+	in.ExpiresAt.DeepCopyInto(&out.ExpiresAt)
+	if in.RevokedAt != nil {
+		in, out := &in.RevokedAt, &out.RevokedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessGrantStatus.
+func (in *AccessGrantStatus) DeepCopy() *AccessGrantStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessGrantStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AddonChartSpec) DeepCopyInto(out *AddonChartSpec) {
 	*out = *in
@@ -58,6 +157,13 @@ func (in *AddonChartSpec) DeepCopyInto(out *AddonChartSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Compatibility != nil {
+		in, out := &in.Compatibility, &out.Compatibility
+		*out = make([]AddonVersionCompatibility, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonChartSpec.
@@ -229,6 +335,42 @@ func (in *AddonStatus) DeepCopy() *AddonStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonVersionCompatibility) DeepCopyInto(out *AddonVersionCompatibility) {
+	*out = *in
+	out.KubernetesVersions = in.KubernetesVersions
+	if in.ButlerVersions != nil {
+		in, out := &in.ButlerVersions, &out.ButlerVersions
+		*out = new(VersionRange)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonVersionCompatibility.
+func (in *AddonVersionCompatibility) DeepCopy() *AddonVersionCompatibility {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonVersionCompatibility)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonVersionRequirement) DeepCopyInto(out *AddonVersionRequirement) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonVersionRequirement.
+func (in *AddonVersionRequirement) DeepCopy() *AddonVersionRequirement {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonVersionRequirement)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AddonVersions) DeepCopyInto(out *AddonVersions) {
 	*out = *in
@@ -277,6 +419,16 @@ func (in *AddonsSpec) DeepCopyInto(out *AddonsSpec) {
 		*out = new(GitOpsSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(BackupAddonSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Multus != nil {
+		in, out := &in.Multus, &out.Multus
+		*out = new(MultusSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonsSpec.
@@ -290,81 +442,207 @@ func (in *AddonsSpec) DeepCopy() *AddonsSpec {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AuditConfig) DeepCopyInto(out *AuditConfig) {
+func (in *AllocationStrategy) DeepCopyInto(out *AllocationStrategy) {
+	*out = *in
+	if in.FirstFit != nil {
+		in, out := &in.FirstFit, &out.FirstFit
+		*out = new(FirstFitStrategy)
+		**out = **in
+	}
+	if in.BestFit != nil {
+		in, out := &in.BestFit, &out.BestFit
+		*out = new(BestFitStrategy)
+		**out = **in
+	}
+	if in.Random != nil {
+		in, out := &in.Random, &out.Random
+		*out = new(RandomStrategy)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AllocationStrategy.
+func (in *AllocationStrategy) DeepCopy() *AllocationStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(AllocationStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApprovalPolicy) DeepCopyInto(out *ApprovalPolicy) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]ApprovalRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalPolicy.
+func (in *ApprovalPolicy) DeepCopy() *ApprovalPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ApprovalPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApprovalRule) DeepCopyInto(out *ApprovalRule) {
+	*out = *in
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]ChangeOperation, len(*in))
+		copy(*out, *in)
+	}
+	if in.Approvers != nil {
+		in, out := &in.Approvers, &out.Approvers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalRule.
+func (in *ApprovalRule) DeepCopy() *ApprovalRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ApprovalRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArchiveConfig) DeepCopyInto(out *ArchiveConfig) {
 	*out = *in
 	if in.Enabled != nil {
 		in, out := &in.Enabled, &out.Enabled
 		*out = new(bool)
 		**out = **in
 	}
-	if in.BufferSize != nil {
-		in, out := &in.BufferSize, &out.BufferSize
-		*out = new(int32)
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.Artifacts != nil {
+		in, out := &in.Artifacts, &out.Artifacts
+		*out = make([]ArchivedArtifactType, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditConfig.
-func (in *AuditConfig) DeepCopy() *AuditConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArchiveConfig.
+func (in *ArchiveConfig) DeepCopy() *ArchiveConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(AuditConfig)
+	out := new(ArchiveConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AutoEnrollConfig) DeepCopyInto(out *AutoEnrollConfig) {
+func (in *ArchivedArtifact) DeepCopyInto(out *ArchivedArtifact) {
 	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoEnrollConfig.
-func (in *AutoEnrollConfig) DeepCopy() *AutoEnrollConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArchivedArtifact.
+func (in *ArchivedArtifact) DeepCopy() *ArchivedArtifact {
 	if in == nil {
 		return nil
 	}
-	out := new(AutoEnrollConfig)
+	out := new(ArchivedArtifact)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureProviderConfig) DeepCopyInto(out *AzureProviderConfig) {
+func (in *ArgoCDOptions) DeepCopyInto(out *ArgoCDOptions) {
 	*out = *in
+	if in.AdminSecretRef != nil {
+		in, out := &in.AdminSecretRef, &out.AdminSecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureProviderConfig.
-func (in *AzureProviderConfig) DeepCopy() *AzureProviderConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArgoCDOptions.
+func (in *ArgoCDOptions) DeepCopy() *ArgoCDOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(AzureProviderConfig)
+	out := new(ArgoCDOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ButlerConfig) DeepCopyInto(out *ButlerConfig) {
+func (in *AuditConfig) DeepCopyInto(out *AuditConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BufferSize != nil {
+		in, out := &in.BufferSize, &out.BufferSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.EventRetention != nil {
+		in, out := &in.EventRetention, &out.EventRetention
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditConfig.
+func (in *AuditConfig) DeepCopy() *AuditConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditEvent) DeepCopyInto(out *AuditEvent) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ButlerConfig.
-func (in *ButlerConfig) DeepCopy() *ButlerConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditEvent.
+func (in *AuditEvent) DeepCopy() *AuditEvent {
 	if in == nil {
 		return nil
 	}
-	out := new(ButlerConfig)
+	out := new(AuditEvent)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ButlerConfig) DeepCopyObject() runtime.Object {
+func (in *AuditEvent) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -372,31 +650,31 @@ func (in *ButlerConfig) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ButlerConfigList) DeepCopyInto(out *ButlerConfigList) {
+func (in *AuditEventList) DeepCopyInto(out *AuditEventList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ButlerConfig, len(*in))
+		*out = make([]AuditEvent, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ButlerConfigList.
-func (in *ButlerConfigList) DeepCopy() *ButlerConfigList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditEventList.
+func (in *AuditEventList) DeepCopy() *AuditEventList {
 	if in == nil {
 		return nil
 	}
-	out := new(ButlerConfigList)
+	out := new(AuditEventList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ButlerConfigList) DeepCopyObject() runtime.Object {
+func (in *AuditEventList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -404,394 +682,326 @@ func (in *ButlerConfigList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ButlerConfigSpec) DeepCopyInto(out *ButlerConfigSpec) {
+func (in *AuditFieldChange) DeepCopyInto(out *AuditFieldChange) {
 	*out = *in
-	out.MultiTenancy = in.MultiTenancy
-	if in.DefaultProviderConfigRef != nil {
-		in, out := &in.DefaultProviderConfigRef, &out.DefaultProviderConfigRef
-		*out = new(LocalObjectReference)
-		**out = **in
-	}
-	if in.DefaultTeamLimits != nil {
-		in, out := &in.DefaultTeamLimits, &out.DefaultTeamLimits
-		*out = new(ResourceLimits)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.DefaultAddonVersions != nil {
-		in, out := &in.DefaultAddonVersions, &out.DefaultAddonVersions
-		*out = new(AddonVersions)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditFieldChange.
+func (in *AuditFieldChange) DeepCopy() *AuditFieldChange {
+	if in == nil {
+		return nil
 	}
-	if in.GitProvider != nil {
-		in, out := &in.GitProvider, &out.GitProvider
-		*out = new(GitProviderConfig)
-		**out = **in
+	out := new(AuditFieldChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditRecord) DeepCopyInto(out *AuditRecord) {
+	*out = *in
+	out.ResourceRef = in.ResourceRef
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]AuditFieldChange, len(*in))
+		copy(*out, *in)
 	}
-	if in.ControlPlaneExposure != nil {
-		in, out := &in.ControlPlaneExposure, &out.ControlPlaneExposure
-		*out = new(ControlPlaneExposureSpec)
-		**out = **in
-	}
-	if in.Observability != nil {
-		in, out := &in.Observability, &out.Observability
-		*out = new(ObservabilityConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.DefaultControlPlaneResources != nil {
-		in, out := &in.DefaultControlPlaneResources, &out.DefaultControlPlaneResources
-		*out = new(ControlPlaneResourcesSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ImageFactory != nil {
-		in, out := &in.ImageFactory, &out.ImageFactory
-		*out = new(ImageFactoryConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.DefaultTimeServers != nil {
-		in, out := &in.DefaultTimeServers, &out.DefaultTimeServers
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Audit != nil {
-		in, out := &in.Audit, &out.Audit
-		*out = new(AuditConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Notifications != nil {
-		in, out := &in.Notifications, &out.Notifications
-		*out = new(NotificationsConfig)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditRecord.
+func (in *AuditRecord) DeepCopy() *AuditRecord {
+	if in == nil {
+		return nil
 	}
+	out := new(AuditRecord)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ButlerConfigSpec.
-func (in *ButlerConfigSpec) DeepCopy() *ButlerConfigSpec {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoEnrollConfig) DeepCopyInto(out *AutoEnrollConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoEnrollConfig.
+func (in *AutoEnrollConfig) DeepCopy() *AutoEnrollConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(ButlerConfigSpec)
+	out := new(AutoEnrollConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ButlerConfigStatus) DeepCopyInto(out *ButlerConfigStatus) {
+func (in *AzureProviderConfig) DeepCopyInto(out *AzureProviderConfig) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.GitProvider != nil {
-		in, out := &in.GitProvider, &out.GitProvider
-		*out = new(GitProviderStatus)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Observability != nil {
-		in, out := &in.Observability, &out.Observability
-		*out = new(ObservabilityStatus)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ButlerConfigStatus.
-func (in *ButlerConfigStatus) DeepCopy() *ButlerConfigStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureProviderConfig.
+func (in *AzureProviderConfig) DeepCopy() *AzureProviderConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(ButlerConfigStatus)
+	out := new(AzureProviderConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ButlerControllerAddonSpec) DeepCopyInto(out *ButlerControllerAddonSpec) {
+func (in *BMCConfig) DeepCopyInto(out *BMCConfig) {
 	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
+	if in.CredentialsRef != nil {
+		in, out := &in.CredentialsRef, &out.CredentialsRef
+		*out = new(SecretReference)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ButlerControllerAddonSpec.
-func (in *ButlerControllerAddonSpec) DeepCopy() *ButlerControllerAddonSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BMCConfig.
+func (in *BMCConfig) DeepCopy() *BMCConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(ButlerControllerAddonSpec)
+	out := new(BMCConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CAPIAddonSpec) DeepCopyInto(out *CAPIAddonSpec) {
+func (in *BackupAddonSpec) DeepCopyInto(out *BackupAddonSpec) {
 	*out = *in
 	if in.Enabled != nil {
 		in, out := &in.Enabled, &out.Enabled
 		*out = new(bool)
 		**out = **in
 	}
-	if in.InfrastructureProviders != nil {
-		in, out := &in.InfrastructureProviders, &out.InfrastructureProviders
-		*out = make([]CAPIInfraProviderSpec, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.StorageLocation != nil {
+		in, out := &in.StorageLocation, &out.StorageLocation
+		*out = new(BackupStorageLocation)
+		**out = **in
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(BackupSchedule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SnapshotVolumes != nil {
+		in, out := &in.SnapshotVolumes, &out.SnapshotVolumes
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = new(ExtensionValues)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CAPIAddonSpec.
-func (in *CAPIAddonSpec) DeepCopy() *CAPIAddonSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupAddonSpec.
+func (in *BackupAddonSpec) DeepCopy() *BackupAddonSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(CAPIAddonSpec)
+	out := new(BackupAddonSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CAPIInfraProviderSpec) DeepCopyInto(out *CAPIInfraProviderSpec) {
+func (in *BackupRetention) DeepCopyInto(out *BackupRetention) {
 	*out = *in
-	if in.CredentialsSecretRef != nil {
-		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
-		*out = new(SecretReference)
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.KeepLast != nil {
+		in, out := &in.KeepLast, &out.KeepLast
+		*out = new(int32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CAPIInfraProviderSpec.
-func (in *CAPIInfraProviderSpec) DeepCopy() *CAPIInfraProviderSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRetention.
+func (in *BackupRetention) DeepCopy() *BackupRetention {
 	if in == nil {
 		return nil
 	}
-	out := new(CAPIInfraProviderSpec)
+	out := new(BackupRetention)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CNIAddonSpec) DeepCopyInto(out *CNIAddonSpec) {
+func (in *BackupSchedule) DeepCopyInto(out *BackupSchedule) {
 	*out = *in
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.IncludedNamespaces != nil {
+		in, out := &in.IncludedNamespaces, &out.IncludedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedNamespaces != nil {
+		in, out := &in.ExcludedNamespaces, &out.ExcludedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CNIAddonSpec.
-func (in *CNIAddonSpec) DeepCopy() *CNIAddonSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupSchedule.
+func (in *BackupSchedule) DeepCopy() *BackupSchedule {
 	if in == nil {
 		return nil
 	}
-	out := new(CNIAddonSpec)
+	out := new(BackupSchedule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CNISpec) DeepCopyInto(out *CNISpec) {
+func (in *BackupStorageLocation) DeepCopyInto(out *BackupStorageLocation) {
 	*out = *in
-	if in.Values != nil {
-		in, out := &in.Values, &out.Values
-		*out = new(ExtensionValues)
-		(*in).DeepCopyInto(*out)
-	}
+	out.CredentialsRef = in.CredentialsRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CNISpec.
-func (in *CNISpec) DeepCopy() *CNISpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStorageLocation.
+func (in *BackupStorageLocation) DeepCopy() *BackupStorageLocation {
 	if in == nil {
 		return nil
 	}
-	out := new(CNISpec)
+	out := new(BackupStorageLocation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CertManagerAddonSpec) DeepCopyInto(out *CertManagerAddonSpec) {
+func (in *BareMetalBootConfig) DeepCopyInto(out *BareMetalBootConfig) {
 	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
+	if in.UEFI != nil {
+		in, out := &in.UEFI, &out.UEFI
 		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertManagerAddonSpec.
-func (in *CertManagerAddonSpec) DeepCopy() *CertManagerAddonSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BareMetalBootConfig.
+func (in *BareMetalBootConfig) DeepCopy() *BareMetalBootConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(CertManagerAddonSpec)
+	out := new(BareMetalBootConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CertManagerSpec) DeepCopyInto(out *CertManagerSpec) {
+func (in *BareMetalHost) DeepCopyInto(out *BareMetalHost) {
 	*out = *in
-	if in.Values != nil {
-		in, out := &in.Values, &out.Values
-		*out = new(ExtensionValues)
-		(*in).DeepCopyInto(*out)
+	in.BMC.DeepCopyInto(&out.BMC)
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertManagerSpec.
-func (in *CertManagerSpec) DeepCopy() *CertManagerSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BareMetalHost.
+func (in *BareMetalHost) DeepCopy() *BareMetalHost {
 	if in == nil {
 		return nil
 	}
-	out := new(CertManagerSpec)
+	out := new(BareMetalHost)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterBootstrap) DeepCopyInto(out *ClusterBootstrap) {
+func (in *BareMetalProviderConfig) DeepCopyInto(out *BareMetalProviderConfig) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.Boot.DeepCopyInto(&out.Boot)
+	if in.InventoryRef != nil {
+		in, out := &in.InventoryRef, &out.InventoryRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]BareMetalHost, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBootstrap.
-func (in *ClusterBootstrap) DeepCopy() *ClusterBootstrap {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BareMetalProviderConfig.
+func (in *BareMetalProviderConfig) DeepCopy() *BareMetalProviderConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterBootstrap)
+	out := new(BareMetalProviderConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterBootstrap) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterBootstrapAddonsSpec) DeepCopyInto(out *ClusterBootstrapAddonsSpec) {
+func (in *BastionConfig) DeepCopyInto(out *BastionConfig) {
 	*out = *in
-	if in.CNI != nil {
-		in, out := &in.CNI, &out.CNI
-		*out = new(CNIAddonSpec)
-		**out = **in
-	}
-	if in.Storage != nil {
-		in, out := &in.Storage, &out.Storage
-		*out = new(StorageAddonSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.LoadBalancer != nil {
-		in, out := &in.LoadBalancer, &out.LoadBalancer
-		*out = new(LoadBalancerAddonSpec)
-		**out = **in
-	}
-	if in.GitOps != nil {
-		in, out := &in.GitOps, &out.GitOps
-		*out = new(GitOpsAddonSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ControlPlaneHA != nil {
-		in, out := &in.ControlPlaneHA, &out.ControlPlaneHA
-		*out = new(ControlPlaneHAAddonSpec)
-		**out = **in
-	}
-	if in.CertManager != nil {
-		in, out := &in.CertManager, &out.CertManager
-		*out = new(CertManagerAddonSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Ingress != nil {
-		in, out := &in.Ingress, &out.Ingress
-		*out = new(IngressAddonSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ControlPlaneProvider != nil {
-		in, out := &in.ControlPlaneProvider, &out.ControlPlaneProvider
-		*out = new(ControlPlaneProviderAddonSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.CAPI != nil {
-		in, out := &in.CAPI, &out.CAPI
-		*out = new(CAPIAddonSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ButlerController != nil {
-		in, out := &in.ButlerController, &out.ButlerController
-		*out = new(ButlerControllerAddonSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Console != nil {
-		in, out := &in.Console, &out.Console
-		*out = new(ConsoleAddonSpec)
-		(*in).DeepCopyInto(*out)
-	}
+	out.PrivateKeySecretRef = in.PrivateKeySecretRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBootstrapAddonsSpec.
-func (in *ClusterBootstrapAddonsSpec) DeepCopy() *ClusterBootstrapAddonsSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BastionConfig.
+func (in *BastionConfig) DeepCopy() *BastionConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterBootstrapAddonsSpec)
+	out := new(BastionConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterBootstrapClusterSpec) DeepCopyInto(out *ClusterBootstrapClusterSpec) {
+func (in *BestFitStrategy) DeepCopyInto(out *BestFitStrategy) {
 	*out = *in
-	in.ControlPlane.DeepCopyInto(&out.ControlPlane)
-	if in.Workers != nil {
-		in, out := &in.Workers, &out.Workers
-		*out = new(ClusterBootstrapNodePool)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBootstrapClusterSpec.
-func (in *ClusterBootstrapClusterSpec) DeepCopy() *ClusterBootstrapClusterSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BestFitStrategy.
+func (in *BestFitStrategy) DeepCopy() *BestFitStrategy {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterBootstrapClusterSpec)
+	out := new(BestFitStrategy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterBootstrapList) DeepCopyInto(out *ClusterBootstrapList) {
+func (in *ButlerConfig) DeepCopyInto(out *ButlerConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]ClusterBootstrap, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBootstrapList.
-func (in *ClusterBootstrapList) DeepCopy() *ClusterBootstrapList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ButlerConfig.
+func (in *ButlerConfig) DeepCopy() *ButlerConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterBootstrapList)
+	out := new(ButlerConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterBootstrapList) DeepCopyObject() runtime.Object {
+func (in *ButlerConfig) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -799,284 +1009,408 @@ func (in *ClusterBootstrapList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterBootstrapMachineStatus) DeepCopyInto(out *ClusterBootstrapMachineStatus) {
+func (in *ButlerConfigList) DeepCopyInto(out *ButlerConfigList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ButlerConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBootstrapMachineStatus.
-func (in *ClusterBootstrapMachineStatus) DeepCopy() *ClusterBootstrapMachineStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ButlerConfigList.
+func (in *ButlerConfigList) DeepCopy() *ButlerConfigList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterBootstrapMachineStatus)
+	out := new(ButlerConfigList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ButlerConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterBootstrapNetworkSpec) DeepCopyInto(out *ClusterBootstrapNetworkSpec) {
+func (in *ButlerConfigSpec) DeepCopyInto(out *ButlerConfigSpec) {
 	*out = *in
-	if in.LoadBalancerPool != nil {
-		in, out := &in.LoadBalancerPool, &out.LoadBalancerPool
-		*out = new(LoadBalancerPoolSpec)
+	out.MultiTenancy = in.MultiTenancy
+	if in.DefaultProviderConfigRef != nil {
+		in, out := &in.DefaultProviderConfigRef, &out.DefaultProviderConfigRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+	if in.DefaultTeamLimits != nil {
+		in, out := &in.DefaultTeamLimits, &out.DefaultTeamLimits
+		*out = new(ResourceLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefaultAddonVersions != nil {
+		in, out := &in.DefaultAddonVersions, &out.DefaultAddonVersions
+		*out = new(AddonVersions)
+		**out = **in
+	}
+	if in.GitProvider != nil {
+		in, out := &in.GitProvider, &out.GitProvider
+		*out = new(GitProviderConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ControlPlaneExposure != nil {
+		in, out := &in.ControlPlaneExposure, &out.ControlPlaneExposure
+		*out = new(ControlPlaneExposureSpec)
 		**out = **in
 	}
+	if in.Observability != nil {
+		in, out := &in.Observability, &out.Observability
+		*out = new(ObservabilityConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefaultControlPlaneResources != nil {
+		in, out := &in.DefaultControlPlaneResources, &out.DefaultControlPlaneResources
+		*out = new(ControlPlaneResourcesSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImageFactory != nil {
+		in, out := &in.ImageFactory, &out.ImageFactory
+		*out = new(ImageFactoryConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SSHCertificateAuthority != nil {
+		in, out := &in.SSHCertificateAuthority, &out.SSHCertificateAuthority
+		*out = new(SSHCertificateAuthority)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefaultTimeServers != nil {
+		in, out := &in.DefaultTimeServers, &out.DefaultTimeServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Audit != nil {
+		in, out := &in.Audit, &out.Audit
+		*out = new(AuditConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = new(NotificationsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ApprovalPolicy != nil {
+		in, out := &in.ApprovalPolicy, &out.ApprovalPolicy
+		*out = new(ApprovalPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Archive != nil {
+		in, out := &in.Archive, &out.Archive
+		*out = new(ArchiveConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProvisioningLimits != nil {
+		in, out := &in.ProvisioningLimits, &out.ProvisioningLimits
+		*out = new(ProvisioningLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnvironmentPolicies != nil {
+		in, out := &in.EnvironmentPolicies, &out.EnvironmentPolicies
+		*out = make([]EnvironmentPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ReconcileTuning != nil {
+		in, out := &in.ReconcileTuning, &out.ReconcileTuning
+		*out = new(ReconcileTuning)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBootstrapNetworkSpec.
-func (in *ClusterBootstrapNetworkSpec) DeepCopy() *ClusterBootstrapNetworkSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ButlerConfigSpec.
+func (in *ButlerConfigSpec) DeepCopy() *ButlerConfigSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterBootstrapNetworkSpec)
+	out := new(ButlerConfigSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterBootstrapNodePool) DeepCopyInto(out *ClusterBootstrapNodePool) {
+func (in *ButlerConfigStatus) DeepCopyInto(out *ButlerConfigStatus) {
 	*out = *in
-	if in.ExtraDisks != nil {
-		in, out := &in.ExtraDisks, &out.ExtraDisks
-		*out = make([]DiskSpec, len(*in))
-		copy(*out, *in)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	if in.GitProvider != nil {
+		in, out := &in.GitProvider, &out.GitProvider
+		*out = new(GitProviderStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Observability != nil {
+		in, out := &in.Observability, &out.Observability
+		*out = new(ObservabilityStatus)
+		**out = **in
+	}
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]ComponentHealth, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Fleet != nil {
+		in, out := &in.Fleet, &out.Fleet
+		*out = new(FleetStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Reconcile != nil {
+		in, out := &in.Reconcile, &out.Reconcile
+		*out = new(ReconcileStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBootstrapNodePool.
-func (in *ClusterBootstrapNodePool) DeepCopy() *ClusterBootstrapNodePool {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ButlerConfigStatus.
+func (in *ButlerConfigStatus) DeepCopy() *ButlerConfigStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterBootstrapNodePool)
+	out := new(ButlerConfigStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterBootstrapSpec) DeepCopyInto(out *ClusterBootstrapSpec) {
+func (in *ButlerControllerAddonSpec) DeepCopyInto(out *ButlerControllerAddonSpec) {
 	*out = *in
-	out.ProviderRef = in.ProviderRef
-	in.Cluster.DeepCopyInto(&out.Cluster)
-	in.Network.DeepCopyInto(&out.Network)
-	in.Talos.DeepCopyInto(&out.Talos)
-	in.Addons.DeepCopyInto(&out.Addons)
-	if in.ControlPlaneExposure != nil {
-		in, out := &in.ControlPlaneExposure, &out.ControlPlaneExposure
-		*out = new(ControlPlaneExposureSpec)
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
 		**out = **in
 	}
+	in.PlatformComponentSpec.DeepCopyInto(&out.PlatformComponentSpec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBootstrapSpec.
-func (in *ClusterBootstrapSpec) DeepCopy() *ClusterBootstrapSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ButlerControllerAddonSpec.
+func (in *ButlerControllerAddonSpec) DeepCopy() *ButlerControllerAddonSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterBootstrapSpec)
+	out := new(ButlerControllerAddonSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterBootstrapStatus) DeepCopyInto(out *ClusterBootstrapStatus) {
+func (in *CAPIAddonSpec) DeepCopyInto(out *CAPIAddonSpec) {
 	*out = *in
-	if in.Machines != nil {
-		in, out := &in.Machines, &out.Machines
-		*out = make([]ClusterBootstrapMachineStatus, len(*in))
-		copy(*out, *in)
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
 	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+	if in.InfrastructureProviders != nil {
+		in, out := &in.InfrastructureProviders, &out.InfrastructureProviders
+		*out = make([]CAPIInfraProviderSpec, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	in.LastUpdated.DeepCopyInto(&out.LastUpdated)
-	if in.AddonsInstalled != nil {
-		in, out := &in.AddonsInstalled, &out.AddonsInstalled
-		*out = make(map[string]bool, len(*in))
+	if in.ProviderRepositories != nil {
+		in, out := &in.ProviderRepositories, &out.ProviderRepositories
+		*out = make([]CAPIProviderRepository, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ImageOverrides != nil {
+		in, out := &in.ImageOverrides, &out.ImageOverrides
+		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBootstrapStatus.
-func (in *ClusterBootstrapStatus) DeepCopy() *ClusterBootstrapStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CAPIAddonSpec.
+func (in *CAPIAddonSpec) DeepCopy() *CAPIAddonSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterBootstrapStatus)
+	out := new(CAPIAddonSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterBootstrapTalosSpec) DeepCopyInto(out *ClusterBootstrapTalosSpec) {
+func (in *CAPIInfraProviderSpec) DeepCopyInto(out *CAPIInfraProviderSpec) {
 	*out = *in
-	if in.ConfigPatches != nil {
-		in, out := &in.ConfigPatches, &out.ConfigPatches
-		*out = make([]TalosConfigPatch, len(*in))
-		copy(*out, *in)
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(SecretReference)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBootstrapTalosSpec.
-func (in *ClusterBootstrapTalosSpec) DeepCopy() *ClusterBootstrapTalosSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CAPIInfraProviderSpec.
+func (in *CAPIInfraProviderSpec) DeepCopy() *CAPIInfraProviderSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterBootstrapTalosSpec)
+	out := new(CAPIInfraProviderSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterDefaults) DeepCopyInto(out *ClusterDefaults) {
+func (in *CAPIObjectReference) DeepCopyInto(out *CAPIObjectReference) {
 	*out = *in
-	if in.WorkerCount != nil {
-		in, out := &in.WorkerCount, &out.WorkerCount
-		*out = new(int32)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CAPIObjectReference.
+func (in *CAPIObjectReference) DeepCopy() *CAPIObjectReference {
+	if in == nil {
+		return nil
+	}
+	out := new(CAPIObjectReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CAPIObjectRefs) DeepCopyInto(out *CAPIObjectRefs) {
+	*out = *in
+	if in.Cluster != nil {
+		in, out := &in.Cluster, &out.Cluster
+		*out = new(CAPIObjectReference)
 		**out = **in
 	}
-	if in.WorkerCPU != nil {
-		in, out := &in.WorkerCPU, &out.WorkerCPU
-		*out = new(int32)
+	if in.ControlPlane != nil {
+		in, out := &in.ControlPlane, &out.ControlPlane
+		*out = new(CAPIObjectReference)
 		**out = **in
 	}
-	if in.WorkerMemoryGi != nil {
-		in, out := &in.WorkerMemoryGi, &out.WorkerMemoryGi
-		*out = new(int32)
+	if in.InfrastructureCluster != nil {
+		in, out := &in.InfrastructureCluster, &out.InfrastructureCluster
+		*out = new(CAPIObjectReference)
 		**out = **in
 	}
-	if in.WorkerDiskGi != nil {
-		in, out := &in.WorkerDiskGi, &out.WorkerDiskGi
-		*out = new(int32)
+	if in.MachineDeployment != nil {
+		in, out := &in.MachineDeployment, &out.MachineDeployment
+		*out = new(CAPIObjectReference)
 		**out = **in
 	}
-	if in.DefaultAddons != nil {
-		in, out := &in.DefaultAddons, &out.DefaultAddons
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.BootstrapConfigTemplate != nil {
+		in, out := &in.BootstrapConfigTemplate, &out.BootstrapConfigTemplate
+		*out = new(CAPIObjectReference)
+		**out = **in
+	}
+	if in.InfrastructureMachineTemplate != nil {
+		in, out := &in.InfrastructureMachineTemplate, &out.InfrastructureMachineTemplate
+		*out = new(CAPIObjectReference)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterDefaults.
-func (in *ClusterDefaults) DeepCopy() *ClusterDefaults {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CAPIObjectRefs.
+func (in *CAPIObjectRefs) DeepCopy() *CAPIObjectRefs {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterDefaults)
+	out := new(CAPIObjectRefs)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComponentResources) DeepCopyInto(out *ComponentResources) {
+func (in *CAPIProviderRepository) DeepCopyInto(out *CAPIProviderRepository) {
 	*out = *in
-	if in.Requests != nil {
-		in, out := &in.Requests, &out.Requests
-		*out = new(ResourceQuantities)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Limits != nil {
-		in, out := &in.Limits, &out.Limits
-		*out = new(ResourceQuantities)
-		(*in).DeepCopyInto(*out)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentResources.
-func (in *ComponentResources) DeepCopy() *ComponentResources {
-	if in == nil {
-		return nil
-	}
-	out := new(ComponentResources)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConsoleAddonSpec) DeepCopyInto(out *ConsoleAddonSpec) {
-	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Ingress != nil {
-		in, out := &in.Ingress, &out.Ingress
-		*out = new(ConsoleIngressSpec)
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(NamespacedObjectReference)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleAddonSpec.
-func (in *ConsoleAddonSpec) DeepCopy() *ConsoleAddonSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CAPIProviderRepository.
+func (in *CAPIProviderRepository) DeepCopy() *CAPIProviderRepository {
 	if in == nil {
 		return nil
 	}
-	out := new(ConsoleAddonSpec)
+	out := new(CAPIProviderRepository)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConsoleIngressSpec) DeepCopyInto(out *ConsoleIngressSpec) {
+func (in *CNIAddonSpec) DeepCopyInto(out *CNIAddonSpec) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleIngressSpec.
-func (in *ConsoleIngressSpec) DeepCopy() *ConsoleIngressSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CNIAddonSpec.
+func (in *CNIAddonSpec) DeepCopy() *CNIAddonSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ConsoleIngressSpec)
+	out := new(CNIAddonSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ControlPlaneExposureSpec) DeepCopyInto(out *ControlPlaneExposureSpec) {
+func (in *CNISpec) DeepCopyInto(out *CNISpec) {
 	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = new(ExtensionValues)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneExposureSpec.
-func (in *ControlPlaneExposureSpec) DeepCopy() *ControlPlaneExposureSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CNISpec.
+func (in *CNISpec) DeepCopy() *CNISpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ControlPlaneExposureSpec)
+	out := new(CNISpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ControlPlaneHAAddonSpec) DeepCopyInto(out *ControlPlaneHAAddonSpec) {
+func (in *CachedImageStatus) DeepCopyInto(out *CachedImageStatus) {
 	*out = *in
+	out.FactoryRef = in.FactoryRef
+	if in.LastRefreshTime != nil {
+		in, out := &in.LastRefreshTime, &out.LastRefreshTime
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneHAAddonSpec.
-func (in *ControlPlaneHAAddonSpec) DeepCopy() *ControlPlaneHAAddonSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CachedImageStatus.
+func (in *CachedImageStatus) DeepCopy() *CachedImageStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ControlPlaneHAAddonSpec)
+	out := new(CachedImageStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ControlPlaneProviderAddonSpec) DeepCopyInto(out *ControlPlaneProviderAddonSpec) {
+func (in *CertManagerAddonSpec) DeepCopyInto(out *CertManagerAddonSpec) {
 	*out = *in
 	if in.Enabled != nil {
 		in, out := &in.Enabled, &out.Enabled
@@ -1085,424 +1419,296 @@ func (in *ControlPlaneProviderAddonSpec) DeepCopyInto(out *ControlPlaneProviderA
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneProviderAddonSpec.
-func (in *ControlPlaneProviderAddonSpec) DeepCopy() *ControlPlaneProviderAddonSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertManagerAddonSpec.
+func (in *CertManagerAddonSpec) DeepCopy() *CertManagerAddonSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ControlPlaneProviderAddonSpec)
+	out := new(CertManagerAddonSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ControlPlaneResourcesSpec) DeepCopyInto(out *ControlPlaneResourcesSpec) {
+func (in *CertManagerSpec) DeepCopyInto(out *CertManagerSpec) {
 	*out = *in
-	if in.APIServer != nil {
-		in, out := &in.APIServer, &out.APIServer
-		*out = new(ComponentResources)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ControllerManager != nil {
-		in, out := &in.ControllerManager, &out.ControllerManager
-		*out = new(ComponentResources)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Scheduler != nil {
-		in, out := &in.Scheduler, &out.Scheduler
-		*out = new(ComponentResources)
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = new(ExtensionValues)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneResourcesSpec.
-func (in *ControlPlaneResourcesSpec) DeepCopy() *ControlPlaneResourcesSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertManagerSpec.
+func (in *CertManagerSpec) DeepCopy() *CertManagerSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ControlPlaneResourcesSpec)
+	out := new(CertManagerSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ControlPlaneSpec) DeepCopyInto(out *ControlPlaneSpec) {
+func (in *ChangeRequest) DeepCopyInto(out *ChangeRequest) {
 	*out = *in
-	if in.DataStoreRef != nil {
-		in, out := &in.DataStoreRef, &out.DataStoreRef
-		*out = new(LocalObjectReference)
-		**out = **in
-	}
-	if in.CertSANs != nil {
-		in, out := &in.CertSANs, &out.CertSANs
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.ExternalCloudProvider != nil {
-		in, out := &in.ExternalCloudProvider, &out.ExternalCloudProvider
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = new(ControlPlaneResourcesSpec)
-		(*in).DeepCopyInto(*out)
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneSpec.
-func (in *ControlPlaneSpec) DeepCopy() *ControlPlaneSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChangeRequest.
+func (in *ChangeRequest) DeepCopy() *ChangeRequest {
 	if in == nil {
 		return nil
 	}
-	out := new(ControlPlaneSpec)
+	out := new(ChangeRequest)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DiskSpec) DeepCopyInto(out *DiskSpec) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskSpec.
-func (in *DiskSpec) DeepCopy() *DiskSpec {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChangeRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(DiskSpec)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DotfilesSpec) DeepCopyInto(out *DotfilesSpec) {
+func (in *ChangeRequestList) DeepCopyInto(out *ChangeRequestList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ChangeRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DotfilesSpec.
-func (in *DotfilesSpec) DeepCopy() *DotfilesSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChangeRequestList.
+func (in *ChangeRequestList) DeepCopy() *ChangeRequestList {
 	if in == nil {
 		return nil
 	}
-	out := new(DotfilesSpec)
+	out := new(ChangeRequestList)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EditorConfig) DeepCopyInto(out *EditorConfig) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EditorConfig.
-func (in *EditorConfig) DeepCopy() *EditorConfig {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChangeRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(EditorConfig)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EnvironmentLimits) DeepCopyInto(out *EnvironmentLimits) {
+func (in *ChangeRequestSpec) DeepCopyInto(out *ChangeRequestSpec) {
 	*out = *in
-	if in.MaxClusters != nil {
-		in, out := &in.MaxClusters, &out.MaxClusters
-		*out = new(int32)
-		**out = **in
+	out.TargetRef = in.TargetRef
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
 	}
-	if in.MaxClustersPerMember != nil {
-		in, out := &in.MaxClustersPerMember, &out.MaxClustersPerMember
-		*out = new(int32)
-		**out = **in
+	if in.Reviews != nil {
+		in, out := &in.Reviews, &out.Reviews
+		*out = make([]ChangeReview, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentLimits.
-func (in *EnvironmentLimits) DeepCopy() *EnvironmentLimits {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChangeRequestSpec.
+func (in *ChangeRequestSpec) DeepCopy() *ChangeRequestSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(EnvironmentLimits)
+	out := new(ChangeRequestSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EnvironmentSpec) DeepCopyInto(out *EnvironmentSpec) {
+func (in *ChangeRequestStatus) DeepCopyInto(out *ChangeRequestStatus) {
 	*out = *in
-	if in.Limits != nil {
-		in, out := &in.Limits, &out.Limits
-		*out = new(EnvironmentLimits)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Access != nil {
-		in, out := &in.Access, &out.Access
-		*out = new(TeamAccess)
-		(*in).DeepCopyInto(*out)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.ClusterDefaults != nil {
-		in, out := &in.ClusterDefaults, &out.ClusterDefaults
-		*out = new(ClusterDefaults)
-		(*in).DeepCopyInto(*out)
+	if in.AppliedAt != nil {
+		in, out := &in.AppliedAt, &out.AppliedAt
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentSpec.
-func (in *EnvironmentSpec) DeepCopy() *EnvironmentSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChangeRequestStatus.
+func (in *ChangeRequestStatus) DeepCopy() *ChangeRequestStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(EnvironmentSpec)
+	out := new(ChangeRequestStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExtensionValues) DeepCopyInto(out *ExtensionValues) {
+func (in *ChangeReview) DeepCopyInto(out *ChangeReview) {
 	*out = *in
-	if in.Raw != nil {
-		in, out := &in.Raw, &out.Raw
-		*out = make([]byte, len(*in))
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtensionValues.
-func (in *ExtensionValues) DeepCopy() *ExtensionValues {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChangeReview.
+func (in *ChangeReview) DeepCopy() *ChangeReview {
 	if in == nil {
 		return nil
 	}
-	out := new(ExtensionValues)
+	out := new(ChangeReview)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GCPOverride) DeepCopyInto(out *GCPOverride) {
+func (in *ChangeTargetReference) DeepCopyInto(out *ChangeTargetReference) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPOverride.
-func (in *GCPOverride) DeepCopy() *GCPOverride {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChangeTargetReference.
+func (in *ChangeTargetReference) DeepCopy() *ChangeTargetReference {
 	if in == nil {
 		return nil
 	}
-	out := new(GCPOverride)
+	out := new(ChangeTargetReference)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GCPProviderConfig) DeepCopyInto(out *GCPProviderConfig) {
+func (in *ClusterArchive) DeepCopyInto(out *ClusterArchive) {
 	*out = *in
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPProviderConfig.
-func (in *GCPProviderConfig) DeepCopy() *GCPProviderConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterArchive.
+func (in *ClusterArchive) DeepCopy() *ClusterArchive {
 	if in == nil {
 		return nil
 	}
-	out := new(GCPProviderConfig)
+	out := new(ClusterArchive)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterArchive) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GitOpsAddonSpec) DeepCopyInto(out *GitOpsAddonSpec) {
+func (in *ClusterArchiveList) DeepCopyInto(out *ClusterArchiveList) {
 	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterArchive, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitOpsAddonSpec.
-func (in *GitOpsAddonSpec) DeepCopy() *GitOpsAddonSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterArchiveList.
+func (in *ClusterArchiveList) DeepCopy() *ClusterArchiveList {
 	if in == nil {
 		return nil
 	}
-	out := new(GitOpsAddonSpec)
+	out := new(ClusterArchiveList)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GitOpsDirectoryLayout) DeepCopyInto(out *GitOpsDirectoryLayout) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitOpsDirectoryLayout.
-func (in *GitOpsDirectoryLayout) DeepCopy() *GitOpsDirectoryLayout {
-	if in == nil {
-		return nil
-	}
-	out := new(GitOpsDirectoryLayout)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GitOpsSpec) DeepCopyInto(out *GitOpsSpec) {
-	*out = *in
-	if in.Repository != nil {
-		in, out := &in.Repository, &out.Repository
-		*out = new(GitRepositorySpec)
-		(*in).DeepCopyInto(*out)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitOpsSpec.
-func (in *GitOpsSpec) DeepCopy() *GitOpsSpec {
-	if in == nil {
-		return nil
-	}
-	out := new(GitOpsSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GitProviderConfig) DeepCopyInto(out *GitProviderConfig) {
-	*out = *in
-	out.SecretRef = in.SecretRef
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitProviderConfig.
-func (in *GitProviderConfig) DeepCopy() *GitProviderConfig {
-	if in == nil {
-		return nil
-	}
-	out := new(GitProviderConfig)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GitProviderStatus) DeepCopyInto(out *GitProviderStatus) {
-	*out = *in
-	if in.LastValidated != nil {
-		in, out := &in.LastValidated, &out.LastValidated
-		*out = (*in).DeepCopy()
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitProviderStatus.
-func (in *GitProviderStatus) DeepCopy() *GitProviderStatus {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterArchiveList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(GitProviderStatus)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GitRepositorySpec) DeepCopyInto(out *GitRepositorySpec) {
+func (in *ClusterArchiveSpec) DeepCopyInto(out *ClusterArchiveSpec) {
 	*out = *in
-	if in.SecretRef != nil {
-		in, out := &in.SecretRef, &out.SecretRef
-		*out = new(LocalObjectReference)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitRepositorySpec.
-func (in *GitRepositorySpec) DeepCopy() *GitRepositorySpec {
-	if in == nil {
-		return nil
+	out.ClusterRef = in.ClusterRef
+	in.ClusterSpec.DeepCopyInto(&out.ClusterSpec)
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
-	out := new(GitRepositorySpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GoogleWorkspaceConfig) DeepCopyInto(out *GoogleWorkspaceConfig) {
-	*out = *in
-	out.ServiceAccountSecretRef = in.ServiceAccountSecretRef
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleWorkspaceConfig.
-func (in *GoogleWorkspaceConfig) DeepCopy() *GoogleWorkspaceConfig {
-	if in == nil {
-		return nil
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
-	out := new(GoogleWorkspaceConfig)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HarvesterOverride) DeepCopyInto(out *HarvesterOverride) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HarvesterOverride.
-func (in *HarvesterOverride) DeepCopy() *HarvesterOverride {
-	if in == nil {
-		return nil
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+	in.DeletedAt.DeepCopyInto(&out.DeletedAt)
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = new(ClusterUsageTotals)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(HarvesterOverride)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HarvesterProviderConfig) DeepCopyInto(out *HarvesterProviderConfig) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HarvesterProviderConfig.
-func (in *HarvesterProviderConfig) DeepCopy() *HarvesterProviderConfig {
-	if in == nil {
-		return nil
+	if in.Artifacts != nil {
+		in, out := &in.Artifacts, &out.Artifacts
+		*out = make([]ArchivedArtifact, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	out := new(HarvesterProviderConfig)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HelmChartSpec) DeepCopyInto(out *HelmChartSpec) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmChartSpec.
-func (in *HelmChartSpec) DeepCopy() *HelmChartSpec {
-	if in == nil {
-		return nil
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
 	}
-	out := new(HelmChartSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HelmReleaseStatus) DeepCopyInto(out *HelmReleaseStatus) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmReleaseStatus.
-func (in *HelmReleaseStatus) DeepCopy() *HelmReleaseStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterArchiveSpec.
+func (in *ClusterArchiveSpec) DeepCopy() *ClusterArchiveSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(HelmReleaseStatus)
+	out := new(ClusterArchiveSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPAllocation) DeepCopyInto(out *IPAllocation) {
+func (in *ClusterBackupPolicy) DeepCopyInto(out *ClusterBackupPolicy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1510,18 +1716,18 @@ func (in *IPAllocation) DeepCopyInto(out *IPAllocation) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAllocation.
-func (in *IPAllocation) DeepCopy() *IPAllocation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBackupPolicy.
+func (in *ClusterBackupPolicy) DeepCopy() *ClusterBackupPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(IPAllocation)
+	out := new(ClusterBackupPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IPAllocation) DeepCopyObject() runtime.Object {
+func (in *ClusterBackupPolicy) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1529,31 +1735,31 @@ func (in *IPAllocation) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPAllocationList) DeepCopyInto(out *IPAllocationList) {
+func (in *ClusterBackupPolicyList) DeepCopyInto(out *ClusterBackupPolicyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]IPAllocation, len(*in))
+		*out = make([]ClusterBackupPolicy, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAllocationList.
-func (in *IPAllocationList) DeepCopy() *IPAllocationList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBackupPolicyList.
+func (in *ClusterBackupPolicyList) DeepCopy() *ClusterBackupPolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(IPAllocationList)
+	out := new(ClusterBackupPolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IPAllocationList) DeepCopyObject() runtime.Object {
+func (in *ClusterBackupPolicyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1561,34 +1767,48 @@ func (in *IPAllocationList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPAllocationSpec) DeepCopyInto(out *IPAllocationSpec) {
+func (in *ClusterBackupPolicySpec) DeepCopyInto(out *ClusterBackupPolicySpec) {
 	*out = *in
-	out.PoolRef = in.PoolRef
-	out.TenantClusterRef = in.TenantClusterRef
-	if in.Count != nil {
-		in, out := &in.Count, &out.Count
-		*out = new(int32)
+	out.ClusterRef = in.ClusterRef
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(BackupRetention)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IncludedNamespaces != nil {
+		in, out := &in.IncludedNamespaces, &out.IncludedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedNamespaces != nil {
+		in, out := &in.ExcludedNamespaces, &out.ExcludedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StorageLocationRef != nil {
+		in, out := &in.StorageLocationRef, &out.StorageLocationRef
+		*out = new(LocalObjectReference)
 		**out = **in
 	}
-	if in.PinnedRange != nil {
-		in, out := &in.PinnedRange, &out.PinnedRange
-		*out = new(PinnedIPRange)
+	if in.SnapshotVolumes != nil {
+		in, out := &in.SnapshotVolumes, &out.SnapshotVolumes
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAllocationSpec.
-func (in *IPAllocationSpec) DeepCopy() *IPAllocationSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBackupPolicySpec.
+func (in *ClusterBackupPolicySpec) DeepCopy() *ClusterBackupPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(IPAllocationSpec)
+	out := new(ClusterBackupPolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPAllocationStatus) DeepCopyInto(out *IPAllocationStatus) {
+func (in *ClusterBackupPolicyStatus) DeepCopyInto(out *ClusterBackupPolicyStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -1597,48 +1817,32 @@ func (in *IPAllocationStatus) DeepCopyInto(out *IPAllocationStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Addresses != nil {
-		in, out := &in.Addresses, &out.Addresses
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.AllocatedAt != nil {
-		in, out := &in.AllocatedAt, &out.AllocatedAt
+	if in.LastBackupTime != nil {
+		in, out := &in.LastBackupTime, &out.LastBackupTime
 		*out = (*in).DeepCopy()
 	}
-	if in.ReleasedAt != nil {
-		in, out := &in.ReleasedAt, &out.ReleasedAt
+	if in.LastSuccessfulBackupTime != nil {
+		in, out := &in.LastSuccessfulBackupTime, &out.LastSuccessfulBackupTime
 		*out = (*in).DeepCopy()
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAllocationStatus.
-func (in *IPAllocationStatus) DeepCopy() *IPAllocationStatus {
-	if in == nil {
-		return nil
+	if in.NextBackupTime != nil {
+		in, out := &in.NextBackupTime, &out.NextBackupTime
+		*out = (*in).DeepCopy()
 	}
-	out := new(IPAllocationStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPPool) DeepCopyInto(out *IPPool) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPool.
-func (in *IPPool) DeepCopy() *IPPool {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBackupPolicyStatus.
+func (in *ClusterBackupPolicyStatus) DeepCopy() *ClusterBackupPolicyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(IPPool)
+	out := new(ClusterBackupPolicyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IdentityProvider) DeepCopyInto(out *IdentityProvider) {
+func (in *ClusterBootstrap) DeepCopyInto(out *ClusterBootstrap) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1646,18 +1850,18 @@ func (in *IdentityProvider) DeepCopyInto(out *IdentityProvider) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityProvider.
-func (in *IdentityProvider) DeepCopy() *IdentityProvider {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBootstrap.
+func (in *ClusterBootstrap) DeepCopy() *ClusterBootstrap {
 	if in == nil {
 		return nil
 	}
-	out := new(IdentityProvider)
+	out := new(ClusterBootstrap)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IdentityProvider) DeepCopyObject() runtime.Object {
+func (in *ClusterBootstrap) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1665,154 +1869,6314 @@ func (in *IdentityProvider) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IdentityProviderList) DeepCopyInto(out *IdentityProviderList) {
+func (in *ClusterBootstrapAddonsSpec) DeepCopyInto(out *ClusterBootstrapAddonsSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]IdentityProvider, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.CNI != nil {
+		in, out := &in.CNI, &out.CNI
+		*out = new(CNIAddonSpec)
+		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityProviderList.
-func (in *IdentityProviderList) DeepCopy() *IdentityProviderList {
-	if in == nil {
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(StorageAddonSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(LoadBalancerAddonSpec)
+		**out = **in
+	}
+	if in.GitOps != nil {
+		in, out := &in.GitOps, &out.GitOps
+		*out = new(GitOpsAddonSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ControlPlaneHA != nil {
+		in, out := &in.ControlPlaneHA, &out.ControlPlaneHA
+		*out = new(ControlPlaneHAAddonSpec)
+		**out = **in
+	}
+	if in.CertManager != nil {
+		in, out := &in.CertManager, &out.CertManager
+		*out = new(CertManagerAddonSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(IngressAddonSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ControlPlaneProvider != nil {
+		in, out := &in.ControlPlaneProvider, &out.ControlPlaneProvider
+		*out = new(ControlPlaneProviderAddonSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CAPI != nil {
+		in, out := &in.CAPI, &out.CAPI
+		*out = new(CAPIAddonSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ButlerController != nil {
+		in, out := &in.ButlerController, &out.ButlerController
+		*out = new(ButlerControllerAddonSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Console != nil {
+		in, out := &in.Console, &out.Console
+		*out = new(ConsoleAddonSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Server != nil {
+		in, out := &in.Server, &out.Server
+		*out = new(ServerAddonSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(MonitoringAddonSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(BackupAddonSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBootstrapAddonsSpec.
+func (in *ClusterBootstrapAddonsSpec) DeepCopy() *ClusterBootstrapAddonsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterBootstrapAddonsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterBootstrapClusterSpec) DeepCopyInto(out *ClusterBootstrapClusterSpec) {
+	*out = *in
+	in.ControlPlane.DeepCopyInto(&out.ControlPlane)
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = new(ClusterBootstrapNodePool)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBootstrapClusterSpec.
+func (in *ClusterBootstrapClusterSpec) DeepCopy() *ClusterBootstrapClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterBootstrapClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterBootstrapList) DeepCopyInto(out *ClusterBootstrapList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterBootstrap, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBootstrapList.
+func (in *ClusterBootstrapList) DeepCopy() *ClusterBootstrapList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterBootstrapList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterBootstrapList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterBootstrapMachineStatus) DeepCopyInto(out *ClusterBootstrapMachineStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBootstrapMachineStatus.
+func (in *ClusterBootstrapMachineStatus) DeepCopy() *ClusterBootstrapMachineStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterBootstrapMachineStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterBootstrapNetworkSpec) DeepCopyInto(out *ClusterBootstrapNetworkSpec) {
+	*out = *in
+	if in.PodCIDRs != nil {
+		in, out := &in.PodCIDRs, &out.PodCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServiceCIDRs != nil {
+		in, out := &in.ServiceCIDRs, &out.ServiceCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VIPPoolRef != nil {
+		in, out := &in.VIPPoolRef, &out.VIPPoolRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+	if in.LoadBalancerPool != nil {
+		in, out := &in.LoadBalancerPool, &out.LoadBalancerPool
+		*out = new(LoadBalancerPoolSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBootstrapNetworkSpec.
+func (in *ClusterBootstrapNetworkSpec) DeepCopy() *ClusterBootstrapNetworkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterBootstrapNetworkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterBootstrapNodeOverride) DeepCopyInto(out *ClusterBootstrapNodeOverride) {
+	*out = *in
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MemoryMB != nil {
+		in, out := &in.MemoryMB, &out.MemoryMB
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DiskGB != nil {
+		in, out := &in.DiskGB, &out.DiskGB
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ExtraDisks != nil {
+		in, out := &in.ExtraDisks, &out.ExtraDisks
+		*out = make([]DiskSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBootstrapNodeOverride.
+func (in *ClusterBootstrapNodeOverride) DeepCopy() *ClusterBootstrapNodeOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterBootstrapNodeOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterBootstrapNodePool) DeepCopyInto(out *ClusterBootstrapNodePool) {
+	*out = *in
+	if in.ExtraDisks != nil {
+		in, out := &in.ExtraDisks, &out.ExtraDisks
+		*out = make([]DiskSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Taints != nil {
+		in, out := &in.Taints, &out.Taints
+		*out = make([]NodeTaint, len(*in))
+		copy(*out, *in)
+	}
+	if in.KubeletExtraArgs != nil {
+		in, out := &in.KubeletExtraArgs, &out.KubeletExtraArgs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodeAnnotations != nil {
+		in, out := &in.NodeAnnotations, &out.NodeAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Firmware != nil {
+		in, out := &in.Firmware, &out.Firmware
+		*out = new(FirmwareSpec)
+		**out = **in
+	}
+	if in.Placement != nil {
+		in, out := &in.Placement, &out.Placement
+		*out = new(PlacementSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]ClusterBootstrapNodeOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBootstrapNodePool.
+func (in *ClusterBootstrapNodePool) DeepCopy() *ClusterBootstrapNodePool {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterBootstrapNodePool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterBootstrapSpec) DeepCopyInto(out *ClusterBootstrapSpec) {
+	*out = *in
+	out.ProviderRef = in.ProviderRef
+	in.Cluster.DeepCopyInto(&out.Cluster)
+	in.Network.DeepCopyInto(&out.Network)
+	in.Talos.DeepCopyInto(&out.Talos)
+	in.Addons.DeepCopyInto(&out.Addons)
+	if in.ControlPlaneExposure != nil {
+		in, out := &in.ControlPlaneExposure, &out.ControlPlaneExposure
+		*out = new(ControlPlaneExposureSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBootstrapSpec.
+func (in *ClusterBootstrapSpec) DeepCopy() *ClusterBootstrapSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterBootstrapSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterBootstrapStatus) DeepCopyInto(out *ClusterBootstrapStatus) {
+	*out = *in
+	if in.VIPAllocationRef != nil {
+		in, out := &in.VIPAllocationRef, &out.VIPAllocationRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(MonitoringEndpoints)
+		**out = **in
+	}
+	if in.Machines != nil {
+		in, out := &in.Machines, &out.Machines
+		*out = make([]ClusterBootstrapMachineStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.LastUpdated.DeepCopyInto(&out.LastUpdated)
+	if in.AddonsInstalled != nil {
+		in, out := &in.AddonsInstalled, &out.AddonsInstalled
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Summary != nil {
+		in, out := &in.Summary, &out.Summary
+		*out = new(StatusSummary)
+		**out = **in
+	}
+	if in.Reconcile != nil {
+		in, out := &in.Reconcile, &out.Reconcile
+		*out = new(ReconcileStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBootstrapStatus.
+func (in *ClusterBootstrapStatus) DeepCopy() *ClusterBootstrapStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterBootstrapStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterBootstrapTalosSpec) DeepCopyInto(out *ClusterBootstrapTalosSpec) {
+	*out = *in
+	if in.ReleaseRef != nil {
+		in, out := &in.ReleaseRef, &out.ReleaseRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+	if in.ConfigPatches != nil {
+		in, out := &in.ConfigPatches, &out.ConfigPatches
+		*out = make([]TalosConfigPatch, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBootstrapTalosSpec.
+func (in *ClusterBootstrapTalosSpec) DeepCopy() *ClusterBootstrapTalosSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterBootstrapTalosSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterComplianceResult) DeepCopyInto(out *ClusterComplianceResult) {
+	*out = *in
+	out.ClusterRef = in.ClusterRef
+	if in.Checks != nil {
+		in, out := &in.Checks, &out.Checks
+		*out = make([]ComplianceCheckResult, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterComplianceResult.
+func (in *ClusterComplianceResult) DeepCopy() *ClusterComplianceResult {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterComplianceResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterDefaults) DeepCopyInto(out *ClusterDefaults) {
+	*out = *in
+	if in.WorkerCount != nil {
+		in, out := &in.WorkerCount, &out.WorkerCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.WorkerCPU != nil {
+		in, out := &in.WorkerCPU, &out.WorkerCPU
+		*out = new(int32)
+		**out = **in
+	}
+	if in.WorkerMemoryGi != nil {
+		in, out := &in.WorkerMemoryGi, &out.WorkerMemoryGi
+		*out = new(int32)
+		**out = **in
+	}
+	if in.WorkerDiskGi != nil {
+		in, out := &in.WorkerDiskGi, &out.WorkerDiskGi
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DefaultAddons != nil {
+		in, out := &in.DefaultAddons, &out.DefaultAddons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterDefaults.
+func (in *ClusterDefaults) DeepCopy() *ClusterDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterMeshPeerStatus) DeepCopyInto(out *ClusterMeshPeerStatus) {
+	*out = *in
+	if in.LastConnectedTime != nil {
+		in, out := &in.LastConnectedTime, &out.LastConnectedTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterMeshPeerStatus.
+func (in *ClusterMeshPeerStatus) DeepCopy() *ClusterMeshPeerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterMeshPeerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterMeshSpec) DeepCopyInto(out *ClusterMeshSpec) {
+	*out = *in
+	if in.Peers != nil {
+		in, out := &in.Peers, &out.Peers
+		*out = make([]NamespacedObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.CASecretRef != nil {
+		in, out := &in.CASecretRef, &out.CASecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterMeshSpec.
+func (in *ClusterMeshSpec) DeepCopy() *ClusterMeshSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterMeshSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterMeshStatus) DeepCopyInto(out *ClusterMeshStatus) {
+	*out = *in
+	if in.Peers != nil {
+		in, out := &in.Peers, &out.Peers
+		*out = make([]ClusterMeshPeerStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterMeshStatus.
+func (in *ClusterMeshStatus) DeepCopy() *ClusterMeshStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterMeshStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterMetadata) DeepCopyInto(out *ClusterMetadata) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterMetadata.
+func (in *ClusterMetadata) DeepCopy() *ClusterMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRestore) DeepCopyInto(out *ClusterRestore) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRestore.
+func (in *ClusterRestore) DeepCopy() *ClusterRestore {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRestore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterRestore) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRestoreList) DeepCopyInto(out *ClusterRestoreList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterRestore, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRestoreList.
+func (in *ClusterRestoreList) DeepCopy() *ClusterRestoreList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRestoreList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterRestoreList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRestoreSpec) DeepCopyInto(out *ClusterRestoreSpec) {
+	*out = *in
+	if in.BackupPolicyRef != nil {
+		in, out := &in.BackupPolicyRef, &out.BackupPolicyRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+	in.Target.DeepCopyInto(&out.Target)
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = make([]RestoreScope, len(*in))
+		copy(*out, *in)
+	}
+	if in.IncludedNamespaces != nil {
+		in, out := &in.IncludedNamespaces, &out.IncludedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedNamespaces != nil {
+		in, out := &in.ExcludedNamespaces, &out.ExcludedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamespaceMapping != nil {
+		in, out := &in.NamespaceMapping, &out.NamespaceMapping
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRestoreSpec.
+func (in *ClusterRestoreSpec) DeepCopy() *ClusterRestoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRestoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRestoreStatus) DeepCopyInto(out *ClusterRestoreStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TargetClusterRef != nil {
+		in, out := &in.TargetClusterRef, &out.TargetClusterRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletedAt != nil {
+		in, out := &in.CompletedAt, &out.CompletedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRestoreStatus.
+func (in *ClusterRestoreStatus) DeepCopy() *ClusterRestoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRestoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplate) DeepCopyInto(out *ClusterTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplate.
+func (in *ClusterTemplate) DeepCopy() *ClusterTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplateList) DeepCopyInto(out *ClusterTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateList.
+func (in *ClusterTemplateList) DeepCopy() *ClusterTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplateSpec) DeepCopyInto(out *ClusterTemplateSpec) {
+	*out = *in
+	if in.Variables != nil {
+		in, out := &in.Variables, &out.Variables
+		*out = make([]ClusterTemplateVariable, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Template.DeepCopyInto(&out.Template)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateSpec.
+func (in *ClusterTemplateSpec) DeepCopy() *ClusterTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplateVariable) DeepCopyInto(out *ClusterTemplateVariable) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enum != nil {
+		in, out := &in.Enum, &out.Enum
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Minimum != nil {
+		in, out := &in.Minimum, &out.Minimum
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Maximum != nil {
+		in, out := &in.Maximum, &out.Maximum
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateVariable.
+func (in *ClusterTemplateVariable) DeepCopy() *ClusterTemplateVariable {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplateVariable)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterUsageTotals) DeepCopyInto(out *ClusterUsageTotals) {
+	*out = *in
+	if in.NodeHours != nil {
+		in, out := &in.NodeHours, &out.NodeHours
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.CPUCoreHours != nil {
+		in, out := &in.CPUCoreHours, &out.CPUCoreHours
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MemoryGiBHours != nil {
+		in, out := &in.MemoryGiBHours, &out.MemoryGiBHours
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterUsageTotals.
+func (in *ClusterUsageTotals) DeepCopy() *ClusterUsageTotals {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterUsageTotals)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComplianceCheckResult) DeepCopyInto(out *ComplianceCheckResult) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComplianceCheckResult.
+func (in *ComplianceCheckResult) DeepCopy() *ComplianceCheckResult {
+	if in == nil {
+		return nil
+	}
+	out := new(ComplianceCheckResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComplianceCheckSummary) DeepCopyInto(out *ComplianceCheckSummary) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComplianceCheckSummary.
+func (in *ComplianceCheckSummary) DeepCopy() *ComplianceCheckSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(ComplianceCheckSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComplianceReport) DeepCopyInto(out *ComplianceReport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComplianceReport.
+func (in *ComplianceReport) DeepCopy() *ComplianceReport {
+	if in == nil {
+		return nil
+	}
+	out := new(ComplianceReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ComplianceReport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComplianceReportList) DeepCopyInto(out *ComplianceReportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ComplianceReport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComplianceReportList.
+func (in *ComplianceReportList) DeepCopy() *ComplianceReportList {
+	if in == nil {
+		return nil
+	}
+	out := new(ComplianceReportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ComplianceReportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComplianceReportSpec) DeepCopyInto(out *ComplianceReportSpec) {
+	*out = *in
+	if in.Checks != nil {
+		in, out := &in.Checks, &out.Checks
+		*out = make([]ComplianceCheck, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackupMaxAge != nil {
+		in, out := &in.BackupMaxAge, &out.BackupMaxAge
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComplianceReportSpec.
+func (in *ComplianceReportSpec) DeepCopy() *ComplianceReportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ComplianceReportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComplianceReportStatus) DeepCopyInto(out *ComplianceReportStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.GeneratedAt != nil {
+		in, out := &in.GeneratedAt, &out.GeneratedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.NextRunTime != nil {
+		in, out := &in.NextRunTime, &out.NextRunTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Summary != nil {
+		in, out := &in.Summary, &out.Summary
+		*out = make([]ComplianceCheckSummary, len(*in))
+		copy(*out, *in)
+	}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]ClusterComplianceResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComplianceReportStatus.
+func (in *ComplianceReportStatus) DeepCopy() *ComplianceReportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ComplianceReportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentHealth) DeepCopyInto(out *ComponentHealth) {
+	*out = *in
+	if in.LastHeartbeat != nil {
+		in, out := &in.LastHeartbeat, &out.LastHeartbeat
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentHealth.
+func (in *ComponentHealth) DeepCopy() *ComponentHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentResources) DeepCopyInto(out *ComponentResources) {
+	*out = *in
+	if in.Requests != nil {
+		in, out := &in.Requests, &out.Requests
+		*out = new(ResourceQuantities)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = new(ResourceQuantities)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentResources.
+func (in *ComponentResources) DeepCopy() *ComponentResources {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsoleAddonSpec) DeepCopyInto(out *ConsoleAddonSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(ConsoleIngressSpec)
+		**out = **in
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(ConsoleConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	in.PlatformComponentSpec.DeepCopyInto(&out.PlatformComponentSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleAddonSpec.
+func (in *ConsoleAddonSpec) DeepCopy() *ConsoleAddonSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsoleAddonSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsoleBranding) DeepCopyInto(out *ConsoleBranding) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleBranding.
+func (in *ConsoleBranding) DeepCopy() *ConsoleBranding {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsoleBranding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsoleConfig) DeepCopyInto(out *ConsoleConfig) {
+	*out = *in
+	if in.IdentityProviderRef != nil {
+		in, out := &in.IdentityProviderRef, &out.IdentityProviderRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+	if in.SessionSecretRef != nil {
+		in, out := &in.SessionSecretRef, &out.SessionSecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.SessionTTL != nil {
+		in, out := &in.SessionTTL, &out.SessionTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Branding != nil {
+		in, out := &in.Branding, &out.Branding
+		*out = new(ConsoleBranding)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleConfig.
+func (in *ConsoleConfig) DeepCopy() *ConsoleConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsoleConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsoleIngressSpec) DeepCopyInto(out *ConsoleIngressSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleIngressSpec.
+func (in *ConsoleIngressSpec) DeepCopy() *ConsoleIngressSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsoleIngressSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneExposureSpec) DeepCopyInto(out *ControlPlaneExposureSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneExposureSpec.
+func (in *ControlPlaneExposureSpec) DeepCopy() *ControlPlaneExposureSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneExposureSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneHAAddonSpec) DeepCopyInto(out *ControlPlaneHAAddonSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneHAAddonSpec.
+func (in *ControlPlaneHAAddonSpec) DeepCopy() *ControlPlaneHAAddonSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneHAAddonSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneProviderAddonSpec) DeepCopyInto(out *ControlPlaneProviderAddonSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DataStore != nil {
+		in, out := &in.DataStore, &out.DataStore
+		*out = new(DataStoreBootstrapSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneProviderAddonSpec.
+func (in *ControlPlaneProviderAddonSpec) DeepCopy() *ControlPlaneProviderAddonSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneProviderAddonSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneResourcesSpec) DeepCopyInto(out *ControlPlaneResourcesSpec) {
+	*out = *in
+	if in.APIServer != nil {
+		in, out := &in.APIServer, &out.APIServer
+		*out = new(ComponentResources)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ControllerManager != nil {
+		in, out := &in.ControllerManager, &out.ControllerManager
+		*out = new(ComponentResources)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Scheduler != nil {
+		in, out := &in.Scheduler, &out.Scheduler
+		*out = new(ComponentResources)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneResourcesSpec.
+func (in *ControlPlaneResourcesSpec) DeepCopy() *ControlPlaneResourcesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneResourcesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneSpec) DeepCopyInto(out *ControlPlaneSpec) {
+	*out = *in
+	if in.DataStoreRef != nil {
+		in, out := &in.DataStoreRef, &out.DataStoreRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+	if in.VIPPoolRef != nil {
+		in, out := &in.VIPPoolRef, &out.VIPPoolRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+	if in.CertSANs != nil {
+		in, out := &in.CertSANs, &out.CertSANs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExternalCloudProvider != nil {
+		in, out := &in.ExternalCloudProvider, &out.ExternalCloudProvider
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(ControlPlaneResourcesSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccessControl != nil {
+		in, out := &in.AccessControl, &out.AccessControl
+		*out = new(APIServerAccessControl)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(EncryptionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneSpec.
+func (in *ControlPlaneSpec) DeepCopy() *ControlPlaneSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CostEstimate) DeepCopyInto(out *CostEstimate) {
+	*out = *in
+	if in.Hourly != nil {
+		in, out := &in.Hourly, &out.Hourly
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Monthly != nil {
+		in, out := &in.Monthly, &out.Monthly
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CostEstimate.
+func (in *CostEstimate) DeepCopy() *CostEstimate {
+	if in == nil {
+		return nil
+	}
+	out := new(CostEstimate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialExpiryStatus) DeepCopyInto(out *CredentialExpiryStatus) {
+	*out = *in
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	if in.DaysRemaining != nil {
+		in, out := &in.DaysRemaining, &out.DaysRemaining
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LastCheckTime != nil {
+		in, out := &in.LastCheckTime, &out.LastCheckTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialExpiryStatus.
+func (in *CredentialExpiryStatus) DeepCopy() *CredentialExpiryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialExpiryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataStoreBootstrapSpec) DeepCopyInto(out *DataStoreBootstrapSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataStoreBootstrapSpec.
+func (in *DataStoreBootstrapSpec) DeepCopy() *DataStoreBootstrapSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DataStoreBootstrapSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceProviderMapping) DeepCopyInto(out *DeviceProviderMapping) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceProviderMapping.
+func (in *DeviceProviderMapping) DeepCopy() *DeviceProviderMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceProviderMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskSpec) DeepCopyInto(out *DiskSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskSpec.
+func (in *DiskSpec) DeepCopy() *DiskSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DotfilesSpec) DeepCopyInto(out *DotfilesSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DotfilesSpec.
+func (in *DotfilesSpec) DeepCopy() *DotfilesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DotfilesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DrainPolicy) DeepCopyInto(out *DrainPolicy) {
+	*out = *in
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.IgnoreDaemonSets != nil {
+		in, out := &in.IgnoreDaemonSets, &out.IgnoreDaemonSets
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DeleteEmptyDirData != nil {
+		in, out := &in.DeleteEmptyDirData, &out.DeleteEmptyDirData
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DrainPolicy.
+func (in *DrainPolicy) DeepCopy() *DrainPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DrainPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EditorConfig) DeepCopyInto(out *EditorConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EditorConfig.
+func (in *EditorConfig) DeepCopy() *EditorConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EditorConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailChannelConfig) DeepCopyInto(out *EmailChannelConfig) {
+	*out = *in
+	if in.Recipients != nil {
+		in, out := &in.Recipients, &out.Recipients
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SMTP != nil {
+		in, out := &in.SMTP, &out.SMTP
+		*out = new(SMTPConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailChannelConfig.
+func (in *EmailChannelConfig) DeepCopy() *EmailChannelConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EmailChannelConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EncryptionConfig) DeepCopyInto(out *EncryptionConfig) {
+	*out = *in
+	if in.AESCBC != nil {
+		in, out := &in.AESCBC, &out.AESCBC
+		*out = new(AESCBCEncryptionConfig)
+		**out = **in
+	}
+	if in.KMS != nil {
+		in, out := &in.KMS, &out.KMS
+		*out = new(KMSEncryptionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RotationInterval != nil {
+		in, out := &in.RotationInterval, &out.RotationInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionConfig.
+func (in *EncryptionConfig) DeepCopy() *EncryptionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EncryptionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EncryptionStatus) DeepCopyInto(out *EncryptionStatus) {
+	*out = *in
+	if in.KeyCreatedAt != nil {
+		in, out := &in.KeyCreatedAt, &out.KeyCreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.LastRotationTime != nil {
+		in, out := &in.LastRotationTime, &out.LastRotationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EncryptedResources != nil {
+		in, out := &in.EncryptedResources, &out.EncryptedResources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionStatus.
+func (in *EncryptionStatus) DeepCopy() *EncryptionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EncryptionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvVar) DeepCopyInto(out *EnvVar) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvVar.
+func (in *EnvVar) DeepCopy() *EnvVar {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvVar)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentLimits) DeepCopyInto(out *EnvironmentLimits) {
+	*out = *in
+	if in.MaxClusters != nil {
+		in, out := &in.MaxClusters, &out.MaxClusters
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxClustersPerMember != nil {
+		in, out := &in.MaxClustersPerMember, &out.MaxClustersPerMember
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentLimits.
+func (in *EnvironmentLimits) DeepCopy() *EnvironmentLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentPolicy) DeepCopyInto(out *EnvironmentPolicy) {
+	*out = *in
+	if in.MinControlPlaneReplicas != nil {
+		in, out := &in.MinControlPlaneReplicas, &out.MinControlPlaneReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinWorkerReplicas != nil {
+		in, out := &in.MinWorkerReplicas, &out.MinWorkerReplicas
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentPolicy.
+func (in *EnvironmentPolicy) DeepCopy() *EnvironmentPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentSpec) DeepCopyInto(out *EnvironmentSpec) {
+	*out = *in
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = new(EnvironmentLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Access != nil {
+		in, out := &in.Access, &out.Access
+		*out = new(TeamAccess)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterDefaults != nil {
+		in, out := &in.ClusterDefaults, &out.ClusterDefaults
+		*out = new(ClusterDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentSpec.
+func (in *EnvironmentSpec) DeepCopy() *EnvironmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtensionValues) DeepCopyInto(out *ExtensionValues) {
+	*out = *in
+	if in.Raw != nil {
+		in, out := &in.Raw, &out.Raw
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtensionValues.
+func (in *ExtensionValues) DeepCopy() *ExtensionValues {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtensionValues)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailureDomain) DeepCopyInto(out *FailureDomain) {
+	*out = *in
+	if in.HostSelector != nil {
+		in, out := &in.HostSelector, &out.HostSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailureDomain.
+func (in *FailureDomain) DeepCopy() *FailureDomain {
+	if in == nil {
+		return nil
+	}
+	out := new(FailureDomain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailureDomainCount) DeepCopyInto(out *FailureDomainCount) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailureDomainCount.
+func (in *FailureDomainCount) DeepCopy() *FailureDomainCount {
+	if in == nil {
+		return nil
+	}
+	out := new(FailureDomainCount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailureDomainDistribution) DeepCopyInto(out *FailureDomainDistribution) {
+	*out = *in
+	if in.Domains != nil {
+		in, out := &in.Domains, &out.Domains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Counts != nil {
+		in, out := &in.Counts, &out.Counts
+		*out = make([]FailureDomainCount, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailureDomainDistribution.
+func (in *FailureDomainDistribution) DeepCopy() *FailureDomainDistribution {
+	if in == nil {
+		return nil
+	}
+	out := new(FailureDomainDistribution)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailureDomainReplicas) DeepCopyInto(out *FailureDomainReplicas) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailureDomainReplicas.
+func (in *FailureDomainReplicas) DeepCopy() *FailureDomainReplicas {
+	if in == nil {
+		return nil
+	}
+	out := new(FailureDomainReplicas)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirmwareSpec) DeepCopyInto(out *FirmwareSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirmwareSpec.
+func (in *FirmwareSpec) DeepCopy() *FirmwareSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FirmwareSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirstFitStrategy) DeepCopyInto(out *FirstFitStrategy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirstFitStrategy.
+func (in *FirstFitStrategy) DeepCopy() *FirstFitStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(FirstFitStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetIPUtilization) DeepCopyInto(out *FleetIPUtilization) {
+	*out = *in
+	if in.ExhaustedPools != nil {
+		in, out := &in.ExhaustedPools, &out.ExhaustedPools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetIPUtilization.
+func (in *FleetIPUtilization) DeepCopy() *FleetIPUtilization {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetIPUtilization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetStatus) DeepCopyInto(out *FleetStatus) {
+	*out = *in
+	if in.ClustersByPhase != nil {
+		in, out := &in.ClustersByPhase, &out.ClustersByPhase
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MachinesByProvider != nil {
+		in, out := &in.MachinesByProvider, &out.MachinesByProvider
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.IPUtilization != nil {
+		in, out := &in.IPUtilization, &out.IPUtilization
+		*out = new(FleetIPUtilization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AddonFailures != nil {
+		in, out := &in.AddonFailures, &out.AddonFailures
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ComponentVersions != nil {
+		in, out := &in.ComponentVersions, &out.ComponentVersions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.UnhealthyComponents != nil {
+		in, out := &in.UnhealthyComponents, &out.UnhealthyComponents
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetStatus.
+func (in *FleetStatus) DeepCopy() *FleetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreezeWindow) DeepCopyInto(out *FreezeWindow) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreezeWindow.
+func (in *FreezeWindow) DeepCopy() *FreezeWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(FreezeWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPOverride) DeepCopyInto(out *GCPOverride) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPOverride.
+func (in *GCPOverride) DeepCopy() *GCPOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPProviderConfig) DeepCopyInto(out *GCPProviderConfig) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPProviderConfig.
+func (in *GCPProviderConfig) DeepCopy() *GCPProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GPUDeviceRequest) DeepCopyInto(out *GPUDeviceRequest) {
+	*out = *in
+	if in.ProviderMapping != nil {
+		in, out := &in.ProviderMapping, &out.ProviderMapping
+		*out = new(DeviceProviderMapping)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GPUDeviceRequest.
+func (in *GPUDeviceRequest) DeepCopy() *GPUDeviceRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(GPUDeviceRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratedResourceRef) DeepCopyInto(out *GeneratedResourceRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratedResourceRef.
+func (in *GeneratedResourceRef) DeepCopy() *GeneratedResourceRef {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratedResourceRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitOpsAddonSpec) DeepCopyInto(out *GitOpsAddonSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Repository != nil {
+		in, out := &in.Repository, &out.Repository
+		*out = new(GitRepositorySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ArgoCD != nil {
+		in, out := &in.ArgoCD, &out.ArgoCD
+		*out = new(ArgoCDOptions)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitOpsAddonSpec.
+func (in *GitOpsAddonSpec) DeepCopy() *GitOpsAddonSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GitOpsAddonSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitOpsDirectoryLayout) DeepCopyInto(out *GitOpsDirectoryLayout) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitOpsDirectoryLayout.
+func (in *GitOpsDirectoryLayout) DeepCopy() *GitOpsDirectoryLayout {
+	if in == nil {
+		return nil
+	}
+	out := new(GitOpsDirectoryLayout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitOpsSpec) DeepCopyInto(out *GitOpsSpec) {
+	*out = *in
+	if in.Repository != nil {
+		in, out := &in.Repository, &out.Repository
+		*out = new(GitRepositorySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ArgoCD != nil {
+		in, out := &in.ArgoCD, &out.ArgoCD
+		*out = new(ArgoCDOptions)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitOpsSpec.
+func (in *GitOpsSpec) DeepCopy() *GitOpsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GitOpsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitProviderConfig) DeepCopyInto(out *GitProviderConfig) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+	if in.WebhookSecretRef != nil {
+		in, out := &in.WebhookSecretRef, &out.WebhookSecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitProviderConfig.
+func (in *GitProviderConfig) DeepCopy() *GitProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GitProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitProviderStatus) DeepCopyInto(out *GitProviderStatus) {
+	*out = *in
+	if in.LastValidated != nil {
+		in, out := &in.LastValidated, &out.LastValidated
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitProviderStatus.
+func (in *GitProviderStatus) DeepCopy() *GitProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GitProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitRepositorySpec) DeepCopyInto(out *GitRepositorySpec) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitRepositorySpec.
+func (in *GitRepositorySpec) DeepCopy() *GitRepositorySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GitRepositorySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleWorkspaceConfig) DeepCopyInto(out *GoogleWorkspaceConfig) {
+	*out = *in
+	out.ServiceAccountSecretRef = in.ServiceAccountSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleWorkspaceConfig.
+func (in *GoogleWorkspaceConfig) DeepCopy() *GoogleWorkspaceConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleWorkspaceConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrafanaAddonSpec) DeepCopyInto(out *GrafanaAddonSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AdminSecretRef != nil {
+		in, out := &in.AdminSecretRef, &out.AdminSecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(ConsoleIngressSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaAddonSpec.
+func (in *GrafanaAddonSpec) DeepCopy() *GrafanaAddonSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GrafanaAddonSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HarvesterOverride) DeepCopyInto(out *HarvesterOverride) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HarvesterOverride.
+func (in *HarvesterOverride) DeepCopy() *HarvesterOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(HarvesterOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HarvesterProviderConfig) DeepCopyInto(out *HarvesterProviderConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HarvesterProviderConfig.
+func (in *HarvesterProviderConfig) DeepCopy() *HarvesterProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HarvesterProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthProbeTuning) DeepCopyInto(out *HealthProbeTuning) {
+	*out = *in
+	if in.ClusterInterval != nil {
+		in, out := &in.ClusterInterval, &out.ClusterInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ProviderInterval != nil {
+		in, out := &in.ProviderInterval, &out.ProviderInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ComponentHeartbeatTimeout != nil {
+		in, out := &in.ComponentHeartbeatTimeout, &out.ComponentHeartbeatTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthProbeTuning.
+func (in *HealthProbeTuning) DeepCopy() *HealthProbeTuning {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthProbeTuning)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmChartSpec) DeepCopyInto(out *HelmChartSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmChartSpec.
+func (in *HelmChartSpec) DeepCopy() *HelmChartSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmChartSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmReleaseStatus) DeepCopyInto(out *HelmReleaseStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmReleaseStatus.
+func (in *HelmReleaseStatus) DeepCopy() *HelmReleaseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmReleaseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationSpec) DeepCopyInto(out *HibernationSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationSpec.
+func (in *HibernationSpec) DeepCopy() *HibernationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationStatus) DeepCopyInto(out *HibernationStatus) {
+	*out = *in
+	if in.SavedReplicas != nil {
+		in, out := &in.SavedReplicas, &out.SavedReplicas
+		*out = make([]PoolReplicas, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastScaleDownTime != nil {
+		in, out := &in.LastScaleDownTime, &out.LastScaleDownTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastScaleUpTime != nil {
+		in, out := &in.LastScaleUpTime, &out.LastScaleUpTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextTransitionTime != nil {
+		in, out := &in.NextTransitionTime, &out.NextTransitionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationStatus.
+func (in *HibernationStatus) DeepCopy() *HibernationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HugePagesSpec) DeepCopyInto(out *HugePagesSpec) {
+	*out = *in
+	out.Size = in.Size.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HugePagesSpec.
+func (in *HugePagesSpec) DeepCopy() *HugePagesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HugePagesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAllocation) DeepCopyInto(out *IPAllocation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAllocation.
+func (in *IPAllocation) DeepCopy() *IPAllocation {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAllocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPAllocation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAllocationAddressReference) DeepCopyInto(out *IPAllocationAddressReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAllocationAddressReference.
+func (in *IPAllocationAddressReference) DeepCopy() *IPAllocationAddressReference {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAllocationAddressReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAllocationList) DeepCopyInto(out *IPAllocationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IPAllocation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAllocationList.
+func (in *IPAllocationList) DeepCopy() *IPAllocationList {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAllocationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPAllocationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAllocationSpec) DeepCopyInto(out *IPAllocationSpec) {
+	*out = *in
+	out.PoolRef = in.PoolRef
+	if in.TenantClusterRef != nil {
+		in, out := &in.TenantClusterRef, &out.TenantClusterRef
+		*out = new(NamespacedObjectReference)
+		**out = **in
+	}
+	if in.ClusterBootstrapRef != nil {
+		in, out := &in.ClusterBootstrapRef, &out.ClusterBootstrapRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PinnedRange != nil {
+		in, out := &in.PinnedRange, &out.PinnedRange
+		*out = new(PinnedIPRange)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAllocationSpec.
+func (in *IPAllocationSpec) DeepCopy() *IPAllocationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAllocationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAllocationStatus) DeepCopyInto(out *IPAllocationStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllocatedAt != nil {
+		in, out := &in.AllocatedAt, &out.AllocatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = make([]IPVerificationResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ReleasedAt != nil {
+		in, out := &in.ReleasedAt, &out.ReleasedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Reconcile != nil {
+		in, out := &in.Reconcile, &out.Reconcile
+		*out = new(ReconcileStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAllocationStatus.
+func (in *IPAllocationStatus) DeepCopy() *IPAllocationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAllocationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPool) DeepCopyInto(out *IPPool) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPool.
+func (in *IPPool) DeepCopy() *IPPool {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPVerificationResult) DeepCopyInto(out *IPVerificationResult) {
+	*out = *in
+	if in.CheckedAt != nil {
+		in, out := &in.CheckedAt, &out.CheckedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPVerificationResult.
+func (in *IPVerificationResult) DeepCopy() *IPVerificationResult {
+	if in == nil {
+		return nil
+	}
+	out := new(IPVerificationResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IdentityProvider) DeepCopyInto(out *IdentityProvider) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityProvider.
+func (in *IdentityProvider) DeepCopy() *IdentityProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(IdentityProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IdentityProvider) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IdentityProviderList) DeepCopyInto(out *IdentityProviderList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IdentityProvider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityProviderList.
+func (in *IdentityProviderList) DeepCopy() *IdentityProviderList {
+	if in == nil {
+		return nil
+	}
+	out := new(IdentityProviderList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IdentityProviderList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IdentityProviderSpec) DeepCopyInto(out *IdentityProviderSpec) {
+	*out = *in
+	if in.OIDC != nil {
+		in, out := &in.OIDC, &out.OIDC
+		*out = new(OIDCConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PlatformRoleGroups != nil {
+		in, out := &in.PlatformRoleGroups, &out.PlatformRoleGroups
+		*out = make([]PlatformRoleGroupEntry, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityProviderSpec.
+func (in *IdentityProviderSpec) DeepCopy() *IdentityProviderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IdentityProviderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IdentityProviderStatus) DeepCopyInto(out *IdentityProviderStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastValidatedTime != nil {
+		in, out := &in.LastValidatedTime, &out.LastValidatedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.DiscoveredEndpoints != nil {
+		in, out := &in.DiscoveredEndpoints, &out.DiscoveredEndpoints
+		*out = new(OIDCDiscoveredEndpoints)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityProviderStatus.
+func (in *IdentityProviderStatus) DeepCopy() *IdentityProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IdentityProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCacheConfig) DeepCopyInto(out *ImageCacheConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]ImageFactoryRef, len(*in))
+		copy(*out, *in)
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCacheConfig.
+func (in *ImageCacheConfig) DeepCopy() *ImageCacheConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCacheConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCacheStatus) DeepCopyInto(out *ImageCacheStatus) {
+	*out = *in
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]CachedImageStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastRefreshTime != nil {
+		in, out := &in.LastRefreshTime, &out.LastRefreshTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCacheStatus.
+func (in *ImageCacheStatus) DeepCopy() *ImageCacheStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCacheStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageFactoryConfig) DeepCopyInto(out *ImageFactoryConfig) {
+	*out = *in
+	if in.CredentialsRef != nil {
+		in, out := &in.CredentialsRef, &out.CredentialsRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.AutoSync != nil {
+		in, out := &in.AutoSync, &out.AutoSync
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageFactoryConfig.
+func (in *ImageFactoryConfig) DeepCopy() *ImageFactoryConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageFactoryConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageFactoryRef) DeepCopyInto(out *ImageFactoryRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageFactoryRef.
+func (in *ImageFactoryRef) DeepCopy() *ImageFactoryRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageFactoryRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageSync) DeepCopyInto(out *ImageSync) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSync.
+func (in *ImageSync) DeepCopy() *ImageSync {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageSync)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageSync) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageSyncList) DeepCopyInto(out *ImageSyncList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ImageSync, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSyncList.
+func (in *ImageSyncList) DeepCopy() *ImageSyncList {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageSyncList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageSyncList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageSyncSpec) DeepCopyInto(out *ImageSyncSpec) {
+	*out = *in
+	out.FactoryRef = in.FactoryRef
+	out.ProviderConfigRef = in.ProviderConfigRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSyncSpec.
+func (in *ImageSyncSpec) DeepCopy() *ImageSyncSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageSyncSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageSyncStatus) DeepCopyInto(out *ImageSyncStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSyncStatus.
+func (in *ImageSyncStatus) DeepCopy() *ImageSyncStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageSyncStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfrastructureOverride) DeepCopyInto(out *InfrastructureOverride) {
+	*out = *in
+	if in.Harvester != nil {
+		in, out := &in.Harvester, &out.Harvester
+		*out = new(HarvesterOverride)
+		**out = **in
+	}
+	if in.Nutanix != nil {
+		in, out := &in.Nutanix, &out.Nutanix
+		*out = new(NutanixOverride)
+		**out = **in
+	}
+	if in.Proxmox != nil {
+		in, out := &in.Proxmox, &out.Proxmox
+		*out = new(ProxmoxOverride)
+		**out = **in
+	}
+	if in.VSphere != nil {
+		in, out := &in.VSphere, &out.VSphere
+		*out = new(VSphereOverride)
+		**out = **in
+	}
+	if in.OpenStack != nil {
+		in, out := &in.OpenStack, &out.OpenStack
+		*out = new(OpenStackOverride)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GCP != nil {
+		in, out := &in.GCP, &out.GCP
+		*out = new(GCPOverride)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructureOverride.
+func (in *InfrastructureOverride) DeepCopy() *InfrastructureOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(InfrastructureOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressAddonSpec) DeepCopyInto(out *IngressAddonSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressAddonSpec.
+func (in *IngressAddonSpec) DeepCopy() *IngressAddonSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressAddonSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressSpec) DeepCopyInto(out *IngressSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = new(ExtensionValues)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressSpec.
+func (in *IngressSpec) DeepCopy() *IngressSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KMSEncryptionConfig) DeepCopyInto(out *KMSEncryptionConfig) {
+	*out = *in
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KMSEncryptionConfig.
+func (in *KMSEncryptionConfig) DeepCopy() *KMSEncryptionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KMSEncryptionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesUpgradePlan) DeepCopyInto(out *KubernetesUpgradePlan) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesUpgradePlan.
+func (in *KubernetesUpgradePlan) DeepCopy() *KubernetesUpgradePlan {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesUpgradePlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KubernetesUpgradePlan) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesUpgradePlanList) DeepCopyInto(out *KubernetesUpgradePlanList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KubernetesUpgradePlan, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesUpgradePlanList.
+func (in *KubernetesUpgradePlanList) DeepCopy() *KubernetesUpgradePlanList {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesUpgradePlanList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KubernetesUpgradePlanList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesUpgradePlanSpec) DeepCopyInto(out *KubernetesUpgradePlanSpec) {
+	*out = *in
+	out.ClusterRef = in.ClusterRef
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(UpgradeStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindow)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesUpgradePlanSpec.
+func (in *KubernetesUpgradePlanSpec) DeepCopy() *KubernetesUpgradePlanSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesUpgradePlanSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesUpgradePlanStatus) DeepCopyInto(out *KubernetesUpgradePlanStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PreflightChecks != nil {
+		in, out := &in.PreflightChecks, &out.PreflightChecks
+		*out = make([]PreflightCheck, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PreflightCheckTime != nil {
+		in, out := &in.PreflightCheckTime, &out.PreflightCheckTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextWindowStart != nil {
+		in, out := &in.NextWindowStart, &out.NextWindowStart
+		*out = (*in).DeepCopy()
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesUpgradePlanStatus.
+func (in *KubernetesUpgradePlanStatus) DeepCopy() *KubernetesUpgradePlanStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesUpgradePlanStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesVersionCatalog) DeepCopyInto(out *KubernetesVersionCatalog) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesVersionCatalog.
+func (in *KubernetesVersionCatalog) DeepCopy() *KubernetesVersionCatalog {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesVersionCatalog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KubernetesVersionCatalog) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesVersionCatalogList) DeepCopyInto(out *KubernetesVersionCatalogList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KubernetesVersionCatalog, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesVersionCatalogList.
+func (in *KubernetesVersionCatalogList) DeepCopy() *KubernetesVersionCatalogList {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesVersionCatalogList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KubernetesVersionCatalogList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesVersionCatalogSpec) DeepCopyInto(out *KubernetesVersionCatalogSpec) {
+	*out = *in
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]KubernetesVersionEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesVersionCatalogSpec.
+func (in *KubernetesVersionCatalogSpec) DeepCopy() *KubernetesVersionCatalogSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesVersionCatalogSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesVersionEntry) DeepCopyInto(out *KubernetesVersionEntry) {
+	*out = *in
+	if in.ReleaseDate != nil {
+		in, out := &in.ReleaseDate, &out.ReleaseDate
+		*out = (*in).DeepCopy()
+	}
+	if in.EndOfLife != nil {
+		in, out := &in.EndOfLife, &out.EndOfLife
+		*out = (*in).DeepCopy()
+	}
+	if in.Supported != nil {
+		in, out := &in.Supported, &out.Supported
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AddonMinimums != nil {
+		in, out := &in.AddonMinimums, &out.AddonMinimums
+		*out = make([]AddonVersionRequirement, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesVersionEntry.
+func (in *KubernetesVersionEntry) DeepCopy() *KubernetesVersionEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesVersionEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesVersionRange) DeepCopyInto(out *KubernetesVersionRange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesVersionRange.
+func (in *KubernetesVersionRange) DeepCopy() *KubernetesVersionRange {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesVersionRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerAddonSpec) DeepCopyInto(out *LoadBalancerAddonSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerAddonSpec.
+func (in *LoadBalancerAddonSpec) DeepCopy() *LoadBalancerAddonSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerAddonSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerPoolSpec) DeepCopyInto(out *LoadBalancerPoolSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerPoolSpec.
+func (in *LoadBalancerPoolSpec) DeepCopy() *LoadBalancerPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerRequest) DeepCopyInto(out *LoadBalancerRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerRequest.
+func (in *LoadBalancerRequest) DeepCopy() *LoadBalancerRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LoadBalancerRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerRequestList) DeepCopyInto(out *LoadBalancerRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LoadBalancerRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerRequestList.
+func (in *LoadBalancerRequestList) DeepCopy() *LoadBalancerRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LoadBalancerRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerRequestSpec) DeepCopyInto(out *LoadBalancerRequestSpec) {
+	*out = *in
+	out.ProviderConfigRef = in.ProviderConfigRef
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]LoadBalancerTarget, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerRequestSpec.
+func (in *LoadBalancerRequestSpec) DeepCopy() *LoadBalancerRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerRequestStatus) DeepCopyInto(out *LoadBalancerRequestStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerRequestStatus.
+func (in *LoadBalancerRequestStatus) DeepCopy() *LoadBalancerRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerSpec) DeepCopyInto(out *LoadBalancerSpec) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = new(ExtensionValues)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerSpec.
+func (in *LoadBalancerSpec) DeepCopy() *LoadBalancerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerTarget) DeepCopyInto(out *LoadBalancerTarget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerTarget.
+func (in *LoadBalancerTarget) DeepCopy() *LoadBalancerTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalObjectReference) DeepCopyInto(out *LocalObjectReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalObjectReference.
+func (in *LocalObjectReference) DeepCopy() *LocalObjectReference {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalObjectReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogCollectionDefaults) DeepCopyInto(out *LogCollectionDefaults) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogCollectionDefaults.
+func (in *LogCollectionDefaults) DeepCopy() *LogCollectionDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(LogCollectionDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineDevices) DeepCopyInto(out *MachineDevices) {
+	*out = *in
+	if in.GPUs != nil {
+		in, out := &in.GPUs, &out.GPUs
+		*out = make([]GPUDeviceRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PCIDevices != nil {
+		in, out := &in.PCIDevices, &out.PCIDevices
+		*out = make([]PCIDeviceRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HugePages != nil {
+		in, out := &in.HugePages, &out.HugePages
+		*out = new(HugePagesSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineDevices.
+func (in *MachineDevices) DeepCopy() *MachineDevices {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineDevices)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineNetworkConfig) DeepCopyInto(out *MachineNetworkConfig) {
+	*out = *in
+	if in.IPAllocationRef != nil {
+		in, out := &in.IPAllocationRef, &out.IPAllocationRef
+		*out = new(IPAllocationAddressReference)
+		**out = **in
+	}
+	if in.DNSServers != nil {
+		in, out := &in.DNSServers, &out.DNSServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SearchDomains != nil {
+		in, out := &in.SearchDomains, &out.SearchDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VLAN != nil {
+		in, out := &in.VLAN, &out.VLAN
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MTU != nil {
+		in, out := &in.MTU, &out.MTU
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineNetworkConfig.
+func (in *MachineNetworkConfig) DeepCopy() *MachineNetworkConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineNetworkConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineNetworkInterface) DeepCopyInto(out *MachineNetworkInterface) {
+	*out = *in
+	if in.VLAN != nil {
+		in, out := &in.VLAN, &out.VLAN
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MTU != nil {
+		in, out := &in.MTU, &out.MTU
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineNetworkInterface.
+func (in *MachineNetworkInterface) DeepCopy() *MachineNetworkInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineNetworkInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineRequest) DeepCopyInto(out *MachineRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineRequest.
+func (in *MachineRequest) DeepCopy() *MachineRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MachineRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineRequestList) DeepCopyInto(out *MachineRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MachineRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineRequestList.
+func (in *MachineRequestList) DeepCopy() *MachineRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MachineRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineRequestSpec) DeepCopyInto(out *MachineRequestSpec) {
+	*out = *in
+	out.ProviderRef = in.ProviderRef
+	if in.ExtraDisks != nil {
+		in, out := &in.ExtraDisks, &out.ExtraDisks
+		*out = make([]DiskSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.NetworkConfig != nil {
+		in, out := &in.NetworkConfig, &out.NetworkConfig
+		*out = new(MachineNetworkConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Networks != nil {
+		in, out := &in.Networks, &out.Networks
+		*out = make([]MachineNetworkInterface, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Drain != nil {
+		in, out := &in.Drain, &out.Drain
+		*out = new(DrainPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Firmware != nil {
+		in, out := &in.Firmware, &out.Firmware
+		*out = new(FirmwareSpec)
+		**out = **in
+	}
+	if in.Devices != nil {
+		in, out := &in.Devices, &out.Devices
+		*out = new(MachineDevices)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Placement != nil {
+		in, out := &in.Placement, &out.Placement
+		*out = new(PlacementSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PowerStateRequestedAt != nil {
+		in, out := &in.PowerStateRequestedAt, &out.PowerStateRequestedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineRequestSpec.
+func (in *MachineRequestSpec) DeepCopy() *MachineRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineRequestStatus) DeepCopyInto(out *MachineRequestStatus) {
+	*out = *in
+	if in.IPAddresses != nil {
+		in, out := &in.IPAddresses, &out.IPAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastPowerTransitionTime != nil {
+		in, out := &in.LastPowerTransitionTime, &out.LastPowerTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ObservedPowerStateRequestedAt != nil {
+		in, out := &in.ObservedPowerStateRequestedAt, &out.ObservedPowerStateRequestedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
+	}
+	if in.Reconcile != nil {
+		in, out := &in.Reconcile, &out.Reconcile
+		*out = new(ReconcileStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineRequestStatus.
+func (in *MachineRequestStatus) DeepCopy() *MachineRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineRollingUpdate) DeepCopyInto(out *MachineRollingUpdate) {
+	*out = *in
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineRollingUpdate.
+func (in *MachineRollingUpdate) DeepCopy() *MachineRollingUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineRollingUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineTemplateSpec) DeepCopyInto(out *MachineTemplateSpec) {
+	*out = *in
+	out.Memory = in.Memory.DeepCopy()
+	out.DiskSize = in.DiskSize.DeepCopy()
+	in.OS.DeepCopyInto(&out.OS)
+	if in.Networks != nil {
+		in, out := &in.Networks, &out.Networks
+		*out = make([]WorkerNetwork, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Taints != nil {
+		in, out := &in.Taints, &out.Taints
+		*out = make([]NodeTaint, len(*in))
+		copy(*out, *in)
+	}
+	if in.KubeletExtraArgs != nil {
+		in, out := &in.KubeletExtraArgs, &out.KubeletExtraArgs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodeAnnotations != nil {
+		in, out := &in.NodeAnnotations, &out.NodeAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Firmware != nil {
+		in, out := &in.Firmware, &out.Firmware
+		*out = new(FirmwareSpec)
+		**out = **in
+	}
+	if in.Devices != nil {
+		in, out := &in.Devices, &out.Devices
+		*out = new(MachineDevices)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineTemplateSpec.
+func (in *MachineTemplateSpec) DeepCopy() *MachineTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineUpdateStrategy) DeepCopyInto(out *MachineUpdateStrategy) {
+	*out = *in
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(MachineRollingUpdate)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineUpdateStrategy.
+func (in *MachineUpdateStrategy) DeepCopy() *MachineUpdateStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineUpdateStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]Weekday, len(*in))
+		copy(*out, *in)
+	}
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagementAddon) DeepCopyInto(out *ManagementAddon) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementAddon.
+func (in *ManagementAddon) DeepCopy() *ManagementAddon {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagementAddon)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ManagementAddon) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagementAddonList) DeepCopyInto(out *ManagementAddonList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ManagementAddon, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementAddonList.
+func (in *ManagementAddonList) DeepCopy() *ManagementAddonList {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagementAddonList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ManagementAddonList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagementAddonSpec) DeepCopyInto(out *ManagementAddonSpec) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementAddonSpec.
+func (in *ManagementAddonSpec) DeepCopy() *ManagementAddonSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagementAddonSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagementAddonStatus) DeepCopyInto(out *ManagementAddonStatus) {
+	*out = *in
+	if in.HelmRelease != nil {
+		in, out := &in.HelmRelease, &out.HelmRelease
+		*out = new(HelmReleaseStatus)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementAddonStatus.
+func (in *ManagementAddonStatus) DeepCopy() *ManagementAddonStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagementAddonStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagementPolicySpec) DeepCopyInto(out *ManagementPolicySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementPolicySpec.
+func (in *ManagementPolicySpec) DeepCopy() *ManagementPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagementPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricCollectionDefaults) DeepCopyInto(out *MetricCollectionDefaults) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricCollectionDefaults.
+func (in *MetricCollectionDefaults) DeepCopy() *MetricCollectionDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricCollectionDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringAddonSpec) DeepCopyInto(out *MonitoringAddonSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = new(PrometheusAddonSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Vector != nil {
+		in, out := &in.Vector, &out.Vector
+		*out = new(VectorAddonSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Grafana != nil {
+		in, out := &in.Grafana, &out.Grafana
+		*out = new(GrafanaAddonSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringAddonSpec.
+func (in *MonitoringAddonSpec) DeepCopy() *MonitoringAddonSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringAddonSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringEndpoints) DeepCopyInto(out *MonitoringEndpoints) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringEndpoints.
+func (in *MonitoringEndpoints) DeepCopy() *MonitoringEndpoints {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringEndpoints)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiTenancyConfig) DeepCopyInto(out *MultiTenancyConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiTenancyConfig.
+func (in *MultiTenancyConfig) DeepCopy() *MultiTenancyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiTenancyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultusSpec) DeepCopyInto(out *MultusSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CreateNetworkAttachments != nil {
+		in, out := &in.CreateNetworkAttachments, &out.CreateNetworkAttachments
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = new(ExtensionValues)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultusSpec.
+func (in *MultusSpec) DeepCopy() *MultusSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MultusSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedObjectReference) DeepCopyInto(out *NamespacedObjectReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedObjectReference.
+func (in *NamespacedObjectReference) DeepCopy() *NamespacedObjectReference {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedObjectReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPool) DeepCopyInto(out *NetworkPool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPool.
+func (in *NetworkPool) DeepCopy() *NetworkPool {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkPool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPoolList) DeepCopyInto(out *NetworkPoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NetworkPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPoolList.
+func (in *NetworkPoolList) DeepCopy() *NetworkPoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkPoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPoolSpec) DeepCopyInto(out *NetworkPoolSpec) {
+	*out = *in
+	if in.Reserved != nil {
+		in, out := &in.Reserved, &out.Reserved
+		*out = make([]ReservedRange, len(*in))
+		copy(*out, *in)
+	}
+	if in.TenantAllocation != nil {
+		in, out := &in.TenantAllocation, &out.TenantAllocation
+		*out = new(TenantAllocationConfig)
+		**out = **in
+	}
+	if in.StaticAssignments != nil {
+		in, out := &in.StaticAssignments, &out.StaticAssignments
+		*out = make([]StaticIPAssignment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AllocationStrategy != nil {
+		in, out := &in.AllocationStrategy, &out.AllocationStrategy
+		*out = new(AllocationStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = new(ProviderConfigScope)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProviderRefs != nil {
+		in, out := &in.ProviderRefs, &out.ProviderRefs
+		*out = make([]ProviderReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPoolSpec.
+func (in *NetworkPoolSpec) DeepCopy() *NetworkPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPoolStatus) DeepCopyInto(out *NetworkPoolStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UtilizationPercent != nil {
+		in, out := &in.UtilizationPercent, &out.UtilizationPercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FragmentationPercent != nil {
+		in, out := &in.FragmentationPercent, &out.FragmentationPercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Conflicts != nil {
+		in, out := &in.Conflicts, &out.Conflicts
+		*out = make([]IPVerificationResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Reconcile != nil {
+		in, out := &in.Reconcile, &out.Reconcile
+		*out = new(ReconcileStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPoolStatus.
+func (in *NetworkPoolStatus) DeepCopy() *NetworkPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkQuota) DeepCopyInto(out *NetworkQuota) {
+	*out = *in
+	if in.MaxNodeIPs != nil {
+		in, out := &in.MaxNodeIPs, &out.MaxNodeIPs
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxLoadBalancerIPs != nil {
+		in, out := &in.MaxLoadBalancerIPs, &out.MaxLoadBalancerIPs
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkQuota.
+func (in *NetworkQuota) DeepCopy() *NetworkQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkingSpec) DeepCopyInto(out *NetworkingSpec) {
+	*out = *in
+	if in.PodCIDRs != nil {
+		in, out := &in.PodCIDRs, &out.PodCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServiceCIDRs != nil {
+		in, out := &in.ServiceCIDRs, &out.ServiceCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LoadBalancerPool != nil {
+		in, out := &in.LoadBalancerPool, &out.LoadBalancerPool
+		*out = new(IPPool)
+		**out = **in
+	}
+	if in.LBPoolSize != nil {
+		in, out := &in.LBPoolSize, &out.LBPoolSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.StaticLoadBalancerIPs != nil {
+		in, out := &in.StaticLoadBalancerIPs, &out.StaticLoadBalancerIPs
+		*out = make([]StaticLoadBalancerIP, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ClusterMesh != nil {
+		in, out := &in.ClusterMesh, &out.ClusterMesh
+		*out = new(ClusterMeshSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkingSpec.
+func (in *NetworkingSpec) DeepCopy() *NetworkingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeOperation) DeepCopyInto(out *NodeOperation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeOperation.
+func (in *NodeOperation) DeepCopy() *NodeOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodeOperation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeOperationList) DeepCopyInto(out *NodeOperationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NodeOperation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeOperationList.
+func (in *NodeOperationList) DeepCopy() *NodeOperationList {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeOperationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodeOperationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeOperationSpec) DeepCopyInto(out *NodeOperationSpec) {
+	*out = *in
+	out.ClusterRef = in.ClusterRef
+	if in.Drain != nil {
+		in, out := &in.Drain, &out.Drain
+		*out = new(DrainPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TTLAfterCompletion != nil {
+		in, out := &in.TTLAfterCompletion, &out.TTLAfterCompletion
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeOperationSpec.
+func (in *NodeOperationSpec) DeepCopy() *NodeOperationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeOperationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeOperationStatus) DeepCopyInto(out *NodeOperationStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BlockedPods != nil {
+		in, out := &in.BlockedPods, &out.BlockedPods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeOperationStatus.
+func (in *NodeOperationStatus) DeepCopy() *NodeOperationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeOperationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePool) DeepCopyInto(out *NodePool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePool.
+func (in *NodePool) DeepCopy() *NodePool {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodePool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePoolAutoscaling) DeepCopyInto(out *NodePoolAutoscaling) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolAutoscaling.
+func (in *NodePoolAutoscaling) DeepCopy() *NodePoolAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePoolAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePoolList) DeepCopyInto(out *NodePoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NodePool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolList.
+func (in *NodePoolList) DeepCopy() *NodePoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodePoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePoolSpec) DeepCopyInto(out *NodePoolSpec) {
+	*out = *in
+	out.ClusterRef = in.ClusterRef
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(NodePoolAutoscaling)
+		**out = **in
+	}
+	in.MachineTemplate.DeepCopyInto(&out.MachineTemplate)
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Taints != nil {
+		in, out := &in.Taints, &out.Taints
+		*out = make([]NodeTaint, len(*in))
+		copy(*out, *in)
+	}
+	if in.InfrastructureOverride != nil {
+		in, out := &in.InfrastructureOverride, &out.InfrastructureOverride
+		*out = new(InfrastructureOverride)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpdateStrategy != nil {
+		in, out := &in.UpdateStrategy, &out.UpdateStrategy
+		*out = new(MachineUpdateStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Drain != nil {
+		in, out := &in.Drain, &out.Drain
+		*out = new(DrainPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Distribution != nil {
+		in, out := &in.Distribution, &out.Distribution
+		*out = new(FailureDomainDistribution)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolSpec.
+func (in *NodePoolSpec) DeepCopy() *NodePoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePoolStatus) DeepCopyInto(out *NodePoolStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FailureDomains != nil {
+		in, out := &in.FailureDomains, &out.FailureDomains
+		*out = make([]FailureDomainReplicas, len(*in))
+		copy(*out, *in)
+	}
+	if in.MachineDeploymentRef != nil {
+		in, out := &in.MachineDeploymentRef, &out.MachineDeploymentRef
+		*out = new(NamespacedObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolStatus.
+func (in *NodePoolStatus) DeepCopy() *NodePoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeRebootRecord) DeepCopyInto(out *NodeRebootRecord) {
+	*out = *in
+	in.CompletedAt.DeepCopyInto(&out.CompletedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeRebootRecord.
+func (in *NodeRebootRecord) DeepCopy() *NodeRebootRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeRebootRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeTaint) DeepCopyInto(out *NodeTaint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeTaint.
+func (in *NodeTaint) DeepCopy() *NodeTaint {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeTaint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationChannel) DeepCopyInto(out *NotificationChannel) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationChannel.
+func (in *NotificationChannel) DeepCopy() *NotificationChannel {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationChannel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationChannel) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationChannelList) DeepCopyInto(out *NotificationChannelList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NotificationChannel, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationChannelList.
+func (in *NotificationChannelList) DeepCopy() *NotificationChannelList {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationChannelList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationChannelList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationChannelSpec) DeepCopyInto(out *NotificationChannelSpec) {
+	*out = *in
+	if in.Slack != nil {
+		in, out := &in.Slack, &out.Slack
+		*out = new(SlackChannelConfig)
+		**out = **in
+	}
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(EmailChannelConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PagerDuty != nil {
+		in, out := &in.PagerDuty, &out.PagerDuty
+		*out = new(PagerDutyChannelConfig)
+		**out = **in
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(WebhookChannelConfig)
+		**out = **in
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]NotificationRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationChannelSpec.
+func (in *NotificationChannelSpec) DeepCopy() *NotificationChannelSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationChannelSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationChannelStatus) DeepCopyInto(out *NotificationChannelStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastDeliveryTime != nil {
+		in, out := &in.LastDeliveryTime, &out.LastDeliveryTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastFailureTime != nil {
+		in, out := &in.LastFailureTime, &out.LastFailureTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationChannelStatus.
+func (in *NotificationChannelStatus) DeepCopy() *NotificationChannelStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationChannelStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationRoute) DeepCopyInto(out *NotificationRoute) {
+	*out = *in
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]NotificationEventType, len(*in))
+		copy(*out, *in)
+	}
+	if in.Teams != nil {
+		in, out := &in.Teams, &out.Teams
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationRoute.
+func (in *NotificationRoute) DeepCopy() *NotificationRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationsConfig) DeepCopyInto(out *NotificationsConfig) {
+	*out = *in
+	if in.SMTP != nil {
+		in, out := &in.SMTP, &out.SMTP
+		*out = new(SMTPConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationsConfig.
+func (in *NotificationsConfig) DeepCopy() *NotificationsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NutanixOverride) DeepCopyInto(out *NutanixOverride) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NutanixOverride.
+func (in *NutanixOverride) DeepCopy() *NutanixOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(NutanixOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NutanixProviderConfig) DeepCopyInto(out *NutanixProviderConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NutanixProviderConfig.
+func (in *NutanixProviderConfig) DeepCopy() *NutanixProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NutanixProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCConfig) DeepCopyInto(out *OIDCConfig) {
+	*out = *in
+	out.ClientSecretRef = in.ClientSecretRef
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GoogleWorkspace != nil {
+		in, out := &in.GoogleWorkspace, &out.GoogleWorkspace
+		*out = new(GoogleWorkspaceConfig)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCConfig.
+func (in *OIDCConfig) DeepCopy() *OIDCConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCDiscoveredEndpoints) DeepCopyInto(out *OIDCDiscoveredEndpoints) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCDiscoveredEndpoints.
+func (in *OIDCDiscoveredEndpoints) DeepCopy() *OIDCDiscoveredEndpoints {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCDiscoveredEndpoints)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OSSpec) DeepCopyInto(out *OSSpec) {
+	*out = *in
+	if in.Talos != nil {
+		in, out := &in.Talos, &out.Talos
+		*out = new(TalosConfig)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OSSpec.
+func (in *OSSpec) DeepCopy() *OSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservabilityCollectionConfig) DeepCopyInto(out *ObservabilityCollectionConfig) {
+	*out = *in
+	if in.AutoEnroll != nil {
+		in, out := &in.AutoEnroll, &out.AutoEnroll
+		*out = new(AutoEnrollConfig)
+		**out = **in
+	}
+	if in.Logs != nil {
+		in, out := &in.Logs, &out.Logs
+		*out = new(LogCollectionDefaults)
+		**out = **in
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = new(MetricCollectionDefaults)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityCollectionConfig.
+func (in *ObservabilityCollectionConfig) DeepCopy() *ObservabilityCollectionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservabilityCollectionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservabilityConfig) DeepCopyInto(out *ObservabilityConfig) {
+	*out = *in
+	if in.Pipeline != nil {
+		in, out := &in.Pipeline, &out.Pipeline
+		*out = new(ObservabilityPipelineConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Collection != nil {
+		in, out := &in.Collection, &out.Collection
+		*out = new(ObservabilityCollectionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityConfig.
+func (in *ObservabilityConfig) DeepCopy() *ObservabilityConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservabilityConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservabilityPipelineConfig) DeepCopyInto(out *ObservabilityPipelineConfig) {
+	*out = *in
+	if in.ClusterRef != nil {
+		in, out := &in.ClusterRef, &out.ClusterRef
+		*out = new(NamespacedObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPipelineConfig.
+func (in *ObservabilityPipelineConfig) DeepCopy() *ObservabilityPipelineConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservabilityPipelineConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservabilityStatus) DeepCopyInto(out *ObservabilityStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityStatus.
+func (in *ObservabilityStatus) DeepCopy() *ObservabilityStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservabilityStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservedClusterState) DeepCopyInto(out *ObservedClusterState) {
+	*out = *in
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = new(WorkerStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Addons != nil {
+		in, out := &in.Addons, &out.Addons
+		*out = make([]AddonStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservedClusterState.
+func (in *ObservedClusterState) DeepCopy() *ObservedClusterState {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservedClusterState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackOverride) DeepCopyInto(out *OpenStackOverride) {
+	*out = *in
+	if in.SecurityGroupIDs != nil {
+		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackOverride.
+func (in *OpenStackOverride) DeepCopy() *OpenStackOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackProviderConfig) DeepCopyInto(out *OpenStackProviderConfig) {
+	*out = *in
+	if in.CACertSecretRef != nil {
+		in, out := &in.CACertSecretRef, &out.CACertSecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.SecurityGroupIDs != nil {
+		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackProviderConfig.
+func (in *OpenStackProviderConfig) DeepCopy() *OpenStackProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OwnershipSpec) DeepCopyInto(out *OwnershipSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OwnershipSpec.
+func (in *OwnershipSpec) DeepCopy() *OwnershipSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OwnershipSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PCIDeviceRequest) DeepCopyInto(out *PCIDeviceRequest) {
+	*out = *in
+	if in.ProviderMapping != nil {
+		in, out := &in.ProviderMapping, &out.ProviderMapping
+		*out = new(DeviceProviderMapping)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PCIDeviceRequest.
+func (in *PCIDeviceRequest) DeepCopy() *PCIDeviceRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(PCIDeviceRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PagerDutyChannelConfig) DeepCopyInto(out *PagerDutyChannelConfig) {
+	*out = *in
+	out.RoutingKeySecretRef = in.RoutingKeySecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PagerDutyChannelConfig.
+func (in *PagerDutyChannelConfig) DeepCopy() *PagerDutyChannelConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PagerDutyChannelConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PinnedIPRange) DeepCopyInto(out *PinnedIPRange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PinnedIPRange.
+func (in *PinnedIPRange) DeepCopy() *PinnedIPRange {
+	if in == nil {
+		return nil
+	}
+	out := new(PinnedIPRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementSpec) DeepCopyInto(out *PlacementSpec) {
+	*out = *in
+	if in.HostSelector != nil {
+		in, out := &in.HostSelector, &out.HostSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementSpec.
+func (in *PlacementSpec) DeepCopy() *PlacementSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlatformComponentSpec) DeepCopyInto(out *PlatformComponentSpec) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(ComponentResources)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExtraEnv != nil {
+		in, out := &in.ExtraEnv, &out.ExtraEnv
+		*out = make([]EnvVar, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformComponentSpec.
+func (in *PlatformComponentSpec) DeepCopy() *PlatformComponentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PlatformComponentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlatformRoleGroupEntry) DeepCopyInto(out *PlatformRoleGroupEntry) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformRoleGroupEntry.
+func (in *PlatformRoleGroupEntry) DeepCopy() *PlatformRoleGroupEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(PlatformRoleGroupEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Playbook) DeepCopyInto(out *Playbook) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Playbook.
+func (in *Playbook) DeepCopy() *Playbook {
+	if in == nil {
+		return nil
+	}
+	out := new(Playbook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Playbook) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlaybookApproval) DeepCopyInto(out *PlaybookApproval) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ApprovedAt != nil {
+		in, out := &in.ApprovedAt, &out.ApprovedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlaybookApproval.
+func (in *PlaybookApproval) DeepCopy() *PlaybookApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(PlaybookApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlaybookApprovalStep) DeepCopyInto(out *PlaybookApprovalStep) {
+	*out = *in
+	if in.Approvers != nil {
+		in, out := &in.Approvers, &out.Approvers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlaybookApprovalStep.
+func (in *PlaybookApprovalStep) DeepCopy() *PlaybookApprovalStep {
+	if in == nil {
+		return nil
+	}
+	out := new(PlaybookApprovalStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlaybookList) DeepCopyInto(out *PlaybookList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Playbook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlaybookList.
+func (in *PlaybookList) DeepCopy() *PlaybookList {
+	if in == nil {
+		return nil
+	}
+	out := new(PlaybookList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PlaybookList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlaybookNodeStep) DeepCopyInto(out *PlaybookNodeStep) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.IgnoreDaemonSets != nil {
+		in, out := &in.IgnoreDaemonSets, &out.IgnoreDaemonSets
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlaybookNodeStep.
+func (in *PlaybookNodeStep) DeepCopy() *PlaybookNodeStep {
+	if in == nil {
+		return nil
+	}
+	out := new(PlaybookNodeStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlaybookScaleWorkersStep) DeepCopyInto(out *PlaybookScaleWorkersStep) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlaybookScaleWorkersStep.
+func (in *PlaybookScaleWorkersStep) DeepCopy() *PlaybookScaleWorkersStep {
+	if in == nil {
+		return nil
+	}
+	out := new(PlaybookScaleWorkersStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlaybookSpec) DeepCopyInto(out *PlaybookSpec) {
+	*out = *in
+	in.Targets.DeepCopyInto(&out.Targets)
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]PlaybookStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlaybookSpec.
+func (in *PlaybookSpec) DeepCopy() *PlaybookSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PlaybookSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlaybookStatus) DeepCopyInto(out *PlaybookStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]PlaybookStepStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Approvals != nil {
+		in, out := &in.Approvals, &out.Approvals
+		*out = make([]PlaybookApproval, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ResolvedTargets != nil {
+		in, out := &in.ResolvedTargets, &out.ResolvedTargets
+		*out = make([]NamespacedObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlaybookStatus.
+func (in *PlaybookStatus) DeepCopy() *PlaybookStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PlaybookStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlaybookStep) DeepCopyInto(out *PlaybookStep) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DrainNode != nil {
+		in, out := &in.DrainNode, &out.DrainNode
+		*out = new(PlaybookNodeStep)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReplaceNode != nil {
+		in, out := &in.ReplaceNode, &out.ReplaceNode
+		*out = new(PlaybookNodeStep)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpgradeAddon != nil {
+		in, out := &in.UpgradeAddon, &out.UpgradeAddon
+		*out = new(PlaybookUpgradeAddonStep)
+		**out = **in
+	}
+	if in.ScaleWorkers != nil {
+		in, out := &in.ScaleWorkers, &out.ScaleWorkers
+		*out = new(PlaybookScaleWorkersStep)
+		**out = **in
+	}
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(PlaybookApprovalStep)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Wait != nil {
+		in, out := &in.Wait, &out.Wait
+		*out = new(PlaybookWaitStep)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlaybookStep.
+func (in *PlaybookStep) DeepCopy() *PlaybookStep {
+	if in == nil {
+		return nil
+	}
+	out := new(PlaybookStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlaybookStepStatus) DeepCopyInto(out *PlaybookStepStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]PlaybookTargetStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlaybookStepStatus.
+func (in *PlaybookStepStatus) DeepCopy() *PlaybookStepStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PlaybookStepStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlaybookTargetStatus) DeepCopyInto(out *PlaybookTargetStatus) {
+	*out = *in
+	out.Cluster = in.Cluster
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlaybookTargetStatus.
+func (in *PlaybookTargetStatus) DeepCopy() *PlaybookTargetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PlaybookTargetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlaybookTargets) DeepCopyInto(out *PlaybookTargets) {
+	*out = *in
+	if in.ClusterRefs != nil {
+		in, out := &in.ClusterRefs, &out.ClusterRefs
+		*out = make([]NamespacedObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlaybookTargets.
+func (in *PlaybookTargets) DeepCopy() *PlaybookTargets {
+	if in == nil {
+		return nil
+	}
+	out := new(PlaybookTargets)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlaybookUpgradeAddonStep) DeepCopyInto(out *PlaybookUpgradeAddonStep) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlaybookUpgradeAddonStep.
+func (in *PlaybookUpgradeAddonStep) DeepCopy() *PlaybookUpgradeAddonStep {
+	if in == nil {
+		return nil
+	}
+	out := new(PlaybookUpgradeAddonStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlaybookWaitStep) DeepCopyInto(out *PlaybookWaitStep) {
+	*out = *in
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlaybookWaitStep.
+func (in *PlaybookWaitStep) DeepCopy() *PlaybookWaitStep {
+	if in == nil {
+		return nil
+	}
+	out := new(PlaybookWaitStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyException) DeepCopyInto(out *PolicyException) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.ExpiresAt.DeepCopyInto(&out.ExpiresAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyException.
+func (in *PolicyException) DeepCopy() *PolicyException {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyException)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyExceptionStatus) DeepCopyInto(out *PolicyExceptionStatus) {
+	*out = *in
+	if in.AppliedAt != nil {
+		in, out := &in.AppliedAt, &out.AppliedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.RemovedAt != nil {
+		in, out := &in.RemovedAt, &out.RemovedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyExceptionStatus.
+func (in *PolicyExceptionStatus) DeepCopy() *PolicyExceptionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyExceptionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PoolAvailability) DeepCopyInto(out *PoolAvailability) {
+	*out = *in
+	out.PoolRef = in.PoolRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PoolAvailability.
+func (in *PoolAvailability) DeepCopy() *PoolAvailability {
+	if in == nil {
+		return nil
+	}
+	out := new(PoolAvailability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PoolReference) DeepCopyInto(out *PoolReference) {
+	*out = *in
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PoolReference.
+func (in *PoolReference) DeepCopy() *PoolReference {
+	if in == nil {
+		return nil
+	}
+	out := new(PoolReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PoolReplicas) DeepCopyInto(out *PoolReplicas) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PoolReplicas.
+func (in *PoolReplicas) DeepCopy() *PoolReplicas {
+	if in == nil {
+		return nil
+	}
+	out := new(PoolReplicas)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreflightCheck) DeepCopyInto(out *PreflightCheck) {
+	*out = *in
+	if in.AffectedObjects != nil {
+		in, out := &in.AffectedObjects, &out.AffectedObjects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreflightCheck.
+func (in *PreflightCheck) DeepCopy() *PreflightCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(PreflightCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreflightStatus) DeepCopyInto(out *PreflightStatus) {
+	*out = *in
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(PreflightTarget)
+		**out = **in
+	}
+	if in.Checks != nil {
+		in, out := &in.Checks, &out.Checks
+		*out = make([]PreflightCheck, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CheckTime != nil {
+		in, out := &in.CheckTime, &out.CheckTime
+		*out = (*in).DeepCopy()
+	}
+	if in.UpgradePlanRef != nil {
+		in, out := &in.UpgradePlanRef, &out.UpgradePlanRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreflightStatus.
+func (in *PreflightStatus) DeepCopy() *PreflightStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PreflightStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreflightTarget) DeepCopyInto(out *PreflightTarget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreflightTarget.
+func (in *PreflightTarget) DeepCopy() *PreflightTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(PreflightTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreviewEnvironment) DeepCopyInto(out *PreviewEnvironment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreviewEnvironment.
+func (in *PreviewEnvironment) DeepCopy() *PreviewEnvironment {
+	if in == nil {
+		return nil
+	}
+	out := new(PreviewEnvironment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PreviewEnvironment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreviewEnvironmentList) DeepCopyInto(out *PreviewEnvironmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PreviewEnvironment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreviewEnvironmentList.
+func (in *PreviewEnvironmentList) DeepCopy() *PreviewEnvironmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(PreviewEnvironmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PreviewEnvironmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreviewEnvironmentSpec) DeepCopyInto(out *PreviewEnvironmentSpec) {
+	*out = *in
+	out.ClusterRef = in.ClusterRef
+	out.Source = in.Source
+	in.Manifests.DeepCopyInto(&out.Manifests)
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DeleteOnClose != nil {
+		in, out := &in.DeleteOnClose, &out.DeleteOnClose
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreviewEnvironmentSpec.
+func (in *PreviewEnvironmentSpec) DeepCopy() *PreviewEnvironmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PreviewEnvironmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreviewEnvironmentStatus) DeepCopyInto(out *PreviewEnvironmentStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastDeployedAt != nil {
+		in, out := &in.LastDeployedAt, &out.LastDeployedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreviewEnvironmentStatus.
+func (in *PreviewEnvironmentStatus) DeepCopy() *PreviewEnvironmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PreviewEnvironmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreviewManifests) DeepCopyInto(out *PreviewManifests) {
+	*out = *in
+	if in.Helm != nil {
+		in, out := &in.Helm, &out.Helm
+		*out = new(HelmChartSpec)
+		**out = **in
+	}
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = new(ExtensionValues)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreviewManifests.
+func (in *PreviewManifests) DeepCopy() *PreviewManifests {
+	if in == nil {
+		return nil
+	}
+	out := new(PreviewManifests)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreviewSource) DeepCopyInto(out *PreviewSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreviewSource.
+func (in *PreviewSource) DeepCopy() *PreviewSource {
+	if in == nil {
+		return nil
+	}
+	out := new(PreviewSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusAddonSpec) DeepCopyInto(out *PrometheusAddonSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StorageSize != nil {
+		in, out := &in.StorageSize, &out.StorageSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusAddonSpec.
+func (in *PrometheusAddonSpec) DeepCopy() *PrometheusAddonSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusAddonSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderCapacity) DeepCopyInto(out *ProviderCapacity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderCapacity.
+func (in *ProviderCapacity) DeepCopy() *ProviderCapacity {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderCapacity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderClientRateLimit) DeepCopyInto(out *ProviderClientRateLimit) {
+	*out = *in
+	if in.RequestTimeout != nil {
+		in, out := &in.RequestTimeout, &out.RequestTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderClientRateLimit.
+func (in *ProviderClientRateLimit) DeepCopy() *ProviderClientRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderClientRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderConcurrencyLimit) DeepCopyInto(out *ProviderConcurrencyLimit) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConcurrencyLimit.
+func (in *ProviderConcurrencyLimit) DeepCopy() *ProviderConcurrencyLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderConcurrencyLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderConfig) DeepCopyInto(out *ProviderConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfig.
+func (in *ProviderConfig) DeepCopy() *ProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProviderConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderConfigList) DeepCopyInto(out *ProviderConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProviderConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigList.
+func (in *ProviderConfigList) DeepCopy() *ProviderConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProviderConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderConfigScope) DeepCopyInto(out *ProviderConfigScope) {
+	*out = *in
+	if in.TeamRef != nil {
+		in, out := &in.TeamRef, &out.TeamRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigScope.
+func (in *ProviderConfigScope) DeepCopy() *ProviderConfigScope {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderConfigScope)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderConfigSpec) DeepCopyInto(out *ProviderConfigSpec) {
+	*out = *in
+	out.CredentialsRef = in.CredentialsRef
+	if in.CredentialExpiryWarning != nil {
+		in, out := &in.CredentialExpiryWarning, &out.CredentialExpiryWarning
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Harvester != nil {
+		in, out := &in.Harvester, &out.Harvester
+		*out = new(HarvesterProviderConfig)
+		**out = **in
+	}
+	if in.Nutanix != nil {
+		in, out := &in.Nutanix, &out.Nutanix
+		*out = new(NutanixProviderConfig)
+		**out = **in
+	}
+	if in.Proxmox != nil {
+		in, out := &in.Proxmox, &out.Proxmox
+		*out = new(ProxmoxProviderConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VSphere != nil {
+		in, out := &in.VSphere, &out.VSphere
+		*out = new(VSphereProviderConfig)
+		**out = **in
+	}
+	if in.OpenStack != nil {
+		in, out := &in.OpenStack, &out.OpenStack
+		*out = new(OpenStackProviderConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BareMetal != nil {
+		in, out := &in.BareMetal, &out.BareMetal
+		*out = new(BareMetalProviderConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(AzureProviderConfig)
+		**out = **in
+	}
+	if in.AWS != nil {
+		in, out := &in.AWS, &out.AWS
+		*out = new(AWSProviderConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GCP != nil {
+		in, out := &in.GCP, &out.GCP
+		*out = new(GCPProviderConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = new(ProviderConfigScope)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(ProviderNetworkConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = new(ProviderLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Bastion != nil {
+		in, out := &in.Bastion, &out.Bastion
+		*out = new(BastionConfig)
+		**out = **in
+	}
+	if in.ImageCache != nil {
+		in, out := &in.ImageCache, &out.ImageCache
+		*out = new(ImageCacheConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientRateLimit != nil {
+		in, out := &in.ClientRateLimit, &out.ClientRateLimit
+		*out = new(ProviderClientRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FreezeWindows != nil {
+		in, out := &in.FreezeWindows, &out.FreezeWindows
+		*out = make([]FreezeWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FailureDomains != nil {
+		in, out := &in.FailureDomains, &out.FailureDomains
+		*out = make([]FailureDomain, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigSpec.
+func (in *ProviderConfigSpec) DeepCopy() *ProviderConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderConfigStatus) DeepCopyInto(out *ProviderConfigStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastValidationTime != nil {
+		in, out := &in.LastValidationTime, &out.LastValidationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastProbeTime != nil {
+		in, out := &in.LastProbeTime, &out.LastProbeTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = new(ProviderCapacity)
+		**out = **in
+	}
+	if in.CredentialExpiry != nil {
+		in, out := &in.CredentialExpiry, &out.CredentialExpiry
+		*out = new(CredentialExpiryStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImageCache != nil {
+		in, out := &in.ImageCache, &out.ImageCache
+		*out = new(ImageCacheStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Reconcile != nil {
+		in, out := &in.Reconcile, &out.Reconcile
+		*out = new(ReconcileStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigStatus.
+func (in *ProviderConfigStatus) DeepCopy() *ProviderConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderLBConfig) DeepCopyInto(out *ProviderLBConfig) {
+	*out = *in
+	if in.DefaultPoolSize != nil {
+		in, out := &in.DefaultPoolSize, &out.DefaultPoolSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.InitialPoolSize != nil {
+		in, out := &in.InitialPoolSize, &out.InitialPoolSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.GrowthIncrement != nil {
+		in, out := &in.GrowthIncrement, &out.GrowthIncrement
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderLBConfig.
+func (in *ProviderLBConfig) DeepCopy() *ProviderLBConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderLBConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderLimits) DeepCopyInto(out *ProviderLimits) {
+	*out = *in
+	if in.MaxClustersPerTeam != nil {
+		in, out := &in.MaxClustersPerTeam, &out.MaxClustersPerTeam
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxNodesPerTeam != nil {
+		in, out := &in.MaxNodesPerTeam, &out.MaxNodesPerTeam
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderLimits.
+func (in *ProviderLimits) DeepCopy() *ProviderLimits {
+	if in == nil {
 		return nil
 	}
-	out := new(IdentityProviderList)
+	out := new(ProviderLimits)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IdentityProviderList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IdentityProviderSpec) DeepCopyInto(out *IdentityProviderSpec) {
+func (in *ProviderNetworkConfig) DeepCopyInto(out *ProviderNetworkConfig) {
 	*out = *in
-	if in.OIDC != nil {
-		in, out := &in.OIDC, &out.OIDC
-		*out = new(OIDCConfig)
-		(*in).DeepCopyInto(*out)
+	if in.PoolRefs != nil {
+		in, out := &in.PoolRefs, &out.PoolRefs
+		*out = make([]PoolReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.PlatformRoleGroups != nil {
-		in, out := &in.PlatformRoleGroups, &out.PlatformRoleGroups
-		*out = make([]PlatformRoleGroupEntry, len(*in))
+	if in.DNSServers != nil {
+		in, out := &in.DNSServers, &out.DNSServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TimeServers != nil {
+		in, out := &in.TimeServers, &out.TimeServers
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(ProviderLBConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QuotaPerTenant != nil {
+		in, out := &in.QuotaPerTenant, &out.QuotaPerTenant
+		*out = new(NetworkQuota)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityProviderSpec.
-func (in *IdentityProviderSpec) DeepCopy() *IdentityProviderSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderNetworkConfig.
+func (in *ProviderNetworkConfig) DeepCopy() *ProviderNetworkConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(IdentityProviderSpec)
+	out := new(ProviderNetworkConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IdentityProviderStatus) DeepCopyInto(out *IdentityProviderStatus) {
+func (in *ProviderPlanResult) DeepCopyInto(out *ProviderPlanResult) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	out.ProviderConfigRef = in.ProviderConfigRef
+	if in.Reasons != nil {
+		in, out := &in.Reasons, &out.Reasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.LastValidatedTime != nil {
-		in, out := &in.LastValidatedTime, &out.LastValidatedTime
-		*out = (*in).DeepCopy()
+	if in.Pools != nil {
+		in, out := &in.Pools, &out.Pools
+		*out = make([]PoolAvailability, len(*in))
+		copy(*out, *in)
 	}
-	if in.DiscoveredEndpoints != nil {
-		in, out := &in.DiscoveredEndpoints, &out.DiscoveredEndpoints
-		*out = new(OIDCDiscoveredEndpoints)
-		**out = **in
+	if in.QuotaHeadroom != nil {
+		in, out := &in.QuotaHeadroom, &out.QuotaHeadroom
+		*out = new(QuotaHeadroom)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EstimatedCost != nil {
+		in, out := &in.EstimatedCost, &out.EstimatedCost
+		*out = new(CostEstimate)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityProviderStatus.
-func (in *IdentityProviderStatus) DeepCopy() *IdentityProviderStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderPlanResult.
+func (in *ProviderPlanResult) DeepCopy() *ProviderPlanResult {
 	if in == nil {
 		return nil
 	}
-	out := new(IdentityProviderStatus)
+	out := new(ProviderPlanResult)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImageFactoryConfig) DeepCopyInto(out *ImageFactoryConfig) {
+func (in *ProviderReference) DeepCopyInto(out *ProviderReference) {
 	*out = *in
-	if in.CredentialsRef != nil {
-		in, out := &in.CredentialsRef, &out.CredentialsRef
-		*out = new(SecretReference)
-		**out = **in
-	}
-	if in.AutoSync != nil {
-		in, out := &in.AutoSync, &out.AutoSync
-		*out = new(bool)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageFactoryConfig.
-func (in *ImageFactoryConfig) DeepCopy() *ImageFactoryConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderReference.
+func (in *ProviderReference) DeepCopy() *ProviderReference {
 	if in == nil {
 		return nil
 	}
-	out := new(ImageFactoryConfig)
+	out := new(ProviderReference)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImageFactoryRef) DeepCopyInto(out *ImageFactoryRef) {
+func (in *ProvisioningLimits) DeepCopyInto(out *ProvisioningLimits) {
 	*out = *in
+	if in.MaxConcurrentMachineRequestsPerProvider != nil {
+		in, out := &in.MaxConcurrentMachineRequestsPerProvider, &out.MaxConcurrentMachineRequestsPerProvider
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ProviderOverrides != nil {
+		in, out := &in.ProviderOverrides, &out.ProviderOverrides
+		*out = make([]ProviderConcurrencyLimit, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxConcurrentClusterCreations != nil {
+		in, out := &in.MaxConcurrentClusterCreations, &out.MaxConcurrentClusterCreations
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxConcurrentClusterCreationsPerTeam != nil {
+		in, out := &in.MaxConcurrentClusterCreationsPerTeam, &out.MaxConcurrentClusterCreationsPerTeam
+		*out = new(int32)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageFactoryRef.
-func (in *ImageFactoryRef) DeepCopy() *ImageFactoryRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningLimits.
+func (in *ProvisioningLimits) DeepCopy() *ProvisioningLimits {
 	if in == nil {
 		return nil
 	}
-	out := new(ImageFactoryRef)
+	out := new(ProvisioningLimits)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImageSync) DeepCopyInto(out *ImageSync) {
+func (in *ProvisioningPlan) DeepCopyInto(out *ProvisioningPlan) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSync.
-func (in *ImageSync) DeepCopy() *ImageSync {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningPlan.
+func (in *ProvisioningPlan) DeepCopy() *ProvisioningPlan {
 	if in == nil {
 		return nil
 	}
-	out := new(ImageSync)
+	out := new(ProvisioningPlan)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ImageSync) DeepCopyObject() runtime.Object {
+func (in *ProvisioningPlan) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1820,31 +8184,31 @@ func (in *ImageSync) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImageSyncList) DeepCopyInto(out *ImageSyncList) {
+func (in *ProvisioningPlanList) DeepCopyInto(out *ProvisioningPlanList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ImageSync, len(*in))
+		*out = make([]ProvisioningPlan, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSyncList.
-func (in *ImageSyncList) DeepCopy() *ImageSyncList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningPlanList.
+func (in *ProvisioningPlanList) DeepCopy() *ProvisioningPlanList {
 	if in == nil {
 		return nil
 	}
-	out := new(ImageSyncList)
+	out := new(ProvisioningPlanList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ImageSyncList) DeepCopyObject() runtime.Object {
+func (in *ProvisioningPlanList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1852,24 +8216,33 @@ func (in *ImageSyncList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImageSyncSpec) DeepCopyInto(out *ImageSyncSpec) {
+func (in *ProvisioningPlanSpec) DeepCopyInto(out *ProvisioningPlanSpec) {
 	*out = *in
-	out.FactoryRef = in.FactoryRef
-	out.ProviderConfigRef = in.ProviderConfigRef
+	in.Cluster.DeepCopyInto(&out.Cluster)
+	if in.ProviderConfigRefs != nil {
+		in, out := &in.ProviderConfigRefs, &out.ProviderConfigRefs
+		*out = make([]ProviderReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.TTLAfterCompletion != nil {
+		in, out := &in.TTLAfterCompletion, &out.TTLAfterCompletion
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSyncSpec.
-func (in *ImageSyncSpec) DeepCopy() *ImageSyncSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningPlanSpec.
+func (in *ProvisioningPlanSpec) DeepCopy() *ProvisioningPlanSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ImageSyncSpec)
+	out := new(ProvisioningPlanSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImageSyncStatus) DeepCopyInto(out *ImageSyncStatus) {
+func (in *ProvisioningPlanStatus) DeepCopyInto(out *ProvisioningPlanStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -1878,134 +8251,319 @@ func (in *ImageSyncStatus) DeepCopyInto(out *ImageSyncStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.LastUpdated != nil {
-		in, out := &in.LastUpdated, &out.LastUpdated
+	if in.Requirements != nil {
+		in, out := &in.Requirements, &out.Requirements
+		*out = new(ProvisioningRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Providers != nil {
+		in, out := &in.Providers, &out.Providers
+		*out = make([]ProviderPlanResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EvaluatedAt != nil {
+		in, out := &in.EvaluatedAt, &out.EvaluatedAt
 		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSyncStatus.
-func (in *ImageSyncStatus) DeepCopy() *ImageSyncStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningPlanStatus.
+func (in *ProvisioningPlanStatus) DeepCopy() *ProvisioningPlanStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvisioningPlanStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvisioningRequirements) DeepCopyInto(out *ProvisioningRequirements) {
+	*out = *in
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningRequirements.
+func (in *ProvisioningRequirements) DeepCopy() *ProvisioningRequirements {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvisioningRequirements)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxmoxOverride) DeepCopyInto(out *ProxmoxOverride) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxmoxOverride.
+func (in *ProxmoxOverride) DeepCopy() *ProxmoxOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxmoxOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxmoxProviderConfig) DeepCopyInto(out *ProxmoxProviderConfig) {
+	*out = *in
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VMIDRange != nil {
+		in, out := &in.VMIDRange, &out.VMIDRange
+		*out = new(VMIDRange)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxmoxProviderConfig.
+func (in *ProxmoxProviderConfig) DeepCopy() *ProxmoxProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxmoxProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaHeadroom) DeepCopyInto(out *QuotaHeadroom) {
+	*out = *in
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaHeadroom.
+func (in *QuotaHeadroom) DeepCopy() *QuotaHeadroom {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaHeadroom)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RandomStrategy) DeepCopyInto(out *RandomStrategy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RandomStrategy.
+func (in *RandomStrategy) DeepCopy() *RandomStrategy {
 	if in == nil {
 		return nil
 	}
-	out := new(ImageSyncStatus)
+	out := new(RandomStrategy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InfrastructureOverride) DeepCopyInto(out *InfrastructureOverride) {
+func (in *RebootPolicy) DeepCopyInto(out *RebootPolicy) {
 	*out = *in
-	if in.Harvester != nil {
-		in, out := &in.Harvester, &out.Harvester
-		*out = new(HarvesterOverride)
-		**out = **in
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindow)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Nutanix != nil {
-		in, out := &in.Nutanix, &out.Nutanix
-		*out = new(NutanixOverride)
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
 		**out = **in
 	}
-	if in.Proxmox != nil {
-		in, out := &in.Proxmox, &out.Proxmox
-		*out = new(ProxmoxOverride)
-		**out = **in
+	if in.Drain != nil {
+		in, out := &in.Drain, &out.Drain
+		*out = new(DrainPolicy)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.GCP != nil {
-		in, out := &in.GCP, &out.GCP
-		*out = new(GCPOverride)
+	if in.DetectRebootRequired != nil {
+		in, out := &in.DetectRebootRequired, &out.DetectRebootRequired
+		*out = new(bool)
 		**out = **in
 	}
+	if in.WorkerPools != nil {
+		in, out := &in.WorkerPools, &out.WorkerPools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructureOverride.
-func (in *InfrastructureOverride) DeepCopy() *InfrastructureOverride {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RebootPolicy.
+func (in *RebootPolicy) DeepCopy() *RebootPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(InfrastructureOverride)
+	out := new(RebootPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressAddonSpec) DeepCopyInto(out *IngressAddonSpec) {
+func (in *RebootStatus) DeepCopyInto(out *RebootStatus) {
 	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
-		**out = **in
+	if in.PendingNodes != nil {
+		in, out := &in.PendingNodes, &out.PendingNodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RebootingNodes != nil {
+		in, out := &in.RebootingNodes, &out.RebootingNodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CompletedNodes != nil {
+		in, out := &in.CompletedNodes, &out.CompletedNodes
+		*out = make([]NodeRebootRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastRebootTime != nil {
+		in, out := &in.LastRebootTime, &out.LastRebootTime
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressAddonSpec.
-func (in *IngressAddonSpec) DeepCopy() *IngressAddonSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RebootStatus.
+func (in *RebootStatus) DeepCopy() *RebootStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressAddonSpec)
+	out := new(RebootStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressSpec) DeepCopyInto(out *IngressSpec) {
+func (in *ReconcileStatus) DeepCopyInto(out *ReconcileStatus) {
 	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
+	if in.LastReconcileTime != nil {
+		in, out := &in.LastReconcileTime, &out.LastReconcileTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSuccessTime != nil {
+		in, out := &in.LastSuccessTime, &out.LastSuccessTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
 		**out = **in
 	}
-	if in.Values != nil {
-		in, out := &in.Values, &out.Values
-		*out = new(ExtensionValues)
-		(*in).DeepCopyInto(*out)
+	if in.NextRequeueTime != nil {
+		in, out := &in.NextRequeueTime, &out.NextRequeueTime
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressSpec.
-func (in *IngressSpec) DeepCopy() *IngressSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconcileStatus.
+func (in *ReconcileStatus) DeepCopy() *ReconcileStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressSpec)
+	out := new(ReconcileStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LoadBalancerAddonSpec) DeepCopyInto(out *LoadBalancerAddonSpec) {
+func (in *ReconcileTuning) DeepCopyInto(out *ReconcileTuning) {
 	*out = *in
+	if in.DefaultResyncInterval != nil {
+		in, out := &in.DefaultResyncInterval, &out.DefaultResyncInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ResyncIntervals != nil {
+		in, out := &in.ResyncIntervals, &out.ResyncIntervals
+		*out = make([]ResyncInterval, len(*in))
+		copy(*out, *in)
+	}
+	if in.StatusRefreshPeriod != nil {
+		in, out := &in.StatusRefreshPeriod, &out.StatusRefreshPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.HealthProbes != nil {
+		in, out := &in.HealthProbes, &out.HealthProbes
+		*out = new(HealthProbeTuning)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerAddonSpec.
-func (in *LoadBalancerAddonSpec) DeepCopy() *LoadBalancerAddonSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconcileTuning.
+func (in *ReconcileTuning) DeepCopy() *ReconcileTuning {
 	if in == nil {
 		return nil
 	}
-	out := new(LoadBalancerAddonSpec)
+	out := new(ReconcileTuning)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LoadBalancerPoolSpec) DeepCopyInto(out *LoadBalancerPoolSpec) {
+func (in *ReservedRange) DeepCopyInto(out *ReservedRange) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerPoolSpec.
-func (in *LoadBalancerPoolSpec) DeepCopy() *LoadBalancerPoolSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservedRange.
+func (in *ReservedRange) DeepCopy() *ReservedRange {
 	if in == nil {
 		return nil
 	}
-	out := new(LoadBalancerPoolSpec)
+	out := new(ReservedRange)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LoadBalancerRequest) DeepCopyInto(out *LoadBalancerRequest) {
+func (in *ResourceClaim) DeepCopyInto(out *ResourceClaim) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -2013,18 +8571,18 @@ func (in *LoadBalancerRequest) DeepCopyInto(out *LoadBalancerRequest) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerRequest.
-func (in *LoadBalancerRequest) DeepCopy() *LoadBalancerRequest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceClaim.
+func (in *ResourceClaim) DeepCopy() *ResourceClaim {
 	if in == nil {
 		return nil
 	}
-	out := new(LoadBalancerRequest)
+	out := new(ResourceClaim)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *LoadBalancerRequest) DeepCopyObject() runtime.Object {
+func (in *ResourceClaim) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2032,31 +8590,31 @@ func (in *LoadBalancerRequest) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LoadBalancerRequestList) DeepCopyInto(out *LoadBalancerRequestList) {
+func (in *ResourceClaimList) DeepCopyInto(out *ResourceClaimList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]LoadBalancerRequest, len(*in))
+		*out = make([]ResourceClaim, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerRequestList.
-func (in *LoadBalancerRequestList) DeepCopy() *LoadBalancerRequestList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceClaimList.
+func (in *ResourceClaimList) DeepCopy() *ResourceClaimList {
 	if in == nil {
 		return nil
 	}
-	out := new(LoadBalancerRequestList)
+	out := new(ResourceClaimList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *LoadBalancerRequestList) DeepCopyObject() runtime.Object {
+func (in *ResourceClaimList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2064,28 +8622,30 @@ func (in *LoadBalancerRequestList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LoadBalancerRequestSpec) DeepCopyInto(out *LoadBalancerRequestSpec) {
+func (in *ResourceClaimSpec) DeepCopyInto(out *ResourceClaimSpec) {
 	*out = *in
-	out.ProviderConfigRef = in.ProviderConfigRef
-	if in.Targets != nil {
-		in, out := &in.Targets, &out.Targets
-		*out = make([]LoadBalancerTarget, len(*in))
-		copy(*out, *in)
+	out.TeamRef = in.TeamRef
+	out.ConsumerRef = in.ConsumerRef
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(v1.Duration)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerRequestSpec.
-func (in *LoadBalancerRequestSpec) DeepCopy() *LoadBalancerRequestSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceClaimSpec.
+func (in *ResourceClaimSpec) DeepCopy() *ResourceClaimSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(LoadBalancerRequestSpec)
+	out := new(ResourceClaimSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LoadBalancerRequestStatus) DeepCopyInto(out *LoadBalancerRequestStatus) {
+func (in *ResourceClaimStatus) DeepCopyInto(out *ResourceClaimStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -2094,889 +8654,1001 @@ func (in *LoadBalancerRequestStatus) DeepCopyInto(out *LoadBalancerRequestStatus
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.LastUpdated != nil {
-		in, out := &in.LastUpdated, &out.LastUpdated
+	if in.ReservedAt != nil {
+		in, out := &in.ReservedAt, &out.ReservedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Headroom != nil {
+		in, out := &in.Headroom, &out.Headroom
+		*out = new(QuotaHeadroom)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerRequestStatus.
-func (in *LoadBalancerRequestStatus) DeepCopy() *LoadBalancerRequestStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceClaimStatus.
+func (in *ResourceClaimStatus) DeepCopy() *ResourceClaimStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(LoadBalancerRequestStatus)
+	out := new(ResourceClaimStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LoadBalancerSpec) DeepCopyInto(out *LoadBalancerSpec) {
+func (in *ResourceLimits) DeepCopyInto(out *ResourceLimits) {
 	*out = *in
-	if in.Values != nil {
-		in, out := &in.Values, &out.Values
-		*out = new(ExtensionValues)
-		(*in).DeepCopyInto(*out)
+	if in.MaxClusters != nil {
+		in, out := &in.MaxClusters, &out.MaxClusters
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxWorkersPerCluster != nil {
+		in, out := &in.MaxWorkersPerCluster, &out.MaxWorkersPerCluster
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxTotalCPU != nil {
+		in, out := &in.MaxTotalCPU, &out.MaxTotalCPU
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxTotalMemory != nil {
+		in, out := &in.MaxTotalMemory, &out.MaxTotalMemory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxTotalStorage != nil {
+		in, out := &in.MaxTotalStorage, &out.MaxTotalStorage
+		x := (*in).DeepCopy()
+		*out = &x
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerSpec.
-func (in *LoadBalancerSpec) DeepCopy() *LoadBalancerSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceLimits.
+func (in *ResourceLimits) DeepCopy() *ResourceLimits {
 	if in == nil {
 		return nil
 	}
-	out := new(LoadBalancerSpec)
+	out := new(ResourceLimits)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LoadBalancerTarget) DeepCopyInto(out *LoadBalancerTarget) {
+func (in *ResourceQuantities) DeepCopyInto(out *ResourceQuantities) {
 	*out = *in
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerTarget.
-func (in *LoadBalancerTarget) DeepCopy() *LoadBalancerTarget {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceQuantities.
+func (in *ResourceQuantities) DeepCopy() *ResourceQuantities {
 	if in == nil {
 		return nil
 	}
-	out := new(LoadBalancerTarget)
+	out := new(ResourceQuantities)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LocalObjectReference) DeepCopyInto(out *LocalObjectReference) {
+func (in *RestoreNewCluster) DeepCopyInto(out *RestoreNewCluster) {
 	*out = *in
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(TenantClusterSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalObjectReference.
-func (in *LocalObjectReference) DeepCopy() *LocalObjectReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreNewCluster.
+func (in *RestoreNewCluster) DeepCopy() *RestoreNewCluster {
 	if in == nil {
 		return nil
 	}
-	out := new(LocalObjectReference)
+	out := new(RestoreNewCluster)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LogCollectionDefaults) DeepCopyInto(out *LogCollectionDefaults) {
+func (in *RestoreTarget) DeepCopyInto(out *RestoreTarget) {
 	*out = *in
+	if in.ClusterRef != nil {
+		in, out := &in.ClusterRef, &out.ClusterRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+	if in.NewCluster != nil {
+		in, out := &in.NewCluster, &out.NewCluster
+		*out = new(RestoreNewCluster)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogCollectionDefaults.
-func (in *LogCollectionDefaults) DeepCopy() *LogCollectionDefaults {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreTarget.
+func (in *RestoreTarget) DeepCopy() *RestoreTarget {
 	if in == nil {
 		return nil
 	}
-	out := new(LogCollectionDefaults)
+	out := new(RestoreTarget)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineRequest) DeepCopyInto(out *MachineRequest) {
+func (in *ResyncInterval) DeepCopyInto(out *ResyncInterval) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	out.Interval = in.Interval
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineRequest.
-func (in *MachineRequest) DeepCopy() *MachineRequest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResyncInterval.
+func (in *ResyncInterval) DeepCopy() *ResyncInterval {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineRequest)
+	out := new(ResyncInterval)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MachineRequest) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineRequestList) DeepCopyInto(out *MachineRequestList) {
+func (in *RolloutStatus) DeepCopyInto(out *RolloutStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]MachineRequest, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletedAt != nil {
+		in, out := &in.CompletedAt, &out.CompletedAt
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineRequestList.
-func (in *MachineRequestList) DeepCopy() *MachineRequestList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStatus.
+func (in *RolloutStatus) DeepCopy() *RolloutStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineRequestList)
+	out := new(RolloutStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MachineRequestList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineRequestSpec) DeepCopyInto(out *MachineRequestSpec) {
+func (in *SLOSpec) DeepCopyInto(out *SLOSpec) {
 	*out = *in
-	out.ProviderRef = in.ProviderRef
-	if in.ExtraDisks != nil {
-		in, out := &in.ExtraDisks, &out.ExtraDisks
-		*out = make([]DiskSpec, len(*in))
-		copy(*out, *in)
-	}
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(v1.Duration)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineRequestSpec.
-func (in *MachineRequestSpec) DeepCopy() *MachineRequestSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SLOSpec.
+func (in *SLOSpec) DeepCopy() *SLOSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineRequestSpec)
+	out := new(SLOSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineRequestStatus) DeepCopyInto(out *MachineRequestStatus) {
+func (in *SLOStatus) DeepCopyInto(out *SLOStatus) {
 	*out = *in
-	if in.IPAddresses != nil {
-		in, out := &in.IPAddresses, &out.IPAddresses
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 	if in.LastUpdated != nil {
 		in, out := &in.LastUpdated, &out.LastUpdated
 		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineRequestStatus.
-func (in *MachineRequestStatus) DeepCopy() *MachineRequestStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SLOStatus.
+func (in *SLOStatus) DeepCopy() *SLOStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineRequestStatus)
+	out := new(SLOStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineTemplateSpec) DeepCopyInto(out *MachineTemplateSpec) {
+func (in *SMTPConfig) DeepCopyInto(out *SMTPConfig) {
 	*out = *in
-	out.Memory = in.Memory.DeepCopy()
-	out.DiskSize = in.DiskSize.DeepCopy()
-	in.OS.DeepCopyInto(&out.OS)
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.StartTLS != nil {
+		in, out := &in.StartTLS, &out.StartTLS
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineTemplateSpec.
-func (in *MachineTemplateSpec) DeepCopy() *MachineTemplateSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SMTPConfig.
+func (in *SMTPConfig) DeepCopy() *SMTPConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineTemplateSpec)
+	out := new(SMTPConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagementAddon) DeepCopyInto(out *ManagementAddon) {
+func (in *SSHCertificateAuthority) DeepCopyInto(out *SSHCertificateAuthority) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	out.CAKeySecretRef = in.CAKeySecretRef
+	if in.MaxTTL != nil {
+		in, out := &in.MaxTTL, &out.MaxTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementAddon.
-func (in *ManagementAddon) DeepCopy() *ManagementAddon {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHCertificateAuthority.
+func (in *SSHCertificateAuthority) DeepCopy() *SSHCertificateAuthority {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagementAddon)
+	out := new(SSHCertificateAuthority)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ManagementAddon) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagementAddonList) DeepCopyInto(out *ManagementAddonList) {
+func (in *SSHKeyEntry) DeepCopyInto(out *SSHKeyEntry) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]ManagementAddon, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.AddedAt != nil {
+		in, out := &in.AddedAt, &out.AddedAt
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementAddonList.
-func (in *ManagementAddonList) DeepCopy() *ManagementAddonList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHKeyEntry.
+func (in *SSHKeyEntry) DeepCopy() *SSHKeyEntry {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagementAddonList)
+	out := new(SSHKeyEntry)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ManagementAddonList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHKnownHosts) DeepCopyInto(out *SSHKnownHosts) {
+	*out = *in
+	if in.Entries != nil {
+		in, out := &in.Entries, &out.Entries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	return nil
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHKnownHosts.
+func (in *SSHKnownHosts) DeepCopy() *SSHKnownHosts {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHKnownHosts)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagementAddonSpec) DeepCopyInto(out *ManagementAddonSpec) {
+func (in *SecretReference) DeepCopyInto(out *SecretReference) {
 	*out = *in
-	if in.Values != nil {
-		in, out := &in.Values, &out.Values
-		*out = new(runtime.RawExtension)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementAddonSpec.
-func (in *ManagementAddonSpec) DeepCopy() *ManagementAddonSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretReference.
+func (in *SecretReference) DeepCopy() *SecretReference {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagementAddonSpec)
+	out := new(SecretReference)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagementAddonStatus) DeepCopyInto(out *ManagementAddonStatus) {
+func (in *ServerAddonSpec) DeepCopyInto(out *ServerAddonSpec) {
 	*out = *in
-	if in.HelmRelease != nil {
-		in, out := &in.HelmRelease, &out.HelmRelease
-		*out = new(HelmReleaseStatus)
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
 		**out = **in
 	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(ConsoleIngressSpec)
+		**out = **in
+	}
+	if in.IdentityProviderRefs != nil {
+		in, out := &in.IdentityProviderRefs, &out.IdentityProviderRefs
+		*out = make([]LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(ServerTLSSpec)
+		(*in).DeepCopyInto(*out)
 	}
+	in.PlatformComponentSpec.DeepCopyInto(&out.PlatformComponentSpec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementAddonStatus.
-func (in *ManagementAddonStatus) DeepCopy() *ManagementAddonStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerAddonSpec.
+func (in *ServerAddonSpec) DeepCopy() *ServerAddonSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagementAddonStatus)
+	out := new(ServerAddonSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagementPolicySpec) DeepCopyInto(out *ManagementPolicySpec) {
+func (in *ServerTLSSpec) DeepCopyInto(out *ServerTLSSpec) {
 	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementPolicySpec.
-func (in *ManagementPolicySpec) DeepCopy() *ManagementPolicySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerTLSSpec.
+func (in *ServerTLSSpec) DeepCopy() *ServerTLSSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagementPolicySpec)
+	out := new(ServerTLSSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MetricCollectionDefaults) DeepCopyInto(out *MetricCollectionDefaults) {
+func (in *SlackChannelConfig) DeepCopyInto(out *SlackChannelConfig) {
 	*out = *in
+	out.WebhookSecretRef = in.WebhookSecretRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricCollectionDefaults.
-func (in *MetricCollectionDefaults) DeepCopy() *MetricCollectionDefaults {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SlackChannelConfig.
+func (in *SlackChannelConfig) DeepCopy() *SlackChannelConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(MetricCollectionDefaults)
+	out := new(SlackChannelConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MultiTenancyConfig) DeepCopyInto(out *MultiTenancyConfig) {
+func (in *StaticIPAssignment) DeepCopyInto(out *StaticIPAssignment) {
 	*out = *in
+	out.Range = in.Range
+	if in.TenantClusterRef != nil {
+		in, out := &in.TenantClusterRef, &out.TenantClusterRef
+		*out = new(NamespacedObjectReference)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiTenancyConfig.
-func (in *MultiTenancyConfig) DeepCopy() *MultiTenancyConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticIPAssignment.
+func (in *StaticIPAssignment) DeepCopy() *StaticIPAssignment {
 	if in == nil {
 		return nil
 	}
-	out := new(MultiTenancyConfig)
+	out := new(StaticIPAssignment)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NamespacedObjectReference) DeepCopyInto(out *NamespacedObjectReference) {
+func (in *StaticLoadBalancerIP) DeepCopyInto(out *StaticLoadBalancerIP) {
 	*out = *in
+	if in.ServiceRef != nil {
+		in, out := &in.ServiceRef, &out.ServiceRef
+		*out = new(NamespacedObjectReference)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedObjectReference.
-func (in *NamespacedObjectReference) DeepCopy() *NamespacedObjectReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticLoadBalancerIP.
+func (in *StaticLoadBalancerIP) DeepCopy() *StaticLoadBalancerIP {
 	if in == nil {
 		return nil
 	}
-	out := new(NamespacedObjectReference)
+	out := new(StaticLoadBalancerIP)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkPool) DeepCopyInto(out *NetworkPool) {
+func (in *StaticLoadBalancerIPStatus) DeepCopyInto(out *StaticLoadBalancerIPStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPool.
-func (in *NetworkPool) DeepCopy() *NetworkPool {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticLoadBalancerIPStatus.
+func (in *StaticLoadBalancerIPStatus) DeepCopy() *StaticLoadBalancerIPStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkPool)
+	out := new(StaticLoadBalancerIPStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NetworkPool) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkPoolList) DeepCopyInto(out *NetworkPoolList) {
+func (in *StatusSummary) DeepCopyInto(out *StatusSummary) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]NetworkPool, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPoolList.
-func (in *NetworkPoolList) DeepCopy() *NetworkPoolList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatusSummary.
+func (in *StatusSummary) DeepCopy() *StatusSummary {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkPoolList)
+	out := new(StatusSummary)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NetworkPoolList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkPoolSpec) DeepCopyInto(out *NetworkPoolSpec) {
+func (in *StorageAddonSpec) DeepCopyInto(out *StorageAddonSpec) {
 	*out = *in
-	if in.Reserved != nil {
-		in, out := &in.Reserved, &out.Reserved
-		*out = make([]ReservedRange, len(*in))
-		copy(*out, *in)
-	}
-	if in.TenantAllocation != nil {
-		in, out := &in.TenantAllocation, &out.TenantAllocation
-		*out = new(TenantAllocationConfig)
+	if in.ReplicaCount != nil {
+		in, out := &in.ReplicaCount, &out.ReplicaCount
+		*out = new(int32)
 		**out = **in
 	}
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]StoragePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPoolSpec.
-func (in *NetworkPoolSpec) DeepCopy() *NetworkPoolSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageAddonSpec.
+func (in *StorageAddonSpec) DeepCopy() *StorageAddonSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkPoolSpec)
+	out := new(StorageAddonSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkPoolStatus) DeepCopyInto(out *NetworkPoolStatus) {
+func (in *StoragePolicy) DeepCopyInto(out *StoragePolicy) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.ReplicaCount != nil {
+		in, out := &in.ReplicaCount, &out.ReplicaCount
+		*out = new(int32)
+		**out = **in
 	}
-	if in.FragmentationPercent != nil {
-		in, out := &in.FragmentationPercent, &out.FragmentationPercent
+	if in.SnapshotRetain != nil {
+		in, out := &in.SnapshotRetain, &out.SnapshotRetain
 		*out = new(int32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPoolStatus.
-func (in *NetworkPoolStatus) DeepCopy() *NetworkPoolStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StoragePolicy.
+func (in *StoragePolicy) DeepCopy() *StoragePolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkPoolStatus)
+	out := new(StoragePolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkQuota) DeepCopyInto(out *NetworkQuota) {
+func (in *StorageSpec) DeepCopyInto(out *StorageSpec) {
 	*out = *in
-	if in.MaxNodeIPs != nil {
-		in, out := &in.MaxNodeIPs, &out.MaxNodeIPs
-		*out = new(int32)
-		**out = **in
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]StoragePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.MaxLoadBalancerIPs != nil {
-		in, out := &in.MaxLoadBalancerIPs, &out.MaxLoadBalancerIPs
-		*out = new(int32)
-		**out = **in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = new(ExtensionValues)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkQuota.
-func (in *NetworkQuota) DeepCopy() *NetworkQuota {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageSpec.
+func (in *StorageSpec) DeepCopy() *StorageSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkQuota)
+	out := new(StorageSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkingSpec) DeepCopyInto(out *NetworkingSpec) {
+func (in *SupportBundle) DeepCopyInto(out *SupportBundle) {
 	*out = *in
-	if in.LoadBalancerPool != nil {
-		in, out := &in.LoadBalancerPool, &out.LoadBalancerPool
-		*out = new(IPPool)
-		**out = **in
-	}
-	if in.LBPoolSize != nil {
-		in, out := &in.LBPoolSize, &out.LBPoolSize
-		*out = new(int32)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkingSpec.
-func (in *NetworkingSpec) DeepCopy() *NetworkingSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SupportBundle.
+func (in *SupportBundle) DeepCopy() *SupportBundle {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkingSpec)
+	out := new(SupportBundle)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SupportBundle) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NotificationsConfig) DeepCopyInto(out *NotificationsConfig) {
+func (in *SupportBundleArtifact) DeepCopyInto(out *SupportBundleArtifact) {
 	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationsConfig.
-func (in *NotificationsConfig) DeepCopy() *NotificationsConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SupportBundleArtifact.
+func (in *SupportBundleArtifact) DeepCopy() *SupportBundleArtifact {
 	if in == nil {
 		return nil
 	}
-	out := new(NotificationsConfig)
+	out := new(SupportBundleArtifact)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NutanixOverride) DeepCopyInto(out *NutanixOverride) {
+func (in *SupportBundleList) DeepCopyInto(out *SupportBundleList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SupportBundle, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NutanixOverride.
-func (in *NutanixOverride) DeepCopy() *NutanixOverride {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SupportBundleList.
+func (in *SupportBundleList) DeepCopy() *SupportBundleList {
 	if in == nil {
 		return nil
 	}
-	out := new(NutanixOverride)
+	out := new(SupportBundleList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SupportBundleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NutanixProviderConfig) DeepCopyInto(out *NutanixProviderConfig) {
+func (in *SupportBundleRedaction) DeepCopyInto(out *SupportBundleRedaction) {
 	*out = *in
+	if in.Patterns != nil {
+		in, out := &in.Patterns, &out.Patterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NutanixProviderConfig.
-func (in *NutanixProviderConfig) DeepCopy() *NutanixProviderConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SupportBundleRedaction.
+func (in *SupportBundleRedaction) DeepCopy() *SupportBundleRedaction {
 	if in == nil {
 		return nil
 	}
-	out := new(NutanixProviderConfig)
+	out := new(SupportBundleRedaction)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OIDCConfig) DeepCopyInto(out *OIDCConfig) {
+func (in *SupportBundleSpec) DeepCopyInto(out *SupportBundleSpec) {
 	*out = *in
-	out.ClientSecretRef = in.ClientSecretRef
-	if in.Scopes != nil {
-		in, out := &in.Scopes, &out.Scopes
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.ClusterRef != nil {
+		in, out := &in.ClusterRef, &out.ClusterRef
+		*out = new(NamespacedObjectReference)
+		**out = **in
 	}
-	if in.GoogleWorkspace != nil {
-		in, out := &in.GoogleWorkspace, &out.GoogleWorkspace
-		*out = new(GoogleWorkspaceConfig)
+	if in.TeamRef != nil {
+		in, out := &in.TeamRef, &out.TeamRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+	if in.IncludeLogs != nil {
+		in, out := &in.IncludeLogs, &out.IncludeLogs
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LogSince != nil {
+		in, out := &in.LogSince, &out.LogSince
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.IncludeEvents != nil {
+		in, out := &in.IncludeEvents, &out.IncludeEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Redaction != nil {
+		in, out := &in.Redaction, &out.Redaction
+		*out = new(SupportBundleRedaction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(v1.Duration)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCConfig.
-func (in *OIDCConfig) DeepCopy() *OIDCConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SupportBundleSpec.
+func (in *SupportBundleSpec) DeepCopy() *SupportBundleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(OIDCConfig)
+	out := new(SupportBundleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OIDCDiscoveredEndpoints) DeepCopyInto(out *OIDCDiscoveredEndpoints) {
+func (in *SupportBundleStatus) DeepCopyInto(out *SupportBundleStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Artifact != nil {
+		in, out := &in.Artifact, &out.Artifact
+		*out = new(SupportBundleArtifact)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CompletedAt != nil {
+		in, out := &in.CompletedAt, &out.CompletedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCDiscoveredEndpoints.
-func (in *OIDCDiscoveredEndpoints) DeepCopy() *OIDCDiscoveredEndpoints {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SupportBundleStatus.
+func (in *SupportBundleStatus) DeepCopy() *SupportBundleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(OIDCDiscoveredEndpoints)
+	out := new(SupportBundleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OSSpec) DeepCopyInto(out *OSSpec) {
+func (in *TalosConfig) DeepCopyInto(out *TalosConfig) {
 	*out = *in
-	if in.Talos != nil {
-		in, out := &in.Talos, &out.Talos
-		*out = new(TalosConfig)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OSSpec.
-func (in *OSSpec) DeepCopy() *OSSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TalosConfig.
+func (in *TalosConfig) DeepCopy() *TalosConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(OSSpec)
+	out := new(TalosConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityCollectionConfig) DeepCopyInto(out *ObservabilityCollectionConfig) {
+func (in *TalosConfigPatch) DeepCopyInto(out *TalosConfigPatch) {
 	*out = *in
-	if in.AutoEnroll != nil {
-		in, out := &in.AutoEnroll, &out.AutoEnroll
-		*out = new(AutoEnrollConfig)
-		**out = **in
-	}
-	if in.Logs != nil {
-		in, out := &in.Logs, &out.Logs
-		*out = new(LogCollectionDefaults)
-		**out = **in
-	}
-	if in.Metrics != nil {
-		in, out := &in.Metrics, &out.Metrics
-		*out = new(MetricCollectionDefaults)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityCollectionConfig.
-func (in *ObservabilityCollectionConfig) DeepCopy() *ObservabilityCollectionConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TalosConfigPatch.
+func (in *TalosConfigPatch) DeepCopy() *TalosConfigPatch {
 	if in == nil {
 		return nil
 	}
-	out := new(ObservabilityCollectionConfig)
+	out := new(TalosConfigPatch)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityConfig) DeepCopyInto(out *ObservabilityConfig) {
+func (in *TalosRelease) DeepCopyInto(out *TalosRelease) {
 	*out = *in
-	if in.Pipeline != nil {
-		in, out := &in.Pipeline, &out.Pipeline
-		*out = new(ObservabilityPipelineConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Collection != nil {
-		in, out := &in.Collection, &out.Collection
-		*out = new(ObservabilityCollectionConfig)
-		(*in).DeepCopyInto(*out)
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityConfig.
-func (in *ObservabilityConfig) DeepCopy() *ObservabilityConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TalosRelease.
+func (in *TalosRelease) DeepCopy() *TalosRelease {
 	if in == nil {
 		return nil
 	}
-	out := new(ObservabilityConfig)
+	out := new(TalosRelease)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TalosRelease) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityPipelineConfig) DeepCopyInto(out *ObservabilityPipelineConfig) {
+func (in *TalosReleaseList) DeepCopyInto(out *TalosReleaseList) {
 	*out = *in
-	if in.ClusterRef != nil {
-		in, out := &in.ClusterRef, &out.ClusterRef
-		*out = new(NamespacedObjectReference)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TalosRelease, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPipelineConfig.
-func (in *ObservabilityPipelineConfig) DeepCopy() *ObservabilityPipelineConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TalosReleaseList.
+func (in *TalosReleaseList) DeepCopy() *TalosReleaseList {
 	if in == nil {
 		return nil
 	}
-	out := new(ObservabilityPipelineConfig)
+	out := new(TalosReleaseList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TalosReleaseList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityStatus) DeepCopyInto(out *ObservabilityStatus) {
+func (in *TalosReleaseSpec) DeepCopyInto(out *TalosReleaseSpec) {
 	*out = *in
+	if in.Extensions != nil {
+		in, out := &in.Extensions, &out.Extensions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.KubernetesVersions = in.KubernetesVersions
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityStatus.
-func (in *ObservabilityStatus) DeepCopy() *ObservabilityStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TalosReleaseSpec.
+func (in *TalosReleaseSpec) DeepCopy() *TalosReleaseSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ObservabilityStatus)
+	out := new(TalosReleaseSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservedClusterState) DeepCopyInto(out *ObservedClusterState) {
+func (in *TalosReleaseStatus) DeepCopyInto(out *TalosReleaseStatus) {
 	*out = *in
-	if in.Workers != nil {
-		in, out := &in.Workers, &out.Workers
-		*out = new(WorkerStatus)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Addons != nil {
-		in, out := &in.Addons, &out.Addons
-		*out = make([]AddonStatus, len(*in))
-		copy(*out, *in)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservedClusterState.
-func (in *ObservedClusterState) DeepCopy() *ObservedClusterState {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TalosReleaseStatus.
+func (in *TalosReleaseStatus) DeepCopy() *TalosReleaseStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ObservedClusterState)
+	out := new(TalosReleaseStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PinnedIPRange) DeepCopyInto(out *PinnedIPRange) {
+func (in *Team) DeepCopyInto(out *Team) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PinnedIPRange.
-func (in *PinnedIPRange) DeepCopy() *PinnedIPRange {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Team.
+func (in *Team) DeepCopy() *Team {
 	if in == nil {
 		return nil
 	}
-	out := new(PinnedIPRange)
+	out := new(Team)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Team) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PlatformRoleGroupEntry) DeepCopyInto(out *PlatformRoleGroupEntry) {
+func (in *TeamAccess) DeepCopyInto(out *TeamAccess) {
 	*out = *in
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]TeamUser, len(*in))
+		copy(*out, *in)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]TeamGroup, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformRoleGroupEntry.
-func (in *PlatformRoleGroupEntry) DeepCopy() *PlatformRoleGroupEntry {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamAccess.
+func (in *TeamAccess) DeepCopy() *TeamAccess {
 	if in == nil {
 		return nil
 	}
-	out := new(PlatformRoleGroupEntry)
+	out := new(TeamAccess)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PoolReference) DeepCopyInto(out *PoolReference) {
+func (in *TeamBootstrapItemStatus) DeepCopyInto(out *TeamBootstrapItemStatus) {
 	*out = *in
-	if in.Priority != nil {
-		in, out := &in.Priority, &out.Priority
-		*out = new(int32)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PoolReference.
-func (in *PoolReference) DeepCopy() *PoolReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamBootstrapItemStatus.
+func (in *TeamBootstrapItemStatus) DeepCopy() *TeamBootstrapItemStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PoolReference)
+	out := new(TeamBootstrapItemStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProviderCapacity) DeepCopyInto(out *ProviderCapacity) {
+func (in *TeamBootstrapSpec) DeepCopyInto(out *TeamBootstrapSpec) {
 	*out = *in
+	if in.NetworkPool != nil {
+		in, out := &in.NetworkPool, &out.NetworkPool
+		*out = new(TeamNetworkPoolBootstrap)
+		**out = **in
+	}
+	if in.ProviderConfigs != nil {
+		in, out := &in.ProviderConfigs, &out.ProviderConfigs
+		*out = make([]ProviderReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.WorkspaceTemplates != nil {
+		in, out := &in.WorkspaceTemplates, &out.WorkspaceTemplates
+		*out = make([]LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.NotificationChannel != nil {
+		in, out := &in.NotificationChannel, &out.NotificationChannel
+		*out = new(TeamNotificationChannelBootstrap)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderCapacity.
-func (in *ProviderCapacity) DeepCopy() *ProviderCapacity {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamBootstrapSpec.
+func (in *TeamBootstrapSpec) DeepCopy() *TeamBootstrapSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ProviderCapacity)
+	out := new(TeamBootstrapSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProviderConfig) DeepCopyInto(out *ProviderConfig) {
+func (in *TeamGroup) DeepCopyInto(out *TeamGroup) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfig.
-func (in *ProviderConfig) DeepCopy() *ProviderConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamGroup.
+func (in *TeamGroup) DeepCopy() *TeamGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(ProviderConfig)
+	out := new(TeamGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProviderConfig) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProviderConfigList) DeepCopyInto(out *ProviderConfigList) {
+func (in *TeamList) DeepCopyInto(out *TeamList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ProviderConfig, len(*in))
+		*out = make([]Team, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigList.
-func (in *ProviderConfigList) DeepCopy() *ProviderConfigList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamList.
+func (in *TeamList) DeepCopy() *TeamList {
 	if in == nil {
 		return nil
 	}
-	out := new(ProviderConfigList)
+	out := new(TeamList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProviderConfigList) DeepCopyObject() runtime.Object {
+func (in *TeamList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2984,459 +9656,585 @@ func (in *ProviderConfigList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProviderConfigScope) DeepCopyInto(out *ProviderConfigScope) {
+func (in *TeamNetworkPoolBootstrap) DeepCopyInto(out *TeamNetworkPoolBootstrap) {
 	*out = *in
-	if in.TeamRef != nil {
-		in, out := &in.TeamRef, &out.TeamRef
-		*out = new(LocalObjectReference)
+	out.ParentPoolRef = in.ParentPoolRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamNetworkPoolBootstrap.
+func (in *TeamNetworkPoolBootstrap) DeepCopy() *TeamNetworkPoolBootstrap {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamNetworkPoolBootstrap)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamNotificationChannelBootstrap) DeepCopyInto(out *TeamNotificationChannelBootstrap) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(SecretReference)
 		**out = **in
 	}
+	if in.Recipients != nil {
+		in, out := &in.Recipients, &out.Recipients
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigScope.
-func (in *ProviderConfigScope) DeepCopy() *ProviderConfigScope {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamNotificationChannelBootstrap.
+func (in *TeamNotificationChannelBootstrap) DeepCopy() *TeamNotificationChannelBootstrap {
 	if in == nil {
 		return nil
 	}
-	out := new(ProviderConfigScope)
+	out := new(TeamNotificationChannelBootstrap)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProviderConfigSpec) DeepCopyInto(out *ProviderConfigSpec) {
+func (in *TeamResourceLimits) DeepCopyInto(out *TeamResourceLimits) {
 	*out = *in
-	out.CredentialsRef = in.CredentialsRef
-	if in.Harvester != nil {
-		in, out := &in.Harvester, &out.Harvester
-		*out = new(HarvesterProviderConfig)
+	if in.MaxClusters != nil {
+		in, out := &in.MaxClusters, &out.MaxClusters
+		*out = new(int32)
 		**out = **in
 	}
-	if in.Nutanix != nil {
-		in, out := &in.Nutanix, &out.Nutanix
-		*out = new(NutanixProviderConfig)
+	if in.MaxNodesPerCluster != nil {
+		in, out := &in.MaxNodesPerCluster, &out.MaxNodesPerCluster
+		*out = new(int32)
 		**out = **in
 	}
-	if in.Proxmox != nil {
-		in, out := &in.Proxmox, &out.Proxmox
-		*out = new(ProxmoxProviderConfig)
-		(*in).DeepCopyInto(*out)
+	if in.MaxTotalNodes != nil {
+		in, out := &in.MaxTotalNodes, &out.MaxTotalNodes
+		*out = new(int32)
+		**out = **in
 	}
-	if in.Azure != nil {
-		in, out := &in.Azure, &out.Azure
-		*out = new(AzureProviderConfig)
+	if in.MaxCPUCores != nil {
+		in, out := &in.MaxCPUCores, &out.MaxCPUCores
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxMemory != nil {
+		in, out := &in.MaxMemory, &out.MaxMemory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxStorage != nil {
+		in, out := &in.MaxStorage, &out.MaxStorage
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.DefaultNodeCount != nil {
+		in, out := &in.DefaultNodeCount, &out.DefaultNodeCount
+		*out = new(int32)
 		**out = **in
 	}
-	if in.AWS != nil {
-		in, out := &in.AWS, &out.AWS
-		*out = new(AWSProviderConfig)
-		(*in).DeepCopyInto(*out)
+	if in.DefaultCPUPerNode != nil {
+		in, out := &in.DefaultCPUPerNode, &out.DefaultCPUPerNode
+		x := (*in).DeepCopy()
+		*out = &x
 	}
-	if in.GCP != nil {
-		in, out := &in.GCP, &out.GCP
-		*out = new(GCPProviderConfig)
-		(*in).DeepCopyInto(*out)
+	if in.DefaultMemoryPerNode != nil {
+		in, out := &in.DefaultMemoryPerNode, &out.DefaultMemoryPerNode
+		x := (*in).DeepCopy()
+		*out = &x
 	}
-	if in.Scope != nil {
-		in, out := &in.Scope, &out.Scope
-		*out = new(ProviderConfigScope)
-		(*in).DeepCopyInto(*out)
+	if in.AllowedKubernetesVersions != nil {
+		in, out := &in.AllowedKubernetesVersions, &out.AllowedKubernetesVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.Network != nil {
-		in, out := &in.Network, &out.Network
-		*out = new(ProviderNetworkConfig)
-		(*in).DeepCopyInto(*out)
+	if in.AllowedProviders != nil {
+		in, out := &in.AllowedProviders, &out.AllowedProviders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.Limits != nil {
-		in, out := &in.Limits, &out.Limits
-		*out = new(ProviderLimits)
+	if in.AllowedAddons != nil {
+		in, out := &in.AllowedAddons, &out.AllowedAddons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeniedAddons != nil {
+		in, out := &in.DeniedAddons, &out.DeniedAddons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.WorkspacePolicy != nil {
+		in, out := &in.WorkspacePolicy, &out.WorkspacePolicy
+		*out = new(WorkspacePolicy)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigSpec.
-func (in *ProviderConfigSpec) DeepCopy() *ProviderConfigSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamResourceLimits.
+func (in *TeamResourceLimits) DeepCopy() *TeamResourceLimits {
 	if in == nil {
 		return nil
 	}
-	out := new(ProviderConfigSpec)
+	out := new(TeamResourceLimits)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProviderConfigStatus) DeepCopyInto(out *ProviderConfigStatus) {
+func (in *TeamResourceReference) DeepCopyInto(out *TeamResourceReference) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.LastValidationTime != nil {
-		in, out := &in.LastValidationTime, &out.LastValidationTime
-		*out = (*in).DeepCopy()
-	}
-	if in.LastProbeTime != nil {
-		in, out := &in.LastProbeTime, &out.LastProbeTime
-		*out = (*in).DeepCopy()
-	}
-	if in.Capacity != nil {
-		in, out := &in.Capacity, &out.Capacity
-		*out = new(ProviderCapacity)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigStatus.
-func (in *ProviderConfigStatus) DeepCopy() *ProviderConfigStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamResourceReference.
+func (in *TeamResourceReference) DeepCopy() *TeamResourceReference {
 	if in == nil {
 		return nil
 	}
-	out := new(ProviderConfigStatus)
+	out := new(TeamResourceReference)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProviderLBConfig) DeepCopyInto(out *ProviderLBConfig) {
+func (in *TeamResourceUsage) DeepCopyInto(out *TeamResourceUsage) {
 	*out = *in
-	if in.DefaultPoolSize != nil {
-		in, out := &in.DefaultPoolSize, &out.DefaultPoolSize
+	if in.TotalCPU != nil {
+		in, out := &in.TotalCPU, &out.TotalCPU
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.TotalMemory != nil {
+		in, out := &in.TotalMemory, &out.TotalMemory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.TotalStorage != nil {
+		in, out := &in.TotalStorage, &out.TotalStorage
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Reserved != nil {
+		in, out := &in.Reserved, &out.Reserved
+		*out = new(ProvisioningRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterUtilization != nil {
+		in, out := &in.ClusterUtilization, &out.ClusterUtilization
 		*out = new(int32)
 		**out = **in
 	}
-	if in.InitialPoolSize != nil {
-		in, out := &in.InitialPoolSize, &out.InitialPoolSize
+	if in.NodeUtilization != nil {
+		in, out := &in.NodeUtilization, &out.NodeUtilization
 		*out = new(int32)
 		**out = **in
 	}
-	if in.GrowthIncrement != nil {
-		in, out := &in.GrowthIncrement, &out.GrowthIncrement
+	if in.CPUUtilization != nil {
+		in, out := &in.CPUUtilization, &out.CPUUtilization
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MemoryUtilization != nil {
+		in, out := &in.MemoryUtilization, &out.MemoryUtilization
 		*out = new(int32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderLBConfig.
-func (in *ProviderLBConfig) DeepCopy() *ProviderLBConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamResourceUsage.
+func (in *TeamResourceUsage) DeepCopy() *TeamResourceUsage {
 	if in == nil {
 		return nil
 	}
-	out := new(ProviderLBConfig)
+	out := new(TeamResourceUsage)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProviderLimits) DeepCopyInto(out *ProviderLimits) {
+func (in *TeamSpec) DeepCopyInto(out *TeamSpec) {
 	*out = *in
-	if in.MaxClustersPerTeam != nil {
-		in, out := &in.MaxClustersPerTeam, &out.MaxClustersPerTeam
-		*out = new(int32)
+	in.Access.DeepCopyInto(&out.Access)
+	if in.ResourceLimits != nil {
+		in, out := &in.ResourceLimits, &out.ResourceLimits
+		*out = new(TeamResourceLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProviderConfigRef != nil {
+		in, out := &in.ProviderConfigRef, &out.ProviderConfigRef
+		*out = new(LocalObjectReference)
 		**out = **in
 	}
-	if in.MaxNodesPerTeam != nil {
-		in, out := &in.MaxNodesPerTeam, &out.MaxNodesPerTeam
-		*out = new(int32)
+	if in.ClusterDefaults != nil {
+		in, out := &in.ClusterDefaults, &out.ClusterDefaults
+		*out = new(ClusterDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Environments != nil {
+		in, out := &in.Environments, &out.Environments
+		*out = make([]EnvironmentSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DeletionGracePeriod != nil {
+		in, out := &in.DeletionGracePeriod, &out.DeletionGracePeriod
+		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.Bootstrap != nil {
+		in, out := &in.Bootstrap, &out.Bootstrap
+		*out = new(TeamBootstrapSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderLimits.
-func (in *ProviderLimits) DeepCopy() *ProviderLimits {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamSpec.
+func (in *TeamSpec) DeepCopy() *TeamSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ProviderLimits)
+	out := new(TeamSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProviderNetworkConfig) DeepCopyInto(out *ProviderNetworkConfig) {
+func (in *TeamStatus) DeepCopyInto(out *TeamStatus) {
 	*out = *in
-	if in.PoolRefs != nil {
-		in, out := &in.PoolRefs, &out.PoolRefs
-		*out = make([]PoolReference, len(*in))
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.DNSServers != nil {
-		in, out := &in.DNSServers, &out.DNSServers
-		*out = make([]string, len(*in))
+	if in.ResourceUsage != nil {
+		in, out := &in.ResourceUsage, &out.ResourceUsage
+		*out = new(TeamResourceUsage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BlockingResources != nil {
+		in, out := &in.BlockingResources, &out.BlockingResources
+		*out = make([]TeamResourceReference, len(*in))
 		copy(*out, *in)
 	}
-	if in.TimeServers != nil {
-		in, out := &in.TimeServers, &out.TimeServers
-		*out = make([]string, len(*in))
+	if in.DeletionScheduledAt != nil {
+		in, out := &in.DeletionScheduledAt, &out.DeletionScheduledAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Bootstrap != nil {
+		in, out := &in.Bootstrap, &out.Bootstrap
+		*out = make([]TeamBootstrapItemStatus, len(*in))
 		copy(*out, *in)
 	}
-	if in.LoadBalancer != nil {
-		in, out := &in.LoadBalancer, &out.LoadBalancer
-		*out = new(ProviderLBConfig)
-		(*in).DeepCopyInto(*out)
+	if in.Summary != nil {
+		in, out := &in.Summary, &out.Summary
+		*out = new(StatusSummary)
+		**out = **in
 	}
-	if in.QuotaPerTenant != nil {
-		in, out := &in.QuotaPerTenant, &out.QuotaPerTenant
-		*out = new(NetworkQuota)
+	if in.Reconcile != nil {
+		in, out := &in.Reconcile, &out.Reconcile
+		*out = new(ReconcileStatus)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderNetworkConfig.
-func (in *ProviderNetworkConfig) DeepCopy() *ProviderNetworkConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamStatus.
+func (in *TeamStatus) DeepCopy() *TeamStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ProviderNetworkConfig)
+	out := new(TeamStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProviderReference) DeepCopyInto(out *ProviderReference) {
+func (in *TeamUser) DeepCopyInto(out *TeamUser) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderReference.
-func (in *ProviderReference) DeepCopy() *ProviderReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamUser.
+func (in *TeamUser) DeepCopy() *TeamUser {
 	if in == nil {
 		return nil
 	}
-	out := new(ProviderReference)
+	out := new(TeamUser)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProxmoxOverride) DeepCopyInto(out *ProxmoxOverride) {
+func (in *TemplatePublication) DeepCopyInto(out *TemplatePublication) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxmoxOverride.
-func (in *ProxmoxOverride) DeepCopy() *ProxmoxOverride {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplatePublication.
+func (in *TemplatePublication) DeepCopy() *TemplatePublication {
 	if in == nil {
 		return nil
 	}
-	out := new(ProxmoxOverride)
+	out := new(TemplatePublication)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TemplatePublication) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProxmoxProviderConfig) DeepCopyInto(out *ProxmoxProviderConfig) {
+func (in *TemplatePublicationList) DeepCopyInto(out *TemplatePublicationList) {
 	*out = *in
-	if in.Nodes != nil {
-		in, out := &in.Nodes, &out.Nodes
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.VMIDRange != nil {
-		in, out := &in.VMIDRange, &out.VMIDRange
-		*out = new(VMIDRange)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TemplatePublication, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxmoxProviderConfig.
-func (in *ProxmoxProviderConfig) DeepCopy() *ProxmoxProviderConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplatePublicationList.
+func (in *TemplatePublicationList) DeepCopy() *TemplatePublicationList {
 	if in == nil {
 		return nil
 	}
-	out := new(ProxmoxProviderConfig)
+	out := new(TemplatePublicationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TemplatePublicationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReservedRange) DeepCopyInto(out *ReservedRange) {
+func (in *TemplatePublicationSpec) DeepCopyInto(out *TemplatePublicationSpec) {
 	*out = *in
+	out.TemplateRef = in.TemplateRef
+	if in.Review != nil {
+		in, out := &in.Review, &out.Review
+		*out = new(ChangeReview)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservedRange.
-func (in *ReservedRange) DeepCopy() *ReservedRange {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplatePublicationSpec.
+func (in *TemplatePublicationSpec) DeepCopy() *TemplatePublicationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ReservedRange)
+	out := new(TemplatePublicationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceLimits) DeepCopyInto(out *ResourceLimits) {
+func (in *TemplatePublicationStatus) DeepCopyInto(out *TemplatePublicationStatus) {
 	*out = *in
-	if in.MaxClusters != nil {
-		in, out := &in.MaxClusters, &out.MaxClusters
-		*out = new(int32)
-		**out = **in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.MaxWorkersPerCluster != nil {
-		in, out := &in.MaxWorkersPerCluster, &out.MaxWorkersPerCluster
-		*out = new(int32)
+	if in.PublishedTemplateRef != nil {
+		in, out := &in.PublishedTemplateRef, &out.PublishedTemplateRef
+		*out = new(NamespacedObjectReference)
 		**out = **in
 	}
-	if in.MaxTotalCPU != nil {
-		in, out := &in.MaxTotalCPU, &out.MaxTotalCPU
-		x := (*in).DeepCopy()
-		*out = &x
-	}
-	if in.MaxTotalMemory != nil {
-		in, out := &in.MaxTotalMemory, &out.MaxTotalMemory
-		x := (*in).DeepCopy()
-		*out = &x
-	}
-	if in.MaxTotalStorage != nil {
-		in, out := &in.MaxTotalStorage, &out.MaxTotalStorage
-		x := (*in).DeepCopy()
-		*out = &x
+	if in.PublishedAt != nil {
+		in, out := &in.PublishedAt, &out.PublishedAt
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceLimits.
-func (in *ResourceLimits) DeepCopy() *ResourceLimits {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplatePublicationStatus.
+func (in *TemplatePublicationStatus) DeepCopy() *TemplatePublicationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceLimits)
+	out := new(TemplatePublicationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceQuantities) DeepCopyInto(out *ResourceQuantities) {
+func (in *TenantAddon) DeepCopyInto(out *TenantAddon) {
 	*out = *in
-	if in.CPU != nil {
-		in, out := &in.CPU, &out.CPU
-		x := (*in).DeepCopy()
-		*out = &x
-	}
-	if in.Memory != nil {
-		in, out := &in.Memory, &out.Memory
-		x := (*in).DeepCopy()
-		*out = &x
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceQuantities.
-func (in *ResourceQuantities) DeepCopy() *ResourceQuantities {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantAddon.
+func (in *TenantAddon) DeepCopy() *TenantAddon {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceQuantities)
+	out := new(TenantAddon)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TenantAddon) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SSHKeyEntry) DeepCopyInto(out *SSHKeyEntry) {
+func (in *TenantAddonList) DeepCopyInto(out *TenantAddonList) {
 	*out = *in
-	if in.AddedAt != nil {
-		in, out := &in.AddedAt, &out.AddedAt
-		*out = (*in).DeepCopy()
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TenantAddon, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHKeyEntry.
-func (in *SSHKeyEntry) DeepCopy() *SSHKeyEntry {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantAddonList.
+func (in *TenantAddonList) DeepCopy() *TenantAddonList {
 	if in == nil {
 		return nil
 	}
-	out := new(SSHKeyEntry)
+	out := new(TenantAddonList)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretReference) DeepCopyInto(out *SecretReference) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretReference.
-func (in *SecretReference) DeepCopy() *SecretReference {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TenantAddonList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(SecretReference)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *StorageAddonSpec) DeepCopyInto(out *StorageAddonSpec) {
+func (in *TenantAddonSpec) DeepCopyInto(out *TenantAddonSpec) {
 	*out = *in
-	if in.ReplicaCount != nil {
-		in, out := &in.ReplicaCount, &out.ReplicaCount
-		*out = new(int32)
+	out.ClusterRef = in.ClusterRef
+	if in.Helm != nil {
+		in, out := &in.Helm, &out.Helm
+		*out = new(HelmChartSpec)
 		**out = **in
 	}
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = new(ExtensionValues)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageAddonSpec.
-func (in *StorageAddonSpec) DeepCopy() *StorageAddonSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantAddonSpec.
+func (in *TenantAddonSpec) DeepCopy() *TenantAddonSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(StorageAddonSpec)
+	out := new(TenantAddonSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *StorageSpec) DeepCopyInto(out *StorageSpec) {
+func (in *TenantAddonStatus) DeepCopyInto(out *TenantAddonStatus) {
 	*out = *in
-	if in.Values != nil {
-		in, out := &in.Values, &out.Values
-		*out = new(ExtensionValues)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HelmRelease != nil {
+		in, out := &in.HelmRelease, &out.HelmRelease
+		*out = new(HelmReleaseStatus)
+		**out = **in
+	}
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Reconcile != nil {
+		in, out := &in.Reconcile, &out.Reconcile
+		*out = new(ReconcileStatus)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageSpec.
-func (in *StorageSpec) DeepCopy() *StorageSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantAddonStatus.
+func (in *TenantAddonStatus) DeepCopy() *TenantAddonStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(StorageSpec)
+	out := new(TenantAddonStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TalosConfig) DeepCopyInto(out *TalosConfig) {
+func (in *TenantAllocationConfig) DeepCopyInto(out *TenantAllocationConfig) {
 	*out = *in
+	out.Defaults = in.Defaults
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TalosConfig.
-func (in *TalosConfig) DeepCopy() *TalosConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantAllocationConfig.
+func (in *TenantAllocationConfig) DeepCopy() *TenantAllocationConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(TalosConfig)
+	out := new(TenantAllocationConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TalosConfigPatch) DeepCopyInto(out *TalosConfigPatch) {
+func (in *TenantAllocationDefaults) DeepCopyInto(out *TenantAllocationDefaults) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TalosConfigPatch.
-func (in *TalosConfigPatch) DeepCopy() *TalosConfigPatch {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantAllocationDefaults.
+func (in *TenantAllocationDefaults) DeepCopy() *TenantAllocationDefaults {
 	if in == nil {
 		return nil
 	}
-	out := new(TalosConfigPatch)
+	out := new(TenantAllocationDefaults)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Team) DeepCopyInto(out *Team) {
+func (in *TenantCluster) DeepCopyInto(out *TenantCluster) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -3444,18 +10242,18 @@ func (in *Team) DeepCopyInto(out *Team) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Team.
-func (in *Team) DeepCopy() *Team {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantCluster.
+func (in *TenantCluster) DeepCopy() *TenantCluster {
 	if in == nil {
 		return nil
 	}
-	out := new(Team)
+	out := new(TenantCluster)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Team) DeepCopyObject() runtime.Object {
+func (in *TenantCluster) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3463,71 +10261,31 @@ func (in *Team) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TeamAccess) DeepCopyInto(out *TeamAccess) {
-	*out = *in
-	if in.Users != nil {
-		in, out := &in.Users, &out.Users
-		*out = make([]TeamUser, len(*in))
-		copy(*out, *in)
-	}
-	if in.Groups != nil {
-		in, out := &in.Groups, &out.Groups
-		*out = make([]TeamGroup, len(*in))
-		copy(*out, *in)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamAccess.
-func (in *TeamAccess) DeepCopy() *TeamAccess {
-	if in == nil {
-		return nil
-	}
-	out := new(TeamAccess)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TeamGroup) DeepCopyInto(out *TeamGroup) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamGroup.
-func (in *TeamGroup) DeepCopy() *TeamGroup {
-	if in == nil {
-		return nil
-	}
-	out := new(TeamGroup)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TeamList) DeepCopyInto(out *TeamList) {
+func (in *TenantClusterList) DeepCopyInto(out *TenantClusterList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Team, len(*in))
+		*out = make([]TenantCluster, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamList.
-func (in *TeamList) DeepCopy() *TeamList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantClusterList.
+func (in *TenantClusterList) DeepCopy() *TenantClusterList {
 	if in == nil {
 		return nil
 	}
-	out := new(TeamList)
+	out := new(TenantClusterList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *TeamList) DeepCopyObject() runtime.Object {
+func (in *TenantClusterList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3535,217 +10293,288 @@ func (in *TeamList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TeamResourceLimits) DeepCopyInto(out *TeamResourceLimits) {
+func (in *TenantClusterSpec) DeepCopyInto(out *TenantClusterSpec) {
 	*out = *in
-	if in.MaxClusters != nil {
-		in, out := &in.MaxClusters, &out.MaxClusters
-		*out = new(int32)
-		**out = **in
-	}
-	if in.MaxNodesPerCluster != nil {
-		in, out := &in.MaxNodesPerCluster, &out.MaxNodesPerCluster
-		*out = new(int32)
+	if in.TeamRef != nil {
+		in, out := &in.TeamRef, &out.TeamRef
+		*out = new(LocalObjectReference)
 		**out = **in
 	}
-	if in.MaxTotalNodes != nil {
-		in, out := &in.MaxTotalNodes, &out.MaxTotalNodes
-		*out = new(int32)
+	if in.ProviderConfigRef != nil {
+		in, out := &in.ProviderConfigRef, &out.ProviderConfigRef
+		*out = new(ProviderReference)
 		**out = **in
 	}
-	if in.MaxCPUCores != nil {
-		in, out := &in.MaxCPUCores, &out.MaxCPUCores
-		x := (*in).DeepCopy()
-		*out = &x
+	if in.Virtual != nil {
+		in, out := &in.Virtual, &out.Virtual
+		*out = new(VirtualClusterSpec)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.MaxMemory != nil {
-		in, out := &in.MaxMemory, &out.MaxMemory
-		x := (*in).DeepCopy()
-		*out = &x
+	in.ControlPlane.DeepCopyInto(&out.ControlPlane)
+	in.Workers.DeepCopyInto(&out.Workers)
+	if in.WorkerPools != nil {
+		in, out := &in.WorkerPools, &out.WorkerPools
+		*out = make([]WorkerPoolSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.MaxStorage != nil {
-		in, out := &in.MaxStorage, &out.MaxStorage
-		x := (*in).DeepCopy()
-		*out = &x
+	if in.RebootPolicy != nil {
+		in, out := &in.RebootPolicy, &out.RebootPolicy
+		*out = new(RebootPolicy)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.DefaultNodeCount != nil {
-		in, out := &in.DefaultNodeCount, &out.DefaultNodeCount
-		*out = new(int32)
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(HibernationSpec)
 		**out = **in
 	}
-	if in.DefaultCPUPerNode != nil {
-		in, out := &in.DefaultCPUPerNode, &out.DefaultCPUPerNode
-		x := (*in).DeepCopy()
-		*out = &x
-	}
-	if in.DefaultMemoryPerNode != nil {
-		in, out := &in.DefaultMemoryPerNode, &out.DefaultMemoryPerNode
-		x := (*in).DeepCopy()
-		*out = &x
-	}
-	if in.AllowedKubernetesVersions != nil {
-		in, out := &in.AllowedKubernetesVersions, &out.AllowedKubernetesVersions
+	in.Networking.DeepCopyInto(&out.Networking)
+	out.ManagementPolicy = in.ManagementPolicy
+	in.Addons.DeepCopyInto(&out.Addons)
+	if in.TimeServers != nil {
+		in, out := &in.TimeServers, &out.TimeServers
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.AllowedProviders != nil {
-		in, out := &in.AllowedProviders, &out.AllowedProviders
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.InfrastructureOverride != nil {
+		in, out := &in.InfrastructureOverride, &out.InfrastructureOverride
+		*out = new(InfrastructureOverride)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.AllowedAddons != nil {
-		in, out := &in.AllowedAddons, &out.AllowedAddons
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Workspaces != nil {
+		in, out := &in.Workspaces, &out.Workspaces
+		*out = new(WorkspacesConfig)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.DeniedAddons != nil {
-		in, out := &in.DeniedAddons, &out.DeniedAddons
-		*out = make([]string, len(*in))
+	if in.AccessGrants != nil {
+		in, out := &in.AccessGrants, &out.AccessGrants
+		*out = make([]AccessGrant, len(*in))
 		copy(*out, *in)
 	}
+	if in.SLO != nil {
+		in, out := &in.SLO, &out.SLO
+		*out = new(SLOSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterMetadata != nil {
+		in, out := &in.ClusterMetadata, &out.ClusterMetadata
+		*out = new(ClusterMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PolicyExceptions != nil {
+		in, out := &in.PolicyExceptions, &out.PolicyExceptions
+		*out = make([]PolicyException, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Ownership != nil {
+		in, out := &in.Ownership, &out.Ownership
+		*out = new(OwnershipSpec)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamResourceLimits.
-func (in *TeamResourceLimits) DeepCopy() *TeamResourceLimits {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantClusterSpec.
+func (in *TenantClusterSpec) DeepCopy() *TenantClusterSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(TeamResourceLimits)
+	out := new(TenantClusterSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TeamResourceUsage) DeepCopyInto(out *TeamResourceUsage) {
+func (in *TenantClusterStatus) DeepCopyInto(out *TenantClusterStatus) {
 	*out = *in
-	if in.TotalCPU != nil {
-		in, out := &in.TotalCPU, &out.TotalCPU
-		x := (*in).DeepCopy()
-		*out = &x
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.TotalMemory != nil {
-		in, out := &in.TotalMemory, &out.TotalMemory
-		x := (*in).DeepCopy()
-		*out = &x
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(EncryptionStatus)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.TotalStorage != nil {
-		in, out := &in.TotalStorage, &out.TotalStorage
-		x := (*in).DeepCopy()
-		*out = &x
+	if in.APIServerAccess != nil {
+		in, out := &in.APIServerAccess, &out.APIServerAccess
+		*out = new(APIServerAccessStatus)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.ClusterUtilization != nil {
-		in, out := &in.ClusterUtilization, &out.ClusterUtilization
-		*out = new(int32)
+	if in.KubeconfigSecretRef != nil {
+		in, out := &in.KubeconfigSecretRef, &out.KubeconfigSecretRef
+		*out = new(LocalObjectReference)
 		**out = **in
 	}
-	if in.NodeUtilization != nil {
-		in, out := &in.NodeUtilization, &out.NodeUtilization
-		*out = new(int32)
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ObservedState != nil {
+		in, out := &in.ObservedState, &out.ObservedState
+		*out = new(ObservedClusterState)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPAllocationRef != nil {
+		in, out := &in.IPAllocationRef, &out.IPAllocationRef
+		*out = new(LocalObjectReference)
 		**out = **in
 	}
-	if in.CPUUtilization != nil {
-		in, out := &in.CPUUtilization, &out.CPUUtilization
-		*out = new(int32)
+	if in.VIPAllocationRef != nil {
+		in, out := &in.VIPAllocationRef, &out.VIPAllocationRef
+		*out = new(LocalObjectReference)
 		**out = **in
 	}
-	if in.MemoryUtilization != nil {
-		in, out := &in.MemoryUtilization, &out.MemoryUtilization
-		*out = new(int32)
+	if in.LBAllocationRef != nil {
+		in, out := &in.LBAllocationRef, &out.LBAllocationRef
+		*out = new(LocalObjectReference)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamResourceUsage.
-func (in *TeamResourceUsage) DeepCopy() *TeamResourceUsage {
-	if in == nil {
-		return nil
+	if in.ImageSyncRef != nil {
+		in, out := &in.ImageSyncRef, &out.ImageSyncRef
+		*out = new(LocalObjectReference)
+		**out = **in
 	}
-	out := new(TeamResourceUsage)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TeamSpec) DeepCopyInto(out *TeamSpec) {
-	*out = *in
-	in.Access.DeepCopyInto(&out.Access)
-	if in.ResourceLimits != nil {
-		in, out := &in.ResourceLimits, &out.ResourceLimits
-		*out = new(TeamResourceLimits)
+	if in.CAPIRefs != nil {
+		in, out := &in.CAPIRefs, &out.CAPIRefs
+		*out = new(CAPIObjectRefs)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkerRollout != nil {
+		in, out := &in.WorkerRollout, &out.WorkerRollout
+		*out = new(RolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Preflight != nil {
+		in, out := &in.Preflight, &out.Preflight
+		*out = new(PreflightStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(HibernationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Reboot != nil {
+		in, out := &in.Reboot, &out.Reboot
+		*out = new(RebootStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkerFailureDomains != nil {
+		in, out := &in.WorkerFailureDomains, &out.WorkerFailureDomains
+		*out = make([]WorkerPoolFailureDomains, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AccessGrants != nil {
+		in, out := &in.AccessGrants, &out.AccessGrants
+		*out = make([]AccessGrantStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PolicyExceptions != nil {
+		in, out := &in.PolicyExceptions, &out.PolicyExceptions
+		*out = make([]PolicyExceptionStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ResourceRefs != nil {
+		in, out := &in.ResourceRefs, &out.ResourceRefs
+		*out = make([]GeneratedResourceRef, len(*in))
+		copy(*out, *in)
+	}
+	if in.StaticLoadBalancerIPs != nil {
+		in, out := &in.StaticLoadBalancerIPs, &out.StaticLoadBalancerIPs
+		*out = make([]StaticLoadBalancerIPStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClusterMesh != nil {
+		in, out := &in.ClusterMesh, &out.ClusterMesh
+		*out = new(ClusterMeshStatus)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ProviderConfigRef != nil {
-		in, out := &in.ProviderConfigRef, &out.ProviderConfigRef
-		*out = new(LocalObjectReference)
+	if in.Summary != nil {
+		in, out := &in.Summary, &out.Summary
+		*out = new(StatusSummary)
 		**out = **in
 	}
-	if in.ClusterDefaults != nil {
-		in, out := &in.ClusterDefaults, &out.ClusterDefaults
-		*out = new(ClusterDefaults)
+	if in.SLO != nil {
+		in, out := &in.SLO, &out.SLO
+		*out = new(SLOStatus)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Environments != nil {
-		in, out := &in.Environments, &out.Environments
-		*out = make([]EnvironmentSpec, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Reconcile != nil {
+		in, out := &in.Reconcile, &out.Reconcile
+		*out = new(ReconcileStatus)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamSpec.
-func (in *TeamSpec) DeepCopy() *TeamSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantClusterStatus.
+func (in *TenantClusterStatus) DeepCopy() *TenantClusterStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(TeamSpec)
+	out := new(TenantClusterStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TeamStatus) DeepCopyInto(out *TeamStatus) {
+func (in *Toleration) DeepCopyInto(out *Toleration) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.ResourceUsage != nil {
-		in, out := &in.ResourceUsage, &out.ResourceUsage
-		*out = new(TeamResourceUsage)
-		(*in).DeepCopyInto(*out)
+	if in.TolerationSeconds != nil {
+		in, out := &in.TolerationSeconds, &out.TolerationSeconds
+		*out = new(int64)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamStatus.
-func (in *TeamStatus) DeepCopy() *TeamStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Toleration.
+func (in *Toleration) DeepCopy() *Toleration {
 	if in == nil {
 		return nil
 	}
-	out := new(TeamStatus)
+	out := new(Toleration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TeamUser) DeepCopyInto(out *TeamUser) {
+func (in *UpgradeStrategy) DeepCopyInto(out *UpgradeStrategy) {
 	*out = *in
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = new(MachineRollingUpdate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkerPoolOrder != nil {
+		in, out := &in.WorkerPoolOrder, &out.WorkerPoolOrder
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamUser.
-func (in *TeamUser) DeepCopy() *TeamUser {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeStrategy.
+func (in *UpgradeStrategy) DeepCopy() *UpgradeStrategy {
 	if in == nil {
 		return nil
 	}
-	out := new(TeamUser)
+	out := new(UpgradeStrategy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TenantAddon) DeepCopyInto(out *TenantAddon) {
+func (in *User) DeepCopyInto(out *User) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -3753,18 +10582,18 @@ func (in *TenantAddon) DeepCopyInto(out *TenantAddon) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantAddon.
-func (in *TenantAddon) DeepCopy() *TenantAddon {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new User.
+func (in *User) DeepCopy() *User {
 	if in == nil {
 		return nil
 	}
-	out := new(TenantAddon)
+	out := new(User)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *TenantAddon) DeepCopyObject() runtime.Object {
+func (in *User) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3772,31 +10601,31 @@ func (in *TenantAddon) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TenantAddonList) DeepCopyInto(out *TenantAddonList) {
+func (in *UserList) DeepCopyInto(out *UserList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]TenantAddon, len(*in))
+		*out = make([]User, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantAddonList.
-func (in *TenantAddonList) DeepCopy() *TenantAddonList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserList.
+func (in *UserList) DeepCopy() *UserList {
 	if in == nil {
 		return nil
 	}
-	out := new(TenantAddonList)
+	out := new(UserList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *TenantAddonList) DeepCopyObject() runtime.Object {
+func (in *UserList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3804,39 +10633,60 @@ func (in *TenantAddonList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TenantAddonSpec) DeepCopyInto(out *TenantAddonSpec) {
+func (in *UserSpec) DeepCopyInto(out *UserSpec) {
 	*out = *in
-	out.ClusterRef = in.ClusterRef
-	if in.Helm != nil {
-		in, out := &in.Helm, &out.Helm
-		*out = new(HelmChartSpec)
-		**out = **in
-	}
-	if in.Values != nil {
-		in, out := &in.Values, &out.Values
-		*out = new(ExtensionValues)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.DependsOn != nil {
-		in, out := &in.DependsOn, &out.DependsOn
-		*out = make([]LocalObjectReference, len(*in))
-		copy(*out, *in)
+	if in.SSHKeys != nil {
+		in, out := &in.SSHKeys, &out.SSHKeys
+		*out = make([]SSHKeyEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantAddonSpec.
-func (in *TenantAddonSpec) DeepCopy() *TenantAddonSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserSpec.
+func (in *UserSpec) DeepCopy() *UserSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(TenantAddonSpec)
+	out := new(UserSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TenantAddonStatus) DeepCopyInto(out *TenantAddonStatus) {
+func (in *UserStatus) DeepCopyInto(out *UserStatus) {
 	*out = *in
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.InviteExpiresAt != nil {
+		in, out := &in.InviteExpiresAt, &out.InviteExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	if in.InviteSentAt != nil {
+		in, out := &in.InviteSentAt, &out.InviteSentAt
+		*out = (*in).DeepCopy()
+	}
+	if in.PasswordChangedAt != nil {
+		in, out := &in.PasswordChangedAt, &out.PasswordChangedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.LastLoginTime != nil {
+		in, out := &in.LastLoginTime, &out.LastLoginTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LockedUntil != nil {
+		in, out := &in.LockedUntil, &out.LockedUntil
+		*out = (*in).DeepCopy()
+	}
+	if in.Teams != nil {
+		in, out := &in.Teams, &out.Teams
+		*out = make([]UserTeamMembership, len(*in))
+		copy(*out, *in)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -3844,439 +10694,525 @@ func (in *TenantAddonStatus) DeepCopyInto(out *TenantAddonStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.HelmRelease != nil {
-		in, out := &in.HelmRelease, &out.HelmRelease
-		*out = new(HelmReleaseStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserStatus.
+func (in *UserStatus) DeepCopy() *UserStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UserStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserTeamMembership) DeepCopyInto(out *UserTeamMembership) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserTeamMembership.
+func (in *UserTeamMembership) DeepCopy() *UserTeamMembership {
+	if in == nil {
+		return nil
+	}
+	out := new(UserTeamMembership)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMIDRange) DeepCopyInto(out *VMIDRange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMIDRange.
+func (in *VMIDRange) DeepCopy() *VMIDRange {
+	if in == nil {
+		return nil
+	}
+	out := new(VMIDRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VSphereOverride) DeepCopyInto(out *VSphereOverride) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VSphereOverride.
+func (in *VSphereOverride) DeepCopy() *VSphereOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(VSphereOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VSphereProviderConfig) DeepCopyInto(out *VSphereProviderConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VSphereProviderConfig.
+func (in *VSphereProviderConfig) DeepCopy() *VSphereProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VSphereProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VectorAddonSpec) DeepCopyInto(out *VectorAddonSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
 		**out = **in
 	}
-	if in.LastTransitionTime != nil {
-		in, out := &in.LastTransitionTime, &out.LastTransitionTime
-		*out = (*in).DeepCopy()
+	if in.StorageSize != nil {
+		in, out := &in.StorageSize, &out.StorageSize
+		x := (*in).DeepCopy()
+		*out = &x
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantAddonStatus.
-func (in *TenantAddonStatus) DeepCopy() *TenantAddonStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VectorAddonSpec.
+func (in *VectorAddonSpec) DeepCopy() *VectorAddonSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(TenantAddonStatus)
+	out := new(VectorAddonSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TenantAllocationConfig) DeepCopyInto(out *TenantAllocationConfig) {
+func (in *VersionRange) DeepCopyInto(out *VersionRange) {
 	*out = *in
-	out.Defaults = in.Defaults
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantAllocationConfig.
-func (in *TenantAllocationConfig) DeepCopy() *TenantAllocationConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VersionRange.
+func (in *VersionRange) DeepCopy() *VersionRange {
+	if in == nil {
+		return nil
+	}
+	out := new(VersionRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualClusterQuota) DeepCopyInto(out *VirtualClusterQuota) {
+	*out = *in
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Pods != nil {
+		in, out := &in.Pods, &out.Pods
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LoadBalancers != nil {
+		in, out := &in.LoadBalancers, &out.LoadBalancers
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualClusterQuota.
+func (in *VirtualClusterQuota) DeepCopy() *VirtualClusterQuota {
 	if in == nil {
 		return nil
 	}
-	out := new(TenantAllocationConfig)
+	out := new(VirtualClusterQuota)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TenantAllocationDefaults) DeepCopyInto(out *TenantAllocationDefaults) {
+func (in *VirtualClusterSpec) DeepCopyInto(out *VirtualClusterSpec) {
 	*out = *in
+	out.HostClusterRef = in.HostClusterRef
+	if in.Sync != nil {
+		in, out := &in.Sync, &out.Sync
+		*out = new(VirtualClusterSync)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceQuota != nil {
+		in, out := &in.ResourceQuota, &out.ResourceQuota
+		*out = new(VirtualClusterQuota)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantAllocationDefaults.
-func (in *TenantAllocationDefaults) DeepCopy() *TenantAllocationDefaults {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualClusterSpec.
+func (in *VirtualClusterSpec) DeepCopy() *VirtualClusterSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(TenantAllocationDefaults)
+	out := new(VirtualClusterSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TenantCluster) DeepCopyInto(out *TenantCluster) {
+func (in *VirtualClusterSync) DeepCopyInto(out *VirtualClusterSync) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.ToHost != nil {
+		in, out := &in.ToHost, &out.ToHost
+		*out = make([]VirtualSyncToHostResource, len(*in))
+		copy(*out, *in)
+	}
+	if in.FromHost != nil {
+		in, out := &in.FromHost, &out.FromHost
+		*out = make([]VirtualSyncFromHostResource, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantCluster.
-func (in *TenantCluster) DeepCopy() *TenantCluster {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualClusterSync.
+func (in *VirtualClusterSync) DeepCopy() *VirtualClusterSync {
 	if in == nil {
 		return nil
 	}
-	out := new(TenantCluster)
+	out := new(VirtualClusterSync)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *TenantCluster) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TenantClusterList) DeepCopyInto(out *TenantClusterList) {
+func (in *WebhookChannelConfig) DeepCopyInto(out *WebhookChannelConfig) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]TenantCluster, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	out.URLSecretRef = in.URLSecretRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantClusterList.
-func (in *TenantClusterList) DeepCopy() *TenantClusterList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookChannelConfig.
+func (in *WebhookChannelConfig) DeepCopy() *WebhookChannelConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(TenantClusterList)
+	out := new(WebhookChannelConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *TenantClusterList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TenantClusterSpec) DeepCopyInto(out *TenantClusterSpec) {
+func (in *WorkerNetwork) DeepCopyInto(out *WorkerNetwork) {
 	*out = *in
-	if in.TeamRef != nil {
-		in, out := &in.TeamRef, &out.TeamRef
-		*out = new(LocalObjectReference)
-		**out = **in
-	}
-	if in.ProviderConfigRef != nil {
-		in, out := &in.ProviderConfigRef, &out.ProviderConfigRef
-		*out = new(ProviderReference)
-		**out = **in
-	}
-	in.ControlPlane.DeepCopyInto(&out.ControlPlane)
-	in.Workers.DeepCopyInto(&out.Workers)
-	in.Networking.DeepCopyInto(&out.Networking)
-	out.ManagementPolicy = in.ManagementPolicy
-	in.Addons.DeepCopyInto(&out.Addons)
-	if in.TimeServers != nil {
-		in, out := &in.TimeServers, &out.TimeServers
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.InfrastructureOverride != nil {
-		in, out := &in.InfrastructureOverride, &out.InfrastructureOverride
-		*out = new(InfrastructureOverride)
-		(*in).DeepCopyInto(*out)
+	if in.PoolRef != nil {
+		in, out := &in.PoolRef, &out.PoolRef
+		*out = new(LocalObjectReference)
+		**out = **in
 	}
-	if in.Workspaces != nil {
-		in, out := &in.Workspaces, &out.Workspaces
-		*out = new(WorkspacesConfig)
-		(*in).DeepCopyInto(*out)
+	if in.MTU != nil {
+		in, out := &in.MTU, &out.MTU
+		*out = new(int32)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantClusterSpec.
-func (in *TenantClusterSpec) DeepCopy() *TenantClusterSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerNetwork.
+func (in *WorkerNetwork) DeepCopy() *WorkerNetwork {
 	if in == nil {
 		return nil
 	}
-	out := new(TenantClusterSpec)
+	out := new(WorkerNetwork)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TenantClusterStatus) DeepCopyInto(out *TenantClusterStatus) {
+func (in *WorkerPoolFailureDomains) DeepCopyInto(out *WorkerPoolFailureDomains) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.KubeconfigSecretRef != nil {
-		in, out := &in.KubeconfigSecretRef, &out.KubeconfigSecretRef
-		*out = new(LocalObjectReference)
-		**out = **in
-	}
-	if in.LastTransitionTime != nil {
-		in, out := &in.LastTransitionTime, &out.LastTransitionTime
-		*out = (*in).DeepCopy()
-	}
-	if in.ObservedState != nil {
-		in, out := &in.ObservedState, &out.ObservedState
-		*out = new(ObservedClusterState)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.IPAllocationRef != nil {
-		in, out := &in.IPAllocationRef, &out.IPAllocationRef
-		*out = new(LocalObjectReference)
-		**out = **in
-	}
-	if in.LBAllocationRef != nil {
-		in, out := &in.LBAllocationRef, &out.LBAllocationRef
-		*out = new(LocalObjectReference)
-		**out = **in
-	}
-	if in.ImageSyncRef != nil {
-		in, out := &in.ImageSyncRef, &out.ImageSyncRef
-		*out = new(LocalObjectReference)
-		**out = **in
+	if in.Domains != nil {
+		in, out := &in.Domains, &out.Domains
+		*out = make([]FailureDomainReplicas, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantClusterStatus.
-func (in *TenantClusterStatus) DeepCopy() *TenantClusterStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerPoolFailureDomains.
+func (in *WorkerPoolFailureDomains) DeepCopy() *WorkerPoolFailureDomains {
 	if in == nil {
 		return nil
 	}
-	out := new(TenantClusterStatus)
+	out := new(WorkerPoolFailureDomains)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *User) DeepCopyInto(out *User) {
+func (in *WorkerPoolSpec) DeepCopyInto(out *WorkerPoolSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.MachineTemplate.DeepCopyInto(&out.MachineTemplate)
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Taints != nil {
+		in, out := &in.Taints, &out.Taints
+		*out = make([]NodeTaint, len(*in))
+		copy(*out, *in)
+	}
+	if in.InfrastructureOverride != nil {
+		in, out := &in.InfrastructureOverride, &out.InfrastructureOverride
+		*out = new(InfrastructureOverride)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpdateStrategy != nil {
+		in, out := &in.UpdateStrategy, &out.UpdateStrategy
+		*out = new(MachineUpdateStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Drain != nil {
+		in, out := &in.Drain, &out.Drain
+		*out = new(DrainPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Distribution != nil {
+		in, out := &in.Distribution, &out.Distribution
+		*out = new(FailureDomainDistribution)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new User.
-func (in *User) DeepCopy() *User {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerPoolSpec.
+func (in *WorkerPoolSpec) DeepCopy() *WorkerPoolSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(User)
+	out := new(WorkerPoolSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *User) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *UserList) DeepCopyInto(out *UserList) {
+func (in *WorkerStatus) DeepCopyInto(out *WorkerStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]User, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserList.
-func (in *UserList) DeepCopy() *UserList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerStatus.
+func (in *WorkerStatus) DeepCopy() *WorkerStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(UserList)
+	out := new(WorkerStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *UserList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *UserSpec) DeepCopyInto(out *UserSpec) {
+func (in *WorkersSpec) DeepCopyInto(out *WorkersSpec) {
 	*out = *in
-	if in.SSHKeys != nil {
-		in, out := &in.SSHKeys, &out.SSHKeys
-		*out = make([]SSHKeyEntry, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	in.MachineTemplate.DeepCopyInto(&out.MachineTemplate)
+	if in.UpdateStrategy != nil {
+		in, out := &in.UpdateStrategy, &out.UpdateStrategy
+		*out = new(MachineUpdateStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Drain != nil {
+		in, out := &in.Drain, &out.Drain
+		*out = new(DrainPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Distribution != nil {
+		in, out := &in.Distribution, &out.Distribution
+		*out = new(FailureDomainDistribution)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserSpec.
-func (in *UserSpec) DeepCopy() *UserSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkersSpec.
+func (in *WorkersSpec) DeepCopy() *WorkersSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(UserSpec)
+	out := new(WorkersSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *UserStatus) DeepCopyInto(out *UserStatus) {
+func (in *Workspace) DeepCopyInto(out *Workspace) {
 	*out = *in
-	if in.PasswordSecretRef != nil {
-		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
-		*out = new(SecretReference)
-		**out = **in
-	}
-	if in.InviteExpiresAt != nil {
-		in, out := &in.InviteExpiresAt, &out.InviteExpiresAt
-		*out = (*in).DeepCopy()
-	}
-	if in.InviteSentAt != nil {
-		in, out := &in.InviteSentAt, &out.InviteSentAt
-		*out = (*in).DeepCopy()
-	}
-	if in.PasswordChangedAt != nil {
-		in, out := &in.PasswordChangedAt, &out.PasswordChangedAt
-		*out = (*in).DeepCopy()
-	}
-	if in.LastLoginTime != nil {
-		in, out := &in.LastLoginTime, &out.LastLoginTime
-		*out = (*in).DeepCopy()
-	}
-	if in.LockedUntil != nil {
-		in, out := &in.LockedUntil, &out.LockedUntil
-		*out = (*in).DeepCopy()
-	}
-	if in.Teams != nil {
-		in, out := &in.Teams, &out.Teams
-		*out = make([]UserTeamMembership, len(*in))
-		copy(*out, *in)
-	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserStatus.
-func (in *UserStatus) DeepCopy() *UserStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Workspace.
+func (in *Workspace) DeepCopy() *Workspace {
 	if in == nil {
 		return nil
 	}
-	out := new(UserStatus)
+	out := new(Workspace)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Workspace) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *UserTeamMembership) DeepCopyInto(out *UserTeamMembership) {
+func (in *WorkspaceClass) DeepCopyInto(out *WorkspaceClass) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserTeamMembership.
-func (in *UserTeamMembership) DeepCopy() *UserTeamMembership {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceClass.
+func (in *WorkspaceClass) DeepCopy() *WorkspaceClass {
 	if in == nil {
 		return nil
 	}
-	out := new(UserTeamMembership)
+	out := new(WorkspaceClass)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkspaceClass) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VMIDRange) DeepCopyInto(out *VMIDRange) {
+func (in *WorkspaceClassGPU) DeepCopyInto(out *WorkspaceClassGPU) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMIDRange.
-func (in *VMIDRange) DeepCopy() *VMIDRange {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceClassGPU.
+func (in *WorkspaceClassGPU) DeepCopy() *WorkspaceClassGPU {
 	if in == nil {
 		return nil
 	}
-	out := new(VMIDRange)
+	out := new(WorkspaceClassGPU)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *WorkerStatus) DeepCopyInto(out *WorkerStatus) {
+func (in *WorkspaceClassList) DeepCopyInto(out *WorkspaceClassList) {
 	*out = *in
-	if in.Nodes != nil {
-		in, out := &in.Nodes, &out.Nodes
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WorkspaceClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerStatus.
-func (in *WorkerStatus) DeepCopy() *WorkerStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceClassList.
+func (in *WorkspaceClassList) DeepCopy() *WorkspaceClassList {
 	if in == nil {
 		return nil
 	}
-	out := new(WorkerStatus)
+	out := new(WorkspaceClassList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkspaceClassList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *WorkersSpec) DeepCopyInto(out *WorkersSpec) {
+func (in *WorkspaceClassSpec) DeepCopyInto(out *WorkspaceClassSpec) {
 	*out = *in
-	in.MachineTemplate.DeepCopyInto(&out.MachineTemplate)
+	out.CPU = in.CPU.DeepCopy()
+	out.Memory = in.Memory.DeepCopy()
+	if in.GPU != nil {
+		in, out := &in.GPU, &out.GPU
+		*out = new(WorkspaceClassGPU)
+		**out = **in
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkersSpec.
-func (in *WorkersSpec) DeepCopy() *WorkersSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceClassSpec.
+func (in *WorkspaceClassSpec) DeepCopy() *WorkspaceClassSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(WorkersSpec)
+	out := new(WorkspaceClassSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Workspace) DeepCopyInto(out *Workspace) {
+func (in *WorkspaceColocation) DeepCopyInto(out *WorkspaceColocation) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Workspace.
-func (in *Workspace) DeepCopy() *Workspace {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceColocation.
+func (in *WorkspaceColocation) DeepCopy() *WorkspaceColocation {
 	if in == nil {
 		return nil
 	}
-	out := new(Workspace)
+	out := new(WorkspaceColocation)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Workspace) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkspaceEnvSource) DeepCopyInto(out *WorkspaceEnvSource) {
 	*out = *in
@@ -4324,6 +11260,76 @@ func (in *WorkspaceList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspacePolicy) DeepCopyInto(out *WorkspacePolicy) {
+	*out = *in
+	if in.AllowedSecrets != nil {
+		in, out := &in.AllowedSecrets, &out.AllowedSecrets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedSecretSelector != nil {
+		in, out := &in.AllowedSecretSelector, &out.AllowedSecretSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxSidecars != nil {
+		in, out := &in.MaxSidecars, &out.MaxSidecars
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AllowedImagePrefixes != nil {
+		in, out := &in.AllowedImagePrefixes, &out.AllowedImagePrefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxCPUPerWorkspace != nil {
+		in, out := &in.MaxCPUPerWorkspace, &out.MaxCPUPerWorkspace
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxMemoryPerWorkspace != nil {
+		in, out := &in.MaxMemoryPerWorkspace, &out.MaxMemoryPerWorkspace
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxStoragePerWorkspace != nil {
+		in, out := &in.MaxStoragePerWorkspace, &out.MaxStoragePerWorkspace
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxWorkspaces != nil {
+		in, out := &in.MaxWorkspaces, &out.MaxWorkspaces
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AllowedTemplates != nil {
+		in, out := &in.AllowedTemplates, &out.AllowedTemplates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedClasses != nil {
+		in, out := &in.AllowedClasses, &out.AllowedClasses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SSHCertificateAuthority != nil {
+		in, out := &in.SSHCertificateAuthority, &out.SSHCertificateAuthority
+		*out = new(SSHCertificateAuthority)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspacePolicy.
+func (in *WorkspacePolicy) DeepCopy() *WorkspacePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkspacePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkspaceRepository) DeepCopyInto(out *WorkspaceRepository) {
 	*out = *in
@@ -4332,6 +11338,16 @@ func (in *WorkspaceRepository) DeepCopyInto(out *WorkspaceRepository) {
 		*out = new(LocalObjectReference)
 		**out = **in
 	}
+	if in.KnownHosts != nil {
+		in, out := &in.KnownHosts, &out.KnownHosts
+		*out = new(SSHKnownHosts)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SparseCheckout != nil {
+		in, out := &in.SparseCheckout, &out.SparseCheckout
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceRepository.
@@ -4374,6 +11390,115 @@ func (in *WorkspaceResources) DeepCopy() *WorkspaceResources {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspaceSecret) DeepCopyInto(out *WorkspaceSecret) {
+	*out = *in
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]WorkspaceSecretEnv, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceSecret.
+func (in *WorkspaceSecret) DeepCopy() *WorkspaceSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkspaceSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspaceSecretEnv) DeepCopyInto(out *WorkspaceSecretEnv) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceSecretEnv.
+func (in *WorkspaceSecretEnv) DeepCopy() *WorkspaceSecretEnv {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkspaceSecretEnv)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspaceSecretStatus) DeepCopyInto(out *WorkspaceSecretStatus) {
+	*out = *in
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceSecretStatus.
+func (in *WorkspaceSecretStatus) DeepCopy() *WorkspaceSecretStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkspaceSecretStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspaceSidecar) DeepCopyInto(out *WorkspaceSidecar) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]EnvVar, len(*in))
+		copy(*out, *in)
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]WorkspaceSidecarPort, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(ComponentResources)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceSidecar.
+func (in *WorkspaceSidecar) DeepCopy() *WorkspaceSidecar {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkspaceSidecar)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspaceSidecarPort) DeepCopyInto(out *WorkspaceSidecarPort) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceSidecarPort.
+func (in *WorkspaceSidecarPort) DeepCopy() *WorkspaceSidecarPort {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkspaceSidecarPort)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkspaceSpec) DeepCopyInto(out *WorkspaceSpec) {
 	*out = *in
@@ -4430,6 +11555,30 @@ func (in *WorkspaceSpec) DeepCopyInto(out *WorkspaceSpec) {
 		*out = new(EditorConfig)
 		**out = **in
 	}
+	if in.Secrets != nil {
+		in, out := &in.Secrets, &out.Secrets
+		*out = make([]WorkspaceSecret, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Sidecars != nil {
+		in, out := &in.Sidecars, &out.Sidecars
+		*out = make([]WorkspaceSidecar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Colocate != nil {
+		in, out := &in.Colocate, &out.Colocate
+		*out = new(WorkspaceColocation)
+		**out = **in
+	}
+	if in.AccessGrants != nil {
+		in, out := &in.AccessGrants, &out.AccessGrants
+		*out = make([]AccessGrant, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceSpec.
@@ -4452,6 +11601,11 @@ func (in *WorkspaceStatus) DeepCopyInto(out *WorkspaceStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.SSHCertificateMaxTTL != nil {
+		in, out := &in.SSHCertificateMaxTTL, &out.SSHCertificateMaxTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	if in.LastActivityTime != nil {
 		in, out := &in.LastActivityTime, &out.LastActivityTime
 		*out = (*in).DeepCopy()
@@ -4460,6 +11614,25 @@ func (in *WorkspaceStatus) DeepCopyInto(out *WorkspaceStatus) {
 		in, out := &in.LastDisconnectTime, &out.LastDisconnectTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Secrets != nil {
+		in, out := &in.Secrets, &out.Secrets
+		*out = make([]WorkspaceSecretStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AccessGrants != nil {
+		in, out := &in.AccessGrants, &out.AccessGrants
+		*out = make([]AccessGrantStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Reconcile != nil {
+		in, out := &in.Reconcile, &out.Reconcile
+		*out = new(ReconcileStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceStatus.
@@ -4533,6 +11706,20 @@ func (in *WorkspaceTemplateBody) DeepCopyInto(out *WorkspaceTemplateBody) {
 		x := (*in).DeepCopy()
 		*out = &x
 	}
+	if in.Secrets != nil {
+		in, out := &in.Secrets, &out.Secrets
+		*out = make([]WorkspaceSecret, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Sidecars != nil {
+		in, out := &in.Sidecars, &out.Sidecars
+		*out = make([]WorkspaceSidecar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceTemplateBody.
@@ -4577,9 +11764,29 @@ func (in *WorkspaceTemplateList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspaceTemplateReference) DeepCopyInto(out *WorkspaceTemplateReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceTemplateReference.
+func (in *WorkspaceTemplateReference) DeepCopy() *WorkspaceTemplateReference {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkspaceTemplateReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkspaceTemplateSpec) DeepCopyInto(out *WorkspaceTemplateSpec) {
 	*out = *in
+	if in.BaseTemplateRef != nil {
+		in, out := &in.BaseTemplateRef, &out.BaseTemplateRef
+		*out = new(WorkspaceTemplateReference)
+		**out = **in
+	}
 	in.Template.DeepCopyInto(&out.Template)
 }
 
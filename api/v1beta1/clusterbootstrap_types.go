@@ -0,0 +1,242 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/butlerdotdev/butler-api/api/v1alpha1"
+)
+
+// ClusterBootstrapSpec defines the desired state of ClusterBootstrap
+type ClusterBootstrapSpec struct {
+	// Provider is the infrastructure provider type.
+	// +kubebuilder:validation:Enum=harvester;nutanix;proxmox;vsphere;openstack;baremetal;gcp;aws;azure
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="provider is immutable"
+	Provider string `json:"provider"`
+
+	// ProviderRef references the ProviderConfig to use for provisioning
+	// +kubebuilder:validation:Required
+	ProviderRef v1alpha1.ProviderReference `json:"providerRef"`
+
+	// Cluster defines the cluster configuration
+	// +kubebuilder:validation:Required
+	Cluster v1alpha1.ClusterBootstrapClusterSpec `json:"cluster"`
+
+	// Network defines network configuration for the cluster
+	// +kubebuilder:validation:Required
+	Network v1alpha1.ClusterBootstrapNetworkSpec `json:"network"`
+
+	// Talos defines Talos-specific configuration
+	// +kubebuilder:validation:Required
+	Talos v1alpha1.ClusterBootstrapTalosSpec `json:"talos"`
+
+	// Addons defines which addons to install
+	// +optional
+	Addons ClusterBootstrapAddonsSpec `json:"addons,omitempty"`
+
+	// ControlPlaneExposure configures how tenant control planes are exposed.
+	// This is a platform-level setting written to ButlerConfig after bootstrap
+	// and inherited by all TenantClusters.
+	// Defaults to LoadBalancer mode if not specified.
+	// +optional
+	ControlPlaneExposure *v1alpha1.ControlPlaneExposureSpec `json:"controlPlaneExposure,omitempty"`
+
+	// Paused can be set to true to pause reconciliation
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// ClusterBootstrapAddonsSpec defines which addons to install during bootstrap
+type ClusterBootstrapAddonsSpec struct {
+	// CNI defines the CNI configuration
+	// +optional
+	CNI *v1alpha1.CNIAddonSpec `json:"cni,omitempty"`
+
+	// Storage defines storage configuration
+	// +optional
+	Storage *v1alpha1.StorageAddonSpec `json:"storage,omitempty"`
+
+	// LoadBalancer defines load balancer configuration
+	// +optional
+	LoadBalancer *LoadBalancerAddonSpec `json:"loadBalancer,omitempty"`
+
+	// GitOps defines GitOps configuration
+	// +optional
+	GitOps *v1alpha1.GitOpsAddonSpec `json:"gitOps,omitempty"`
+
+	// ControlPlaneHA defines control plane HA configuration
+	// +optional
+	ControlPlaneHA *v1alpha1.ControlPlaneHAAddonSpec `json:"controlPlaneHA,omitempty"`
+
+	// CertManager defines cert-manager configuration
+	// +optional
+	CertManager *v1alpha1.CertManagerAddonSpec `json:"certManager,omitempty"`
+
+	// Ingress defines ingress controller configuration
+	// +optional
+	Ingress *v1alpha1.IngressAddonSpec `json:"ingress,omitempty"`
+
+	// ControlPlaneProvider defines hosted control plane provider (Steward)
+	// +optional
+	ControlPlaneProvider *v1alpha1.ControlPlaneProviderAddonSpec `json:"controlPlaneProvider,omitempty"`
+
+	// CAPI defines Cluster API configuration
+	// +optional
+	CAPI *v1alpha1.CAPIAddonSpec `json:"capi,omitempty"`
+
+	// ButlerController defines butler-controller configuration
+	// +optional
+	ButlerController *v1alpha1.ButlerControllerAddonSpec `json:"butlerController,omitempty"`
+
+	// Console defines Butler Console configuration
+	// +optional
+	Console *v1alpha1.ConsoleAddonSpec `json:"console,omitempty"`
+
+	// Server defines butler-server (the API backend for the console) configuration
+	// +optional
+	Server *v1alpha1.ServerAddonSpec `json:"server,omitempty"`
+
+	// Monitoring defines platform observability for the management cluster
+	// +optional
+	Monitoring *v1alpha1.MonitoringAddonSpec `json:"monitoring,omitempty"`
+
+	// Backup defines backups of the management cluster
+	// +optional
+	Backup *v1alpha1.BackupAddonSpec `json:"backup,omitempty"`
+}
+
+// LoadBalancerAddonSpec defines load balancer configuration
+// The v1alpha1 addressPool field is removed; use network.loadBalancerPool
+type LoadBalancerAddonSpec struct {
+	// Type is the load balancer type
+	// +kubebuilder:validation:Enum=metallb;none
+	// +kubebuilder:default=metallb
+	Type string `json:"type,omitempty"`
+}
+
+// ClusterBootstrapStatus defines the observed state of ClusterBootstrap
+type ClusterBootstrapStatus struct {
+	// Phase is the current phase of bootstrap
+	// +optional
+	Phase v1alpha1.ClusterBootstrapPhase `json:"phase,omitempty"`
+
+	// ControlPlaneEndpoint is the endpoint for the control plane
+	// +optional
+	ControlPlaneEndpoint string `json:"controlPlaneEndpoint,omitempty"`
+
+	// VIP is the address allocated from spec.network.vipPoolRef
+	// +optional
+	VIP string `json:"vip,omitempty"`
+
+	// VIPAllocationRef references the controlplane IPAllocation for the VIP
+	// +optional
+	VIPAllocationRef *v1alpha1.LocalObjectReference `json:"vipAllocationRef,omitempty"`
+
+	// Kubeconfig contains the base64-encoded kubeconfig for the cluster
+	// +optional
+	Kubeconfig string `json:"kubeconfig,omitempty"`
+
+	// TalosConfig contains the base64-encoded talosconfig for the cluster
+	// +optional
+	TalosConfig string `json:"talosconfig,omitempty"`
+
+	// ConsoleURL is the URL to access the Butler Console
+	// +optional
+	ConsoleURL string `json:"consoleURL,omitempty"`
+
+	// ServerURL is the URL to access butler-server
+	// +optional
+	ServerURL string `json:"serverURL,omitempty"`
+
+	// Monitoring reports endpoints of the management cluster monitoring stack
+	// +optional
+	Monitoring *v1alpha1.MonitoringEndpoints `json:"monitoring,omitempty"`
+
+	// Machines contains the status of each machine
+	// +optional
+	Machines []v1alpha1.ClusterBootstrapMachineStatus `json:"machines,omitempty"`
+
+	// FailureReason indicates why bootstrap failed
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+
+	// FailureMessage provides details about the failure
+	// +optional
+	FailureMessage string `json:"failureMessage,omitempty"`
+
+	// Conditions represents the current conditions of the ClusterBootstrap
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// LastUpdated is the timestamp of the last status update
+	// +optional
+	LastUpdated metav1.Time `json:"lastUpdated,omitempty"`
+
+	// ObservedGeneration is the last observed generation
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// AddonsInstalled tracks which addons have been installed
+	// +optional
+	AddonsInstalled map[string]bool `json:"addonsInstalled,omitempty"`
+
+	// Summary holds flat counts for printer columns
+	// +optional
+	Summary *v1alpha1.StatusSummary `json:"summary,omitempty"`
+
+	// Reconcile reports controller reconcile diagnostics
+	// +optional
+	Reconcile *v1alpha1.ReconcileStatus `json:"reconcile,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=cb,categories=butler
+// +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".spec.cluster.name"
+// +kubebuilder:printcolumn:name="Topology",type="string",JSONPath=".spec.cluster.topology"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Control Plane",type="string",JSONPath=".status.summary.controlPlaneReady"
+// +kubebuilder:printcolumn:name="Workers",type="string",JSONPath=".status.summary.workersReady"
+// +kubebuilder:printcolumn:name="Addons",type="string",JSONPath=".status.summary.addonsHealthy"
+// +kubebuilder:printcolumn:name="Endpoint",type="string",JSONPath=".status.controlPlaneEndpoint"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:validation:XValidation:rule="!has(self.spec.controlPlaneExposure) || self.spec.controlPlaneExposure.mode != 'Ingress' || self.spec.controlPlaneExposure.hostname != ''",message="hostname is required when controlPlaneExposure.mode is Ingress"
+// +kubebuilder:validation:XValidation:rule="!has(self.spec.controlPlaneExposure) || self.spec.controlPlaneExposure.mode != 'Gateway' || self.spec.controlPlaneExposure.hostname != ''",message="hostname is required when controlPlaneExposure.mode is Gateway"
+// +kubebuilder:validation:XValidation:rule="!has(self.spec.controlPlaneExposure) || self.spec.controlPlaneExposure.mode != 'Gateway' || self.spec.controlPlaneExposure.gatewayRef != ''",message="gatewayRef is required when controlPlaneExposure.mode is Gateway"
+
+// ClusterBootstrap is the Schema for the clusterbootstraps API
+type ClusterBootstrap struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterBootstrapSpec   `json:"spec,omitempty"`
+	Status ClusterBootstrapStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterBootstrapList contains a list of ClusterBootstrap
+type ClusterBootstrapList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterBootstrap `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterBootstrap{}, &ClusterBootstrapList{})
+}
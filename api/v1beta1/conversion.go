@@ -0,0 +1,604 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/butlerdotdev/butler-api/api/v1alpha1"
+)
+
+// conversionData holds v1alpha1 fields dropped by v1beta1. It is stored in
+// the v1alpha1.AnnotationConversionData annotation on converted objects.
+type conversionData struct {
+	// AddressPool is the v1alpha1 addons.loadBalancer.addressPool.
+	AddressPool string `json:"addressPool,omitempty"`
+
+	// DerivedLoadBalancerPool records that network.loadBalancerPool was
+	// populated from AddressPool during conversion and was not set by the user.
+	DerivedLoadBalancerPool bool `json:"derivedLoadBalancerPool,omitempty"`
+}
+
+// ConvertTo converts this TenantCluster to the hub version.
+func (src *TenantCluster) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*v1alpha1.TenantCluster)
+	if !ok {
+		return fmt.Errorf("unexpected hub type %T", dstRaw)
+	}
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec = tenantClusterSpecToHub(&src.Spec)
+	dst.Status = tenantClusterStatusToHub(&src.Status)
+	return nil
+}
+
+// ConvertFrom converts from the hub version to this TenantCluster.
+func (dst *TenantCluster) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*v1alpha1.TenantCluster)
+	if !ok {
+		return fmt.Errorf("unexpected hub type %T", srcRaw)
+	}
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec = tenantClusterSpecFromHub(&src.Spec)
+	dst.Status = tenantClusterStatusFromHub(&src.Status)
+	return nil
+}
+
+// ConvertTo converts this Team to the hub version.
+func (src *Team) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*v1alpha1.Team)
+	if !ok {
+		return fmt.Errorf("unexpected hub type %T", dstRaw)
+	}
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec = teamSpecToHub(&src.Spec)
+	dst.Status = teamStatusToHub(&src.Status)
+	return nil
+}
+
+// ConvertFrom converts from the hub version to this Team.
+func (dst *Team) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*v1alpha1.Team)
+	if !ok {
+		return fmt.Errorf("unexpected hub type %T", srcRaw)
+	}
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec = teamSpecFromHub(&src.Spec)
+	dst.Status = teamStatusFromHub(&src.Status)
+	return nil
+}
+
+// ConvertTo converts this ProviderConfig to the hub version.
+func (src *ProviderConfig) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*v1alpha1.ProviderConfig)
+	if !ok {
+		return fmt.Errorf("unexpected hub type %T", dstRaw)
+	}
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec = providerConfigSpecToHub(&src.Spec)
+	dst.Status = providerConfigStatusToHub(&src.Status)
+	return nil
+}
+
+// ConvertFrom converts from the hub version to this ProviderConfig.
+func (dst *ProviderConfig) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*v1alpha1.ProviderConfig)
+	if !ok {
+		return fmt.Errorf("unexpected hub type %T", srcRaw)
+	}
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec = providerConfigSpecFromHub(&src.Spec)
+	dst.Status = providerConfigStatusFromHub(&src.Status)
+	return nil
+}
+
+// ConvertTo converts this ClusterBootstrap to the hub version.
+// The v1alpha1 addons.loadBalancer.addressPool is restored from the
+// conversion data annotation if it still matches network.loadBalancerPool.
+func (src *ClusterBootstrap) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*v1alpha1.ClusterBootstrap)
+	if !ok {
+		return fmt.Errorf("unexpected hub type %T", dstRaw)
+	}
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Status = clusterBootstrapStatusToHub(&src.Status)
+
+	s := src.Spec.DeepCopy()
+	dst.Spec = v1alpha1.ClusterBootstrapSpec{
+		Provider:             s.Provider,
+		ProviderRef:          s.ProviderRef,
+		Cluster:              s.Cluster,
+		Network:              s.Network,
+		Talos:                s.Talos,
+		ControlPlaneExposure: s.ControlPlaneExposure,
+		Paused:               s.Paused,
+		Addons: v1alpha1.ClusterBootstrapAddonsSpec{
+			CNI:                  s.Addons.CNI,
+			Storage:              s.Addons.Storage,
+			GitOps:               s.Addons.GitOps,
+			ControlPlaneHA:       s.Addons.ControlPlaneHA,
+			CertManager:          s.Addons.CertManager,
+			Ingress:              s.Addons.Ingress,
+			ControlPlaneProvider: s.Addons.ControlPlaneProvider,
+			CAPI:                 s.Addons.CAPI,
+			ButlerController:     s.Addons.ButlerController,
+			Console:              s.Addons.Console,
+			Server:               s.Addons.Server,
+			Monitoring:           s.Addons.Monitoring,
+			Backup:               s.Addons.Backup,
+		},
+	}
+	if lb := s.Addons.LoadBalancer; lb != nil {
+		dst.Spec.Addons.LoadBalancer = &v1alpha1.LoadBalancerAddonSpec{Type: lb.Type}
+	}
+
+	raw, ok := dst.Annotations[v1alpha1.AnnotationConversionData]
+	if !ok {
+		return nil
+	}
+	delete(dst.Annotations, v1alpha1.AnnotationConversionData)
+	if len(dst.Annotations) == 0 {
+		dst.Annotations = nil
+	}
+	var data conversionData
+	if err := json.Unmarshal([]byte(raw), &data); err != nil {
+		return fmt.Errorf("decoding %s annotation: %w", v1alpha1.AnnotationConversionData, err)
+	}
+	lb := dst.Spec.Addons.LoadBalancer
+	if data.AddressPool == "" || lb == nil {
+		return nil
+	}
+	pool := dst.Spec.Network.LoadBalancerPool
+	if pool == nil {
+		// The legacy value could not be parsed into a pool; keep it as is.
+		if !data.DerivedLoadBalancerPool {
+			lb.AddressPool = data.AddressPool
+		}
+		return nil
+	}
+	legacy, err := v1alpha1.ParseAddressRange(data.AddressPool)
+	if err != nil || legacy.ToAddressRange() != pool.ToAddressRange() {
+		// The pool was changed at v1beta1; it supersedes the legacy value.
+		return nil
+	}
+	lb.AddressPool = data.AddressPool
+	if data.DerivedLoadBalancerPool {
+		dst.Spec.Network.LoadBalancerPool = nil
+	}
+	return nil
+}
+
+// ConvertFrom converts from the hub version to this ClusterBootstrap.
+// A v1alpha1 addons.loadBalancer.addressPool populates
+// network.loadBalancerPool when that is unset, and the original value is
+// kept in the conversion data annotation.
+func (dst *ClusterBootstrap) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*v1alpha1.ClusterBootstrap)
+	if !ok {
+		return fmt.Errorf("unexpected hub type %T", srcRaw)
+	}
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Status = clusterBootstrapStatusFromHub(&src.Status)
+
+	s := src.Spec.DeepCopy()
+	dst.Spec = ClusterBootstrapSpec{
+		Provider:             s.Provider,
+		ProviderRef:          s.ProviderRef,
+		Cluster:              s.Cluster,
+		Network:              s.Network,
+		Talos:                s.Talos,
+		ControlPlaneExposure: s.ControlPlaneExposure,
+		Paused:               s.Paused,
+		Addons: ClusterBootstrapAddonsSpec{
+			CNI:                  s.Addons.CNI,
+			Storage:              s.Addons.Storage,
+			GitOps:               s.Addons.GitOps,
+			ControlPlaneHA:       s.Addons.ControlPlaneHA,
+			CertManager:          s.Addons.CertManager,
+			Ingress:              s.Addons.Ingress,
+			ControlPlaneProvider: s.Addons.ControlPlaneProvider,
+			CAPI:                 s.Addons.CAPI,
+			ButlerController:     s.Addons.ButlerController,
+			Console:              s.Addons.Console,
+			Server:               s.Addons.Server,
+			Monitoring:           s.Addons.Monitoring,
+			Backup:               s.Addons.Backup,
+		},
+	}
+
+	lb := s.Addons.LoadBalancer
+	if lb == nil {
+		return nil
+	}
+	dst.Spec.Addons.LoadBalancer = &LoadBalancerAddonSpec{Type: lb.Type}
+	if lb.AddressPool == "" {
+		return nil
+	}
+
+	data := conversionData{AddressPool: lb.AddressPool}
+	if dst.Spec.Network.LoadBalancerPool == nil {
+		if legacy, err := v1alpha1.ParseAddressRange(lb.AddressPool); err == nil {
+			dst.Spec.Network.LoadBalancerPool = legacy
+			data.DerivedLoadBalancerPool = true
+		}
+	}
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	if dst.Annotations == nil {
+		dst.Annotations = map[string]string{}
+	}
+	dst.Annotations[v1alpha1.AnnotationConversionData] = string(raw)
+	return nil
+}
+
+// tenantClusterSpecToHub converts a v1beta1 TenantClusterSpec to v1alpha1.
+func tenantClusterSpecToHub(in *TenantClusterSpec) v1alpha1.TenantClusterSpec {
+	s := in.DeepCopy()
+	return v1alpha1.TenantClusterSpec{
+		KubernetesVersion:      s.KubernetesVersion,
+		TeamRef:                s.TeamRef,
+		ProviderConfigRef:      s.ProviderConfigRef,
+		BootstrapProvider:      s.BootstrapProvider,
+		TenancyMode:            s.TenancyMode,
+		Virtual:                s.Virtual,
+		ControlPlane:           s.ControlPlane,
+		Workers:                s.Workers,
+		WorkerPools:            s.WorkerPools,
+		RebootPolicy:           s.RebootPolicy,
+		Hibernation:            s.Hibernation,
+		Networking:             s.Networking,
+		ManagementPolicy:       s.ManagementPolicy,
+		Addons:                 s.Addons,
+		TimeServers:            s.TimeServers,
+		InfrastructureOverride: s.InfrastructureOverride,
+		Workspaces:             s.Workspaces,
+		AccessGrants:           s.AccessGrants,
+		SLO:                    s.SLO,
+		ClusterMetadata:        s.ClusterMetadata,
+		PolicyExceptions:       s.PolicyExceptions,
+		Ownership:              s.Ownership,
+		DeletionProtection:     s.DeletionProtection,
+	}
+}
+
+// tenantClusterSpecFromHub converts a v1alpha1 TenantClusterSpec to v1beta1.
+func tenantClusterSpecFromHub(in *v1alpha1.TenantClusterSpec) TenantClusterSpec {
+	s := in.DeepCopy()
+	return TenantClusterSpec{
+		KubernetesVersion:      s.KubernetesVersion,
+		TeamRef:                s.TeamRef,
+		ProviderConfigRef:      s.ProviderConfigRef,
+		BootstrapProvider:      s.BootstrapProvider,
+		TenancyMode:            s.TenancyMode,
+		Virtual:                s.Virtual,
+		ControlPlane:           s.ControlPlane,
+		Workers:                s.Workers,
+		WorkerPools:            s.WorkerPools,
+		RebootPolicy:           s.RebootPolicy,
+		Hibernation:            s.Hibernation,
+		Networking:             s.Networking,
+		ManagementPolicy:       s.ManagementPolicy,
+		Addons:                 s.Addons,
+		TimeServers:            s.TimeServers,
+		InfrastructureOverride: s.InfrastructureOverride,
+		Workspaces:             s.Workspaces,
+		AccessGrants:           s.AccessGrants,
+		SLO:                    s.SLO,
+		ClusterMetadata:        s.ClusterMetadata,
+		PolicyExceptions:       s.PolicyExceptions,
+		Ownership:              s.Ownership,
+		DeletionProtection:     s.DeletionProtection,
+	}
+}
+
+// tenantClusterStatusToHub converts a v1beta1 TenantClusterStatus to v1alpha1.
+func tenantClusterStatusToHub(in *TenantClusterStatus) v1alpha1.TenantClusterStatus {
+	s := in.DeepCopy()
+	return v1alpha1.TenantClusterStatus{
+		Conditions:            s.Conditions,
+		Phase:                 s.Phase,
+		TenantNamespace:       s.TenantNamespace,
+		HostNamespace:         s.HostNamespace,
+		ControlPlaneEndpoint:  s.ControlPlaneEndpoint,
+		Encryption:            s.Encryption,
+		APIServerAccess:       s.APIServerAccess,
+		KubeconfigSecretRef:   s.KubeconfigSecretRef,
+		ObservedGeneration:    s.ObservedGeneration,
+		LastTransitionTime:    s.LastTransitionTime,
+		ObservedState:         s.ObservedState,
+		WorkerNodesReady:      s.WorkerNodesReady,
+		WorkerNodesDesired:    s.WorkerNodesDesired,
+		IPAllocationRef:       s.IPAllocationRef,
+		VIPAllocationRef:      s.VIPAllocationRef,
+		LBAllocationRef:       s.LBAllocationRef,
+		ImageSyncRef:          s.ImageSyncRef,
+		CAPIRefs:              s.CAPIRefs,
+		WorkerRollout:         s.WorkerRollout,
+		Preflight:             s.Preflight,
+		Hibernation:           s.Hibernation,
+		Reboot:                s.Reboot,
+		WorkerFailureDomains:  s.WorkerFailureDomains,
+		AccessGrants:          s.AccessGrants,
+		PolicyExceptions:      s.PolicyExceptions,
+		ResourceRefs:          s.ResourceRefs,
+		StaticLoadBalancerIPs: s.StaticLoadBalancerIPs,
+		ClusterMesh:           s.ClusterMesh,
+		Summary:               s.Summary,
+		SLO:                   s.SLO,
+		Reconcile:             s.Reconcile,
+	}
+}
+
+// tenantClusterStatusFromHub converts a v1alpha1 TenantClusterStatus to v1beta1.
+func tenantClusterStatusFromHub(in *v1alpha1.TenantClusterStatus) TenantClusterStatus {
+	s := in.DeepCopy()
+	return TenantClusterStatus{
+		Conditions:            s.Conditions,
+		Phase:                 s.Phase,
+		TenantNamespace:       s.TenantNamespace,
+		HostNamespace:         s.HostNamespace,
+		ControlPlaneEndpoint:  s.ControlPlaneEndpoint,
+		Encryption:            s.Encryption,
+		APIServerAccess:       s.APIServerAccess,
+		KubeconfigSecretRef:   s.KubeconfigSecretRef,
+		ObservedGeneration:    s.ObservedGeneration,
+		LastTransitionTime:    s.LastTransitionTime,
+		ObservedState:         s.ObservedState,
+		WorkerNodesReady:      s.WorkerNodesReady,
+		WorkerNodesDesired:    s.WorkerNodesDesired,
+		IPAllocationRef:       s.IPAllocationRef,
+		VIPAllocationRef:      s.VIPAllocationRef,
+		LBAllocationRef:       s.LBAllocationRef,
+		ImageSyncRef:          s.ImageSyncRef,
+		CAPIRefs:              s.CAPIRefs,
+		WorkerRollout:         s.WorkerRollout,
+		Preflight:             s.Preflight,
+		Hibernation:           s.Hibernation,
+		Reboot:                s.Reboot,
+		WorkerFailureDomains:  s.WorkerFailureDomains,
+		AccessGrants:          s.AccessGrants,
+		PolicyExceptions:      s.PolicyExceptions,
+		ResourceRefs:          s.ResourceRefs,
+		StaticLoadBalancerIPs: s.StaticLoadBalancerIPs,
+		ClusterMesh:           s.ClusterMesh,
+		Summary:               s.Summary,
+		SLO:                   s.SLO,
+		Reconcile:             s.Reconcile,
+	}
+}
+
+// teamSpecToHub converts a v1beta1 TeamSpec to v1alpha1.
+func teamSpecToHub(in *TeamSpec) v1alpha1.TeamSpec {
+	s := in.DeepCopy()
+	return v1alpha1.TeamSpec{
+		DisplayName:         s.DisplayName,
+		Description:         s.Description,
+		Access:              s.Access,
+		ResourceLimits:      s.ResourceLimits,
+		ProviderConfigRef:   s.ProviderConfigRef,
+		ClusterDefaults:     s.ClusterDefaults,
+		Environments:        s.Environments,
+		DeletionPolicy:      s.DeletionPolicy,
+		DeletionGracePeriod: s.DeletionGracePeriod,
+		Bootstrap:           s.Bootstrap,
+	}
+}
+
+// teamSpecFromHub converts a v1alpha1 TeamSpec to v1beta1.
+func teamSpecFromHub(in *v1alpha1.TeamSpec) TeamSpec {
+	s := in.DeepCopy()
+	return TeamSpec{
+		DisplayName:         s.DisplayName,
+		Description:         s.Description,
+		Access:              s.Access,
+		ResourceLimits:      s.ResourceLimits,
+		ProviderConfigRef:   s.ProviderConfigRef,
+		ClusterDefaults:     s.ClusterDefaults,
+		Environments:        s.Environments,
+		DeletionPolicy:      s.DeletionPolicy,
+		DeletionGracePeriod: s.DeletionGracePeriod,
+		Bootstrap:           s.Bootstrap,
+	}
+}
+
+// teamStatusToHub converts a v1beta1 TeamStatus to v1alpha1.
+func teamStatusToHub(in *TeamStatus) v1alpha1.TeamStatus {
+	s := in.DeepCopy()
+	return v1alpha1.TeamStatus{
+		Conditions:          s.Conditions,
+		Phase:               s.Phase,
+		Namespace:           s.Namespace,
+		ObservedGeneration:  s.ObservedGeneration,
+		ClusterCount:        s.ClusterCount,
+		MemberCount:         s.MemberCount,
+		ResourceUsage:       s.ResourceUsage,
+		QuotaStatus:         s.QuotaStatus,
+		QuotaMessage:        s.QuotaMessage,
+		BlockingResources:   s.BlockingResources,
+		DeletionScheduledAt: s.DeletionScheduledAt,
+		Bootstrap:           s.Bootstrap,
+		Summary:             s.Summary,
+		Reconcile:           s.Reconcile,
+	}
+}
+
+// teamStatusFromHub converts a v1alpha1 TeamStatus to v1beta1.
+func teamStatusFromHub(in *v1alpha1.TeamStatus) TeamStatus {
+	s := in.DeepCopy()
+	return TeamStatus{
+		Conditions:          s.Conditions,
+		Phase:               s.Phase,
+		Namespace:           s.Namespace,
+		ObservedGeneration:  s.ObservedGeneration,
+		ClusterCount:        s.ClusterCount,
+		MemberCount:         s.MemberCount,
+		ResourceUsage:       s.ResourceUsage,
+		QuotaStatus:         s.QuotaStatus,
+		QuotaMessage:        s.QuotaMessage,
+		BlockingResources:   s.BlockingResources,
+		DeletionScheduledAt: s.DeletionScheduledAt,
+		Bootstrap:           s.Bootstrap,
+		Summary:             s.Summary,
+		Reconcile:           s.Reconcile,
+	}
+}
+
+// providerConfigSpecToHub converts a v1beta1 ProviderConfigSpec to v1alpha1.
+func providerConfigSpecToHub(in *ProviderConfigSpec) v1alpha1.ProviderConfigSpec {
+	s := in.DeepCopy()
+	return v1alpha1.ProviderConfigSpec{
+		Provider:                s.Provider,
+		CredentialsRef:          s.CredentialsRef,
+		CredentialExpiryWarning: s.CredentialExpiryWarning,
+		Harvester:               s.Harvester,
+		Nutanix:                 s.Nutanix,
+		Proxmox:                 s.Proxmox,
+		VSphere:                 s.VSphere,
+		OpenStack:               s.OpenStack,
+		BareMetal:               s.BareMetal,
+		Azure:                   s.Azure,
+		AWS:                     s.AWS,
+		GCP:                     s.GCP,
+		Scope:                   s.Scope,
+		Network:                 s.Network,
+		Limits:                  s.Limits,
+		Bastion:                 s.Bastion,
+		ImageCache:              s.ImageCache,
+		ClientRateLimit:         s.ClientRateLimit,
+		FreezeWindows:           s.FreezeWindows,
+		FailureDomains:          s.FailureDomains,
+	}
+}
+
+// providerConfigSpecFromHub converts a v1alpha1 ProviderConfigSpec to v1beta1.
+func providerConfigSpecFromHub(in *v1alpha1.ProviderConfigSpec) ProviderConfigSpec {
+	s := in.DeepCopy()
+	return ProviderConfigSpec{
+		Provider:                s.Provider,
+		CredentialsRef:          s.CredentialsRef,
+		CredentialExpiryWarning: s.CredentialExpiryWarning,
+		Harvester:               s.Harvester,
+		Nutanix:                 s.Nutanix,
+		Proxmox:                 s.Proxmox,
+		VSphere:                 s.VSphere,
+		OpenStack:               s.OpenStack,
+		BareMetal:               s.BareMetal,
+		Azure:                   s.Azure,
+		AWS:                     s.AWS,
+		GCP:                     s.GCP,
+		Scope:                   s.Scope,
+		Network:                 s.Network,
+		Limits:                  s.Limits,
+		Bastion:                 s.Bastion,
+		ImageCache:              s.ImageCache,
+		ClientRateLimit:         s.ClientRateLimit,
+		FreezeWindows:           s.FreezeWindows,
+		FailureDomains:          s.FailureDomains,
+	}
+}
+
+// providerConfigStatusToHub converts a v1beta1 ProviderConfigStatus to v1alpha1.
+func providerConfigStatusToHub(in *ProviderConfigStatus) v1alpha1.ProviderConfigStatus {
+	s := in.DeepCopy()
+	return v1alpha1.ProviderConfigStatus{
+		Conditions:         s.Conditions,
+		Validated:          s.Validated,
+		LastValidationTime: s.LastValidationTime,
+		ProviderVersion:    s.ProviderVersion,
+		Ready:              s.Ready,
+		LastProbeTime:      s.LastProbeTime,
+		Capacity:           s.Capacity,
+		ActiveFreezeWindow: s.ActiveFreezeWindow,
+		CredentialExpiry:   s.CredentialExpiry,
+		ImageCache:         s.ImageCache,
+		BastionHostKey:     s.BastionHostKey,
+		Reconcile:          s.Reconcile,
+	}
+}
+
+// providerConfigStatusFromHub converts a v1alpha1 ProviderConfigStatus to v1beta1.
+func providerConfigStatusFromHub(in *v1alpha1.ProviderConfigStatus) ProviderConfigStatus {
+	s := in.DeepCopy()
+	return ProviderConfigStatus{
+		Conditions:         s.Conditions,
+		Validated:          s.Validated,
+		LastValidationTime: s.LastValidationTime,
+		ProviderVersion:    s.ProviderVersion,
+		Ready:              s.Ready,
+		LastProbeTime:      s.LastProbeTime,
+		Capacity:           s.Capacity,
+		ActiveFreezeWindow: s.ActiveFreezeWindow,
+		CredentialExpiry:   s.CredentialExpiry,
+		ImageCache:         s.ImageCache,
+		BastionHostKey:     s.BastionHostKey,
+		Reconcile:          s.Reconcile,
+	}
+}
+
+// clusterBootstrapStatusToHub converts a v1beta1 ClusterBootstrapStatus to v1alpha1.
+func clusterBootstrapStatusToHub(in *ClusterBootstrapStatus) v1alpha1.ClusterBootstrapStatus {
+	s := in.DeepCopy()
+	return v1alpha1.ClusterBootstrapStatus{
+		Phase:                s.Phase,
+		ControlPlaneEndpoint: s.ControlPlaneEndpoint,
+		VIP:                  s.VIP,
+		VIPAllocationRef:     s.VIPAllocationRef,
+		Kubeconfig:           s.Kubeconfig,
+		TalosConfig:          s.TalosConfig,
+		ConsoleURL:           s.ConsoleURL,
+		ServerURL:            s.ServerURL,
+		Monitoring:           s.Monitoring,
+		Machines:             s.Machines,
+		FailureReason:        s.FailureReason,
+		FailureMessage:       s.FailureMessage,
+		Conditions:           s.Conditions,
+		LastUpdated:          s.LastUpdated,
+		ObservedGeneration:   s.ObservedGeneration,
+		AddonsInstalled:      s.AddonsInstalled,
+		Summary:              s.Summary,
+		Reconcile:            s.Reconcile,
+	}
+}
+
+// clusterBootstrapStatusFromHub converts a v1alpha1 ClusterBootstrapStatus to v1beta1.
+func clusterBootstrapStatusFromHub(in *v1alpha1.ClusterBootstrapStatus) ClusterBootstrapStatus {
+	s := in.DeepCopy()
+	return ClusterBootstrapStatus{
+		Phase:                s.Phase,
+		ControlPlaneEndpoint: s.ControlPlaneEndpoint,
+		VIP:                  s.VIP,
+		VIPAllocationRef:     s.VIPAllocationRef,
+		Kubeconfig:           s.Kubeconfig,
+		TalosConfig:          s.TalosConfig,
+		ConsoleURL:           s.ConsoleURL,
+		ServerURL:            s.ServerURL,
+		Monitoring:           s.Monitoring,
+		Machines:             s.Machines,
+		FailureReason:        s.FailureReason,
+		FailureMessage:       s.FailureMessage,
+		Conditions:           s.Conditions,
+		LastUpdated:          s.LastUpdated,
+		ObservedGeneration:   s.ObservedGeneration,
+		AddonsInstalled:      s.AddonsInstalled,
+		Summary:              s.Summary,
+		Reconcile:            s.Reconcile,
+	}
+}
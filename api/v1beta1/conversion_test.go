@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+	"sigs.k8s.io/randfill"
+
+	"github.com/butlerdotdev/butler-api/api/v1alpha1"
+)
+
+func TestTenantClusterRoundTrip(t *testing.T) {
+	hub := &v1alpha1.TenantCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "c1", Namespace: "team-a", Labels: map[string]string{"env": "dev"}},
+		Spec: v1alpha1.TenantClusterSpec{
+			KubernetesVersion: "v1.31.2",
+			Workers:           v1alpha1.WorkersSpec{Replicas: 3},
+		},
+		Status: v1alpha1.TenantClusterStatus{Phase: v1alpha1.TenantClusterPhaseReady},
+	}
+
+	var spoke TenantCluster
+	if err := spoke.ConvertFrom(hub); err != nil {
+		t.Fatalf("ConvertFrom: %v", err)
+	}
+	var back v1alpha1.TenantCluster
+	if err := spoke.ConvertTo(&back); err != nil {
+		t.Fatalf("ConvertTo: %v", err)
+	}
+	if !equality.Semantic.DeepEqual(hub, &back) {
+		t.Errorf("round trip mismatch:\n got %+v\nwant %+v", back, *hub)
+	}
+}
+
+func TestClusterBootstrapConvertFrom(t *testing.T) {
+	tests := []struct {
+		name        string
+		addressPool string
+		pool        *v1alpha1.LoadBalancerPoolSpec
+		wantPool    *v1alpha1.LoadBalancerPoolSpec
+		wantData    bool
+	}{
+		{
+			name:     "no legacy pool",
+			pool:     &v1alpha1.LoadBalancerPoolSpec{Start: "10.0.0.10", End: "10.0.0.20"},
+			wantPool: &v1alpha1.LoadBalancerPoolSpec{Start: "10.0.0.10", End: "10.0.0.20"},
+		},
+		{
+			name:        "legacy pool populates network pool",
+			addressPool: "10.0.0.10-10.0.0.20",
+			wantPool:    &v1alpha1.LoadBalancerPoolSpec{Start: "10.0.0.10", End: "10.0.0.20"},
+			wantData:    true,
+		},
+		{
+			name:        "legacy pool matches network pool",
+			addressPool: "10.0.0.10-10.0.0.20",
+			pool:        &v1alpha1.LoadBalancerPoolSpec{Start: "10.0.0.10", End: "10.0.0.20"},
+			wantPool:    &v1alpha1.LoadBalancerPoolSpec{Start: "10.0.0.10", End: "10.0.0.20"},
+			wantData:    true,
+		},
+		{
+			name:        "unparseable legacy pool",
+			addressPool: "not-a-range",
+			wantData:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hub := &v1alpha1.ClusterBootstrap{
+				ObjectMeta: metav1.ObjectMeta{Name: "mgmt"},
+				Spec: v1alpha1.ClusterBootstrapSpec{
+					Provider: "harvester",
+					Network:  v1alpha1.ClusterBootstrapNetworkSpec{LoadBalancerPool: tt.pool},
+					Addons: v1alpha1.ClusterBootstrapAddonsSpec{
+						LoadBalancer: &v1alpha1.LoadBalancerAddonSpec{Type: "metallb", AddressPool: tt.addressPool},
+					},
+				},
+			}
+
+			var spoke ClusterBootstrap
+			if err := spoke.ConvertFrom(hub); err != nil {
+				t.Fatalf("ConvertFrom: %v", err)
+			}
+			if !equality.Semantic.DeepEqual(spoke.Spec.Network.LoadBalancerPool, tt.wantPool) {
+				t.Errorf("loadBalancerPool = %+v, want %+v", spoke.Spec.Network.LoadBalancerPool, tt.wantPool)
+			}
+			if _, ok := spoke.Annotations[v1alpha1.AnnotationConversionData]; ok != tt.wantData {
+				t.Errorf("conversion data annotation present = %v, want %v", ok, tt.wantData)
+			}
+
+			var back v1alpha1.ClusterBootstrap
+			if err := spoke.ConvertTo(&back); err != nil {
+				t.Fatalf("ConvertTo: %v", err)
+			}
+			if !equality.Semantic.DeepEqual(hub, &back) {
+				t.Errorf("round trip mismatch:\n got %+v\nwant %+v", back.Spec, hub.Spec)
+			}
+		})
+	}
+}
+
+func TestClusterBootstrapConvertToChangedPool(t *testing.T) {
+	hub := &v1alpha1.ClusterBootstrap{
+		Spec: v1alpha1.ClusterBootstrapSpec{
+			Addons: v1alpha1.ClusterBootstrapAddonsSpec{
+				LoadBalancer: &v1alpha1.LoadBalancerAddonSpec{Type: "metallb", AddressPool: "10.0.0.10-10.0.0.20"},
+			},
+		},
+	}
+	var spoke ClusterBootstrap
+	if err := spoke.ConvertFrom(hub); err != nil {
+		t.Fatalf("ConvertFrom: %v", err)
+	}
+
+	// A v1beta1 client moves the pool; the stale legacy value must not come back.
+	spoke.Spec.Network.LoadBalancerPool = &v1alpha1.LoadBalancerPoolSpec{Start: "10.0.0.50", End: "10.0.0.60"}
+
+	var back v1alpha1.ClusterBootstrap
+	if err := spoke.ConvertTo(&back); err != nil {
+		t.Fatalf("ConvertTo: %v", err)
+	}
+	if got := back.Spec.Addons.LoadBalancer.AddressPool; got != "" {
+		t.Errorf("addressPool = %q, want empty", got)
+	}
+	if got := back.Spec.Network.LoadBalancerPool.ToAddressRange(); got != "10.0.0.50-10.0.0.60" {
+		t.Errorf("loadBalancerPool = %q, want 10.0.0.50-10.0.0.60", got)
+	}
+	if back.Annotations != nil {
+		t.Errorf("annotations = %v, want nil", back.Annotations)
+	}
+}
+
+// TestFilledRoundTrip round-trips hub objects with every field set, so a
+// field missing from a v1beta1 type or its conversion function fails.
+func TestFilledRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		hub   func() conversion.Hub
+		spoke conversion.Convertible
+	}{
+		{"TenantCluster", func() conversion.Hub { return &v1alpha1.TenantCluster{} }, &TenantCluster{}},
+		{"Team", func() conversion.Hub { return &v1alpha1.Team{} }, &Team{}},
+		{"ProviderConfig", func() conversion.Hub { return &v1alpha1.ProviderConfig{} }, &ProviderConfig{}},
+	}
+
+	f := randfill.NewWithSeed(1).NilChance(0).NumElements(1, 2)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 5; i++ {
+				hub := tt.hub()
+				f.Fill(hub)
+				// Conversion does not copy TypeMeta.
+				hub.GetObjectKind().SetGroupVersionKind(schema.GroupVersionKind{})
+
+				if err := tt.spoke.ConvertFrom(hub); err != nil {
+					t.Fatalf("ConvertFrom: %v", err)
+				}
+				back := tt.hub()
+				if err := tt.spoke.ConvertTo(back); err != nil {
+					t.Fatalf("ConvertTo: %v", err)
+				}
+				back.GetObjectKind().SetGroupVersionKind(schema.GroupVersionKind{})
+				if !equality.Semantic.DeepEqual(hub, back) {
+					t.Fatalf("round trip mismatch:\n got %+v\nwant %+v", back, hub)
+				}
+			}
+		})
+	}
+}
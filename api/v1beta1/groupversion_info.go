@@ -0,0 +1,44 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1beta1 contains API Schema definitions for the butler v1beta1 API group.
+//
+// v1beta1 is served alongside v1alpha1 for TenantCluster, ClusterBootstrap,
+// Team, and ProviderConfig. v1alpha1 remains the storage version and the
+// conversion hub. Each kind has its own spec and status types, converted
+// field by field, so the versions can diverge; nested types are shared with
+// v1alpha1 until they change. A v1alpha1 field that v1beta1 drops is kept
+// in the conversion data annotation so it survives a round trip, as
+// ClusterBootstrap does for addons.loadBalancer.addressPool.
+// +kubebuilder:object:generate=true
+// +groupName=butler.butlerlabs.dev
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+var (
+	// GroupVersion is group version used to register these objects.
+	GroupVersion = schema.GroupVersion{Group: "butler.butlerlabs.dev", Version: "v1beta1"}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme.
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
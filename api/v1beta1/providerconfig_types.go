@@ -0,0 +1,235 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/butlerdotdev/butler-api/api/v1alpha1"
+)
+
+// ProviderConfigSpec defines the desired state of ProviderConfig.
+type ProviderConfigSpec struct {
+	// Provider specifies the infrastructure provider type.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="provider is immutable"
+	Provider v1alpha1.ProviderType `json:"provider"`
+
+	// CredentialsRef references the Secret containing provider credentials.
+	// The Secret must contain the appropriate keys for the provider type:
+	// - harvester: "kubeconfig" (Harvester kubeconfig)
+	// - nutanix: "username", "password"
+	// - proxmox: "username", "password" or "token"
+	// - vsphere: "username", "password"
+	// - openstack: "applicationCredentialID", "applicationCredentialSecret"
+	// - baremetal: "username", "password" (default BMC credentials)
+	// - gcp: "serviceAccountKey" (JSON service account key)
+	// +kubebuilder:validation:Required
+	CredentialsRef v1alpha1.SecretReference `json:"credentialsRef"`
+
+	// CredentialExpiryWarning is how long before the credentials expire the
+	// CredentialsExpiringSoon condition is set, so rotation can be planned.
+	// Expiry is detected from token and certificate metadata where the
+	// provider exposes it.
+	// +kubebuilder:default="336h"
+	// +optional
+	CredentialExpiryWarning *metav1.Duration `json:"credentialExpiryWarning,omitempty"`
+
+	// Harvester contains Harvester-specific configuration.
+	// Required when provider is "harvester".
+	// +optional
+	Harvester *v1alpha1.HarvesterProviderConfig `json:"harvester,omitempty"`
+
+	// Nutanix contains Nutanix-specific configuration.
+	// Required when provider is "nutanix".
+	// +optional
+	Nutanix *v1alpha1.NutanixProviderConfig `json:"nutanix,omitempty"`
+
+	// Proxmox contains Proxmox-specific configuration.
+	// Required when provider is "proxmox".
+	// +optional
+	Proxmox *v1alpha1.ProxmoxProviderConfig `json:"proxmox,omitempty"`
+
+	// VSphere contains vSphere-specific configuration.
+	// Required when provider is "vsphere".
+	// +optional
+	VSphere *v1alpha1.VSphereProviderConfig `json:"vsphere,omitempty"`
+
+	// OpenStack contains OpenStack-specific configuration.
+	// Required when provider is "openstack".
+	// +optional
+	OpenStack *v1alpha1.OpenStackProviderConfig `json:"openstack,omitempty"`
+
+	// BareMetal contains bare-metal configuration.
+	// Required when provider is "baremetal".
+	// +optional
+	BareMetal *v1alpha1.BareMetalProviderConfig `json:"baremetal,omitempty"`
+
+	// Azure contains Azure-specific configuration.
+	// Required when provider is "azure".
+	// +optional
+	Azure *v1alpha1.AzureProviderConfig `json:"azure,omitempty"`
+
+	// AWS contains AWS-specific configuration.
+	// Required when provider is "aws".
+	// +optional
+	AWS *v1alpha1.AWSProviderConfig `json:"aws,omitempty"`
+
+	// GCP contains GCP-specific configuration.
+	// Required when provider is "gcp".
+	// +optional
+	GCP *v1alpha1.GCPProviderConfig `json:"gcp,omitempty"`
+
+	// Scope defines the visibility of this ProviderConfig.
+	// Platform-scoped providers are available to all teams.
+	// Team-scoped providers are restricted to a specific team.
+	// +optional
+	Scope *v1alpha1.ProviderConfigScope `json:"scope,omitempty"`
+
+	// Network configures IPAM and network settings for this provider.
+	// +optional
+	Network *v1alpha1.ProviderNetworkConfig `json:"network,omitempty"`
+
+	// Limits defines resource limits enforced per-team on this provider.
+	// +optional
+	Limits *v1alpha1.ProviderLimits `json:"limits,omitempty"`
+
+	// Bastion configures an SSH jump host for out-of-band access to the
+	// infrastructure, such as uploading images to hypervisors or
+	// troubleshooting. Used by controllers and the console's connect features.
+	// +optional
+	Bastion *v1alpha1.BastionConfig `json:"bastion,omitempty"`
+
+	// ImageCache pre-pulls machine images to the provider so machines boot
+	// from a local copy instead of downloading the image per provision.
+	// +optional
+	ImageCache *v1alpha1.ImageCacheConfig `json:"imageCache,omitempty"`
+
+	// ClientRateLimit tunes how controllers call the provider API. Providers
+	// such as Prism Central and Harvester throttle aggressive clients.
+	// Changes take effect without restarting controllers.
+	// +optional
+	ClientRateLimit *v1alpha1.ProviderClientRateLimit `json:"clientRateLimit,omitempty"`
+
+	// FreezeWindows are maintenance windows during which no new
+	// MachineRequests are fulfilled on this provider. Requests created during
+	// a window stay Pending until it ends.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=32
+	FreezeWindows []v1alpha1.FreezeWindow `json:"freezeWindows,omitempty"`
+
+	// FailureDomains lists independent failure domains on this provider,
+	// such as availability zones, racks, or hypervisor clusters. Worker
+	// pools are distributed across them according to their distribution
+	// policy. If empty, machines are placed without regard to failure domains.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	FailureDomains []v1alpha1.FailureDomain `json:"failureDomains,omitempty"`
+}
+
+// ProviderConfigStatus defines the observed state of ProviderConfig.
+type ProviderConfigStatus struct {
+	// Conditions represent the latest available observations of the ProviderConfig's state.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Validated indicates whether the provider configuration has been validated.
+	// +optional
+	Validated bool `json:"validated,omitempty"`
+
+	// LastValidationTime is the timestamp of the last successful validation.
+	// +optional
+	LastValidationTime *metav1.Time `json:"lastValidationTime,omitempty"`
+
+	// ProviderVersion is the detected version of the infrastructure provider.
+	// +optional
+	ProviderVersion string `json:"providerVersion,omitempty"`
+
+	// Ready indicates overall readiness of the provider.
+	// +optional
+	Ready bool `json:"ready,omitempty"`
+
+	// LastProbeTime is the timestamp of the last health probe.
+	// +optional
+	LastProbeTime *metav1.Time `json:"lastProbeTime,omitempty"`
+
+	// Capacity reports the available capacity of this provider.
+	// +optional
+	Capacity *v1alpha1.ProviderCapacity `json:"capacity,omitempty"`
+
+	// ActiveFreezeWindow is the name of the freeze window in effect, if any.
+	// +optional
+	ActiveFreezeWindow string `json:"activeFreezeWindow,omitempty"`
+
+	// CredentialExpiry reports when the credentials expire. Nil when the
+	// provider does not expose an expiry or the credentials never expire.
+	// +optional
+	CredentialExpiry *v1alpha1.CredentialExpiryStatus `json:"credentialExpiry,omitempty"`
+
+	// ImageCache reports the state of the image cache.
+	// +optional
+	ImageCache *v1alpha1.ImageCacheStatus `json:"imageCache,omitempty"`
+
+	// BastionHostKey is the bastion host key recorded on first connection
+	// when spec.bastion.hostKey is not set. Clear it to accept a new key.
+	// +optional
+	BastionHostKey string `json:"bastionHostKey,omitempty"`
+
+	// Reconcile reports controller reconcile diagnostics.
+	// +optional
+	Reconcile *v1alpha1.ReconcileStatus `json:"reconcile,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=pc,categories=butler
+// +kubebuilder:printcolumn:name="Provider",type="string",JSONPath=".spec.provider",description="Infrastructure provider type"
+// +kubebuilder:printcolumn:name="Scope",type="string",JSONPath=".spec.scope.type",description="Visibility scope"
+// +kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready",description="Provider ready"
+// +kubebuilder:printcolumn:name="Validated",type="boolean",JSONPath=".status.validated",description="Configuration validated"
+// +kubebuilder:printcolumn:name="Credentials Expire",type="date",JSONPath=".status.credentialExpiry.expiresAt",description="Credential expiry",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ProviderConfig defines the configuration for an infrastructure provider.
+// It contains credentials and provider-specific settings needed to create
+// and manage virtual machines.
+type ProviderConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProviderConfigSpec   `json:"spec,omitempty"`
+	Status ProviderConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProviderConfigList contains a list of ProviderConfig.
+type ProviderConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProviderConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ProviderConfig{}, &ProviderConfigList{})
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/butlerdotdev/butler-api/api/v1alpha1"
+)
+
+// TeamSpec defines the desired state of Team.
+type TeamSpec struct {
+	// DisplayName is a human-readable name for the Team.
+	// +optional
+	DisplayName string `json:"displayName,omitempty"`
+
+	// Description provides additional context about the Team.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Access defines who can access this Team's resources.
+	// +optional
+	Access v1alpha1.TeamAccess `json:"access,omitempty"`
+
+	// ResourceLimits defines the resource quotas and restrictions for this Team.
+	// If not specified, defaults from ButlerConfig are used.
+	// If ButlerConfig has no defaults, no limits are enforced.
+	// +optional
+	ResourceLimits *v1alpha1.TeamResourceLimits `json:"resourceLimits,omitempty"`
+
+	// ProviderConfigRef references a Team-specific ProviderConfig.
+	// If not specified, the platform default is used.
+	// +optional
+	ProviderConfigRef *v1alpha1.LocalObjectReference `json:"providerConfigRef,omitempty"`
+
+	// ClusterDefaults defines default values for new clusters in this team.
+	// +optional
+	ClusterDefaults *v1alpha1.ClusterDefaults `json:"clusterDefaults,omitempty"`
+
+	// Environments defines logical groupings of TenantClusters within this Team
+	// (for example dev, stage, prod, per-user sandboxes, shared utilities).
+	// When any environment is defined, new TenantClusters in this Team must
+	// carry the butler.butlerlabs.dev/environment label set to a matching name.
+	// Existing unlabeled TenantClusters continue to work and count against the
+	// Team's total only; the butleradm env migrate command can backfill labels.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Environments []v1alpha1.EnvironmentSpec `json:"environments,omitempty"`
+
+	// DeletionPolicy controls what happens to the Team's resources when the
+	// Team is deleted. Enforced by the admission webhook and the Team finalizer.
+	// +kubebuilder:default=Forbid
+	// +optional
+	DeletionPolicy v1alpha1.TeamDeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// DeletionGracePeriod delays CascadeDelete after the Team is deleted.
+	// During the grace period the Team is Terminating, new resources are
+	// rejected, and members are notified so they can export what they need.
+	// +kubebuilder:default="24h"
+	// +optional
+	DeletionGracePeriod *metav1.Duration `json:"deletionGracePeriod,omitempty"`
+
+	// Bootstrap lists resources provisioned for the Team when it is created.
+	// Items are created once; later edits to the created resources are not
+	// reverted, and removing an item does not delete what it created.
+	// +optional
+	Bootstrap *v1alpha1.TeamBootstrapSpec `json:"bootstrap,omitempty"`
+}
+
+// TeamStatus defines the observed state of Team.
+type TeamStatus struct {
+	// Conditions represent the latest available observations of the Team's state.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Phase represents the current phase of the Team.
+	// +optional
+	Phase v1alpha1.TeamPhase `json:"phase,omitempty"`
+
+	// Namespace is the namespace created for this Team.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// ObservedGeneration is the last observed generation.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// ClusterCount is the number of TenantClusters in this Team.
+	// +optional
+	ClusterCount int32 `json:"clusterCount,omitempty"`
+
+	// MemberCount is the total number of users with access to this Team.
+	// +optional
+	MemberCount int32 `json:"memberCount,omitempty"`
+
+	// ResourceUsage shows the current resource usage for this Team.
+	// +optional
+	ResourceUsage *v1alpha1.TeamResourceUsage `json:"resourceUsage,omitempty"`
+
+	// QuotaStatus indicates whether the team is within quota.
+	// +optional
+	// +kubebuilder:validation:Enum=OK;Warning;Exceeded
+	QuotaStatus string `json:"quotaStatus,omitempty"`
+
+	// QuotaMessage provides details about quota status.
+	// +optional
+	QuotaMessage string `json:"quotaMessage,omitempty"`
+
+	// BlockingResources lists resources preventing deletion of the Team.
+	// Set while the Team is Terminating.
+	// +optional
+	BlockingResources []v1alpha1.TeamResourceReference `json:"blockingResources,omitempty"`
+
+	// DeletionScheduledAt is when CascadeDelete will start deleting resources.
+	// +optional
+	DeletionScheduledAt *metav1.Time `json:"deletionScheduledAt,omitempty"`
+
+	// Bootstrap reports the state of each item in spec.bootstrap.
+	// +optional
+	Bootstrap []v1alpha1.TeamBootstrapItemStatus `json:"bootstrap,omitempty"`
+
+	// Summary holds flat counts for printer columns.
+	// +optional
+	Summary *v1alpha1.StatusSummary `json:"summary,omitempty"`
+
+	// Reconcile reports controller reconcile diagnostics.
+	// +optional
+	Reconcile *v1alpha1.ReconcileStatus `json:"reconcile,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=tm,categories=butler
+// +kubebuilder:printcolumn:name="Display Name",type="string",JSONPath=".spec.displayName",description="Human-readable name"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Current phase"
+// +kubebuilder:printcolumn:name="Namespace",type="string",JSONPath=".status.namespace",description="Team namespace"
+// +kubebuilder:printcolumn:name="Clusters",type="integer",JSONPath=".status.clusterCount",description="Number of clusters"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.summary.clustersReady",description="Ready/total clusters"
+// +kubebuilder:printcolumn:name="Quota",type="string",JSONPath=".status.quotaStatus",description="Quota status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// Team is the Schema for the teams API.
+// A Team represents a group of users who share access to TenantClusters.
+// Each Team gets its own namespace where TenantClusters are created.
+type Team struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TeamSpec   `json:"spec,omitempty"`
+	Status TeamStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TeamList contains a list of Team.
+type TeamList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Team `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Team{}, &TeamList{})
+}
@@ -0,0 +1,345 @@
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/butlerdotdev/butler-api/api/v1alpha1"
+)
+
+// TenantClusterSpec defines the desired state of TenantCluster.
+// +kubebuilder:validation:XValidation:rule="!has(self.bootstrapProvider) || self.bootstrapProvider != 'talos' || (has(self.workers.machineTemplate) && has(self.workers.machineTemplate.os) && has(self.workers.machineTemplate.os.type) && self.workers.machineTemplate.os.type == 'talos')",message="bootstrapProvider talos requires workers.machineTemplate.os.type talos"
+// +kubebuilder:validation:XValidation:rule="!has(self.bootstrapProvider) || self.bootstrapProvider != 'talos' || !has(self.workerPools) || self.workerPools.all(p, has(p.machineTemplate.os) && has(p.machineTemplate.os.type) && p.machineTemplate.os.type == 'talos')",message="bootstrapProvider talos requires workerPools machineTemplate.os.type talos"
+// +kubebuilder:validation:XValidation:rule="has(self.virtual) == (has(self.tenancyMode) && self.tenancyMode == 'virtual')",message="virtual is required for, and only allowed with, tenancyMode virtual"
+// +kubebuilder:validation:XValidation:rule="(has(self.tenancyMode) && self.tenancyMode == 'virtual') || (has(self.workers) && self.workers.replicas >= 1)",message="workers.replicas must be at least 1 for dedicated clusters"
+// +kubebuilder:validation:XValidation:rule="!has(self.tenancyMode) || self.tenancyMode != 'virtual' || ((!has(self.workers) || self.workers.replicas == 0) && !has(self.workerPools))",message="virtual clusters have no workers; workers.replicas must be 0 and workerPools unset"
+type TenantClusterSpec struct {
+	// KubernetesVersion is the target Kubernetes version.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^v\d+\.\d+\.\d+$`
+	KubernetesVersion string `json:"kubernetesVersion"`
+
+	// TeamRef references the Team this cluster belongs to.
+	// Required when multi-tenancy mode is Enforced.
+	// +optional
+	TeamRef *v1alpha1.LocalObjectReference `json:"teamRef,omitempty"`
+
+	// ProviderConfigRef references the ProviderConfig for infrastructure.
+	// If not specified, defaults are used (Team's or platform's).
+	// Namespace defaults to butler-system if not specified.
+	// +optional
+	ProviderConfigRef *v1alpha1.ProviderReference `json:"providerConfigRef,omitempty"`
+
+	// BootstrapProvider selects the CAPI bootstrap provider for worker nodes.
+	// If not specified, talos is used for Talos workers and kubeadm otherwise.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="bootstrapProvider is immutable"
+	// +optional
+	BootstrapProvider v1alpha1.BootstrapProviderType `json:"bootstrapProvider,omitempty"`
+
+	// TenancyMode selects a dedicated cluster or a virtual cluster in a
+	// shared host cluster. Addons, access grants, and the kubeconfig Secret
+	// work the same in both modes.
+	// +kubebuilder:default=dedicated
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="tenancyMode is immutable"
+	// +optional
+	TenancyMode v1alpha1.TenancyMode `json:"tenancyMode,omitempty"`
+
+	// Virtual configures the vcluster. Required when tenancyMode is virtual.
+	// +optional
+	Virtual *v1alpha1.VirtualClusterSpec `json:"virtual,omitempty"`
+
+	// ControlPlane configures the Steward-hosted control plane.
+	// +optional
+	ControlPlane v1alpha1.ControlPlaneSpec `json:"controlPlane,omitempty"`
+
+	// Workers configures the worker nodes.
+	// This is the default worker pool; see WorkerPools for additional pools.
+	// Required for dedicated clusters; virtual clusters have no workers.
+	// +optional
+	Workers v1alpha1.WorkersSpec `json:"workers"`
+
+	// WorkerPools adds worker pools alongside the default pool in Workers,
+	// for example GPU or memory-optimized nodes. Each pool is a separate
+	// MachineDeployment with its own machine template, labels, and taints.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:rule="self.all(p, p.name != 'default')",message="worker pool name default is reserved for spec.workers"
+	WorkerPools []v1alpha1.WorkerPoolSpec `json:"workerPools,omitempty"`
+
+	// RebootPolicy enables rolling reboots of workers, for example to
+	// apply kernel updates on Rocky or Flatcar nodes.
+	// +optional
+	RebootPolicy *v1alpha1.RebootPolicy `json:"rebootPolicy,omitempty"`
+
+	// Hibernation scales workers down off-hours on a schedule, for
+	// example to stop development clusters overnight.
+	// +optional
+	Hibernation *v1alpha1.HibernationSpec `json:"hibernation,omitempty"`
+
+	// Networking configures cluster networking.
+	// +optional
+	Networking v1alpha1.NetworkingSpec `json:"networking,omitempty"`
+
+	// ManagementPolicy defines how Butler manages this cluster.
+	// +optional
+	ManagementPolicy v1alpha1.ManagementPolicySpec `json:"managementPolicy,omitempty"`
+
+	// Addons defines the initial addons to install.
+	// These are installed at cluster creation time.
+	// Additional addons can be added via TenantAddon resources.
+	// +optional
+	Addons v1alpha1.AddonsSpec `json:"addons,omitempty"`
+
+	// TimeServers overrides the NTP servers used by Talos worker nodes.
+	// If empty, falls back to ProviderConfig.spec.network.timeServers,
+	// then ButlerConfig.spec.defaultTimeServers, then pool.ntp.org.
+	// Required on networks where the Talos default (time.cloudflare.com) is unreachable.
+	// +optional
+	TimeServers []string `json:"timeServers,omitempty"`
+
+	// InfrastructureOverride allows overriding provider-specific settings.
+	// These take precedence over ProviderConfig defaults.
+	// +optional
+	InfrastructureOverride *v1alpha1.InfrastructureOverride `json:"infrastructureOverride,omitempty"`
+
+	// Workspaces configures cloud development environments on this cluster.
+	// When enabled, users can create Workspace resources that provision pods
+	// with SSH access in the tenant cluster's "workspaces" namespace.
+	// +optional
+	Workspaces *v1alpha1.WorkspacesConfig `json:"workspaces,omitempty"`
+
+	// AccessGrants give users temporary elevated access to this cluster,
+	// for example just-in-time production access.
+	// +optional
+	// +listType=map
+	// +listMapKey=user
+	// +kubebuilder:validation:MaxItems=32
+	AccessGrants []v1alpha1.AccessGrant `json:"accessGrants,omitempty"`
+
+	// SLO declares the availability objective promised for this cluster.
+	// Requires an observability pipeline in ButlerConfig to be measured.
+	// +optional
+	SLO *v1alpha1.SLOSpec `json:"slo,omitempty"`
+
+	// ClusterMetadata is propagated to objects Butler generates for this
+	// cluster so that policy and cost tooling can select them.
+	// +optional
+	ClusterMetadata *v1alpha1.ClusterMetadata `json:"clusterMetadata,omitempty"`
+
+	// PolicyExceptions lists temporary exceptions from admission policies
+	// in this cluster. Each exception is removed at its expiry.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=64
+	PolicyExceptions []v1alpha1.PolicyException `json:"policyExceptions,omitempty"`
+
+	// Ownership records who owns the cluster and how to reach them during
+	// an incident.
+	// +optional
+	Ownership *v1alpha1.OwnershipSpec `json:"ownership,omitempty"`
+
+	// DeletionProtection denies deletion of the TenantCluster until it is
+	// set back to false.
+	// +optional
+	DeletionProtection bool `json:"deletionProtection,omitempty"`
+}
+
+// TenantClusterStatus defines the observed state of TenantCluster.
+type TenantClusterStatus struct {
+	// Conditions represent the latest available observations.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Phase represents the current phase of the cluster.
+	// +optional
+	Phase v1alpha1.TenantClusterPhase `json:"phase,omitempty"`
+
+	// TenantNamespace is the namespace containing CAPI/Steward resources.
+	// +optional
+	TenantNamespace string `json:"tenantNamespace,omitempty"`
+
+	// HostNamespace is the namespace in the host cluster running the
+	// vcluster. Only set for virtual clusters.
+	// +optional
+	HostNamespace string `json:"hostNamespace,omitempty"`
+
+	// ControlPlaneEndpoint is the API server endpoint.
+	// +optional
+	ControlPlaneEndpoint string `json:"controlPlaneEndpoint,omitempty"`
+
+	// Encryption reports encryption at rest and key rotation progress.
+	// Only set when spec.controlPlane.encryption is configured.
+	// +optional
+	Encryption *v1alpha1.EncryptionStatus `json:"encryption,omitempty"`
+
+	// APIServerAccess reports the API server access control rules in effect.
+	// Only set when spec.controlPlane.accessControl is configured.
+	// +optional
+	APIServerAccess *v1alpha1.APIServerAccessStatus `json:"apiServerAccess,omitempty"`
+
+	// KubeconfigSecretRef references the Secret containing the kubeconfig.
+	// +optional
+	KubeconfigSecretRef *v1alpha1.LocalObjectReference `json:"kubeconfigSecretRef,omitempty"`
+
+	// ObservedGeneration is the last observed generation.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastTransitionTime is when the phase last changed.
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// ObservedState is the observed state of the cluster.
+	// +optional
+	ObservedState *v1alpha1.ObservedClusterState `json:"observedState,omitempty"`
+
+	// WorkerNodesReady is the count of ready worker nodes.
+	// Zero is a valid value (no omitempty) to distinguish "0 ready" from "not set".
+	// +optional
+	WorkerNodesReady int32 `json:"workerNodesReady"`
+
+	// WorkerNodesDesired is the desired count of worker nodes.
+	// Zero is a valid value (no omitempty) to distinguish "0 desired" from "not set".
+	// +optional
+	WorkerNodesDesired int32 `json:"workerNodesDesired"`
+
+	// IPAllocationRef references the node IP allocation from IPAM.
+	// +optional
+	IPAllocationRef *v1alpha1.LocalObjectReference `json:"ipAllocationRef,omitempty"`
+
+	// VIPAllocationRef references the control plane VIP allocation from IPAM.
+	// +optional
+	VIPAllocationRef *v1alpha1.LocalObjectReference `json:"vipAllocationRef,omitempty"`
+
+	// LBAllocationRef references the load balancer IP allocation from IPAM.
+	// +optional
+	LBAllocationRef *v1alpha1.LocalObjectReference `json:"lbAllocationRef,omitempty"`
+
+	// ImageSyncRef references the ImageSync resource for this cluster's OS image.
+	// +optional
+	ImageSyncRef *v1alpha1.LocalObjectReference `json:"imageSyncRef,omitempty"`
+
+	// CAPIRefs names the Cluster API objects generated for this cluster.
+	// All objects live in TenantNamespace.
+	// +optional
+	CAPIRefs *v1alpha1.CAPIObjectRefs `json:"capiRefs,omitempty"`
+
+	// WorkerRollout reports progress of the current worker replacement.
+	// +optional
+	WorkerRollout *v1alpha1.RolloutStatus `json:"workerRollout,omitempty"`
+
+	// Preflight reports the most recent upgrade pre-flight checks.
+	// +optional
+	Preflight *v1alpha1.PreflightStatus `json:"preflight,omitempty"`
+
+	// Hibernation reports the hibernation state when spec.hibernation is set.
+	// +optional
+	Hibernation *v1alpha1.HibernationStatus `json:"hibernation,omitempty"`
+
+	// Reboot reports rolling reboot progress when spec.rebootPolicy is set.
+	// +optional
+	Reboot *v1alpha1.RebootStatus `json:"reboot,omitempty"`
+
+	// WorkerFailureDomains reports workers per failure domain for
+	// spec.workers and spec.workerPools. NodePools report their own.
+	// +optional
+	// +listType=map
+	// +listMapKey=pool
+	WorkerFailureDomains []v1alpha1.WorkerPoolFailureDomains `json:"workerFailureDomains,omitempty"`
+
+	// AccessGrants records current and past access grants.
+	// +optional
+	AccessGrants []v1alpha1.AccessGrantStatus `json:"accessGrants,omitempty"`
+
+	// PolicyExceptions records current and past policy exceptions.
+	// +optional
+	PolicyExceptions []v1alpha1.PolicyExceptionStatus `json:"policyExceptions,omitempty"`
+
+	// ResourceRefs lists every object Butler created for this cluster,
+	// across the management cluster and provider integrations.
+	// Used for troubleshooting and to audit garbage collection on deletion.
+	// +optional
+	ResourceRefs []v1alpha1.GeneratedResourceRef `json:"resourceRefs,omitempty"`
+
+	// StaticLoadBalancerIPs reports the addresses pinned by
+	// spec.networking.staticLoadBalancerIPs.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	StaticLoadBalancerIPs []v1alpha1.StaticLoadBalancerIPStatus `json:"staticLoadBalancerIPs,omitempty"`
+
+	// ClusterMesh reports ClusterMesh connectivity.
+	// +optional
+	ClusterMesh *v1alpha1.ClusterMeshStatus `json:"clusterMesh,omitempty"`
+
+	// Summary holds flat counts for printer columns. See ComputeSummary.
+	// +optional
+	Summary *v1alpha1.StatusSummary `json:"summary,omitempty"`
+
+	// SLO reports observed availability against spec.slo.
+	// +optional
+	SLO *v1alpha1.SLOStatus `json:"slo,omitempty"`
+
+	// Reconcile reports controller reconcile diagnostics.
+	// +optional
+	Reconcile *v1alpha1.ReconcileStatus `json:"reconcile,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=tc,categories=butler
+// +kubebuilder:printcolumn:name="Team",type="string",JSONPath=".spec.teamRef.name",description="Owning team"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Cluster phase"
+// +kubebuilder:printcolumn:name="K8s Version",type="string",JSONPath=".spec.kubernetesVersion",description="Kubernetes version"
+// +kubebuilder:printcolumn:name="Workers",type="string",JSONPath=".status.summary.workersReady",description="Ready/desired workers"
+// +kubebuilder:printcolumn:name="Addons",type="string",JSONPath=".status.summary.addonsHealthy",description="Healthy/total addons"
+// +kubebuilder:printcolumn:name="Endpoint",type="string",JSONPath=".status.controlPlaneEndpoint",description="API endpoint"
+// +kubebuilder:printcolumn:name="Tier",type="string",JSONPath=".spec.ownership.environment",description="Environment tier"
+// +kubebuilder:printcolumn:name="Owner",type="string",JSONPath=".spec.ownership.ownerEmail",description="Owner email",priority=1
+// +kubebuilder:printcolumn:name="Slack",type="string",JSONPath=".spec.ownership.slackChannel",description="Incident channel",priority=1
+// +kubebuilder:printcolumn:name="Availability",type="string",JSONPath=".status.slo.observedAvailability",description="Observed availability (%)",priority=1
+// +kubebuilder:printcolumn:name="Hibernation",type="string",JSONPath=".status.hibernation.state",description="Hibernation state",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// TenantCluster is the Schema for the tenantclusters API.
+// It represents a complete Kubernetes cluster managed by Butler.
+type TenantCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TenantClusterSpec   `json:"spec,omitempty"`
+	Status TenantClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TenantClusterList contains a list of TenantCluster.
+type TenantClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TenantCluster `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TenantCluster{}, &TenantClusterList{})
+}
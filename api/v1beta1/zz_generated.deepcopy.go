@@ -0,0 +1,938 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright 2026 The Butler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"github.com/butlerdotdev/butler-api/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterBootstrap) DeepCopyInto(out *ClusterBootstrap) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBootstrap.
+func (in *ClusterBootstrap) DeepCopy() *ClusterBootstrap {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterBootstrap)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterBootstrap) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterBootstrapAddonsSpec) DeepCopyInto(out *ClusterBootstrapAddonsSpec) {
+	*out = *in
+	if in.CNI != nil {
+		in, out := &in.CNI, &out.CNI
+		*out = new(v1alpha1.CNIAddonSpec)
+		**out = **in
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(v1alpha1.StorageAddonSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(LoadBalancerAddonSpec)
+		**out = **in
+	}
+	if in.GitOps != nil {
+		in, out := &in.GitOps, &out.GitOps
+		*out = new(v1alpha1.GitOpsAddonSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ControlPlaneHA != nil {
+		in, out := &in.ControlPlaneHA, &out.ControlPlaneHA
+		*out = new(v1alpha1.ControlPlaneHAAddonSpec)
+		**out = **in
+	}
+	if in.CertManager != nil {
+		in, out := &in.CertManager, &out.CertManager
+		*out = new(v1alpha1.CertManagerAddonSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(v1alpha1.IngressAddonSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ControlPlaneProvider != nil {
+		in, out := &in.ControlPlaneProvider, &out.ControlPlaneProvider
+		*out = new(v1alpha1.ControlPlaneProviderAddonSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CAPI != nil {
+		in, out := &in.CAPI, &out.CAPI
+		*out = new(v1alpha1.CAPIAddonSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ButlerController != nil {
+		in, out := &in.ButlerController, &out.ButlerController
+		*out = new(v1alpha1.ButlerControllerAddonSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Console != nil {
+		in, out := &in.Console, &out.Console
+		*out = new(v1alpha1.ConsoleAddonSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Server != nil {
+		in, out := &in.Server, &out.Server
+		*out = new(v1alpha1.ServerAddonSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(v1alpha1.MonitoringAddonSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(v1alpha1.BackupAddonSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBootstrapAddonsSpec.
+func (in *ClusterBootstrapAddonsSpec) DeepCopy() *ClusterBootstrapAddonsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterBootstrapAddonsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterBootstrapList) DeepCopyInto(out *ClusterBootstrapList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterBootstrap, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBootstrapList.
+func (in *ClusterBootstrapList) DeepCopy() *ClusterBootstrapList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterBootstrapList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterBootstrapList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterBootstrapSpec) DeepCopyInto(out *ClusterBootstrapSpec) {
+	*out = *in
+	out.ProviderRef = in.ProviderRef
+	in.Cluster.DeepCopyInto(&out.Cluster)
+	in.Network.DeepCopyInto(&out.Network)
+	in.Talos.DeepCopyInto(&out.Talos)
+	in.Addons.DeepCopyInto(&out.Addons)
+	if in.ControlPlaneExposure != nil {
+		in, out := &in.ControlPlaneExposure, &out.ControlPlaneExposure
+		*out = new(v1alpha1.ControlPlaneExposureSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBootstrapSpec.
+func (in *ClusterBootstrapSpec) DeepCopy() *ClusterBootstrapSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterBootstrapSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterBootstrapStatus) DeepCopyInto(out *ClusterBootstrapStatus) {
+	*out = *in
+	if in.VIPAllocationRef != nil {
+		in, out := &in.VIPAllocationRef, &out.VIPAllocationRef
+		*out = new(v1alpha1.LocalObjectReference)
+		**out = **in
+	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(v1alpha1.MonitoringEndpoints)
+		**out = **in
+	}
+	if in.Machines != nil {
+		in, out := &in.Machines, &out.Machines
+		*out = make([]v1alpha1.ClusterBootstrapMachineStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.LastUpdated.DeepCopyInto(&out.LastUpdated)
+	if in.AddonsInstalled != nil {
+		in, out := &in.AddonsInstalled, &out.AddonsInstalled
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Summary != nil {
+		in, out := &in.Summary, &out.Summary
+		*out = new(v1alpha1.StatusSummary)
+		**out = **in
+	}
+	if in.Reconcile != nil {
+		in, out := &in.Reconcile, &out.Reconcile
+		*out = new(v1alpha1.ReconcileStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBootstrapStatus.
+func (in *ClusterBootstrapStatus) DeepCopy() *ClusterBootstrapStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterBootstrapStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerAddonSpec) DeepCopyInto(out *LoadBalancerAddonSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerAddonSpec.
+func (in *LoadBalancerAddonSpec) DeepCopy() *LoadBalancerAddonSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerAddonSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderConfig) DeepCopyInto(out *ProviderConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfig.
+func (in *ProviderConfig) DeepCopy() *ProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProviderConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderConfigList) DeepCopyInto(out *ProviderConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProviderConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigList.
+func (in *ProviderConfigList) DeepCopy() *ProviderConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProviderConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderConfigSpec) DeepCopyInto(out *ProviderConfigSpec) {
+	*out = *in
+	out.CredentialsRef = in.CredentialsRef
+	if in.CredentialExpiryWarning != nil {
+		in, out := &in.CredentialExpiryWarning, &out.CredentialExpiryWarning
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Harvester != nil {
+		in, out := &in.Harvester, &out.Harvester
+		*out = new(v1alpha1.HarvesterProviderConfig)
+		**out = **in
+	}
+	if in.Nutanix != nil {
+		in, out := &in.Nutanix, &out.Nutanix
+		*out = new(v1alpha1.NutanixProviderConfig)
+		**out = **in
+	}
+	if in.Proxmox != nil {
+		in, out := &in.Proxmox, &out.Proxmox
+		*out = new(v1alpha1.ProxmoxProviderConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VSphere != nil {
+		in, out := &in.VSphere, &out.VSphere
+		*out = new(v1alpha1.VSphereProviderConfig)
+		**out = **in
+	}
+	if in.OpenStack != nil {
+		in, out := &in.OpenStack, &out.OpenStack
+		*out = new(v1alpha1.OpenStackProviderConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BareMetal != nil {
+		in, out := &in.BareMetal, &out.BareMetal
+		*out = new(v1alpha1.BareMetalProviderConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(v1alpha1.AzureProviderConfig)
+		**out = **in
+	}
+	if in.AWS != nil {
+		in, out := &in.AWS, &out.AWS
+		*out = new(v1alpha1.AWSProviderConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GCP != nil {
+		in, out := &in.GCP, &out.GCP
+		*out = new(v1alpha1.GCPProviderConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = new(v1alpha1.ProviderConfigScope)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(v1alpha1.ProviderNetworkConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = new(v1alpha1.ProviderLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Bastion != nil {
+		in, out := &in.Bastion, &out.Bastion
+		*out = new(v1alpha1.BastionConfig)
+		**out = **in
+	}
+	if in.ImageCache != nil {
+		in, out := &in.ImageCache, &out.ImageCache
+		*out = new(v1alpha1.ImageCacheConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientRateLimit != nil {
+		in, out := &in.ClientRateLimit, &out.ClientRateLimit
+		*out = new(v1alpha1.ProviderClientRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FreezeWindows != nil {
+		in, out := &in.FreezeWindows, &out.FreezeWindows
+		*out = make([]v1alpha1.FreezeWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FailureDomains != nil {
+		in, out := &in.FailureDomains, &out.FailureDomains
+		*out = make([]v1alpha1.FailureDomain, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigSpec.
+func (in *ProviderConfigSpec) DeepCopy() *ProviderConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderConfigStatus) DeepCopyInto(out *ProviderConfigStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastValidationTime != nil {
+		in, out := &in.LastValidationTime, &out.LastValidationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastProbeTime != nil {
+		in, out := &in.LastProbeTime, &out.LastProbeTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = new(v1alpha1.ProviderCapacity)
+		**out = **in
+	}
+	if in.CredentialExpiry != nil {
+		in, out := &in.CredentialExpiry, &out.CredentialExpiry
+		*out = new(v1alpha1.CredentialExpiryStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImageCache != nil {
+		in, out := &in.ImageCache, &out.ImageCache
+		*out = new(v1alpha1.ImageCacheStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Reconcile != nil {
+		in, out := &in.Reconcile, &out.Reconcile
+		*out = new(v1alpha1.ReconcileStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigStatus.
+func (in *ProviderConfigStatus) DeepCopy() *ProviderConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Team) DeepCopyInto(out *Team) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Team.
+func (in *Team) DeepCopy() *Team {
+	if in == nil {
+		return nil
+	}
+	out := new(Team)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Team) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamList) DeepCopyInto(out *TeamList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Team, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamList.
+func (in *TeamList) DeepCopy() *TeamList {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TeamList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamSpec) DeepCopyInto(out *TeamSpec) {
+	*out = *in
+	in.Access.DeepCopyInto(&out.Access)
+	if in.ResourceLimits != nil {
+		in, out := &in.ResourceLimits, &out.ResourceLimits
+		*out = new(v1alpha1.TeamResourceLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProviderConfigRef != nil {
+		in, out := &in.ProviderConfigRef, &out.ProviderConfigRef
+		*out = new(v1alpha1.LocalObjectReference)
+		**out = **in
+	}
+	if in.ClusterDefaults != nil {
+		in, out := &in.ClusterDefaults, &out.ClusterDefaults
+		*out = new(v1alpha1.ClusterDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Environments != nil {
+		in, out := &in.Environments, &out.Environments
+		*out = make([]v1alpha1.EnvironmentSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DeletionGracePeriod != nil {
+		in, out := &in.DeletionGracePeriod, &out.DeletionGracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Bootstrap != nil {
+		in, out := &in.Bootstrap, &out.Bootstrap
+		*out = new(v1alpha1.TeamBootstrapSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamSpec.
+func (in *TeamSpec) DeepCopy() *TeamSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamStatus) DeepCopyInto(out *TeamStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ResourceUsage != nil {
+		in, out := &in.ResourceUsage, &out.ResourceUsage
+		*out = new(v1alpha1.TeamResourceUsage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BlockingResources != nil {
+		in, out := &in.BlockingResources, &out.BlockingResources
+		*out = make([]v1alpha1.TeamResourceReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeletionScheduledAt != nil {
+		in, out := &in.DeletionScheduledAt, &out.DeletionScheduledAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Bootstrap != nil {
+		in, out := &in.Bootstrap, &out.Bootstrap
+		*out = make([]v1alpha1.TeamBootstrapItemStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Summary != nil {
+		in, out := &in.Summary, &out.Summary
+		*out = new(v1alpha1.StatusSummary)
+		**out = **in
+	}
+	if in.Reconcile != nil {
+		in, out := &in.Reconcile, &out.Reconcile
+		*out = new(v1alpha1.ReconcileStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamStatus.
+func (in *TeamStatus) DeepCopy() *TeamStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantCluster) DeepCopyInto(out *TenantCluster) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantCluster.
+func (in *TenantCluster) DeepCopy() *TenantCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TenantCluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantClusterList) DeepCopyInto(out *TenantClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TenantCluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantClusterList.
+func (in *TenantClusterList) DeepCopy() *TenantClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TenantClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantClusterSpec) DeepCopyInto(out *TenantClusterSpec) {
+	*out = *in
+	if in.TeamRef != nil {
+		in, out := &in.TeamRef, &out.TeamRef
+		*out = new(v1alpha1.LocalObjectReference)
+		**out = **in
+	}
+	if in.ProviderConfigRef != nil {
+		in, out := &in.ProviderConfigRef, &out.ProviderConfigRef
+		*out = new(v1alpha1.ProviderReference)
+		**out = **in
+	}
+	if in.Virtual != nil {
+		in, out := &in.Virtual, &out.Virtual
+		*out = new(v1alpha1.VirtualClusterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.ControlPlane.DeepCopyInto(&out.ControlPlane)
+	in.Workers.DeepCopyInto(&out.Workers)
+	if in.WorkerPools != nil {
+		in, out := &in.WorkerPools, &out.WorkerPools
+		*out = make([]v1alpha1.WorkerPoolSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RebootPolicy != nil {
+		in, out := &in.RebootPolicy, &out.RebootPolicy
+		*out = new(v1alpha1.RebootPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(v1alpha1.HibernationSpec)
+		**out = **in
+	}
+	in.Networking.DeepCopyInto(&out.Networking)
+	out.ManagementPolicy = in.ManagementPolicy
+	in.Addons.DeepCopyInto(&out.Addons)
+	if in.TimeServers != nil {
+		in, out := &in.TimeServers, &out.TimeServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InfrastructureOverride != nil {
+		in, out := &in.InfrastructureOverride, &out.InfrastructureOverride
+		*out = new(v1alpha1.InfrastructureOverride)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Workspaces != nil {
+		in, out := &in.Workspaces, &out.Workspaces
+		*out = new(v1alpha1.WorkspacesConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccessGrants != nil {
+		in, out := &in.AccessGrants, &out.AccessGrants
+		*out = make([]v1alpha1.AccessGrant, len(*in))
+		copy(*out, *in)
+	}
+	if in.SLO != nil {
+		in, out := &in.SLO, &out.SLO
+		*out = new(v1alpha1.SLOSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterMetadata != nil {
+		in, out := &in.ClusterMetadata, &out.ClusterMetadata
+		*out = new(v1alpha1.ClusterMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PolicyExceptions != nil {
+		in, out := &in.PolicyExceptions, &out.PolicyExceptions
+		*out = make([]v1alpha1.PolicyException, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Ownership != nil {
+		in, out := &in.Ownership, &out.Ownership
+		*out = new(v1alpha1.OwnershipSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantClusterSpec.
+func (in *TenantClusterSpec) DeepCopy() *TenantClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantClusterStatus) DeepCopyInto(out *TenantClusterStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(v1alpha1.EncryptionStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.APIServerAccess != nil {
+		in, out := &in.APIServerAccess, &out.APIServerAccess
+		*out = new(v1alpha1.APIServerAccessStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubeconfigSecretRef != nil {
+		in, out := &in.KubeconfigSecretRef, &out.KubeconfigSecretRef
+		*out = new(v1alpha1.LocalObjectReference)
+		**out = **in
+	}
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ObservedState != nil {
+		in, out := &in.ObservedState, &out.ObservedState
+		*out = new(v1alpha1.ObservedClusterState)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPAllocationRef != nil {
+		in, out := &in.IPAllocationRef, &out.IPAllocationRef
+		*out = new(v1alpha1.LocalObjectReference)
+		**out = **in
+	}
+	if in.VIPAllocationRef != nil {
+		in, out := &in.VIPAllocationRef, &out.VIPAllocationRef
+		*out = new(v1alpha1.LocalObjectReference)
+		**out = **in
+	}
+	if in.LBAllocationRef != nil {
+		in, out := &in.LBAllocationRef, &out.LBAllocationRef
+		*out = new(v1alpha1.LocalObjectReference)
+		**out = **in
+	}
+	if in.ImageSyncRef != nil {
+		in, out := &in.ImageSyncRef, &out.ImageSyncRef
+		*out = new(v1alpha1.LocalObjectReference)
+		**out = **in
+	}
+	if in.CAPIRefs != nil {
+		in, out := &in.CAPIRefs, &out.CAPIRefs
+		*out = new(v1alpha1.CAPIObjectRefs)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkerRollout != nil {
+		in, out := &in.WorkerRollout, &out.WorkerRollout
+		*out = new(v1alpha1.RolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Preflight != nil {
+		in, out := &in.Preflight, &out.Preflight
+		*out = new(v1alpha1.PreflightStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(v1alpha1.HibernationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Reboot != nil {
+		in, out := &in.Reboot, &out.Reboot
+		*out = new(v1alpha1.RebootStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkerFailureDomains != nil {
+		in, out := &in.WorkerFailureDomains, &out.WorkerFailureDomains
+		*out = make([]v1alpha1.WorkerPoolFailureDomains, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AccessGrants != nil {
+		in, out := &in.AccessGrants, &out.AccessGrants
+		*out = make([]v1alpha1.AccessGrantStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PolicyExceptions != nil {
+		in, out := &in.PolicyExceptions, &out.PolicyExceptions
+		*out = make([]v1alpha1.PolicyExceptionStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ResourceRefs != nil {
+		in, out := &in.ResourceRefs, &out.ResourceRefs
+		*out = make([]v1alpha1.GeneratedResourceRef, len(*in))
+		copy(*out, *in)
+	}
+	if in.StaticLoadBalancerIPs != nil {
+		in, out := &in.StaticLoadBalancerIPs, &out.StaticLoadBalancerIPs
+		*out = make([]v1alpha1.StaticLoadBalancerIPStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClusterMesh != nil {
+		in, out := &in.ClusterMesh, &out.ClusterMesh
+		*out = new(v1alpha1.ClusterMeshStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Summary != nil {
+		in, out := &in.Summary, &out.Summary
+		*out = new(v1alpha1.StatusSummary)
+		**out = **in
+	}
+	if in.SLO != nil {
+		in, out := &in.SLO, &out.SLO
+		*out = new(v1alpha1.SLOStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Reconcile != nil {
+		in, out := &in.Reconcile, &out.Reconcile
+		*out = new(v1alpha1.ReconcileStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantClusterStatus.
+func (in *TenantClusterStatus) DeepCopy() *TenantClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}